@@ -2,104 +2,306 @@ package auth
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"math/rand"
 	"os"
+	"strconv"
+	"sync/atomic"
 	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/status"
 
-	"github.com/getsentry/sentry-go"
 	acl "github.com/ory/keto/proto/ory/keto/relation_tuples/v1alpha2"
 )
 
-func CheckPermission(nameSpace string, object string, relation string, subject string) (bool, error) {
-	conn, err := grpc.Dial(os.Getenv("KETO_READ_API"), grpc.WithInsecure())
-	if err != nil {
-		sentry.CaptureException(err)
-		defer sentry.Flush(2 * time.Second)
-		return false, err
+const (
+	defaultKetoTimeout    = 5 * time.Second
+	defaultKetoMaxRetries = 3
+	ketoBaseBackoff       = 100 * time.Millisecond
+	ketoMaxBackoff        = 2 * time.Second
+)
+
+var (
+	// ErrKetoUnavailable is returned when Keto could not be reached, also after retrying
+	ErrKetoUnavailable = errors.New("keto is unavailable")
+
+	// ErrKetoInvalidTuple is returned when Keto rejects the relation tuple
+	ErrKetoInvalidTuple = errors.New("invalid relation tuple")
+
+	// ErrKetoClosed is returned when the client is used after Close
+	ErrKetoClosed = errors.New("keto client is closed")
+)
+
+// KetoError is returned by every KetoClient call that fails. It matches
+// ErrKetoUnavailable or ErrKetoInvalidTuple with errors.Is depending on the
+// gRPC status code.
+type KetoError struct {
+	Op   string
+	Code codes.Code
+	Err  error
+}
+
+func (e *KetoError) Error() string {
+	return fmt.Sprintf("keto %s: %v", e.Op, e.Err)
+}
+
+func (e *KetoError) Unwrap() error {
+	return e.Err
+}
+
+func (e *KetoError) Is(target error) bool {
+	switch target {
+	case ErrKetoUnavailable:
+		return retryable(e.Code)
+	case ErrKetoInvalidTuple:
+		return e.Code == codes.InvalidArgument || e.Code == codes.NotFound
 	}
 
-	client := acl.NewCheckServiceClient(conn)
+	return false
+}
 
-	res, err := client.Check(context.Background(), &acl.CheckRequest{
-		Namespace: nameSpace,
-		Object:    object,
-		Relation:  relation,
-		Subject:   acl.NewSubjectID(subject),
-	})
+// RelationTuple grants Relation (the permission) on Object in Namespace to the Subject (user ID)
+type RelationTuple struct {
+	Namespace string
+	Object    string
+	Relation  string
+	Subject   string
+}
 
-	if err != nil {
-		return false, err
+func (t RelationTuple) proto() *acl.RelationTuple {
+	return &acl.RelationTuple{
+		Namespace: t.Namespace,
+		Object:    t.Object,
+		Relation:  t.Relation,
+		Subject:   acl.NewSubjectID(t.Subject),
 	}
+}
+
+type KetoConfig struct {
+	ReadAddress  string
+	WriteAddress string
+
+	// Timeout bounds each attempt when the context has no earlier deadline
+	Timeout time.Duration
 
-	if res.Allowed {
-		return true, nil
-	} else {
-		return false, nil
+	// MaxRetries is the number of retries after the first attempt on transient errors
+	MaxRetries int
+}
+
+// KetoConfigFromEnv reads KETO_READ_API, KETO_WRITE_API, KETO_TIMEOUT (seconds)
+// and KETO_MAX_RETRIES
+func KetoConfigFromEnv() (KetoConfig, error) {
+	config := KetoConfig{
+		ReadAddress:  os.Getenv("KETO_READ_API"),
+		WriteAddress: os.Getenv("KETO_WRITE_API"),
+		Timeout:      defaultKetoTimeout,
+		MaxRetries:   defaultKetoMaxRetries,
+	}
+
+	if value := os.Getenv("KETO_TIMEOUT"); value != "" {
+		seconds, err := strconv.Atoi(value)
+		if err != nil {
+			return config, fmt.Errorf("unable to convert keto timeout to int: %w", err)
+		}
+		config.Timeout = time.Duration(seconds) * time.Second
+	}
+
+	if value := os.Getenv("KETO_MAX_RETRIES"); value != "" {
+		retries, err := strconv.Atoi(value)
+		if err != nil {
+			return config, fmt.Errorf("unable to convert keto max retries to int: %w", err)
+		}
+		config.MaxRetries = retries
 	}
+
+	return config, nil
 }
 
-func GrantPermission(nameSpace string, object string, relation string, subject string) (bool, error) {
-	conn, err := grpc.Dial(os.Getenv("KETO_WRITE_API"), grpc.WithInsecure())
+// KetoClient keeps one gRPC connection to the Keto read API and one to the
+// write API for the lifetime of the service. The connections reconnect on
+// their own, so the client is created once at startup and closed on shutdown.
+type KetoClient struct {
+	config    KetoConfig
+	readConn  *grpc.ClientConn
+	writeConn *grpc.ClientConn
+	check     acl.CheckServiceClient
+	write     acl.WriteServiceClient
+	closed    atomic.Bool
+}
+
+// NewKetoClient sets up the connections without waiting for Keto to answer;
+// use Health to find out whether it is reachable
+func NewKetoClient(config KetoConfig) (*KetoClient, error) {
+	if config.ReadAddress == "" || config.WriteAddress == "" {
+		return nil, fmt.Errorf("keto read and write addresses are required")
+	}
+
+	if config.Timeout <= 0 {
+		config.Timeout = defaultKetoTimeout
+	}
+
+	if config.MaxRetries < 0 {
+		config.MaxRetries = 0
+	}
+
+	readConn, err := dial(config.ReadAddress)
 	if err != nil {
-		sentry.CaptureException(err)
-		defer sentry.Flush(2 * time.Second)
-		panic("Encountered error: " + err.Error())
-	}
-
-	client := acl.NewWriteServiceClient(conn)
-
-	_, err = client.TransactRelationTuples(context.Background(), &acl.TransactRelationTuplesRequest{
-		RelationTupleDeltas: []*acl.RelationTupleDelta{
-			{
-				Action: acl.RelationTupleDelta_ACTION_INSERT,
-				RelationTuple: &acl.RelationTuple{
-					Namespace: nameSpace,
-					Object:    object,
-					Relation:  relation,
-					Subject:   acl.NewSubjectID(subject),
-				},
-			},
-		},
-	})
+		return nil, fmt.Errorf("unable to set up keto read connection: %w", err)
+	}
 
+	writeConn, err := dial(config.WriteAddress)
 	if err != nil {
-		return false, err
-	} else {
-		return true, nil
+		_ = readConn.Close()
+		return nil, fmt.Errorf("unable to set up keto write connection: %w", err)
 	}
 
+	return &KetoClient{
+		config:    config,
+		readConn:  readConn,
+		writeConn: writeConn,
+		check:     acl.NewCheckServiceClient(readConn),
+		write:     acl.NewWriteServiceClient(writeConn),
+	}, nil
 }
 
-// delete permission
-func RevokePermission(nameSpace string, object string, relation string, subject string) (bool, error) {
-	conn, err := grpc.Dial(os.Getenv("KETO_WRITE_API"), grpc.WithInsecure())
-	if err != nil {
-		sentry.CaptureException(err)
-		defer sentry.Flush(2 * time.Second)
-		panic("Encountered error: " + err.Error())
-	}
-
-	client := acl.NewWriteServiceClient(conn)
-
-	_, err = client.TransactRelationTuples(context.Background(), &acl.TransactRelationTuplesRequest{
-		RelationTupleDeltas: []*acl.RelationTupleDelta{
-			{
-				Action: acl.RelationTupleDelta_ACTION_DELETE,
-				RelationTuple: &acl.RelationTuple{
-					Namespace: nameSpace,
-					Object:    object,
-					Relation:  relation,
-					Subject:   acl.NewSubjectID(subject),
+func dial(address string) (*grpc.ClientConn, error) {
+	return grpc.Dial(
+		address,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:                30 * time.Second,
+			Timeout:             10 * time.Second,
+			PermitWithoutStream: true,
+		}),
+	)
+}
+
+// Check reports whether the relation tuple exists
+func (c *KetoClient) Check(ctx context.Context, tuple RelationTuple) (bool, error) {
+	var allowed bool
+
+	err := c.call(ctx, "check", func(ctx context.Context) error {
+		response, err := c.check.Check(ctx, &acl.CheckRequest{
+			Namespace: tuple.Namespace,
+			Object:    tuple.Object,
+			Relation:  tuple.Relation,
+			Subject:   acl.NewSubjectID(tuple.Subject),
+		})
+		if err != nil {
+			return err
+		}
+
+		allowed = response.Allowed
+		return nil
+	})
+
+	return allowed, err
+}
+
+// Grant inserts the relation tuple; inserting an existing tuple is a no-op
+func (c *KetoClient) Grant(ctx context.Context, tuple RelationTuple) error {
+	return c.transact(ctx, "grant", acl.RelationTupleDelta_ACTION_INSERT, tuple)
+}
+
+// Revoke deletes the relation tuple; deleting a missing tuple is a no-op
+func (c *KetoClient) Revoke(ctx context.Context, tuple RelationTuple) error {
+	return c.transact(ctx, "revoke", acl.RelationTupleDelta_ACTION_DELETE, tuple)
+}
+
+func (c *KetoClient) transact(ctx context.Context, op string, action acl.RelationTupleDelta_Action, tuple RelationTuple) error {
+	return c.call(ctx, op, func(ctx context.Context) error {
+		_, err := c.write.TransactRelationTuples(ctx, &acl.TransactRelationTuplesRequest{
+			RelationTupleDeltas: []*acl.RelationTupleDelta{
+				{
+					Action:        action,
+					RelationTuple: tuple.proto(),
 				},
 			},
-		},
+		})
+		return err
 	})
+}
 
-	if err != nil {
-		return false, err
-	} else {
-		return true, nil
+// Health checks the gRPC health service of the read and the write API
+func (c *KetoClient) Health(ctx context.Context) error {
+	for name, conn := range map[string]*grpc.ClientConn{"read": c.readConn, "write": c.writeConn} {
+		response, err := grpc_health_v1.NewHealthClient(conn).Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+		if err != nil {
+			return &KetoError{Op: name + " health", Code: status.Code(err), Err: err}
+		}
+
+		if response.Status != grpc_health_v1.HealthCheckResponse_SERVING {
+			return &KetoError{Op: name + " health", Code: codes.Unavailable, Err: fmt.Errorf("status %s", response.Status)}
+		}
+	}
+
+	return nil
+}
+
+func (c *KetoClient) Close() error {
+	c.closed.Store(true)
+
+	readErr := c.readConn.Close()
+	writeErr := c.writeConn.Close()
+	if readErr != nil {
+		return readErr
+	}
+
+	return writeErr
+}
+
+// call runs f with a deadline per attempt and retries transient failures
+// with exponential backoff until MaxRetries or the context is done
+func (c *KetoClient) call(ctx context.Context, op string, f func(ctx context.Context) error) error {
+	if c.closed.Load() {
+		return &KetoError{Op: op, Code: codes.Canceled, Err: ErrKetoClosed}
+	}
+
+	var err error
+
+	for attempt := 0; ; attempt++ {
+		attemptContext, cancel := context.WithTimeout(ctx, c.config.Timeout)
+		err = f(attemptContext)
+		cancel()
+
+		if err == nil {
+			return nil
+		}
+
+		code := status.Code(err)
+		if !retryable(code) || attempt >= c.config.MaxRetries || ctx.Err() != nil {
+			return &KetoError{Op: op, Code: code, Err: err}
+		}
+
+		select {
+		case <-time.After(backoff(attempt)):
+		case <-ctx.Done():
+			return &KetoError{Op: op, Code: status.FromContextError(ctx.Err()).Code(), Err: err}
+		}
+	}
+}
+
+func retryable(code codes.Code) bool {
+	switch code {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted:
+		return true
+	}
+
+	return false
+}
+
+// backoff doubles the wait on each attempt, with jitter, up to ketoMaxBackoff
+func backoff(attempt int) time.Duration {
+	wait := ketoBaseBackoff << attempt
+	if wait > ketoMaxBackoff || wait <= 0 {
+		wait = ketoMaxBackoff
 	}
 
+	return wait/2 + time.Duration(rand.Int63n(int64(wait/2)+1))
 }
@@ -0,0 +1,11 @@
+CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
+
+CREATE TABLE IF NOT EXISTS granted_permissions (
+    id text,
+    name_space text,
+    user_id text,
+    permission text,
+    object text,
+    granted_at timestamp with time zone,
+    PRIMARY KEY (id)
+);
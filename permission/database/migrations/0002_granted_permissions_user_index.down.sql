@@ -0,0 +1 @@
+DROP INDEX IF EXISTS granted_permissions_user_id_idx;
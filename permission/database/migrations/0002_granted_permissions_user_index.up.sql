@@ -0,0 +1 @@
+CREATE INDEX IF NOT EXISTS granted_permissions_user_id_idx ON granted_permissions (user_id);
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"permissions_aws/graph/model"
 	"sync"
 	"time"
 
@@ -18,9 +17,12 @@ type DatabaseParameters struct {
 	port     string
 	name     string
 	timeout  time.Duration
+
+	// dsn replaces the connection string built from the fields above
+	dsn string
 }
 
-// DatabaseCredentials is a set of dynamic credentials retrieved from Vault
+// DatabaseCredentials is a set of credentials retrieved from a CredentialsProvider
 type DatabaseCredentials struct {
 	Username string `json:"username"`
 	Password string `json:"password"`
@@ -32,7 +34,7 @@ type Database struct {
 	parameters      DatabaseParameters
 }
 
-// NewDatabase establishes a database connection with the given Vault credentials
+// NewDatabase establishes a database connection with the given credentials
 func NewDatabase(ctx context.Context, parameters DatabaseParameters, credentials DatabaseCredentials) (*Database, error) {
 	database := &Database{
 		connection:      nil,
@@ -73,6 +75,9 @@ func (db *Database) Reconnect(ctx context.Context, credentials DatabaseCredentia
 		credentials.Username,
 		credentials.Password,
 	)
+	if db.parameters.dsn != "" {
+		connectionString = db.parameters.dsn
+	}
 
 	connection, err := gorm.Open(postgres.Open(connectionString), &gorm.Config{
 		SkipDefaultTransaction: true,
@@ -145,6 +150,23 @@ func (db *Database) Close() error {
 	return nil
 }
 
+// Ping verifies that the current connection is still alive
+func (db *Database) Ping(ctx context.Context) error {
+	/* */ db.connectionMutex.Lock()
+	defer db.connectionMutex.Unlock()
+
+	if db.connection == nil {
+		return fmt.Errorf("database connection is not established")
+	}
+
+	sqlDB, err := db.connection.DB()
+	if err != nil {
+		return err
+	}
+
+	return sqlDB.PingContext(ctx)
+}
+
 // share the database connection with other packages by using a global variable
 var dbConn gorm.DB
 
@@ -159,12 +181,3 @@ func (db *Database) GetConnection() {
 func GetOpenConnection() *gorm.DB {
 	return &dbConn
 }
-
-// Use models to create tables
-func CreateTables() {
-	db := GetOpenConnection()
-	// run command to generate uuid extension
-	// CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
-	db.Exec("CREATE EXTENSION IF NOT EXISTS \"uuid-ossp\";")
-	db.AutoMigrate(model.GrantedPermission{})
-}
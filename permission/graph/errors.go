@@ -0,0 +1,25 @@
+package graph
+
+import (
+	"context"
+	"permissions_aws/util"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// ErrorPresenter adds the ErrorCode of the error to the "code" extension of
+// every GraphQL error; errors of the request itself (parsing, validation)
+// already carry a code from gqlgen and keep it
+func ErrorPresenter(ctx context.Context, err error) *gqlerror.Error {
+	presented := graphql.DefaultErrorPresenter(ctx, err)
+
+	if presented.Extensions == nil {
+		presented.Extensions = map[string]interface{}{}
+	}
+	if _, ok := presented.Extensions["code"]; !ok {
+		presented.Extensions["code"] = util.ErrorCodeOf(err)
+	}
+
+	return presented
+}
@@ -75,8 +75,17 @@ type ComplexityRoot struct {
 		RevokePermission func(childComplexity int, id string) int
 	}
 
+	PermissionCheckResult struct {
+		Allowed    func(childComplexity int) int
+		NameSpace  func(childComplexity int) int
+		Object     func(childComplexity int) int
+		Permission func(childComplexity int) int
+		UserID     func(childComplexity int) int
+	}
+
 	Query struct {
 		CheckPermission          func(childComplexity int, nameSpace model.NameSpaceEnum, userID string, permission model.PermissionEnum, object string) int
+		CheckPermissions         func(childComplexity int, checks []*model.PermissionCheckInput) int
 		GetAllGrantedPermissions func(childComplexity int) int
 		GetGrantedPermissions    func(childComplexity int, userID string) int
 	}
@@ -97,6 +106,7 @@ type QueryResolver interface {
 	GetGrantedPermissions(ctx context.Context, userID string) (*model.GetGrantedPermissionsResponse, error)
 	GetAllGrantedPermissions(ctx context.Context) ([]*model.GrantedPermissionResponse, error)
 	CheckPermission(ctx context.Context, nameSpace model.NameSpaceEnum, userID string, permission model.PermissionEnum, object string) (bool, error)
+	CheckPermissions(ctx context.Context, checks []*model.PermissionCheckInput) ([]*model.PermissionCheckResult, error)
 }
 
 type executableSchema struct {
@@ -250,6 +260,41 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.RevokePermission(childComplexity, args["id"].(string)), true
 
+	case "PermissionCheckResult.allowed":
+		if e.complexity.PermissionCheckResult.Allowed == nil {
+			break
+		}
+
+		return e.complexity.PermissionCheckResult.Allowed(childComplexity), true
+
+	case "PermissionCheckResult.nameSpace":
+		if e.complexity.PermissionCheckResult.NameSpace == nil {
+			break
+		}
+
+		return e.complexity.PermissionCheckResult.NameSpace(childComplexity), true
+
+	case "PermissionCheckResult.object":
+		if e.complexity.PermissionCheckResult.Object == nil {
+			break
+		}
+
+		return e.complexity.PermissionCheckResult.Object(childComplexity), true
+
+	case "PermissionCheckResult.permission":
+		if e.complexity.PermissionCheckResult.Permission == nil {
+			break
+		}
+
+		return e.complexity.PermissionCheckResult.Permission(childComplexity), true
+
+	case "PermissionCheckResult.userId":
+		if e.complexity.PermissionCheckResult.UserID == nil {
+			break
+		}
+
+		return e.complexity.PermissionCheckResult.UserID(childComplexity), true
+
 	case "Query.CheckPermission":
 		if e.complexity.Query.CheckPermission == nil {
 			break
@@ -262,6 +307,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.CheckPermission(childComplexity, args["NameSpace"].(model.NameSpaceEnum), args["userId"].(string), args["permission"].(model.PermissionEnum), args["object"].(string)), true
 
+	case "Query.checkPermissions":
+		if e.complexity.Query.CheckPermissions == nil {
+			break
+		}
+
+		args, err := ec.field_Query_checkPermissions_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.CheckPermissions(childComplexity, args["checks"].([]*model.PermissionCheckInput)), true
+
 	case "Query.getAllGrantedPermissions":
 		if e.complexity.Query.GetAllGrantedPermissions == nil {
 			break
@@ -318,6 +375,7 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 	ec := executionContext{rc, e}
 	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
 		ec.unmarshalInputGrantedPermissionInput,
+		ec.unmarshalInputPermissionCheckInput,
 	)
 	first := true
 
@@ -422,6 +480,21 @@ type GetGrantedPermissionsResponse {
   permissions: [GrantedPermission!]!
 }
 
+input PermissionCheckInput {
+  nameSpace: NameSpaceEnum!
+  userId: ID!
+  permission: PermissionEnum!
+  object: ID!
+}
+
+type PermissionCheckResult {
+  nameSpace: NameSpaceEnum!
+  userId: ID!
+  permission: PermissionEnum!
+  object: ID!
+  allowed: Boolean!
+}
+
 type User {
   id: ID
   email: String
@@ -465,6 +538,10 @@ type Query {
     permission: PermissionEnum!
     object: ID!
   ): Boolean!
+  """
+  answers many (subject, relation, object) tuples in one call, in the order of the checks
+  """
+  checkPermissions(checks: [PermissionCheckInput!]!): [PermissionCheckResult!]!
 }
 `, BuiltIn: false},
 }
@@ -561,6 +638,21 @@ func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_checkPermissions_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 []*model.PermissionCheckInput
+	if tmp, ok := rawArgs["checks"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("checks"))
+		arg0, err = ec.unmarshalNPermissionCheckInput2ᚕᚖpermissions_awsᚋgraphᚋmodelᚐPermissionCheckInputᚄ(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["checks"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Query_getGrantedPermissions_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -1442,6 +1534,226 @@ func (ec *executionContext) fieldContext_Mutation_revokePermission(ctx context.C
 	return fc, nil
 }
 
+func (ec *executionContext) _PermissionCheckResult_nameSpace(ctx context.Context, field graphql.CollectedField, obj *model.PermissionCheckResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PermissionCheckResult_nameSpace(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.NameSpace, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.NameSpaceEnum)
+	fc.Result = res
+	return ec.marshalNNameSpaceEnum2permissions_awsᚋgraphᚋmodelᚐNameSpaceEnum(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PermissionCheckResult_nameSpace(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PermissionCheckResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type NameSpaceEnum does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PermissionCheckResult_userId(ctx context.Context, field graphql.CollectedField, obj *model.PermissionCheckResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PermissionCheckResult_userId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.UserID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PermissionCheckResult_userId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PermissionCheckResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PermissionCheckResult_permission(ctx context.Context, field graphql.CollectedField, obj *model.PermissionCheckResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PermissionCheckResult_permission(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Permission, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.PermissionEnum)
+	fc.Result = res
+	return ec.marshalNPermissionEnum2permissions_awsᚋgraphᚋmodelᚐPermissionEnum(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PermissionCheckResult_permission(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PermissionCheckResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type PermissionEnum does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PermissionCheckResult_object(ctx context.Context, field graphql.CollectedField, obj *model.PermissionCheckResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PermissionCheckResult_object(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Object, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PermissionCheckResult_object(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PermissionCheckResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PermissionCheckResult_allowed(ctx context.Context, field graphql.CollectedField, obj *model.PermissionCheckResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PermissionCheckResult_allowed(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Allowed, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PermissionCheckResult_allowed(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PermissionCheckResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Query_getGrantedPermissions(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_Query_getGrantedPermissions(ctx, field)
 	if err != nil {
@@ -1620,6 +1932,73 @@ func (ec *executionContext) fieldContext_Query_CheckPermission(ctx context.Conte
 	return fc, nil
 }
 
+func (ec *executionContext) _Query_checkPermissions(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_checkPermissions(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().CheckPermissions(rctx, fc.Args["checks"].([]*model.PermissionCheckInput))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.PermissionCheckResult)
+	fc.Result = res
+	return ec.marshalNPermissionCheckResult2ᚕᚖpermissions_awsᚋgraphᚋmodelᚐPermissionCheckResultᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_checkPermissions(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "nameSpace":
+				return ec.fieldContext_PermissionCheckResult_nameSpace(ctx, field)
+			case "userId":
+				return ec.fieldContext_PermissionCheckResult_userId(ctx, field)
+			case "permission":
+				return ec.fieldContext_PermissionCheckResult_permission(ctx, field)
+			case "object":
+				return ec.fieldContext_PermissionCheckResult_object(ctx, field)
+			case "allowed":
+				return ec.fieldContext_PermissionCheckResult_allowed(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PermissionCheckResult", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_checkPermissions_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_Query___type(ctx, field)
 	if err != nil {
@@ -3738,6 +4117,58 @@ func (ec *executionContext) unmarshalInputGrantedPermissionInput(ctx context.Con
 	return it, nil
 }
 
+func (ec *executionContext) unmarshalInputPermissionCheckInput(ctx context.Context, obj interface{}) (model.PermissionCheckInput, error) {
+	var it model.PermissionCheckInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"nameSpace", "userId", "permission", "object"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "nameSpace":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("nameSpace"))
+			it.NameSpace, err = ec.unmarshalNNameSpaceEnum2permissions_awsᚋgraphᚋmodelᚐNameSpaceEnum(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "userId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("userId"))
+			it.UserID, err = ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "permission":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("permission"))
+			it.Permission, err = ec.unmarshalNPermissionEnum2permissions_awsᚋgraphᚋmodelᚐPermissionEnum(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "object":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("object"))
+			it.Object, err = ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
 // endregion **************************** input.gotpl *****************************
 
 // region    ************************** interface.gotpl ***************************
@@ -3945,6 +4376,62 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 	return out
 }
 
+var permissionCheckResultImplementors = []string{"PermissionCheckResult"}
+
+func (ec *executionContext) _PermissionCheckResult(ctx context.Context, sel ast.SelectionSet, obj *model.PermissionCheckResult) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, permissionCheckResultImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PermissionCheckResult")
+		case "nameSpace":
+
+			out.Values[i] = ec._PermissionCheckResult_nameSpace(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "userId":
+
+			out.Values[i] = ec._PermissionCheckResult_userId(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "permission":
+
+			out.Values[i] = ec._PermissionCheckResult_permission(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "object":
+
+			out.Values[i] = ec._PermissionCheckResult_object(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "allowed":
+
+			out.Values[i] = ec._PermissionCheckResult_allowed(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
 var queryImplementors = []string{"Query"}
 
 func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
@@ -4030,6 +4517,29 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
 			}
 
+			out.Concurrently(i, func() graphql.Marshaler {
+				return rrm(innerCtx)
+			})
+		case "checkPermissions":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_checkPermissions(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
+			}
+
 			out.Concurrently(i, func() graphql.Marshaler {
 				return rrm(innerCtx)
 			})
@@ -4576,6 +5086,82 @@ func (ec *executionContext) marshalNNameSpaceEnum2permissions_awsᚋgraphᚋmode
 	return v
 }
 
+func (ec *executionContext) unmarshalNPermissionCheckInput2ᚕᚖpermissions_awsᚋgraphᚋmodelᚐPermissionCheckInputᚄ(ctx context.Context, v interface{}) ([]*model.PermissionCheckInput, error) {
+	var vSlice []interface{}
+	if v != nil {
+		vSlice = graphql.CoerceList(v)
+	}
+	var err error
+	res := make([]*model.PermissionCheckInput, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNPermissionCheckInput2ᚖpermissions_awsᚋgraphᚋmodelᚐPermissionCheckInput(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) unmarshalNPermissionCheckInput2ᚖpermissions_awsᚋgraphᚋmodelᚐPermissionCheckInput(ctx context.Context, v interface{}) (*model.PermissionCheckInput, error) {
+	res, err := ec.unmarshalInputPermissionCheckInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNPermissionCheckResult2ᚕᚖpermissions_awsᚋgraphᚋmodelᚐPermissionCheckResultᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.PermissionCheckResult) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNPermissionCheckResult2ᚖpermissions_awsᚋgraphᚋmodelᚐPermissionCheckResult(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNPermissionCheckResult2ᚖpermissions_awsᚋgraphᚋmodelᚐPermissionCheckResult(ctx context.Context, sel ast.SelectionSet, v *model.PermissionCheckResult) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._PermissionCheckResult(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalNPermissionEnum2permissions_awsᚋgraphᚋmodelᚐPermissionEnum(ctx context.Context, v interface{}) (model.PermissionEnum, error) {
 	var res model.PermissionEnum
 	err := res.UnmarshalGQL(v)
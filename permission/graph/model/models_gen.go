@@ -43,6 +43,21 @@ type GrantedPermissionResponse struct {
 	User         *User      `json:"user"`
 }
 
+type PermissionCheckInput struct {
+	NameSpace  NameSpaceEnum  `json:"nameSpace"`
+	UserID     string         `json:"userId"`
+	Permission PermissionEnum `json:"permission"`
+	Object     string         `json:"object"`
+}
+
+type PermissionCheckResult struct {
+	NameSpace  NameSpaceEnum  `json:"nameSpace"`
+	UserID     string         `json:"userId"`
+	Permission PermissionEnum `json:"permission"`
+	Object     string         `json:"object"`
+	Allowed    bool           `json:"allowed"`
+}
+
 type User struct {
 	ID        *string `json:"id"`
 	Email     *string `json:"email"`
@@ -1,9 +1,15 @@
 package graph
 
 import (
+	"permissions_aws/auth"
+
 	"gorm.io/gorm"
 )
 
+// maxPermissionChecks bounds the number of tuples of one checkPermissions call
+const maxPermissionChecks = 100
+
 type Resolver struct {
-	DB *gorm.DB
+	DB   *gorm.DB
+	Keto *auth.KetoClient
 }
@@ -3,10 +3,12 @@ package graph
 import (
 	"context"
 	"fmt"
+	"log"
 	"permissions_aws/auth"
 	"permissions_aws/graph/generated"
 	"permissions_aws/graph/model"
 	"permissions_aws/util"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
@@ -38,7 +40,7 @@ func (r *mutationResolver) GrantPermission(ctx context.Context, input model.Gran
 	}
 
 	if permission.ID != "" {
-		return nil, fmt.Errorf("Permission already exists: %s", permission.ID)
+		return nil, util.NewError(util.ErrorCodeConflict, fmt.Sprintf("Permission already exists: %s", permission.ID))
 	}
 
 	grantedPermission := &model.GrantedPermission{
@@ -51,14 +53,15 @@ func (r *mutationResolver) GrantPermission(ctx context.Context, input model.Gran
 	}
 
 	// create keto relation tuple
-	result, err := auth.GrantPermission(input.NameSpace.String(), input.Object, input.Permission.String(), input.UserID)
+	err = r.Keto.Grant(ctx, auth.RelationTuple{
+		Namespace: grantedPermission.NameSpace,
+		Object:    grantedPermission.Object,
+		Relation:  grantedPermission.Permission,
+		Subject:   grantedPermission.UserID,
+	})
 	if err != nil {
 		util.SentryLogError(err)
-		return nil, err
-	}
-
-	if !result {
-		return nil, fmt.Errorf("Failed to grant permission (Keto)")
+		return nil, fmt.Errorf("Failed to grant permission (Keto): %w", err)
 	}
 
 	err = r.DB.Create(grantedPermission).Error
@@ -67,7 +70,20 @@ func (r *mutationResolver) GrantPermission(ctx context.Context, input model.Gran
 		return nil, err
 	}
 
-	user, err := util.GetUser(input.UserID)
+	// the callers cache permission checks for a short time, tell them to drop them
+	err = util.PublishPermissionChanged(ctx, util.PermissionChangedEvent{
+		Action:     util.PermissionGranted,
+		NameSpace:  grantedPermission.NameSpace,
+		UserID:     grantedPermission.UserID,
+		Permission: grantedPermission.Permission,
+		Object:     grantedPermission.Object,
+	})
+	if err != nil {
+		util.SentryLogError(err)
+		log.Printf("permission changed event: %v", err)
+	}
+
+	user, err := util.GetUser(ctx, input.UserID)
 
 	if err != nil {
 		util.SentryLogError(err)
@@ -76,7 +92,7 @@ func (r *mutationResolver) GrantPermission(ctx context.Context, input model.Gran
 	}
 
 	if user.ID == nil {
-		return nil, fmt.Errorf("User not found")
+		return nil, util.NewError(util.ErrorCodeNotFound, "User not found")
 	}
 
 	return &model.GrantedPermissionResponse{
@@ -92,7 +108,7 @@ func (r *mutationResolver) GrantPermission(ctx context.Context, input model.Gran
 // RevokePermission is the resolver for the revokePermission field.
 func (r *mutationResolver) RevokePermission(ctx context.Context, id string) (*string, error) {
 	if id == "" {
-		return nil, fmt.Errorf("id is required")
+		return nil, util.NewError(util.ErrorCodeValidation, "id is required")
 	}
 
 	// get granted permission with its permission
@@ -103,16 +119,16 @@ func (r *mutationResolver) RevokePermission(ctx context.Context, id string) (*st
 		return nil, err
 	}
 
-	// TODO: delete the tuple from keto
 	// delete keto relation tuple
-	result, err := auth.RevokePermission(grantedPermission.NameSpace, grantedPermission.Object, grantedPermission.Permission, grantedPermission.UserID)
+	err = r.Keto.Revoke(ctx, auth.RelationTuple{
+		Namespace: grantedPermission.NameSpace,
+		Object:    grantedPermission.Object,
+		Relation:  grantedPermission.Permission,
+		Subject:   grantedPermission.UserID,
+	})
 	if err != nil {
 		util.SentryLogError(err)
-		return nil, err
-	}
-
-	if !result {
-		return nil, fmt.Errorf("Failed to revoke permission (Keto)")
+		return nil, fmt.Errorf("Failed to revoke permission (Keto): %w", err)
 	}
 
 	// delete granted permission
@@ -122,6 +138,18 @@ func (r *mutationResolver) RevokePermission(ctx context.Context, id string) (*st
 		return nil, err
 	}
 
+	err = util.PublishPermissionChanged(ctx, util.PermissionChangedEvent{
+		Action:     util.PermissionRevoked,
+		NameSpace:  grantedPermission.NameSpace,
+		UserID:     grantedPermission.UserID,
+		Permission: grantedPermission.Permission,
+		Object:     grantedPermission.Object,
+	})
+	if err != nil {
+		util.SentryLogError(err)
+		log.Printf("permission changed event: %v", err)
+	}
+
 	message := "Permission revoked successfully"
 
 	return &message, nil
@@ -130,7 +158,7 @@ func (r *mutationResolver) RevokePermission(ctx context.Context, id string) (*st
 // GetGrantedPermissions is the resolver for the getGrantedPermissions field.
 func (r *queryResolver) GetGrantedPermissions(ctx context.Context, userID string) (*model.GetGrantedPermissionsResponse, error) {
 	if userID == "" {
-		return nil, fmt.Errorf("User ID is required")
+		return nil, util.NewError(util.ErrorCodeValidation, "User ID is required")
 	}
 
 	var grantedPermissions []*model.GrantedPermission
@@ -141,7 +169,7 @@ func (r *queryResolver) GetGrantedPermissions(ctx context.Context, userID string
 		return nil, err
 	}
 
-	user, err := util.GetUser(userID)
+	user, err := util.GetUser(ctx, userID)
 	if err != nil {
 		util.SentryLogError(err)
 
@@ -149,7 +177,7 @@ func (r *queryResolver) GetGrantedPermissions(ctx context.Context, userID string
 	}
 
 	if user.ID == nil {
-		return nil, fmt.Errorf("User not found")
+		return nil, util.NewError(util.ErrorCodeNotFound, "User not found")
 	}
 
 	return &model.GetGrantedPermissionsResponse{
@@ -174,7 +202,7 @@ func (r *queryResolver) GetAllGrantedPermissions(ctx context.Context) ([]*model.
 	var grantedPermissionsResponse []*model.GrantedPermissionResponse
 
 	for _, grantedPermission := range grantedPermissions {
-		user, err := util.GetUser(grantedPermission.UserID)
+		user, err := util.GetUser(ctx, grantedPermission.UserID)
 
 		if err != nil {
 			util.SentryLogError(err)
@@ -183,7 +211,7 @@ func (r *queryResolver) GetAllGrantedPermissions(ctx context.Context) ([]*model.
 		}
 
 		if user.ID == nil {
-			return nil, fmt.Errorf("User not found")
+			return nil, util.NewError(util.ErrorCodeNotFound, "User not found")
 		}
 
 		grantedPermissionsResponse = append(grantedPermissionsResponse, &model.GrantedPermissionResponse{
@@ -202,15 +230,20 @@ func (r *queryResolver) GetAllGrantedPermissions(ctx context.Context) ([]*model.
 // CheckPermission is the resolver for the CheckPermission field.
 func (r *queryResolver) CheckPermission(ctx context.Context, nameSpace model.NameSpaceEnum, userID string, permission model.PermissionEnum, object string) (bool, error) {
 	if userID == "" {
-		return false, fmt.Errorf("User ID is required")
+		return false, util.NewError(util.ErrorCodeValidation, "User ID is required")
 	}
 
 	if object == "" {
-		return false, fmt.Errorf("Object is required")
+		return false, util.NewError(util.ErrorCodeValidation, "Object is required")
 	}
 
 	// check permission from keto
-	result, err := auth.CheckPermission(nameSpace.String(), object, permission.String(), userID)
+	result, err := r.Keto.Check(ctx, auth.RelationTuple{
+		Namespace: nameSpace.String(),
+		Object:    object,
+		Relation:  permission.String(),
+		Subject:   userID,
+	})
 	if err != nil {
 		util.SentryLogError(err)
 		return false, err
@@ -219,6 +252,60 @@ func (r *queryResolver) CheckPermission(ctx context.Context, nameSpace model.Nam
 	return result, nil
 }
 
+// CheckPermissions is the resolver for the checkPermissions field.
+func (r *queryResolver) CheckPermissions(ctx context.Context, checks []*model.PermissionCheckInput) ([]*model.PermissionCheckResult, error) {
+	if len(checks) > maxPermissionChecks {
+		return nil, util.NewError(util.ErrorCodeValidation, fmt.Sprintf("At most %d checks are allowed", maxPermissionChecks))
+	}
+
+	for _, check := range checks {
+		if check.UserID == "" {
+			return nil, util.NewError(util.ErrorCodeValidation, "User ID is required")
+		}
+
+		if check.Object == "" {
+			return nil, util.NewError(util.ErrorCodeValidation, "Object is required")
+		}
+	}
+
+	results := make([]*model.PermissionCheckResult, len(checks))
+	errs := make([]error, len(checks))
+
+	// check the tuples from keto concurrently
+	var wg sync.WaitGroup
+	for i, check := range checks {
+		wg.Add(1)
+		go func(i int, check *model.PermissionCheckInput) {
+			defer wg.Done()
+
+			allowed, err := r.Keto.Check(ctx, auth.RelationTuple{
+				Namespace: check.NameSpace.String(),
+				Object:    check.Object,
+				Relation:  check.Permission.String(),
+				Subject:   check.UserID,
+			})
+			errs[i] = err
+			results[i] = &model.PermissionCheckResult{
+				NameSpace:  check.NameSpace,
+				UserID:     check.UserID,
+				Permission: check.Permission,
+				Object:     check.Object,
+				Allowed:    allowed,
+			}
+		}(i, check)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			util.SentryLogError(err)
+			return nil, err
+		}
+	}
+
+	return results, nil
+}
+
 // Mutation returns generated.MutationResolver implementation.
 func (r *Resolver) Mutation() generated.MutationResolver { return &mutationResolver{r} }
 
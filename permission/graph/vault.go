@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"sync"
 
 	"github.com/getsentry/sentry-go"
 	vault "github.com/hashicorp/vault/api"
@@ -27,6 +28,10 @@ type VaultParameters struct {
 type Vault struct {
 	client     *vault.Client
 	parameters VaultParameters
+
+	// result of the last lease renewal, see LeaseStatus
+	leaseStatus      error
+	leaseStatusMutex sync.Mutex
 }
 
 // NewVaultAppRoleClient logs in to Vault using the AppRole authentication
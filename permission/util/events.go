@@ -0,0 +1,66 @@
+package util
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+)
+
+// PermissionChangedTopic is the pub/sub topic the callers subscribe to in
+// order to invalidate their cached permission checks
+const PermissionChangedTopic = "permission.changed"
+
+const (
+	PermissionGranted = "granted"
+	PermissionRevoked = "revoked"
+)
+
+type PermissionChangedEvent struct {
+	Action     string `json:"action"`
+	NameSpace  string `json:"nameSpace"`
+	UserID     string `json:"userId"`
+	Permission string `json:"permission"`
+	Object     string `json:"object"`
+}
+
+var publishClient = &http.Client{Timeout: time.Second * 5}
+
+// PublishPermissionChanged publishes the event through the Dapr sidecar
+func PublishPermissionChanged(ctx context.Context, event PermissionChangedEvent) error {
+	port := os.Getenv("DAPR_HTTP_PORT")
+	if port == "" {
+		port = "3500"
+	}
+	pubsubName := os.Getenv("PUBSUB_NAME")
+	if pubsubName == "" {
+		pubsubName = "pubsub"
+	}
+
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("unable to marshal permission event: %w", err)
+	}
+
+	url := fmt.Sprintf("http://localhost:%s/v1.0/publish/%s/%s", port, pubsubName, PermissionChangedTopic)
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("unable to create publish request: %w", err)
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := publishClient.Do(request)
+	if err != nil {
+		return fmt.Errorf("unable to publish permission event: %w", err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("publishing permission event responded with status %d", response.StatusCode)
+	}
+
+	return nil
+}
@@ -1,11 +1,8 @@
 package util
 
 import (
-	"bytes"
-	"encoding/json"
+	"context"
 	"fmt"
-	"io/ioutil"
-	"net/http"
 	"os"
 	"permissions_aws/graph/model"
 	"time"
@@ -13,76 +10,30 @@ import (
 	"github.com/getsentry/sentry-go"
 )
 
-func httpRequest(method string, url string, query map[string]string) ([]byte, error) {
-	jsonResult, err := json.Marshal(query)
-	newRequest, err := http.NewRequest(method, url, bytes.NewBuffer(jsonResult))
-	if err != nil {
-
-		SentryLogError(err)
-
-		fmt.Printf("There was an error creating the new request %v", err)
-		return nil, err
-	}
-	newRequest.Header.Set("Content-Type", "application/json")
-
-	client := &http.Client{Timeout: time.Second * 5}
-	response, err := client.Do(newRequest)
-
-	if err != nil {
-		SentryLogError(err)
-		fmt.Printf("There was an error executing the request%v", err)
-	}
-
-	// check if the response is nil
-	if response == nil {
-		SentryLogError(err)
-
-		fmt.Println("check the " + url + " url ")
-		return nil, fmt.Errorf("Something went wrong with the request: " + url)
-	}
-
-	defer response.Body.Close()
-	responseData, err := ioutil.ReadAll(response.Body)
-
-	if err != nil {
-		SentryLogError(err)
-
-		fmt.Printf("Data Read Error%v", err)
-	}
-
-	return responseData, nil
+func userAccountClient() *GraphQLClient {
+	return NewGraphQLClient(os.Getenv("USER_ACCOUNT_API"), os.Getenv("DAPR_USER_APP_ID"))
 }
 
-func GetUser(id string) (*model.User, error) {
-	jsonMapInstance := map[string]string{
-		"query": `
-		{
-			user(id: "` + id + `") {
-			  id
-			  email
-			  firstName
-			  lastName
-			}
-		  }
-		`,
+const getUserQuery = `
+	query GetUser($id: ID) {
+		user(id: $id) {
+			id
+			email
+			firstName
+			lastName
+		}
 	}
+`
 
-	responseData, err := httpRequest("POST", os.Getenv("USER_ACCOUNT_API"), jsonMapInstance)
-	if err != nil {
-		SentryLogError(err)
-
-		fmt.Printf("There was an error executing the request%v", err)
-		return nil, err
-	}
-
-	// convert into User struct
+func GetUser(ctx context.Context, id string) (*model.User, error) {
 	var userData model.UserResponse
-	err = json.Unmarshal(responseData, &userData)
-
+	err := userAccountClient().Do(ctx, getUserQuery, map[string]interface{}{
+		"id": id,
+	}, &userData.Data)
 	if err != nil {
 		SentryLogError(err)
 
-		fmt.Printf("There was an error unmarshaling the JSON instance %v", err)
+		return nil, fmt.Errorf("unable to get user: %w", err)
 	}
 
 	return &model.User{
@@ -0,0 +1,17 @@
+CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
+
+CREATE TABLE IF NOT EXISTS request_swaps (
+    id uuid DEFAULT uuid_generate_v4(),
+    channel_id varchar(64),
+    request_id uuid NOT NULL,
+    user_id varchar(64) NOT NULL,
+    assigned_user_shift_id uuid NOT NULL,
+    assigned_user_shift_id_to_swap uuid NOT NULL,
+    request_note text,
+    status varchar(16) NOT NULL,
+    response_note text,
+    response_by_user_id text,
+    response_at timestamp with time zone,
+    created_at timestamp with time zone DEFAULT now(),
+    PRIMARY KEY (id)
+);
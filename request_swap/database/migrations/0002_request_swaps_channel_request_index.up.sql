@@ -0,0 +1,2 @@
+CREATE INDEX IF NOT EXISTS request_swaps_channel_id_request_id_idx ON request_swaps (channel_id, request_id);
+CREATE INDEX IF NOT EXISTS request_swaps_user_id_idx ON request_swaps (user_id);
@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS request_sagas (
+    id uuid DEFAULT uuid_generate_v4(),
+    kind varchar(16) NOT NULL,
+    status varchar(16) NOT NULL,
+    record_id uuid NOT NULL,
+    request_id uuid,
+    channel_id varchar(64),
+    user_id varchar(64),
+    attempts integer NOT NULL DEFAULT 0,
+    last_error text,
+    created_at timestamp with time zone DEFAULT now(),
+    updated_at timestamp with time zone DEFAULT now(),
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX IF NOT EXISTS request_sagas_open_idx ON request_sagas (updated_at) WHERE status IN ('pending', 'compensating');
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS request_swaps_peer_user_id_idx;
+
+ALTER TABLE request_swaps DROP COLUMN IF EXISTS peer_user_id;
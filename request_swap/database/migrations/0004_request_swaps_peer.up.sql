@@ -0,0 +1,3 @@
+ALTER TABLE request_swaps ADD COLUMN IF NOT EXISTS peer_user_id varchar(64);
+
+CREATE INDEX IF NOT EXISTS request_swaps_peer_user_id_idx ON request_swaps (peer_user_id) WHERE status = 'AWAITING_PEER';
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS request_swaps_channel_id_start_time_idx;
+DROP INDEX IF EXISTS request_swaps_channel_id_user_id_created_at_idx;
+DROP INDEX IF EXISTS request_swaps_channel_id_created_at_idx;
+
+ALTER TABLE request_swaps DROP COLUMN IF EXISTS end_time;
+ALTER TABLE request_swaps DROP COLUMN IF EXISTS start_time;
@@ -0,0 +1,6 @@
+ALTER TABLE request_swaps ADD COLUMN IF NOT EXISTS start_time timestamp with time zone;
+ALTER TABLE request_swaps ADD COLUMN IF NOT EXISTS end_time timestamp with time zone;
+
+CREATE INDEX IF NOT EXISTS request_swaps_channel_id_created_at_idx ON request_swaps (channel_id, created_at, id);
+CREATE INDEX IF NOT EXISTS request_swaps_channel_id_user_id_created_at_idx ON request_swaps (channel_id, user_id, created_at, id);
+CREATE INDEX IF NOT EXISTS request_swaps_channel_id_start_time_idx ON request_swaps (channel_id, start_time, end_time);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS request_swaps_expiring_idx;
+
+DROP TABLE IF EXISTS request_expiry_settings;
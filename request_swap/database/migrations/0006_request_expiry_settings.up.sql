@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS request_expiry_settings (
+    channel_id varchar(64) NOT NULL,
+    enabled boolean NOT NULL,
+    grace_period_minutes integer NOT NULL DEFAULT 0,
+    updated_by_user_id varchar(64),
+    updated_at timestamp with time zone,
+    PRIMARY KEY (channel_id)
+);
+
+CREATE INDEX IF NOT EXISTS request_swaps_expiring_idx ON request_swaps (start_time) WHERE status IN ('AWAITING_PEER', 'PENDING');
@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS request_history (
+    id uuid NOT NULL DEFAULT uuid_generate_v4(),
+    record_id uuid NOT NULL,
+    action varchar(16) NOT NULL,
+    field varchar(64),
+    old_value text,
+    new_value text,
+    actor_user_id varchar(64),
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX IF NOT EXISTS request_history_record_idx ON request_history (record_id, created_at);
+
+CREATE TABLE IF NOT EXISTS request_comments (
+    id uuid NOT NULL DEFAULT uuid_generate_v4(),
+    record_id uuid NOT NULL,
+    user_id varchar(64) NOT NULL,
+    body text NOT NULL,
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX IF NOT EXISTS request_comments_record_idx ON request_comments (record_id, created_at);
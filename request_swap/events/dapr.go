@@ -0,0 +1,64 @@
+package events
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+)
+
+// DaprPublisher publishes the events through the pub/sub component of the
+// Dapr sidecar. The events are sent as CloudEvents already, so Dapr passes
+// them on without wrapping them again.
+type DaprPublisher struct {
+	port       string
+	pubsubName string
+	client     *http.Client
+}
+
+// NewDaprPublisher reads DAPR_HTTP_PORT and PUBSUB_NAME
+func NewDaprPublisher() *DaprPublisher {
+	port := os.Getenv("DAPR_HTTP_PORT")
+	if port == "" {
+		port = "3500"
+	}
+	pubsubName := os.Getenv("PUBSUB_NAME")
+	if pubsubName == "" {
+		pubsubName = "pubsub"
+	}
+
+	return &DaprPublisher{
+		port:       port,
+		pubsubName: pubsubName,
+		client:     &http.Client{Timeout: time.Second * 5},
+	}
+}
+
+func (p *DaprPublisher) Publish(ctx context.Context, event CloudEvent) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("unable to marshal %s event: %w", event.Type, err)
+	}
+
+	url := fmt.Sprintf("http://localhost:%s/v1.0/publish/%s/%s", p.port, p.pubsubName, event.Type)
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("unable to create publish request: %w", err)
+	}
+	request.Header.Set("Content-Type", "application/cloudevents+json")
+
+	response, err := p.client.Do(request)
+	if err != nil {
+		return fmt.Errorf("unable to publish %s event: %w", event.Type, err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("publishing %s event responded with status %d", event.Type, response.StatusCode)
+	}
+
+	return nil
+}
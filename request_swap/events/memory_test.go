@@ -0,0 +1,98 @@
+package events
+
+import (
+	"context"
+	"testing"
+)
+
+func TestMemoryBrokerEvents(t *testing.T) {
+	tests := []struct {
+		name      string
+		published []string
+		types     []string
+		want      []string
+	}{
+		{name: "nothing published", published: nil, types: nil, want: nil},
+		{name: "all types", published: []string{"a", "b", "a"}, types: nil, want: []string{"a", "b", "a"}},
+		{name: "one type", published: []string{"a", "b", "a"}, types: []string{"a"}, want: []string{"a", "a"}},
+		{name: "several types", published: []string{"a", "b", "c"}, types: []string{"c", "a"}, want: []string{"a", "c"}},
+		{name: "unknown type", published: []string{"a", "b"}, types: []string{"c"}, want: nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			broker := NewMemoryBroker()
+			for _, eventType := range test.published {
+				if err := broker.Publish(context.Background(), New(eventType, "subject", nil)); err != nil {
+					t.Fatalf("Publish: %v", err)
+				}
+			}
+
+			got := broker.Events(test.types...)
+			if len(got) != len(test.want) {
+				t.Fatalf("got %d events, want %d", len(got), len(test.want))
+			}
+			for i, event := range got {
+				if event.Type != test.want[i] {
+					t.Errorf("event %d has type %s, want %s", i, event.Type, test.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestMemoryBrokerSubscribe(t *testing.T) {
+	broker := NewMemoryBroker()
+
+	var received []string
+	broker.Subscribe("a", func(ctx context.Context, event CloudEvent) {
+		received = append(received, event.Subject)
+
+		// a handler may publish itself
+		if err := broker.Publish(ctx, New("b", event.Subject, nil)); err != nil {
+			t.Errorf("Publish from handler: %v", err)
+		}
+	})
+
+	for _, subject := range []string{"1", "2"} {
+		if err := broker.Publish(context.Background(), New("a", subject, nil)); err != nil {
+			t.Fatalf("Publish: %v", err)
+		}
+	}
+	if err := broker.Publish(context.Background(), New("c", "3", nil)); err != nil {
+		t.Fatalf("Publish: %v", err)
+	}
+
+	if len(received) != 2 || received[0] != "1" || received[1] != "2" {
+		t.Errorf("handler received %v, want [1 2]", received)
+	}
+	if got := len(broker.Events("b")); got != 2 {
+		t.Errorf("got %d events published by the handler, want 2", got)
+	}
+}
+
+func TestMemoryBrokerReset(t *testing.T) {
+	broker := NewMemoryBroker()
+
+	calls := 0
+	broker.Subscribe("a", func(ctx context.Context, event CloudEvent) {
+		calls++
+	})
+
+	if err := broker.Publish(context.Background(), New("a", "1", nil)); err != nil {
+		t.Fatalf("Publish: %v", err)
+	}
+	broker.Reset()
+
+	if got := broker.Events(); len(got) != 0 {
+		t.Errorf("got %d events after Reset, want 0", len(got))
+	}
+
+	// the subscriptions are kept
+	if err := broker.Publish(context.Background(), New("a", "2", nil)); err != nil {
+		t.Fatalf("Publish: %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("handler called %d times, want 2", calls)
+	}
+}
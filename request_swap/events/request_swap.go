@@ -0,0 +1,85 @@
+package events
+
+import (
+	"request_swaps/graph/model"
+	"time"
+)
+
+// Source identifies this service as the producer of the events
+const Source = "shifts/request-swap"
+
+const (
+	RequestSwapCreated   = "request.swap.created"
+	RequestSwapApproved  = "request.swap.approved"
+	RequestSwapDenied    = "request.swap.denied"
+	RequestSwapCancelled = "request.swap.cancelled"
+	RequestSwapExpired   = "request.swap.expired"
+
+	RequestSwapCommented = "request.swap.commented"
+
+	RequestSwapPeerAccepted = "request.swap.peer_accepted"
+	RequestSwapPeerDeclined = "request.swap.peer_declined"
+)
+
+// RequestSwapData is the data of the request.swap.* events
+type RequestSwapData struct {
+	ID                        string              `json:"id"`
+	RequestID                 *string             `json:"requestId"`
+	ChannelID                 string              `json:"channelId"`
+	UserID                    string              `json:"userId"`
+	AssignedUserShiftID       *string             `json:"assignedUserShiftId"`
+	AssignedUserShiftIDToSwap *string             `json:"assignedUserShiftIdToSwap"`
+	PeerUserID                *string             `json:"peerUserId"`
+	Status                    model.RequestStatus `json:"status"`
+	RequestNote               *string             `json:"requestNote"`
+	ResponseNote              *string             `json:"responseNote"`
+	ResponseByUserID          *string             `json:"responseByUserId"`
+	ResponseAt                *time.Time          `json:"responseAt"`
+}
+
+// NewRequestSwapEvent creates an event about the request swap; responseByUserID
+// is the user that caused the change
+func NewRequestSwapEvent(eventType string, requestSwap model.RequestSwap, responseByUserID *string) CloudEvent {
+	if responseByUserID == nil {
+		responseByUserID = requestSwap.ResponseByUserID
+	}
+
+	return New(eventType, requestSwap.ID, RequestSwapData{
+		ID:                        requestSwap.ID,
+		RequestID:                 requestSwap.RequestID,
+		ChannelID:                 requestSwap.ChannelID,
+		UserID:                    requestSwap.UserID,
+		AssignedUserShiftID:       requestSwap.AssignedUserShiftID,
+		AssignedUserShiftIDToSwap: requestSwap.AssignedUserShiftIDToSwap,
+		PeerUserID:                requestSwap.PeerUserID,
+		Status:                    requestSwap.Status,
+		RequestNote:               requestSwap.RequestNote,
+		ResponseNote:              requestSwap.ResponseNote,
+		ResponseByUserID:          responseByUserID,
+		ResponseAt:                requestSwap.ResponseAt,
+	})
+}
+
+// RequestSwapCommentData is the data of the request.swap.commented event
+type RequestSwapCommentData struct {
+	ID            string    `json:"id"`
+	RequestSwapID string    `json:"requestSwapId"`
+	RequestID     *string   `json:"requestId"`
+	ChannelID     string    `json:"channelId"`
+	UserID        string    `json:"userId"`
+	Body          string    `json:"body"`
+	CreatedAt     time.Time `json:"createdAt"`
+}
+
+// NewRequestSwapCommentEvent creates the event about a comment on the request swap
+func NewRequestSwapCommentEvent(requestSwap model.RequestSwap, comment model.RequestComment) CloudEvent {
+	return New(RequestSwapCommented, requestSwap.ID, RequestSwapCommentData{
+		ID:            comment.ID,
+		RequestSwapID: requestSwap.ID,
+		RequestID:     requestSwap.RequestID,
+		ChannelID:     requestSwap.ChannelID,
+		UserID:        comment.UserID,
+		Body:          comment.Body,
+		CreatedAt:     comment.CreatedAt,
+	})
+}
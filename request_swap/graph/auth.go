@@ -0,0 +1,216 @@
+package graph
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"request_swaps/util"
+	"strings"
+	"time"
+
+	"github.com/getsentry/sentry-go"
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// AuthUser is the caller of the current request, set by the auth middleware
+type AuthUser struct {
+	// ID of the user from the Kratos session or the JWT subject, empty when
+	// the caller is a trusted service acting without a user
+	ID string
+
+	// TrustedService is set when the request carries the shared service token
+	TrustedService bool
+}
+
+type authUserContextKey struct{}
+
+const (
+	serviceTokenHeader = "X-Service-Token"
+	sessionTokenHeader = "X-Session-Token"
+)
+
+var errInvalidCredentials = errors.New("invalid credentials")
+
+// Authenticator validates the credentials of incoming requests: an Ory Kratos
+// session (cookie or X-Session-Token), a signed JWT (Authorization: Bearer)
+// or, for service-to-service calls, the shared X-Service-Token
+type Authenticator struct {
+	kratosHost   string
+	jwtSecret    []byte
+	serviceToken string
+	client       *http.Client
+}
+
+func NewAuthenticator() *Authenticator {
+	return &Authenticator{
+		kratosHost:   strings.TrimSuffix(os.Getenv("ORY_KRATOS_HOST"), "/"),
+		jwtSecret:    []byte(os.Getenv("AUTH_JWT_SECRET")),
+		serviceToken: os.Getenv("SERVICE_TOKEN"),
+		client:       &http.Client{Timeout: 5 * time.Second},
+	}
+}
+
+// Middleware puts the AuthUser into the request context. Requests without
+// credentials continue anonymously and are rejected by the resolvers;
+// requests with invalid credentials are answered with 401.
+func (a *Authenticator) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		authUser, err := a.authenticate(request)
+		if err != nil {
+			if !errors.Is(err, errInvalidCredentials) {
+				sentry.CaptureException(err)
+				log.Printf("authentication error: %v", err)
+			}
+
+			writer.Header().Set("Content-Type", "application/json")
+			writer.WriteHeader(http.StatusUnauthorized)
+			_ = json.NewEncoder(writer).Encode(map[string]interface{}{
+				"errors": []map[string]interface{}{{
+					"message":    "unauthenticated",
+					"extensions": map[string]interface{}{"code": util.ErrorCodeUnauthenticated},
+				}},
+			})
+			return
+		}
+
+		if authUser != nil {
+			request = request.WithContext(context.WithValue(request.Context(), authUserContextKey{}, authUser))
+		}
+
+		next.ServeHTTP(writer, request)
+	})
+}
+
+func (a *Authenticator) authenticate(request *http.Request) (*AuthUser, error) {
+	authUser := &AuthUser{}
+
+	if token := request.Header.Get(serviceTokenHeader); token != "" {
+		if a.serviceToken == "" || subtle.ConstantTimeCompare([]byte(token), []byte(a.serviceToken)) != 1 {
+			return nil, errInvalidCredentials
+		}
+		authUser.TrustedService = true
+	}
+
+	var err error
+	switch {
+	case strings.HasPrefix(request.Header.Get("Authorization"), "Bearer "):
+		authUser.ID, err = a.validateJWT(strings.TrimPrefix(request.Header.Get("Authorization"), "Bearer "))
+	case request.Header.Get(sessionTokenHeader) != "" || request.Header.Get("Cookie") != "":
+		authUser.ID, err = a.whoami(request)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if authUser.ID == "" && !authUser.TrustedService {
+		return nil, nil
+	}
+
+	return authUser, nil
+}
+
+// validateJWT checks an HS256 token signed with AUTH_JWT_SECRET and returns its subject
+func (a *Authenticator) validateJWT(tokenString string) (string, error) {
+	if len(a.jwtSecret) == 0 {
+		return "", errInvalidCredentials
+	}
+
+	claims := &jwt.RegisteredClaims{}
+	_, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method %v", token.Header["alg"])
+		}
+		return a.jwtSecret, nil
+	})
+	if err != nil || claims.Subject == "" {
+		return "", errInvalidCredentials
+	}
+
+	return claims.Subject, nil
+}
+
+type kratosSession struct {
+	Active   bool `json:"active"`
+	Identity struct {
+		ID string `json:"id"`
+	} `json:"identity"`
+}
+
+// whoami resolves the Kratos session from the forwarded cookie or session token.
+// A cookie without a Kratos session is not an error, the request stays anonymous.
+func (a *Authenticator) whoami(request *http.Request) (string, error) {
+	if a.kratosHost == "" {
+		return "", nil
+	}
+
+	whoamiRequest, err := http.NewRequestWithContext(request.Context(), http.MethodGet, a.kratosHost+"/sessions/whoami", nil)
+	if err != nil {
+		return "", err
+	}
+
+	sessionToken := request.Header.Get(sessionTokenHeader)
+	if sessionToken != "" {
+		whoamiRequest.Header.Set(sessionTokenHeader, sessionToken)
+	}
+	if cookie := request.Header.Get("Cookie"); cookie != "" {
+		whoamiRequest.Header.Set("Cookie", cookie)
+	}
+
+	response, err := a.client.Do(whoamiRequest)
+	if err != nil {
+		return "", fmt.Errorf("unable to reach kratos: %w", err)
+	}
+	defer response.Body.Close()
+
+	switch {
+	case response.StatusCode == http.StatusUnauthorized || response.StatusCode == http.StatusForbidden:
+		if sessionToken != "" {
+			return "", errInvalidCredentials
+		}
+		return "", nil
+	case response.StatusCode != http.StatusOK:
+		return "", fmt.Errorf("kratos whoami responded with status %d", response.StatusCode)
+	}
+
+	var session kratosSession
+	if err := json.NewDecoder(response.Body).Decode(&session); err != nil {
+		return "", fmt.Errorf("unable to decode kratos session: %w", err)
+	}
+
+	if !session.Active || session.Identity.ID == "" {
+		return "", errInvalidCredentials
+	}
+
+	return session.Identity.ID, nil
+}
+
+// GetAuthUser returns the caller of the request, nil when unauthenticated
+func GetAuthUser(ctx context.Context) *AuthUser {
+	authUser, _ := ctx.Value(authUserContextKey{}).(*AuthUser)
+	return authUser
+}
+
+// AuthUserID returns the ID of the authenticated user. The deprecated
+// authUserId argument is only honored for trusted services, which act on
+// behalf of a user they have already authenticated.
+func AuthUserID(ctx context.Context, authUserID *string) *string {
+	authUser := GetAuthUser(ctx)
+	if authUser == nil {
+		return nil
+	}
+
+	if authUser.TrustedService && authUserID != nil && *authUserID != "" {
+		return authUserID
+	}
+
+	if authUser.ID == "" {
+		return nil
+	}
+
+	return &authUser.ID
+}
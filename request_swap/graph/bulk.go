@@ -0,0 +1,122 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"request_swaps/graph/model"
+	"request_swaps/util"
+	"time"
+
+	"github.com/getsentry/sentry-go"
+)
+
+// maxBulkSize is the most request swaps a bulk mutation changes at once
+const maxBulkSize = 100
+
+// bulkIDs drops the empty and repeated ids, keeping the order of the others
+func bulkIDs(ids []string) ([]string, error) {
+	seen := make(map[string]bool, len(ids))
+	unique := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if id == "" || seen[id] {
+			continue
+		}
+
+		seen[id] = true
+		unique = append(unique, id)
+	}
+
+	if len(unique) == 0 || len(unique) > maxBulkSize {
+		return nil, util.NewError(util.ErrorCodeValidation, fmt.Sprintf("between 1 and %d ids are required", maxBulkSize))
+	}
+
+	return unique, nil
+}
+
+// changeRequestSwaps runs change for each request swap on its own, so one
+// that fails does not hold the others back, and returns a result per id.
+// The users of the changed request swaps are fetched in one call.
+func (r *Resolver) changeRequestSwaps(ctx context.Context, ids []string, fieldError string, change func(ctx context.Context, id string) (*model.RequestSwap, error)) *model.BulkRequestSwapResponse {
+	defer sentry.Flush(2 * time.Second)
+
+	results := make([]*model.RequestSwapResult, 0, len(ids))
+	changed := map[string]*model.RequestSwap{}
+	seenUsers := map[string]bool{}
+	var userIDs []string
+
+	for _, id := range ids {
+		requestSwap, err := change(ctx, id)
+		if err != nil {
+			sentry.CaptureException(err)
+
+			errorMessage := err.Error()
+			results = append(results, &model.RequestSwapResult{
+				ID: id,
+				Errors: []*model.ShiftError{{
+					Code:    util.ShiftErrorCodeOf(err),
+					Field:   &fieldError,
+					Message: &errorMessage,
+				}},
+			})
+			continue
+		}
+
+		changed[id] = requestSwap
+		if !seenUsers[requestSwap.UserID] {
+			seenUsers[requestSwap.UserID] = true
+			userIDs = append(userIDs, requestSwap.UserID)
+		}
+		results = append(results, &model.RequestSwapResult{ID: id})
+	}
+
+	response := &model.BulkRequestSwapResponse{Results: results}
+	if len(changed) == 0 {
+		return response
+	}
+
+	// the request swaps are changed already, a user that cannot be fetched only leaves user empty
+	users, err := util.GetUsers(ctx, userIDs)
+	if err != nil {
+		sentry.CaptureException(err)
+
+		errorMessage := err.Error()
+		response.Errors = append(response.Errors, &model.ShiftError{
+			Code:    util.ShiftErrorCodeOf(err),
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+	}
+
+	for _, result := range results {
+		if requestSwap, ok := changed[result.ID]; ok {
+			result.Request = newRequestSwapResponse(requestSwap, users[requestSwap.UserID])
+		}
+	}
+
+	return response
+}
+
+// newRequestSwapResponse is the RequestResponse of the request swap
+func newRequestSwapResponse(requestSwap *model.RequestSwap, user *model.User) *model.RequestResponse {
+	var requestID string
+	if requestSwap.RequestID != nil {
+		requestID = *requestSwap.RequestID
+	}
+
+	return &model.RequestResponse{
+		ID:           requestSwap.ID,
+		ChannelID:    requestSwap.ChannelID,
+		RequestID:    requestID,
+		RequestNote:  requestSwap.RequestNote,
+		Status:       &requestSwap.Status,
+		ResponseNote: requestSwap.ResponseNote,
+		ResponseAt:   requestSwap.ResponseAt,
+		CreatedAt:    &requestSwap.CreatedAt,
+		User:         user,
+
+		ShiftToOfferID:   requestSwap.AssignedUserShiftID,
+		ShiftToSwapID:    requestSwap.AssignedUserShiftIDToSwap,
+		ShiftOfferedToID: requestSwap.PeerUserID,
+		ResponseByID:     requestSwap.ResponseByUserID,
+	}
+}
@@ -0,0 +1,240 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"request_swaps/graph/model"
+	"request_swaps/util"
+	"sort"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultSwapCandidateLimit  = 10
+	maxSwapCandidateLimit      = 50
+	defaultSwapCandidateWindow = 7 * 24 * time.Hour
+)
+
+// swapCandidateWindow is how far before and after the shift the shifts of
+// the candidates may start: SWAP_CANDIDATE_WINDOW (hours), a week by default
+func swapCandidateWindow() (time.Duration, error) {
+	value := os.Getenv("SWAP_CANDIDATE_WINDOW")
+	if value == "" {
+		return defaultSwapCandidateWindow, nil
+	}
+
+	hours, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("unable to convert SWAP_CANDIDATE_WINDOW to int: %w", err)
+	}
+
+	return time.Duration(hours) * time.Hour, nil
+}
+
+// suggestSwapCandidates returns the shifts of the other members of the shift
+// group that could be swapped with shift, closest start time first. A swap is
+// possible when neither user would work two shifts at the same time and
+// neither has an approved time off during the shift they would get.
+func suggestSwapCandidates(ctx context.Context, shift *model.AssignedShift, limit int, authUserID string) ([]*model.SwapCandidate, error) {
+	window, err := swapCandidateWindow()
+	if err != nil {
+		return nil, err
+	}
+
+	requesterID := *shift.UserID
+
+	memberIDs, err := util.GetShiftGroupMemberUserIDs(ctx, shift.ChannelID, shift.ShiftGroupID, authUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	members := make(map[string]bool, len(memberIDs))
+	for _, memberID := range memberIDs {
+		members[memberID] = true
+	}
+
+	if !members[requesterID] {
+		return nil, util.NewError(util.ErrorCodeValidation, "The owner of the shift is not a member of its shift group")
+	}
+
+	shifts, err := util.GetAssignedShiftsByChannelIDShiftGroupID(ctx, shift.ChannelID, shift.ShiftGroupID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	from, to := shift.StartTime.Add(-window), shift.StartTime.Add(window)
+
+	var possible []*model.AssignedShift
+	for _, other := range shifts {
+		if other.UserID == nil || *other.UserID == requesterID || !members[*other.UserID] {
+			continue
+		}
+
+		if !other.StartTime.After(now) || other.StartTime.Before(from) || other.StartTime.After(to) {
+			continue
+		}
+
+		possible = append(possible, other)
+	}
+
+	if len(possible) == 0 {
+		return []*model.SwapCandidate{}, nil
+	}
+
+	// the overlaps are checked against every shift of the users, in any channel or shift
+	// group, like createRequestSwap does
+	shiftsByUser, err := userShifts(ctx, shift, possible)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := withoutOverlaps(shift, possible, shiftsByUser)
+	if len(candidates) == 0 {
+		return []*model.SwapCandidate{}, nil
+	}
+
+	timeOffs, err := approvedTimeOffs(ctx, shift, candidates, authUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	suggestions := []*model.SwapCandidate{}
+	for _, candidate := range candidates {
+		if timeOffs.during(requesterID, candidate) || timeOffs.during(*candidate.UserID, shift) {
+			continue
+		}
+
+		suggestions = append(suggestions, &model.SwapCandidate{
+			UserID:              *candidate.UserID,
+			Shift:               candidate,
+			StartTimeDifference: int(candidate.StartTime.Sub(shift.StartTime).Minutes()),
+		})
+	}
+
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		return absInt(suggestions[i].StartTimeDifference) < absInt(suggestions[j].StartTimeDifference)
+	})
+
+	if len(suggestions) > limit {
+		suggestions = suggestions[:limit]
+	}
+
+	return suggestions, nil
+}
+
+// userShifts fetches the shifts of the owner of shift and of the owners of
+// the candidates, in any channel or shift group, over the period covering
+// all their shifts, by the ID of their user
+func userShifts(ctx context.Context, shift *model.AssignedShift, candidates []*model.AssignedShift) (map[string][]*model.AssignedShift, error) {
+	userIDs := []string{*shift.UserID}
+	seen := map[string]bool{*shift.UserID: true}
+
+	from, to := shift.StartTime, shift.EndTime
+	for _, candidate := range candidates {
+		if !seen[*candidate.UserID] {
+			seen[*candidate.UserID] = true
+			userIDs = append(userIDs, *candidate.UserID)
+		}
+
+		if candidate.StartTime.Before(from) {
+			from = candidate.StartTime
+		}
+		if candidate.EndTime.After(to) {
+			to = candidate.EndTime
+		}
+	}
+
+	shiftsByUser := make(map[string][]*model.AssignedShift, len(userIDs))
+	for _, userID := range userIDs {
+		shifts, err := util.GetAssignedShiftsByUserID(ctx, userID, from, to)
+		if err != nil {
+			return nil, err
+		}
+		shiftsByUser[userID] = shifts
+	}
+
+	return shiftsByUser, nil
+}
+
+// withoutOverlaps are the candidates that neither the owner of shift nor the
+// owner of the candidate would work at the same time as another of their
+// shifts after the swap
+func withoutOverlaps(shift *model.AssignedShift, candidates []*model.AssignedShift, shiftsByUser map[string][]*model.AssignedShift) []*model.AssignedShift {
+	requesterID := *shift.UserID
+
+	var result []*model.AssignedShift
+	for _, candidate := range candidates {
+		// each user gets the shift of the other one and gives their own away
+		if findOverlap(shiftsByUser[requesterID], requesterID, candidate, shift.ID) != nil || findOverlap(shiftsByUser[*candidate.UserID], *candidate.UserID, shift, candidate.ID) != nil {
+			continue
+		}
+
+		result = append(result, candidate)
+	}
+
+	return result
+}
+
+// timeOffsByUser are the approved time offs of the users by their ID
+type timeOffsByUser map[string][]*model.TimeOff
+
+// during tells whether the user is off at any time during shift
+func (t timeOffsByUser) during(userID string, shift *model.AssignedShift) bool {
+	for _, timeOff := range t[userID] {
+		end := timeOff.StartTime.Add(24 * time.Hour)
+		if timeOff.EndTime != nil {
+			end = *timeOff.EndTime
+		}
+
+		if timeOff.StartTime.Before(shift.EndTime) && shift.StartTime.Before(end) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// approvedTimeOffs fetches, in one call, the approved time offs of the
+// requester and the candidates over the period covering all their shifts
+func approvedTimeOffs(ctx context.Context, shift *model.AssignedShift, candidates []*model.AssignedShift, authUserID string) (timeOffsByUser, error) {
+	userIDs := []string{*shift.UserID}
+	seen := map[string]bool{*shift.UserID: true}
+
+	from, to := shift.StartTime, shift.EndTime
+	for _, candidate := range candidates {
+		if !seen[*candidate.UserID] {
+			seen[*candidate.UserID] = true
+			userIDs = append(userIDs, *candidate.UserID)
+		}
+
+		if candidate.StartTime.Before(from) {
+			from = candidate.StartTime
+		}
+		if candidate.EndTime.After(to) {
+			to = candidate.EndTime
+		}
+	}
+
+	timeOffs, err := util.GetApprovedTimeOffs(ctx, *shift.ChannelID, userIDs, from, to, authUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	byUser := timeOffsByUser{}
+	for _, timeOff := range timeOffs {
+		byUser[timeOff.UserID] = append(byUser[timeOff.UserID], timeOff)
+	}
+
+	return byUser, nil
+}
+
+func absInt(value int) int {
+	if value < 0 {
+		return -value
+	}
+
+	return value
+}
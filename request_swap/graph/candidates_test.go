@@ -0,0 +1,107 @@
+package graph
+
+import (
+	"request_swaps/graph/model"
+	"testing"
+	"time"
+)
+
+func TestTimeOffsByUserDuring(t *testing.T) {
+	day := time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)
+	at := func(hours int) *time.Time {
+		value := day.Add(time.Duration(hours) * time.Hour)
+		return &value
+	}
+
+	// the shift is on monday from 09:00 to 17:00
+	shift := &model.AssignedShift{StartTime: *at(9), EndTime: *at(17)}
+
+	tests := []struct {
+		name     string
+		timeOffs timeOffsByUser
+		userID   string
+		want     bool
+	}{
+		{name: "no time offs", timeOffs: timeOffsByUser{}, userID: "a", want: false},
+		{name: "time off of another user", timeOffs: timeOffsByUser{"b": {{UserID: "b", StartTime: *at(0), EndTime: at(24)}}}, userID: "a", want: false},
+		{name: "covers the shift", timeOffs: timeOffsByUser{"a": {{UserID: "a", StartTime: *at(0), EndTime: at(24)}}}, userID: "a", want: true},
+		{name: "overlaps the start", timeOffs: timeOffsByUser{"a": {{UserID: "a", StartTime: *at(6), EndTime: at(10)}}}, userID: "a", want: true},
+		{name: "overlaps the end", timeOffs: timeOffsByUser{"a": {{UserID: "a", StartTime: *at(16), EndTime: at(20)}}}, userID: "a", want: true},
+		{name: "ends when the shift starts", timeOffs: timeOffsByUser{"a": {{UserID: "a", StartTime: *at(0), EndTime: at(9)}}}, userID: "a", want: false},
+		{name: "starts when the shift ends", timeOffs: timeOffsByUser{"a": {{UserID: "a", StartTime: *at(17), EndTime: at(20)}}}, userID: "a", want: false},
+		{name: "without an end lasts a day", timeOffs: timeOffsByUser{"a": {{UserID: "a", StartTime: *at(-12)}}}, userID: "a", want: true},
+		{name: "without an end, the day before", timeOffs: timeOffsByUser{"a": {{UserID: "a", StartTime: *at(-24)}}}, userID: "a", want: false},
+		{
+			name: "one of several",
+			timeOffs: timeOffsByUser{"a": {
+				{UserID: "a", StartTime: *at(-48), EndTime: at(-24)},
+				{UserID: "a", StartTime: *at(12), EndTime: at(13)},
+			}},
+			userID: "a",
+			want:   true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.timeOffs.during(test.userID, shift); got != test.want {
+				t.Errorf("got %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestWithoutOverlaps(t *testing.T) {
+	day := time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)
+	id := func(value string) *string { return &value }
+	newShift := func(shiftID string, userID string, shiftGroupID string, channelID string, from int, to int) *model.AssignedShift {
+		return &model.AssignedShift{
+			ID:           shiftID,
+			UserID:       id(userID),
+			ShiftGroupID: id(shiftGroupID),
+			ChannelID:    id(channelID),
+			StartTime:    day.Add(time.Duration(from) * time.Hour),
+			EndTime:      day.Add(time.Duration(to) * time.Hour),
+		}
+	}
+
+	// the requester works 09:00 to 17:00, the candidate 18:00 to 22:00, both in group g of channel c
+	shift := newShift("shift", "requester", "g", "c", 9, 17)
+	candidate := newShift("candidate", "peer", "g", "c", 18, 22)
+
+	tests := []struct {
+		name         string
+		shiftsByUser map[string][]*model.AssignedShift
+		want         bool
+	}{
+		{
+			name:         "no other shifts",
+			shiftsByUser: map[string][]*model.AssignedShift{"requester": {shift}, "peer": {candidate}},
+			want:         true,
+		},
+		{
+			name:         "requester busy in another shift group",
+			shiftsByUser: map[string][]*model.AssignedShift{"requester": {shift, newShift("other", "requester", "h", "c", 20, 23)}, "peer": {candidate}},
+			want:         false,
+		},
+		{
+			name:         "peer busy in another channel",
+			shiftsByUser: map[string][]*model.AssignedShift{"requester": {shift}, "peer": {candidate, newShift("other", "peer", "h", "d", 8, 10)}},
+			want:         false,
+		},
+		{
+			name:         "other shifts at other times",
+			shiftsByUser: map[string][]*model.AssignedShift{"requester": {shift, newShift("other", "requester", "h", "d", 6, 8)}, "peer": {candidate, newShift("next", "peer", "h", "d", 23, 24)}},
+			want:         true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := withoutOverlaps(shift, []*model.AssignedShift{candidate}, test.shiftsByUser)
+			if (len(got) == 1) != test.want {
+				t.Errorf("got %d candidates, want the candidate kept: %v", len(got), test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,320 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"request_swaps/events"
+	"request_swaps/graph/model"
+	"request_swaps/util"
+	"strconv"
+	"time"
+
+	"github.com/getsentry/sentry-go"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultExpiryInterval = 5 * time.Minute
+
+	expiryBatchSize = 100
+
+	// expiryLockID is the advisory lock held by an expiry run, so that only
+	// one replica at a time expires request swaps
+	expiryLockID = 8150727142
+)
+
+// Expirer moves the request swaps still waiting for an answer when their
+// shifts start (plus the grace period of the channel) to EXPIRED. Channels
+// without settings use the defaults of the service.
+type Expirer struct {
+	db     *gorm.DB
+	events events.Publisher
+
+	enabled     bool
+	gracePeriod time.Duration
+}
+
+func NewExpirer(db *gorm.DB, publisher events.Publisher) *Expirer {
+	return &Expirer{
+		db:      db,
+		events:  publisher,
+		enabled: true,
+	}
+}
+
+// Settings are the expiry settings of the channel, or the defaults when the
+// channel has none
+func (e *Expirer) Settings(ctx context.Context, channelID string) (*model.RequestExpirySettings, error) {
+	var settings []*model.RequestExpirySettings
+	err := e.db.WithContext(ctx).Where("channel_id = ?", channelID).Limit(1).Find(&settings).Error
+	if err != nil {
+		return nil, err
+	}
+
+	if len(settings) > 0 {
+		return settings[0], nil
+	}
+
+	return &model.RequestExpirySettings{
+		ChannelID:          channelID,
+		Enabled:            e.enabled,
+		GracePeriodMinutes: int(e.gracePeriod / time.Minute),
+	}, nil
+}
+
+// SetSettings stores the expiry settings of the channel
+func (e *Expirer) SetSettings(ctx context.Context, channelID string, input model.RequestExpirySettingsInput, updatedByUserID string) (*model.RequestExpirySettings, error) {
+	updatedAt := time.Now().UTC()
+	settings := &model.RequestExpirySettings{
+		ChannelID:          channelID,
+		Enabled:            input.Enabled,
+		GracePeriodMinutes: input.GracePeriodMinutes,
+		UpdatedByUserID:    &updatedByUserID,
+		UpdatedAt:          &updatedAt,
+	}
+
+	if err := e.db.WithContext(ctx).Save(settings).Error; err != nil {
+		return nil, err
+	}
+
+	return settings, nil
+}
+
+// RunExpirer expires request swaps every interval until ctx is done. The
+// periods of the request swaps created before they were recorded are filled
+// in first, retried every interval until it succeeds once.
+func (e *Expirer) RunExpirer(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	backfilled := false
+	for {
+		if !backfilled {
+			if _, err := e.BackfillPeriods(ctx); err != nil {
+				sentry.CaptureException(err)
+				log.Printf("request swap period backfill: %v", err)
+			} else {
+				backfilled = true
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := e.Expire(ctx); err != nil {
+				sentry.CaptureException(err)
+				log.Printf("request swap expirer: %v", err)
+			}
+		}
+	}
+}
+
+// Expire expires the request swaps that are due, a batch at a time, and
+// returns how many it expired. When another replica is expiring already it
+// returns right away.
+func (e *Expirer) Expire(ctx context.Context) (int, error) {
+	expired := 0
+
+	for {
+		requestSwaps, locked, err := e.expireBatch(ctx)
+		if err != nil {
+			return expired, err
+		}
+		if !locked {
+			return expired, nil
+		}
+
+		for _, requestSwap := range requestSwaps {
+			e.publish(ctx, events.NewRequestSwapEvent(events.RequestSwapExpired, *requestSwap, nil))
+		}
+
+		expired += len(requestSwaps)
+		if len(requestSwaps) < expiryBatchSize {
+			return expired, nil
+		}
+	}
+}
+
+// BackfillPeriods records the period (startTime and endTime) of the request
+// swaps created before migration 0005 from their shifts, a batch at a time,
+// and returns how many it filled in. Request swaps whose shifts no longer
+// exist are left without a period. Replicas running it at the same time only
+// write the same values.
+func (e *Expirer) BackfillPeriods(ctx context.Context) (int, error) {
+	backfilled := 0
+	lastID := ""
+
+	for {
+		var requestSwaps []*model.RequestSwap
+		err := e.db.WithContext(ctx).Where("start_time IS NULL AND id > ?", lastID).Order("id").Limit(expiryBatchSize).Find(&requestSwaps).Error
+		if err != nil {
+			return backfilled, fmt.Errorf("unable to list the request swaps without a period: %w", err)
+		}
+		if len(requestSwaps) == 0 {
+			return backfilled, nil
+		}
+
+		ids := make([]string, 0, 2*len(requestSwaps))
+		for _, requestSwap := range requestSwaps {
+			if requestSwap.AssignedUserShiftID != nil {
+				ids = append(ids, *requestSwap.AssignedUserShiftID)
+			}
+			if requestSwap.AssignedUserShiftIDToSwap != nil {
+				ids = append(ids, *requestSwap.AssignedUserShiftIDToSwap)
+			}
+		}
+
+		shifts, err := util.GetAssignedShifts(ctx, ids)
+		if err != nil {
+			return backfilled, err
+		}
+
+		for _, requestSwap := range requestSwaps {
+			var shiftToOffer, shiftToSwap *model.AssignedShift
+			if requestSwap.AssignedUserShiftID != nil {
+				shiftToOffer = shifts[*requestSwap.AssignedUserShiftID]
+			}
+			if requestSwap.AssignedUserShiftIDToSwap != nil {
+				shiftToSwap = shifts[*requestSwap.AssignedUserShiftIDToSwap]
+			}
+
+			startTime, endTime := shiftsPeriod(shiftToOffer, shiftToSwap)
+			if startTime == nil {
+				continue
+			}
+
+			err := e.db.WithContext(ctx).Model(&model.RequestSwap{}).Where("id = ? AND start_time IS NULL", requestSwap.ID).Updates(map[string]interface{}{
+				"start_time": startTime,
+				"end_time":   endTime,
+			}).Error
+			if err != nil {
+				return backfilled, fmt.Errorf("unable to record the period of request swap %s: %w", requestSwap.ID, err)
+			}
+			backfilled++
+		}
+
+		lastID = requestSwaps[len(requestSwaps)-1].ID
+		if len(requestSwaps) < expiryBatchSize {
+			return backfilled, nil
+		}
+	}
+}
+
+// expireBatch expires up to expiryBatchSize request swaps in a transaction
+// holding the expiry lock; locked is false when another replica holds it
+func (e *Expirer) expireBatch(ctx context.Context) ([]*model.RequestSwap, bool, error) {
+	var requestSwaps []*model.RequestSwap
+	locked := false
+
+	err := e.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Raw("SELECT pg_try_advisory_xact_lock(?)", expiryLockID).Scan(&locked).Error; err != nil {
+			return fmt.Errorf("unable to acquire the expiry lock: %w", err)
+		}
+		if !locked {
+			return nil
+		}
+
+		// request swaps whose period could not be backfilled never expire
+		err := tx.Raw(`
+			SELECT request_swaps.* FROM request_swaps
+			LEFT JOIN request_expiry_settings ON request_expiry_settings.channel_id = request_swaps.channel_id
+			WHERE request_swaps.status IN ?
+				AND request_swaps.start_time IS NOT NULL
+				AND COALESCE(request_expiry_settings.enabled, ?)
+				AND request_swaps.start_time + make_interval(mins => COALESCE(request_expiry_settings.grace_period_minutes, ?)) <= now()
+			ORDER BY request_swaps.start_time
+			LIMIT ?
+			FOR UPDATE OF request_swaps SKIP LOCKED`,
+			model.ExpiringRequestStatuses, e.enabled, int(e.gracePeriod/time.Minute), expiryBatchSize,
+		).Scan(&requestSwaps).Error
+		if err != nil {
+			return fmt.Errorf("unable to list the request swaps to expire: %w", err)
+		}
+
+		if len(requestSwaps) == 0 {
+			return nil
+		}
+
+		ids := make([]string, 0, len(requestSwaps))
+		entries := make([]*model.RequestHistoryEntry, 0, len(requestSwaps))
+		for _, requestSwap := range requestSwaps {
+			ids = append(ids, requestSwap.ID)
+			entries = append(entries, newStatusEntry(requestSwap.ID, requestSwap.Status, model.RequestStatusExpired, nil))
+		}
+
+		responseAt := time.Now().UTC()
+		err = tx.Model(&model.RequestSwap{}).Where("id IN ?", ids).Updates(map[string]interface{}{
+			"status":      model.RequestStatusExpired,
+			"response_at": responseAt,
+		}).Error
+		if err != nil {
+			return fmt.Errorf("unable to expire request swaps: %w", err)
+		}
+
+		// the service expires them, the entries have no actor
+		if err := recordHistory(tx, entries...); err != nil {
+			return fmt.Errorf("unable to record the expiry of request swaps: %w", err)
+		}
+
+		for _, requestSwap := range requestSwaps {
+			requestSwap.Status = model.RequestStatusExpired
+			requestSwap.ResponseAt = &responseAt
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	return requestSwaps, locked, nil
+}
+
+// publish reports a failure to publish without failing the expiry, like
+// Resolver.publish
+func (e *Expirer) publish(ctx context.Context, event events.CloudEvent) {
+	if e.events == nil {
+		return
+	}
+
+	if err := e.events.Publish(ctx, event); err != nil {
+		sentry.CaptureException(err)
+		log.Printf("unable to publish %s event %s: %v", event.Type, event.ID, err)
+	}
+}
+
+// ConfigureFromEnv reads REQUEST_EXPIRY_ENABLED and
+// REQUEST_EXPIRY_GRACE_PERIOD (minutes), the defaults for channels without
+// settings, and returns the expiry interval REQUEST_EXPIRY_INTERVAL (seconds)
+func (e *Expirer) ConfigureFromEnv() (time.Duration, error) {
+	interval := defaultExpiryInterval
+
+	if value := os.Getenv("REQUEST_EXPIRY_INTERVAL"); value != "" {
+		seconds, err := strconv.Atoi(value)
+		if err != nil {
+			return 0, fmt.Errorf("unable to convert REQUEST_EXPIRY_INTERVAL to int: %w", err)
+		}
+		interval = time.Duration(seconds) * time.Second
+	}
+
+	if value := os.Getenv("REQUEST_EXPIRY_ENABLED"); value != "" {
+		enabled, err := strconv.ParseBool(value)
+		if err != nil {
+			return 0, fmt.Errorf("unable to convert REQUEST_EXPIRY_ENABLED to bool: %w", err)
+		}
+		e.enabled = enabled
+	}
+
+	if value := os.Getenv("REQUEST_EXPIRY_GRACE_PERIOD"); value != "" {
+		minutes, err := strconv.Atoi(value)
+		if err != nil {
+			return 0, fmt.Errorf("unable to convert REQUEST_EXPIRY_GRACE_PERIOD to int: %w", err)
+		}
+		e.gracePeriod = time.Duration(minutes) * time.Minute
+	}
+
+	return interval, nil
+}
@@ -39,6 +39,11 @@ type Config struct {
 type ResolverRoot interface {
 	Mutation() MutationResolver
 	Query() QueryResolver
+	RequestComment() RequestCommentResolver
+	RequestHistoryEntry() RequestHistoryEntryResolver
+	RequestResponse() RequestResponseResolver
+	RequestSwap() RequestSwapResolver
+	SwapCandidate() SwapCandidateResolver
 }
 
 type DirectiveRoot struct {
@@ -80,25 +85,85 @@ type ComplexityRoot struct {
 		UserID          func(childComplexity int) int
 	}
 
+	BulkRequestSwapResponse struct {
+		Errors  func(childComplexity int) int
+		Results func(childComplexity int) int
+	}
+
 	Mutation struct {
-		ApproveRequestSwap func(childComplexity int, id string, responseNote *string, authUserID *string) int
-		CancelRequestSwap  func(childComplexity int, channelID string, requestID string, authUserID *string) int
-		CreateRequestSwap  func(childComplexity int, input model.RequestSwapInput, authUserID *string) int
-		DeleteRequestSwap  func(childComplexity int, id string, authUserID *string) int
-		DenyRequestSwap    func(childComplexity int, id string, responseNote *string, authUserID *string) int
-		UpdateRequestSwap  func(childComplexity int, id string, input model.RequestSwapInput, authUserID *string) int
+		AcceptRequestSwap        func(childComplexity int, id string, responseNote *string, authUserID *string) int
+		AddRequestComment        func(childComplexity int, id string, body string, authUserID *string) int
+		ApproveRequestSwap       func(childComplexity int, id string, responseNote *string, authUserID *string) int
+		ApproveRequestSwaps      func(childComplexity int, ids []string, responseNote *string, authUserID *string) int
+		CancelRequestSwap        func(childComplexity int, channelID string, requestID string, authUserID *string) int
+		CreateRequestSwap        func(childComplexity int, input model.RequestSwapInput, authUserID *string) int
+		DeclineRequestSwap       func(childComplexity int, id string, responseNote *string, authUserID *string) int
+		DeleteRequestSwap        func(childComplexity int, id string, authUserID *string) int
+		DenyRequestSwap          func(childComplexity int, id string, responseNote *string, authUserID *string) int
+		DenyRequestSwaps         func(childComplexity int, ids []string, responseNote *string, authUserID *string) int
+		SetRequestExpirySettings func(childComplexity int, channelID string, input model.RequestExpirySettingsInput, authUserID *string) int
+		UpdateRequestSwap        func(childComplexity int, id string, input model.RequestSwapInput, authUserID *string) int
+	}
+
+	PageInfo struct {
+		EndCursor       func(childComplexity int) int
+		HasNextPage     func(childComplexity int) int
+		HasPreviousPage func(childComplexity int) int
+		StartCursor     func(childComplexity int) int
 	}
 
 	Query struct {
+		GetRequestExpirySettings             func(childComplexity int, channelID string, authUserID *string) int
 		GetRequestSwap                       func(childComplexity int, id string, authUserID *string) int
+		GetRequestSwapsConnection            func(childComplexity int, channelID string, filter *model.RequestFilter, orderBy *model.RequestOrder, first *int, after *string, authUserID *string) int
 		GetRequestsSwaps                     func(childComplexity int, channelID string, authUserID *string) int
 		GetRequestsSwapsByChannelIDRequestID func(childComplexity int, channelID string, requestID string) int
+		SuggestSwapCandidates                func(childComplexity int, assignedShiftID string, limit *int, authUserID *string) int
+	}
+
+	RequestComment struct {
+		Body      func(childComplexity int) int
+		CreatedAt func(childComplexity int) int
+		ID        func(childComplexity int) int
+		User      func(childComplexity int) int
+		UserID    func(childComplexity int) int
+	}
+
+	RequestCommentResponse struct {
+		Comment func(childComplexity int) int
+		Errors  func(childComplexity int) int
+	}
+
+	RequestExpirySettings struct {
+		ChannelID          func(childComplexity int) int
+		Enabled            func(childComplexity int) int
+		GracePeriodMinutes func(childComplexity int) int
+		UpdatedAt          func(childComplexity int) int
+		UpdatedByUserID    func(childComplexity int) int
+	}
+
+	RequestExpirySettingsResponse struct {
+		Errors   func(childComplexity int) int
+		Settings func(childComplexity int) int
+	}
+
+	RequestHistoryEntry struct {
+		Action      func(childComplexity int) int
+		Actor       func(childComplexity int) int
+		ActorUserID func(childComplexity int) int
+		CreatedAt   func(childComplexity int) int
+		Field       func(childComplexity int) int
+		ID          func(childComplexity int) int
+		NewValue    func(childComplexity int) int
+		OldValue    func(childComplexity int) int
 	}
 
 	RequestResponse struct {
 		ChannelID      func(childComplexity int) int
+		Comments       func(childComplexity int) int
 		CreatedAt      func(childComplexity int) int
 		EndTime        func(childComplexity int) int
+		History        func(childComplexity int) int
 		ID             func(childComplexity int) int
 		IsAllDay       func(childComplexity int) int
 		Reason         func(childComplexity int) int
@@ -121,28 +186,56 @@ type ComplexityRoot struct {
 		AssignedUserShiftID       func(childComplexity int) int
 		AssignedUserShiftIDToSwap func(childComplexity int) int
 		ChannelID                 func(childComplexity int) int
+		Comments                  func(childComplexity int) int
 		CreatedAt                 func(childComplexity int) int
+		EndTime                   func(childComplexity int) int
+		History                   func(childComplexity int) int
 		ID                        func(childComplexity int) int
+		PeerUserID                func(childComplexity int) int
 		RequestID                 func(childComplexity int) int
 		RequestNote               func(childComplexity int) int
 		ResponseAt                func(childComplexity int) int
 		ResponseByUserID          func(childComplexity int) int
 		ResponseNote              func(childComplexity int) int
+		StartTime                 func(childComplexity int) int
 		Status                    func(childComplexity int) int
 		UserID                    func(childComplexity int) int
 	}
 
+	RequestSwapConnection struct {
+		Edges    func(childComplexity int) int
+		PageInfo func(childComplexity int) int
+	}
+
+	RequestSwapEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	RequestSwapResponse struct {
 		Errors  func(childComplexity int) int
 		Request func(childComplexity int) int
 	}
 
+	RequestSwapResult struct {
+		Errors  func(childComplexity int) int
+		ID      func(childComplexity int) int
+		Request func(childComplexity int) int
+	}
+
 	ShiftError struct {
 		Code    func(childComplexity int) int
 		Field   func(childComplexity int) int
 		Message func(childComplexity int) int
 	}
 
+	SwapCandidate struct {
+		Shift               func(childComplexity int) int
+		StartTimeDifference func(childComplexity int) int
+		User                func(childComplexity int) int
+		UserID              func(childComplexity int) int
+	}
+
 	User struct {
 		Avatar    func(childComplexity int) int
 		Email     func(childComplexity int) int
@@ -161,11 +254,45 @@ type MutationResolver interface {
 	CancelRequestSwap(ctx context.Context, channelID string, requestID string, authUserID *string) (*model.RequestSwapResponse, error)
 	ApproveRequestSwap(ctx context.Context, id string, responseNote *string, authUserID *string) (*model.RequestSwapResponse, error)
 	DenyRequestSwap(ctx context.Context, id string, responseNote *string, authUserID *string) (*model.RequestSwapResponse, error)
+	AcceptRequestSwap(ctx context.Context, id string, responseNote *string, authUserID *string) (*model.RequestSwapResponse, error)
+	DeclineRequestSwap(ctx context.Context, id string, responseNote *string, authUserID *string) (*model.RequestSwapResponse, error)
+	ApproveRequestSwaps(ctx context.Context, ids []string, responseNote *string, authUserID *string) (*model.BulkRequestSwapResponse, error)
+	DenyRequestSwaps(ctx context.Context, ids []string, responseNote *string, authUserID *string) (*model.BulkRequestSwapResponse, error)
+	AddRequestComment(ctx context.Context, id string, body string, authUserID *string) (*model.RequestCommentResponse, error)
+	SetRequestExpirySettings(ctx context.Context, channelID string, input model.RequestExpirySettingsInput, authUserID *string) (*model.RequestExpirySettingsResponse, error)
 }
 type QueryResolver interface {
 	GetRequestsSwaps(ctx context.Context, channelID string, authUserID *string) ([]*model.RequestSwap, error)
 	GetRequestsSwapsByChannelIDRequestID(ctx context.Context, channelID string, requestID string) (*model.RequestSwap, error)
 	GetRequestSwap(ctx context.Context, id string, authUserID *string) (*model.RequestSwap, error)
+	GetRequestSwapsConnection(ctx context.Context, channelID string, filter *model.RequestFilter, orderBy *model.RequestOrder, first *int, after *string, authUserID *string) (*model.RequestSwapConnection, error)
+	GetRequestExpirySettings(ctx context.Context, channelID string, authUserID *string) (*model.RequestExpirySettings, error)
+	SuggestSwapCandidates(ctx context.Context, assignedShiftID string, limit *int, authUserID *string) ([]*model.SwapCandidate, error)
+}
+type RequestCommentResolver interface {
+	User(ctx context.Context, obj *model.RequestComment) (*model.User, error)
+}
+type RequestHistoryEntryResolver interface {
+	Actor(ctx context.Context, obj *model.RequestHistoryEntry) (*model.User, error)
+}
+type RequestResponseResolver interface {
+	ResponseBy(ctx context.Context, obj *model.RequestResponse) (*model.User, error)
+
+	ShiftOfferedTo(ctx context.Context, obj *model.RequestResponse) (*model.User, error)
+	ShiftToOffer(ctx context.Context, obj *model.RequestResponse) (*model.AssignedShift, error)
+	ShiftToSwap(ctx context.Context, obj *model.RequestResponse) (*model.AssignedShift, error)
+
+	ToSwapWith(ctx context.Context, obj *model.RequestResponse) (*model.AssignedShift, error)
+
+	History(ctx context.Context, obj *model.RequestResponse) ([]*model.RequestHistoryEntry, error)
+	Comments(ctx context.Context, obj *model.RequestResponse) ([]*model.RequestComment, error)
+}
+type RequestSwapResolver interface {
+	History(ctx context.Context, obj *model.RequestSwap) ([]*model.RequestHistoryEntry, error)
+	Comments(ctx context.Context, obj *model.RequestSwap) ([]*model.RequestComment, error)
+}
+type SwapCandidateResolver interface {
+	User(ctx context.Context, obj *model.SwapCandidate) (*model.User, error)
 }
 
 type executableSchema struct {
@@ -386,6 +513,44 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.AssignedShiftActivities.UserID(childComplexity), true
 
+	case "BulkRequestSwapResponse.errors":
+		if e.complexity.BulkRequestSwapResponse.Errors == nil {
+			break
+		}
+
+		return e.complexity.BulkRequestSwapResponse.Errors(childComplexity), true
+
+	case "BulkRequestSwapResponse.results":
+		if e.complexity.BulkRequestSwapResponse.Results == nil {
+			break
+		}
+
+		return e.complexity.BulkRequestSwapResponse.Results(childComplexity), true
+
+	case "Mutation.acceptRequestSwap":
+		if e.complexity.Mutation.AcceptRequestSwap == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_acceptRequestSwap_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AcceptRequestSwap(childComplexity, args["id"].(string), args["responseNote"].(*string), args["authUserId"].(*string)), true
+
+	case "Mutation.addRequestComment":
+		if e.complexity.Mutation.AddRequestComment == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_addRequestComment_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AddRequestComment(childComplexity, args["id"].(string), args["body"].(string), args["authUserId"].(*string)), true
+
 	case "Mutation.approveRequestSwap":
 		if e.complexity.Mutation.ApproveRequestSwap == nil {
 			break
@@ -398,6 +563,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.ApproveRequestSwap(childComplexity, args["id"].(string), args["responseNote"].(*string), args["authUserId"].(*string)), true
 
+	case "Mutation.approveRequestSwaps":
+		if e.complexity.Mutation.ApproveRequestSwaps == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_approveRequestSwaps_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ApproveRequestSwaps(childComplexity, args["ids"].([]string), args["responseNote"].(*string), args["authUserId"].(*string)), true
+
 	case "Mutation.cancelRequestSwap":
 		if e.complexity.Mutation.CancelRequestSwap == nil {
 			break
@@ -422,6 +599,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.CreateRequestSwap(childComplexity, args["input"].(model.RequestSwapInput), args["authUserId"].(*string)), true
 
+	case "Mutation.declineRequestSwap":
+		if e.complexity.Mutation.DeclineRequestSwap == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_declineRequestSwap_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeclineRequestSwap(childComplexity, args["id"].(string), args["responseNote"].(*string), args["authUserId"].(*string)), true
+
 	case "Mutation.deleteRequestSwap":
 		if e.complexity.Mutation.DeleteRequestSwap == nil {
 			break
@@ -446,6 +635,30 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.DenyRequestSwap(childComplexity, args["id"].(string), args["responseNote"].(*string), args["authUserId"].(*string)), true
 
+	case "Mutation.denyRequestSwaps":
+		if e.complexity.Mutation.DenyRequestSwaps == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_denyRequestSwaps_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DenyRequestSwaps(childComplexity, args["ids"].([]string), args["responseNote"].(*string), args["authUserId"].(*string)), true
+
+	case "Mutation.setRequestExpirySettings":
+		if e.complexity.Mutation.SetRequestExpirySettings == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_setRequestExpirySettings_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.SetRequestExpirySettings(childComplexity, args["channelId"].(string), args["input"].(model.RequestExpirySettingsInput), args["authUserId"].(*string)), true
+
 	case "Mutation.updateRequestSwap":
 		if e.complexity.Mutation.UpdateRequestSwap == nil {
 			break
@@ -458,6 +671,46 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.UpdateRequestSwap(childComplexity, args["id"].(string), args["input"].(model.RequestSwapInput), args["authUserId"].(*string)), true
 
+	case "PageInfo.endCursor":
+		if e.complexity.PageInfo.EndCursor == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.EndCursor(childComplexity), true
+
+	case "PageInfo.hasNextPage":
+		if e.complexity.PageInfo.HasNextPage == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.HasNextPage(childComplexity), true
+
+	case "PageInfo.hasPreviousPage":
+		if e.complexity.PageInfo.HasPreviousPage == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.HasPreviousPage(childComplexity), true
+
+	case "PageInfo.startCursor":
+		if e.complexity.PageInfo.StartCursor == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.StartCursor(childComplexity), true
+
+	case "Query.getRequestExpirySettings":
+		if e.complexity.Query.GetRequestExpirySettings == nil {
+			break
+		}
+
+		args, err := ec.field_Query_getRequestExpirySettings_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.GetRequestExpirySettings(childComplexity, args["channelId"].(string), args["authUserId"].(*string)), true
+
 	case "Query.getRequestSwap":
 		if e.complexity.Query.GetRequestSwap == nil {
 			break
@@ -470,6 +723,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.GetRequestSwap(childComplexity, args["id"].(string), args["authUserId"].(*string)), true
 
+	case "Query.getRequestSwapsConnection":
+		if e.complexity.Query.GetRequestSwapsConnection == nil {
+			break
+		}
+
+		args, err := ec.field_Query_getRequestSwapsConnection_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.GetRequestSwapsConnection(childComplexity, args["channelId"].(string), args["filter"].(*model.RequestFilter), args["orderBy"].(*model.RequestOrder), args["first"].(*int), args["after"].(*string), args["authUserId"].(*string)), true
+
 	case "Query.getRequestsSwaps":
 		if e.complexity.Query.GetRequestsSwaps == nil {
 			break
@@ -494,6 +759,172 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.GetRequestsSwapsByChannelIDRequestID(childComplexity, args["channelId"].(string), args["requestId"].(string)), true
 
+	case "Query.suggestSwapCandidates":
+		if e.complexity.Query.SuggestSwapCandidates == nil {
+			break
+		}
+
+		args, err := ec.field_Query_suggestSwapCandidates_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.SuggestSwapCandidates(childComplexity, args["assignedShiftId"].(string), args["limit"].(*int), args["authUserId"].(*string)), true
+
+	case "RequestComment.body":
+		if e.complexity.RequestComment.Body == nil {
+			break
+		}
+
+		return e.complexity.RequestComment.Body(childComplexity), true
+
+	case "RequestComment.createdAt":
+		if e.complexity.RequestComment.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.RequestComment.CreatedAt(childComplexity), true
+
+	case "RequestComment.id":
+		if e.complexity.RequestComment.ID == nil {
+			break
+		}
+
+		return e.complexity.RequestComment.ID(childComplexity), true
+
+	case "RequestComment.user":
+		if e.complexity.RequestComment.User == nil {
+			break
+		}
+
+		return e.complexity.RequestComment.User(childComplexity), true
+
+	case "RequestComment.userId":
+		if e.complexity.RequestComment.UserID == nil {
+			break
+		}
+
+		return e.complexity.RequestComment.UserID(childComplexity), true
+
+	case "RequestCommentResponse.comment":
+		if e.complexity.RequestCommentResponse.Comment == nil {
+			break
+		}
+
+		return e.complexity.RequestCommentResponse.Comment(childComplexity), true
+
+	case "RequestCommentResponse.errors":
+		if e.complexity.RequestCommentResponse.Errors == nil {
+			break
+		}
+
+		return e.complexity.RequestCommentResponse.Errors(childComplexity), true
+
+	case "RequestExpirySettings.channelId":
+		if e.complexity.RequestExpirySettings.ChannelID == nil {
+			break
+		}
+
+		return e.complexity.RequestExpirySettings.ChannelID(childComplexity), true
+
+	case "RequestExpirySettings.enabled":
+		if e.complexity.RequestExpirySettings.Enabled == nil {
+			break
+		}
+
+		return e.complexity.RequestExpirySettings.Enabled(childComplexity), true
+
+	case "RequestExpirySettings.gracePeriodMinutes":
+		if e.complexity.RequestExpirySettings.GracePeriodMinutes == nil {
+			break
+		}
+
+		return e.complexity.RequestExpirySettings.GracePeriodMinutes(childComplexity), true
+
+	case "RequestExpirySettings.updatedAt":
+		if e.complexity.RequestExpirySettings.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.RequestExpirySettings.UpdatedAt(childComplexity), true
+
+	case "RequestExpirySettings.updatedByUserId":
+		if e.complexity.RequestExpirySettings.UpdatedByUserID == nil {
+			break
+		}
+
+		return e.complexity.RequestExpirySettings.UpdatedByUserID(childComplexity), true
+
+	case "RequestExpirySettingsResponse.errors":
+		if e.complexity.RequestExpirySettingsResponse.Errors == nil {
+			break
+		}
+
+		return e.complexity.RequestExpirySettingsResponse.Errors(childComplexity), true
+
+	case "RequestExpirySettingsResponse.settings":
+		if e.complexity.RequestExpirySettingsResponse.Settings == nil {
+			break
+		}
+
+		return e.complexity.RequestExpirySettingsResponse.Settings(childComplexity), true
+
+	case "RequestHistoryEntry.action":
+		if e.complexity.RequestHistoryEntry.Action == nil {
+			break
+		}
+
+		return e.complexity.RequestHistoryEntry.Action(childComplexity), true
+
+	case "RequestHistoryEntry.actor":
+		if e.complexity.RequestHistoryEntry.Actor == nil {
+			break
+		}
+
+		return e.complexity.RequestHistoryEntry.Actor(childComplexity), true
+
+	case "RequestHistoryEntry.actorUserId":
+		if e.complexity.RequestHistoryEntry.ActorUserID == nil {
+			break
+		}
+
+		return e.complexity.RequestHistoryEntry.ActorUserID(childComplexity), true
+
+	case "RequestHistoryEntry.createdAt":
+		if e.complexity.RequestHistoryEntry.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.RequestHistoryEntry.CreatedAt(childComplexity), true
+
+	case "RequestHistoryEntry.field":
+		if e.complexity.RequestHistoryEntry.Field == nil {
+			break
+		}
+
+		return e.complexity.RequestHistoryEntry.Field(childComplexity), true
+
+	case "RequestHistoryEntry.id":
+		if e.complexity.RequestHistoryEntry.ID == nil {
+			break
+		}
+
+		return e.complexity.RequestHistoryEntry.ID(childComplexity), true
+
+	case "RequestHistoryEntry.newValue":
+		if e.complexity.RequestHistoryEntry.NewValue == nil {
+			break
+		}
+
+		return e.complexity.RequestHistoryEntry.NewValue(childComplexity), true
+
+	case "RequestHistoryEntry.oldValue":
+		if e.complexity.RequestHistoryEntry.OldValue == nil {
+			break
+		}
+
+		return e.complexity.RequestHistoryEntry.OldValue(childComplexity), true
+
 	case "RequestResponse.channelId":
 		if e.complexity.RequestResponse.ChannelID == nil {
 			break
@@ -501,6 +932,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.RequestResponse.ChannelID(childComplexity), true
 
+	case "RequestResponse.comments":
+		if e.complexity.RequestResponse.Comments == nil {
+			break
+		}
+
+		return e.complexity.RequestResponse.Comments(childComplexity), true
+
 	case "RequestResponse.createdAt":
 		if e.complexity.RequestResponse.CreatedAt == nil {
 			break
@@ -515,6 +953,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.RequestResponse.EndTime(childComplexity), true
 
+	case "RequestResponse.history":
+		if e.complexity.RequestResponse.History == nil {
+			break
+		}
+
+		return e.complexity.RequestResponse.History(childComplexity), true
+
 	case "RequestResponse.id":
 		if e.complexity.RequestResponse.ID == nil {
 			break
@@ -648,6 +1093,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.RequestSwap.ChannelID(childComplexity), true
 
+	case "RequestSwap.comments":
+		if e.complexity.RequestSwap.Comments == nil {
+			break
+		}
+
+		return e.complexity.RequestSwap.Comments(childComplexity), true
+
 	case "RequestSwap.createdAt":
 		if e.complexity.RequestSwap.CreatedAt == nil {
 			break
@@ -655,6 +1107,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.RequestSwap.CreatedAt(childComplexity), true
 
+	case "RequestSwap.endTime":
+		if e.complexity.RequestSwap.EndTime == nil {
+			break
+		}
+
+		return e.complexity.RequestSwap.EndTime(childComplexity), true
+
+	case "RequestSwap.history":
+		if e.complexity.RequestSwap.History == nil {
+			break
+		}
+
+		return e.complexity.RequestSwap.History(childComplexity), true
+
 	case "RequestSwap.id":
 		if e.complexity.RequestSwap.ID == nil {
 			break
@@ -662,6 +1128,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.RequestSwap.ID(childComplexity), true
 
+	case "RequestSwap.peerUserId":
+		if e.complexity.RequestSwap.PeerUserID == nil {
+			break
+		}
+
+		return e.complexity.RequestSwap.PeerUserID(childComplexity), true
+
 	case "RequestSwap.requestId":
 		if e.complexity.RequestSwap.RequestID == nil {
 			break
@@ -697,6 +1170,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.RequestSwap.ResponseNote(childComplexity), true
 
+	case "RequestSwap.startTime":
+		if e.complexity.RequestSwap.StartTime == nil {
+			break
+		}
+
+		return e.complexity.RequestSwap.StartTime(childComplexity), true
+
 	case "RequestSwap.status":
 		if e.complexity.RequestSwap.Status == nil {
 			break
@@ -711,29 +1191,78 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.RequestSwap.UserID(childComplexity), true
 
-	case "RequestSwapResponse.errors":
-		if e.complexity.RequestSwapResponse.Errors == nil {
+	case "RequestSwapConnection.edges":
+		if e.complexity.RequestSwapConnection.Edges == nil {
 			break
 		}
 
-		return e.complexity.RequestSwapResponse.Errors(childComplexity), true
+		return e.complexity.RequestSwapConnection.Edges(childComplexity), true
 
-	case "RequestSwapResponse.request":
-		if e.complexity.RequestSwapResponse.Request == nil {
+	case "RequestSwapConnection.pageInfo":
+		if e.complexity.RequestSwapConnection.PageInfo == nil {
 			break
 		}
 
-		return e.complexity.RequestSwapResponse.Request(childComplexity), true
+		return e.complexity.RequestSwapConnection.PageInfo(childComplexity), true
 
-	case "ShiftError.code":
-		if e.complexity.ShiftError.Code == nil {
+	case "RequestSwapEdge.cursor":
+		if e.complexity.RequestSwapEdge.Cursor == nil {
 			break
 		}
 
-		return e.complexity.ShiftError.Code(childComplexity), true
+		return e.complexity.RequestSwapEdge.Cursor(childComplexity), true
 
-	case "ShiftError.field":
-		if e.complexity.ShiftError.Field == nil {
+	case "RequestSwapEdge.node":
+		if e.complexity.RequestSwapEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.RequestSwapEdge.Node(childComplexity), true
+
+	case "RequestSwapResponse.errors":
+		if e.complexity.RequestSwapResponse.Errors == nil {
+			break
+		}
+
+		return e.complexity.RequestSwapResponse.Errors(childComplexity), true
+
+	case "RequestSwapResponse.request":
+		if e.complexity.RequestSwapResponse.Request == nil {
+			break
+		}
+
+		return e.complexity.RequestSwapResponse.Request(childComplexity), true
+
+	case "RequestSwapResult.errors":
+		if e.complexity.RequestSwapResult.Errors == nil {
+			break
+		}
+
+		return e.complexity.RequestSwapResult.Errors(childComplexity), true
+
+	case "RequestSwapResult.id":
+		if e.complexity.RequestSwapResult.ID == nil {
+			break
+		}
+
+		return e.complexity.RequestSwapResult.ID(childComplexity), true
+
+	case "RequestSwapResult.request":
+		if e.complexity.RequestSwapResult.Request == nil {
+			break
+		}
+
+		return e.complexity.RequestSwapResult.Request(childComplexity), true
+
+	case "ShiftError.code":
+		if e.complexity.ShiftError.Code == nil {
+			break
+		}
+
+		return e.complexity.ShiftError.Code(childComplexity), true
+
+	case "ShiftError.field":
+		if e.complexity.ShiftError.Field == nil {
 			break
 		}
 
@@ -746,6 +1275,34 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.ShiftError.Message(childComplexity), true
 
+	case "SwapCandidate.shift":
+		if e.complexity.SwapCandidate.Shift == nil {
+			break
+		}
+
+		return e.complexity.SwapCandidate.Shift(childComplexity), true
+
+	case "SwapCandidate.startTimeDifference":
+		if e.complexity.SwapCandidate.StartTimeDifference == nil {
+			break
+		}
+
+		return e.complexity.SwapCandidate.StartTimeDifference(childComplexity), true
+
+	case "SwapCandidate.user":
+		if e.complexity.SwapCandidate.User == nil {
+			break
+		}
+
+		return e.complexity.SwapCandidate.User(childComplexity), true
+
+	case "SwapCandidate.userId":
+		if e.complexity.SwapCandidate.UserID == nil {
+			break
+		}
+
+		return e.complexity.SwapCandidate.UserID(childComplexity), true
+
 	case "User.avatar":
 		if e.complexity.User.Avatar == nil {
 			break
@@ -803,6 +1360,9 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 	rc := graphql.GetOperationContext(ctx)
 	ec := executionContext{rc, e}
 	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
+		ec.unmarshalInputRequestExpirySettingsInput,
+		ec.unmarshalInputRequestFilter,
+		ec.unmarshalInputRequestOrder,
 		ec.unmarshalInputRequestSwapInput,
 	)
 	first := true
@@ -871,12 +1431,115 @@ var sources = []*ast.Source{
   userId: ID!
   assignedUserShiftId: ID
   assignedUserShiftIdToSwap: ID
+  """
+  Owner of the shift to swap, who accepts or declines the swap before a manager approves it
+  """
+  peerUserId: ID
   requestNote: String
   status: RequestStatus!
   responseNote: String
   responseByUserId: ID
   responseAt: Time
   createdAt: Time!
+  """
+  Start of the earliest shift of the swap, recorded when it is created or updated
+  """
+  startTime: Time
+  """
+  End of the latest shift of the swap, recorded when it is created or updated
+  """
+  endTime: Time
+  """
+  Every change of the request swap, oldest first
+  """
+  history: [RequestHistoryEntry!]!
+  """
+  Comments on the request swap, oldest first
+  """
+  comments: [RequestComment!]!
+}
+
+enum RequestHistoryAction {
+  CREATED
+  UPDATED
+  STATUS_CHANGED
+}
+
+"""
+One change of a request; the history is only ever appended to
+"""
+type RequestHistoryEntry {
+  id: ID!
+  action: RequestHistoryAction!
+  """
+  Field that changed, for UPDATED and STATUS_CHANGED
+  """
+  field: String
+  oldValue: String
+  newValue: String
+  """
+  User who made the change; empty for changes of the service itself, such as expiry
+  """
+  actorUserId: ID
+  actor: User
+  createdAt: Time!
+}
+
+type RequestComment {
+  id: ID!
+  userId: ID!
+  user: User
+  body: String!
+  createdAt: Time!
+}
+
+type RequestCommentResponse {
+  errors: [ShiftError!]!
+  comment: RequestComment
+}
+
+type RequestSwapEdge {
+  cursor: String!
+  node: RequestSwap!
+}
+
+type PageInfo {
+  hasNextPage: Boolean!
+  hasPreviousPage: Boolean!
+  startCursor: String
+  endCursor: String
+}
+
+type RequestSwapConnection {
+  edges: [RequestSwapEdge!]!
+  pageInfo: PageInfo!
+}
+
+input RequestFilter {
+  status: [RequestStatus!]
+  userId: ID
+  """
+  Only requests whose shifts overlap the period between from and to
+  """
+  from: Time
+  to: Time
+  createdAfter: Time
+  createdBefore: Time
+}
+
+enum RequestOrderField {
+  CREATED_AT
+  START_TIME
+}
+
+enum OrderDirection {
+  ASC
+  DESC
+}
+
+input RequestOrder {
+  field: RequestOrderField!
+  direction: OrderDirection!
 }
 
 input RequestSwapInput {
@@ -955,6 +1618,21 @@ type RequestResponse {
   toSwapWith: AssignedShift
   type: RequestType
   user: User!
+  history: [RequestHistoryEntry!]!
+  comments: [RequestComment!]!
+}
+
+"""
+A coworker and one of their shifts that could be swapped with a given shift
+"""
+type SwapCandidate {
+  userId: ID!
+  user: User
+  shift: AssignedShift!
+  """
+  Minutes between the start of the candidate's shift and the start of the given shift
+  """
+  startTimeDifference: Int!
 }
 
 type RequestSwapResponse {
@@ -962,24 +1640,86 @@ type RequestSwapResponse {
   request: RequestResponse
 }
 
+"""
+How the expiry scheduler treats the waiting request swaps of a channel
+"""
+type RequestExpirySettings {
+  channelId: ID!
+  enabled: Boolean!
+  """
+  Minutes after the start of its shifts a waiting request swap expires
+  """
+  gracePeriodMinutes: Int!
+  updatedByUserId: ID
+  updatedAt: Time
+}
+
+input RequestExpirySettingsInput {
+  enabled: Boolean!
+  gracePeriodMinutes: Int!
+}
+
+type RequestExpirySettingsResponse {
+  errors: [ShiftError!]!
+  settings: RequestExpirySettings
+}
+
+"""
+Outcome of a bulk mutation for one request swap
+"""
+type RequestSwapResult {
+  id: ID!
+  errors: [ShiftError!]!
+  request: RequestResponse
+}
+
+"""
+errors are about the whole call; each request swap has its own result, in the order of the ids
+"""
+type BulkRequestSwapResponse {
+  errors: [ShiftError!]!
+  results: [RequestSwapResult!]!
+}
+
 type ShiftError {
   code: ShiftErrorCode!
   field: String
   message: String
 }
 
+"""
+Stable error codes, also set as the "code" extension of GraphQL errors
+"""
 enum ShiftErrorCode {
-  GRAPHQL_ERROR
-  INVALID
+  UNAUTHENTICATED
+  FORBIDDEN
   NOT_FOUND
-  REQUIRED
+  VALIDATION
+  CONFLICT
+  UPSTREAM_UNAVAILABLE
+  INTERNAL
+  GRAPHQL_ERROR @deprecated(reason: "Use INTERNAL")
+  INVALID @deprecated(reason: "Use VALIDATION")
+  REQUIRED @deprecated(reason: "Use VALIDATION, UNAUTHENTICATED or FORBIDDEN")
 }
 
 enum RequestStatus {
+  """
+  Waiting for the owner of the shift to swap to accept or decline
+  """
+  AWAITING_PEER
+  """
+  Declined by the owner of the shift to swap
+  """
+  PEER_DECLINED
   PENDING
   APPROVED
   DENIED
   CANCELLED
+  """
+  Still waiting when its shifts started, moved here by the expiry scheduler
+  """
+  EXPIRED
 }
 
 enum RequestType {
@@ -991,40 +1731,105 @@ enum RequestType {
 scalar Time
 
 type Query {
-  getRequestsSwaps(channelId: ID!, authUserId: ID): [RequestSwap]!
+  getRequestsSwaps(channelId: ID!, authUserId: ID @deprecated(reason: "The user is taken from the session; only honored for trusted services")): [RequestSwap]!
   getRequestsSwapsByChannelIdRequestId(
     channelId: ID!
     requestId: ID!
   ): RequestSwap!
-  getRequestSwap(id: ID!, authUserId: ID): RequestSwap!
+  getRequestSwap(id: ID!, authUserId: ID @deprecated(reason: "The user is taken from the session; only honored for trusted services")): RequestSwap!
+  """
+  Request swaps of the channel, a page at a time; newest first unless orderBy says otherwise
+  """
+  getRequestSwapsConnection(
+    channelId: ID!
+    filter: RequestFilter
+    orderBy: RequestOrder
+    first: Int
+    after: String
+    authUserId: ID @deprecated(reason: "The user is taken from the session; only honored for trusted services")
+  ): RequestSwapConnection!
+  """
+  Expiry settings of the channel; the defaults of the service when it has none
+  """
+  getRequestExpirySettings(
+    channelId: ID!
+    authUserId: ID @deprecated(reason: "The user is taken from the session; only honored for trusted services")
+  ): RequestExpirySettings!
+  """
+  Coworkers who could take the assigned shift in exchange for one of theirs, closest in time first
+  """
+  suggestSwapCandidates(
+    assignedShiftId: ID!
+    limit: Int
+    authUserId: ID @deprecated(reason: "The user is taken from the session; only honored for trusted services")
+  ): [SwapCandidate!]!
 }
 
 type Mutation {
   createRequestSwap(
     input: RequestSwapInput!
-    authUserId: ID
+    authUserId: ID @deprecated(reason: "The user is taken from the session; only honored for trusted services")
   ): RequestSwapResponse!
   updateRequestSwap(
     id: ID!
     input: RequestSwapInput!
-    authUserId: ID
+    authUserId: ID @deprecated(reason: "The user is taken from the session; only honored for trusted services")
   ): RequestSwapResponse!
-  deleteRequestSwap(id: ID!, authUserId: ID): RequestSwapResponse!
+  deleteRequestSwap(id: ID!, authUserId: ID @deprecated(reason: "The user is taken from the session; only honored for trusted services")): RequestSwapResponse!
   cancelRequestSwap(
     channelId: ID!
     requestId: ID!
-    authUserId: ID
+    authUserId: ID @deprecated(reason: "The user is taken from the session; only honored for trusted services")
   ): RequestSwapResponse
   approveRequestSwap(
     id: ID!
     responseNote: String
-    authUserId: ID
+    authUserId: ID @deprecated(reason: "The user is taken from the session; only honored for trusted services")
   ): RequestSwapResponse!
   denyRequestSwap(
     id: ID!
     responseNote: String
-    authUserId: ID
+    authUserId: ID @deprecated(reason: "The user is taken from the session; only honored for trusted services")
   ): RequestSwapResponse!
+  acceptRequestSwap(
+    id: ID!
+    responseNote: String
+    authUserId: ID @deprecated(reason: "The user is taken from the session; only honored for trusted services")
+  ): RequestSwapResponse!
+  declineRequestSwap(
+    id: ID!
+    responseNote: String
+    authUserId: ID @deprecated(reason: "The user is taken from the session; only honored for trusted services")
+  ): RequestSwapResponse!
+  """
+  Approves each request swap on its own, at most 100
+  """
+  approveRequestSwaps(
+    ids: [ID!]!
+    responseNote: String
+    authUserId: ID @deprecated(reason: "The user is taken from the session; only honored for trusted services")
+  ): BulkRequestSwapResponse!
+  """
+  Denies each request swap on its own, at most 100
+  """
+  denyRequestSwaps(
+    ids: [ID!]!
+    responseNote: String
+    authUserId: ID @deprecated(reason: "The user is taken from the session; only honored for trusted services")
+  ): BulkRequestSwapResponse!
+  """
+  Adds a comment to the request swap; its requester, its peer and managers can comment
+  """
+  addRequestComment(
+    id: ID!
+    body: String!
+    authUserId: ID @deprecated(reason: "The user is taken from the session; only honored for trusted services")
+  ): RequestCommentResponse!
+  setRequestExpirySettings(
+    channelId: ID!
+    input: RequestExpirySettingsInput!
+    authUserId: ID @deprecated(reason: "The user is taken from the session; only honored for trusted services")
+  ): RequestExpirySettingsResponse!
 }
 `, BuiltIn: false},
 }
@@ -1034,7 +1839,7 @@ var parsedSchema = gqlparser.MustLoadSchema(sources...)
 
 // region    ***************************** args.gotpl *****************************
 
-func (ec *executionContext) field_Mutation_approveRequestSwap_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_acceptRequestSwap_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
 	var arg0 string
@@ -1067,27 +1872,27 @@ func (ec *executionContext) field_Mutation_approveRequestSwap_args(ctx context.C
 	return args, nil
 }
 
-func (ec *executionContext) field_Mutation_cancelRequestSwap_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_addRequestComment_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
 	var arg0 string
-	if tmp, ok := rawArgs["channelId"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("channelId"))
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
 		arg0, err = ec.unmarshalNID2string(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["channelId"] = arg0
+	args["id"] = arg0
 	var arg1 string
-	if tmp, ok := rawArgs["requestId"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("requestId"))
-		arg1, err = ec.unmarshalNID2string(ctx, tmp)
+	if tmp, ok := rawArgs["body"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("body"))
+		arg1, err = ec.unmarshalNString2string(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["requestId"] = arg1
+	args["body"] = arg1
 	var arg2 *string
 	if tmp, ok := rawArgs["authUserId"]; ok {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authUserId"))
@@ -1100,31 +1905,7 @@ func (ec *executionContext) field_Mutation_cancelRequestSwap_args(ctx context.Co
 	return args, nil
 }
 
-func (ec *executionContext) field_Mutation_createRequestSwap_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 model.RequestSwapInput
-	if tmp, ok := rawArgs["input"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
-		arg0, err = ec.unmarshalNRequestSwapInput2request_swapsᚋgraphᚋmodelᚐRequestSwapInput(ctx, tmp)
-		if err != nil {
-			return nil, err
-		}
-	}
-	args["input"] = arg0
-	var arg1 *string
-	if tmp, ok := rawArgs["authUserId"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authUserId"))
-		arg1, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
-		if err != nil {
-			return nil, err
-		}
-	}
-	args["authUserId"] = arg1
-	return args, nil
-}
-
-func (ec *executionContext) field_Mutation_deleteRequestSwap_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_approveRequestSwap_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
 	var arg0 string
@@ -1137,29 +1918,38 @@ func (ec *executionContext) field_Mutation_deleteRequestSwap_args(ctx context.Co
 	}
 	args["id"] = arg0
 	var arg1 *string
+	if tmp, ok := rawArgs["responseNote"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("responseNote"))
+		arg1, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["responseNote"] = arg1
+	var arg2 *string
 	if tmp, ok := rawArgs["authUserId"]; ok {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authUserId"))
-		arg1, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
+		arg2, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["authUserId"] = arg1
+	args["authUserId"] = arg2
 	return args, nil
 }
 
-func (ec *executionContext) field_Mutation_denyRequestSwap_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_approveRequestSwaps_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 string
-	if tmp, ok := rawArgs["id"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
-		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+	var arg0 []string
+	if tmp, ok := rawArgs["ids"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("ids"))
+		arg0, err = ec.unmarshalNID2ᚕstringᚄ(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["id"] = arg0
+	args["ids"] = arg0
 	var arg1 *string
 	if tmp, ok := rawArgs["responseNote"]; ok {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("responseNote"))
@@ -1181,27 +1971,27 @@ func (ec *executionContext) field_Mutation_denyRequestSwap_args(ctx context.Cont
 	return args, nil
 }
 
-func (ec *executionContext) field_Mutation_updateRequestSwap_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_cancelRequestSwap_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
 	var arg0 string
-	if tmp, ok := rawArgs["id"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+	if tmp, ok := rawArgs["channelId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("channelId"))
 		arg0, err = ec.unmarshalNID2string(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["id"] = arg0
-	var arg1 model.RequestSwapInput
-	if tmp, ok := rawArgs["input"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
-		arg1, err = ec.unmarshalNRequestSwapInput2request_swapsᚋgraphᚋmodelᚐRequestSwapInput(ctx, tmp)
+	args["channelId"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["requestId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("requestId"))
+		arg1, err = ec.unmarshalNID2string(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["input"] = arg1
+	args["requestId"] = arg1
 	var arg2 *string
 	if tmp, ok := rawArgs["authUserId"]; ok {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authUserId"))
@@ -1214,22 +2004,31 @@ func (ec *executionContext) field_Mutation_updateRequestSwap_args(ctx context.Co
 	return args, nil
 }
 
-func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_createRequestSwap_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 string
-	if tmp, ok := rawArgs["name"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
-		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+	var arg0 model.RequestSwapInput
+	if tmp, ok := rawArgs["input"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+		arg0, err = ec.unmarshalNRequestSwapInput2request_swapsᚋgraphᚋmodelᚐRequestSwapInput(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["name"] = arg0
+	args["input"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["authUserId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authUserId"))
+		arg1, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["authUserId"] = arg1
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_getRequestSwap_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_declineRequestSwap_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
 	var arg0 string
@@ -1242,223 +2041,544 @@ func (ec *executionContext) field_Query_getRequestSwap_args(ctx context.Context,
 	}
 	args["id"] = arg0
 	var arg1 *string
+	if tmp, ok := rawArgs["responseNote"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("responseNote"))
+		arg1, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["responseNote"] = arg1
+	var arg2 *string
 	if tmp, ok := rawArgs["authUserId"]; ok {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authUserId"))
-		arg1, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
+		arg2, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["authUserId"] = arg1
+	args["authUserId"] = arg2
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_getRequestsSwapsByChannelIdRequestId_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_deleteRequestSwap_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
 	var arg0 string
-	if tmp, ok := rawArgs["channelId"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("channelId"))
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
 		arg0, err = ec.unmarshalNID2string(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["channelId"] = arg0
-	var arg1 string
-	if tmp, ok := rawArgs["requestId"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("requestId"))
-		arg1, err = ec.unmarshalNID2string(ctx, tmp)
+	args["id"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["authUserId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authUserId"))
+		arg1, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["requestId"] = arg1
+	args["authUserId"] = arg1
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_getRequestsSwaps_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_denyRequestSwap_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
 	var arg0 string
-	if tmp, ok := rawArgs["channelId"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("channelId"))
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
 		arg0, err = ec.unmarshalNID2string(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["channelId"] = arg0
+	args["id"] = arg0
 	var arg1 *string
-	if tmp, ok := rawArgs["authUserId"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authUserId"))
-		arg1, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
+	if tmp, ok := rawArgs["responseNote"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("responseNote"))
+		arg1, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["authUserId"] = arg1
+	args["responseNote"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["authUserId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authUserId"))
+		arg2, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["authUserId"] = arg2
 	return args, nil
 }
 
-func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_denyRequestSwaps_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 bool
-	if tmp, ok := rawArgs["includeDeprecated"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("includeDeprecated"))
-		arg0, err = ec.unmarshalOBoolean2bool(ctx, tmp)
+	var arg0 []string
+	if tmp, ok := rawArgs["ids"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("ids"))
+		arg0, err = ec.unmarshalNID2ᚕstringᚄ(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["includeDeprecated"] = arg0
+	args["ids"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["responseNote"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("responseNote"))
+		arg1, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["responseNote"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["authUserId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authUserId"))
+		arg2, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["authUserId"] = arg2
 	return args, nil
 }
 
-func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_setRequestExpirySettings_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 bool
-	if tmp, ok := rawArgs["includeDeprecated"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("includeDeprecated"))
-		arg0, err = ec.unmarshalOBoolean2bool(ctx, tmp)
+	var arg0 string
+	if tmp, ok := rawArgs["channelId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("channelId"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["includeDeprecated"] = arg0
+	args["channelId"] = arg0
+	var arg1 model.RequestExpirySettingsInput
+	if tmp, ok := rawArgs["input"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+		arg1, err = ec.unmarshalNRequestExpirySettingsInput2request_swapsᚋgraphᚋmodelᚐRequestExpirySettingsInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["input"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["authUserId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authUserId"))
+		arg2, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["authUserId"] = arg2
 	return args, nil
 }
 
-// endregion ***************************** args.gotpl *****************************
-
-// region    ************************** directives.gotpl **************************
-
-// endregion ************************** directives.gotpl **************************
-
-// region    **************************** field.gotpl *****************************
-
-func (ec *executionContext) _AssignedShift_id(ctx context.Context, field graphql.CollectedField, obj *model.AssignedShift) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_AssignedShift_id(ctx, field)
-	if err != nil {
-		return graphql.Null
+func (ec *executionContext) field_Mutation_updateRequestSwap_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
 	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	args["id"] = arg0
+	var arg1 model.RequestSwapInput
+	if tmp, ok := rawArgs["input"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+		arg1, err = ec.unmarshalNRequestSwapInput2request_swapsᚋgraphᚋmodelᚐRequestSwapInput(ctx, tmp)
+		if err != nil {
+			return nil, err
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
 	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+	args["input"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["authUserId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authUserId"))
+		arg2, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
 		}
-		return graphql.Null
 	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	args["authUserId"] = arg2
+	return args, nil
 }
 
-func (ec *executionContext) fieldContext_AssignedShift_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AssignedShift",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
-		},
+func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["name"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
 	}
-	return fc, nil
+	args["name"] = arg0
+	return args, nil
 }
 
-func (ec *executionContext) _AssignedShift_break(ctx context.Context, field graphql.CollectedField, obj *model.AssignedShift) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_AssignedShift_break(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+func (ec *executionContext) field_Query_getRequestExpirySettings_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["channelId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("channelId"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Break, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
 	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+	args["channelId"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["authUserId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authUserId"))
+		arg1, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
 		}
-		return graphql.Null
 	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	args["authUserId"] = arg1
+	return args, nil
 }
 
-func (ec *executionContext) fieldContext_AssignedShift_break(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AssignedShift",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+func (ec *executionContext) field_Query_getRequestSwap_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
 	}
-	return fc, nil
+	args["id"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["authUserId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authUserId"))
+		arg1, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["authUserId"] = arg1
+	return args, nil
 }
 
-func (ec *executionContext) _AssignedShift_color(ctx context.Context, field graphql.CollectedField, obj *model.AssignedShift) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_AssignedShift_color(ctx, field)
-	if err != nil {
-		return graphql.Null
+func (ec *executionContext) field_Query_getRequestSwapsConnection_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["channelId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("channelId"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
 	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	args["channelId"] = arg0
+	var arg1 *model.RequestFilter
+	if tmp, ok := rawArgs["filter"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("filter"))
+		arg1, err = ec.unmarshalORequestFilter2ᚖrequest_swapsᚋgraphᚋmodelᚐRequestFilter(ctx, tmp)
+		if err != nil {
+			return nil, err
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Color, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
 	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+	args["filter"] = arg1
+	var arg2 *model.RequestOrder
+	if tmp, ok := rawArgs["orderBy"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("orderBy"))
+		arg2, err = ec.unmarshalORequestOrder2ᚖrequest_swapsᚋgraphᚋmodelᚐRequestOrder(ctx, tmp)
+		if err != nil {
+			return nil, err
 		}
-		return graphql.Null
 	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
-}
-
-func (ec *executionContext) fieldContext_AssignedShift_color(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	args["orderBy"] = arg2
+	var arg3 *int
+	if tmp, ok := rawArgs["first"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("first"))
+		arg3, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["first"] = arg3
+	var arg4 *string
+	if tmp, ok := rawArgs["after"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("after"))
+		arg4, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["after"] = arg4
+	var arg5 *string
+	if tmp, ok := rawArgs["authUserId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authUserId"))
+		arg5, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["authUserId"] = arg5
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_getRequestsSwapsByChannelIdRequestId_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["channelId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("channelId"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["channelId"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["requestId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("requestId"))
+		arg1, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["requestId"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_getRequestsSwaps_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["channelId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("channelId"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["channelId"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["authUserId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authUserId"))
+		arg1, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["authUserId"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_suggestSwapCandidates_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["assignedShiftId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("assignedShiftId"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["assignedShiftId"] = arg0
+	var arg1 *int
+	if tmp, ok := rawArgs["limit"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
+		arg1, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["limit"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["authUserId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authUserId"))
+		arg2, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["authUserId"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 bool
+	if tmp, ok := rawArgs["includeDeprecated"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("includeDeprecated"))
+		arg0, err = ec.unmarshalOBoolean2bool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 bool
+	if tmp, ok := rawArgs["includeDeprecated"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("includeDeprecated"))
+		arg0, err = ec.unmarshalOBoolean2bool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+// endregion ***************************** args.gotpl *****************************
+
+// region    ************************** directives.gotpl **************************
+
+// endregion ************************** directives.gotpl **************************
+
+// region    **************************** field.gotpl *****************************
+
+func (ec *executionContext) _AssignedShift_id(ctx context.Context, field graphql.CollectedField, obj *model.AssignedShift) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AssignedShift_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AssignedShift_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AssignedShift",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AssignedShift_break(ctx context.Context, field graphql.CollectedField, obj *model.AssignedShift) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AssignedShift_break(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Break, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AssignedShift_break(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AssignedShift",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AssignedShift_color(ctx context.Context, field graphql.CollectedField, obj *model.AssignedShift) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AssignedShift_color(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Color, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AssignedShift_color(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "AssignedShift",
 		Field:      field,
@@ -2699,8 +3819,8 @@ func (ec *executionContext) fieldContext_AssignedShiftActivities_isPaid(ctx cont
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_createRequestSwap(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_createRequestSwap(ctx, field)
+func (ec *executionContext) _BulkRequestSwapResponse_errors(ctx context.Context, field graphql.CollectedField, obj *model.BulkRequestSwapResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_BulkRequestSwapResponse_errors(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2713,7 +3833,7 @@ func (ec *executionContext) _Mutation_createRequestSwap(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().CreateRequestSwap(rctx, fc.Args["input"].(model.RequestSwapInput), fc.Args["authUserId"].(*string))
+		return obj.Errors, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2725,12 +3845,116 @@ func (ec *executionContext) _Mutation_createRequestSwap(ctx context.Context, fie
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.RequestSwapResponse)
+	res := resTmp.([]*model.ShiftError)
 	fc.Result = res
-	return ec.marshalNRequestSwapResponse2ᚖrequest_swapsᚋgraphᚋmodelᚐRequestSwapResponse(ctx, field.Selections, res)
+	return ec.marshalNShiftError2ᚕᚖrequest_swapsᚋgraphᚋmodelᚐShiftErrorᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_createRequestSwap(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_BulkRequestSwapResponse_errors(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BulkRequestSwapResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "code":
+				return ec.fieldContext_ShiftError_code(ctx, field)
+			case "field":
+				return ec.fieldContext_ShiftError_field(ctx, field)
+			case "message":
+				return ec.fieldContext_ShiftError_message(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ShiftError", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _BulkRequestSwapResponse_results(ctx context.Context, field graphql.CollectedField, obj *model.BulkRequestSwapResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_BulkRequestSwapResponse_results(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Results, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.RequestSwapResult)
+	fc.Result = res
+	return ec.marshalNRequestSwapResult2ᚕᚖrequest_swapsᚋgraphᚋmodelᚐRequestSwapResultᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_BulkRequestSwapResponse_results(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BulkRequestSwapResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_RequestSwapResult_id(ctx, field)
+			case "errors":
+				return ec.fieldContext_RequestSwapResult_errors(ctx, field)
+			case "request":
+				return ec.fieldContext_RequestSwapResult_request(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RequestSwapResult", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_createRequestSwap(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_createRequestSwap(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().CreateRequestSwap(rctx, fc.Args["input"].(model.RequestSwapInput), fc.Args["authUserId"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.RequestSwapResponse)
+	fc.Result = res
+	return ec.marshalNRequestSwapResponse2ᚖrequest_swapsᚋgraphᚋmodelᚐRequestSwapResponse(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_createRequestSwap(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
@@ -3062,8 +4286,8 @@ func (ec *executionContext) fieldContext_Mutation_denyRequestSwap(ctx context.Co
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getRequestsSwaps(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_getRequestsSwaps(ctx, field)
+func (ec *executionContext) _Mutation_acceptRequestSwap(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_acceptRequestSwap(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3076,7 +4300,7 @@ func (ec *executionContext) _Query_getRequestsSwaps(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().GetRequestsSwaps(rctx, fc.Args["channelId"].(string), fc.Args["authUserId"].(*string))
+		return ec.resolvers.Mutation().AcceptRequestSwap(rctx, fc.Args["id"].(string), fc.Args["responseNote"].(*string), fc.Args["authUserId"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3088,45 +4312,25 @@ func (ec *executionContext) _Query_getRequestsSwaps(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.RequestSwap)
+	res := resTmp.(*model.RequestSwapResponse)
 	fc.Result = res
-	return ec.marshalNRequestSwap2ᚕᚖrequest_swapsᚋgraphᚋmodelᚐRequestSwap(ctx, field.Selections, res)
+	return ec.marshalNRequestSwapResponse2ᚖrequest_swapsᚋgraphᚋmodelᚐRequestSwapResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_getRequestsSwaps(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_acceptRequestSwap(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_RequestSwap_id(ctx, field)
-			case "channelId":
-				return ec.fieldContext_RequestSwap_channelId(ctx, field)
-			case "requestId":
-				return ec.fieldContext_RequestSwap_requestId(ctx, field)
-			case "userId":
-				return ec.fieldContext_RequestSwap_userId(ctx, field)
-			case "assignedUserShiftId":
-				return ec.fieldContext_RequestSwap_assignedUserShiftId(ctx, field)
-			case "assignedUserShiftIdToSwap":
-				return ec.fieldContext_RequestSwap_assignedUserShiftIdToSwap(ctx, field)
-			case "requestNote":
-				return ec.fieldContext_RequestSwap_requestNote(ctx, field)
-			case "status":
-				return ec.fieldContext_RequestSwap_status(ctx, field)
-			case "responseNote":
-				return ec.fieldContext_RequestSwap_responseNote(ctx, field)
-			case "responseByUserId":
-				return ec.fieldContext_RequestSwap_responseByUserId(ctx, field)
-			case "responseAt":
-				return ec.fieldContext_RequestSwap_responseAt(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_RequestSwap_createdAt(ctx, field)
+			case "errors":
+				return ec.fieldContext_RequestSwapResponse_errors(ctx, field)
+			case "request":
+				return ec.fieldContext_RequestSwapResponse_request(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type RequestSwap", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type RequestSwapResponse", field.Name)
 		},
 	}
 	defer func() {
@@ -3136,15 +4340,15 @@ func (ec *executionContext) fieldContext_Query_getRequestsSwaps(ctx context.Cont
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getRequestsSwaps_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_acceptRequestSwap_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getRequestsSwapsByChannelIdRequestId(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_getRequestsSwapsByChannelIdRequestId(ctx, field)
+func (ec *executionContext) _Mutation_declineRequestSwap(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_declineRequestSwap(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3157,7 +4361,7 @@ func (ec *executionContext) _Query_getRequestsSwapsByChannelIdRequestId(ctx cont
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().GetRequestsSwapsByChannelIDRequestID(rctx, fc.Args["channelId"].(string), fc.Args["requestId"].(string))
+		return ec.resolvers.Mutation().DeclineRequestSwap(rctx, fc.Args["id"].(string), fc.Args["responseNote"].(*string), fc.Args["authUserId"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3169,45 +4373,25 @@ func (ec *executionContext) _Query_getRequestsSwapsByChannelIdRequestId(ctx cont
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.RequestSwap)
+	res := resTmp.(*model.RequestSwapResponse)
 	fc.Result = res
-	return ec.marshalNRequestSwap2ᚖrequest_swapsᚋgraphᚋmodelᚐRequestSwap(ctx, field.Selections, res)
+	return ec.marshalNRequestSwapResponse2ᚖrequest_swapsᚋgraphᚋmodelᚐRequestSwapResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_getRequestsSwapsByChannelIdRequestId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_declineRequestSwap(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_RequestSwap_id(ctx, field)
-			case "channelId":
-				return ec.fieldContext_RequestSwap_channelId(ctx, field)
-			case "requestId":
-				return ec.fieldContext_RequestSwap_requestId(ctx, field)
-			case "userId":
-				return ec.fieldContext_RequestSwap_userId(ctx, field)
-			case "assignedUserShiftId":
-				return ec.fieldContext_RequestSwap_assignedUserShiftId(ctx, field)
-			case "assignedUserShiftIdToSwap":
-				return ec.fieldContext_RequestSwap_assignedUserShiftIdToSwap(ctx, field)
-			case "requestNote":
-				return ec.fieldContext_RequestSwap_requestNote(ctx, field)
-			case "status":
-				return ec.fieldContext_RequestSwap_status(ctx, field)
-			case "responseNote":
-				return ec.fieldContext_RequestSwap_responseNote(ctx, field)
-			case "responseByUserId":
-				return ec.fieldContext_RequestSwap_responseByUserId(ctx, field)
-			case "responseAt":
-				return ec.fieldContext_RequestSwap_responseAt(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_RequestSwap_createdAt(ctx, field)
+			case "errors":
+				return ec.fieldContext_RequestSwapResponse_errors(ctx, field)
+			case "request":
+				return ec.fieldContext_RequestSwapResponse_request(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type RequestSwap", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type RequestSwapResponse", field.Name)
 		},
 	}
 	defer func() {
@@ -3217,15 +4401,15 @@ func (ec *executionContext) fieldContext_Query_getRequestsSwapsByChannelIdReques
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getRequestsSwapsByChannelIdRequestId_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_declineRequestSwap_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getRequestSwap(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_getRequestSwap(ctx, field)
+func (ec *executionContext) _Mutation_approveRequestSwaps(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_approveRequestSwaps(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3238,7 +4422,7 @@ func (ec *executionContext) _Query_getRequestSwap(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().GetRequestSwap(rctx, fc.Args["id"].(string), fc.Args["authUserId"].(*string))
+		return ec.resolvers.Mutation().ApproveRequestSwaps(rctx, fc.Args["ids"].([]string), fc.Args["responseNote"].(*string), fc.Args["authUserId"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3250,45 +4434,25 @@ func (ec *executionContext) _Query_getRequestSwap(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.RequestSwap)
+	res := resTmp.(*model.BulkRequestSwapResponse)
 	fc.Result = res
-	return ec.marshalNRequestSwap2ᚖrequest_swapsᚋgraphᚋmodelᚐRequestSwap(ctx, field.Selections, res)
+	return ec.marshalNBulkRequestSwapResponse2ᚖrequest_swapsᚋgraphᚋmodelᚐBulkRequestSwapResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_getRequestSwap(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_approveRequestSwaps(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_RequestSwap_id(ctx, field)
-			case "channelId":
-				return ec.fieldContext_RequestSwap_channelId(ctx, field)
-			case "requestId":
-				return ec.fieldContext_RequestSwap_requestId(ctx, field)
-			case "userId":
-				return ec.fieldContext_RequestSwap_userId(ctx, field)
-			case "assignedUserShiftId":
-				return ec.fieldContext_RequestSwap_assignedUserShiftId(ctx, field)
-			case "assignedUserShiftIdToSwap":
-				return ec.fieldContext_RequestSwap_assignedUserShiftIdToSwap(ctx, field)
-			case "requestNote":
-				return ec.fieldContext_RequestSwap_requestNote(ctx, field)
-			case "status":
-				return ec.fieldContext_RequestSwap_status(ctx, field)
-			case "responseNote":
-				return ec.fieldContext_RequestSwap_responseNote(ctx, field)
-			case "responseByUserId":
-				return ec.fieldContext_RequestSwap_responseByUserId(ctx, field)
-			case "responseAt":
-				return ec.fieldContext_RequestSwap_responseAt(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_RequestSwap_createdAt(ctx, field)
+			case "errors":
+				return ec.fieldContext_BulkRequestSwapResponse_errors(ctx, field)
+			case "results":
+				return ec.fieldContext_BulkRequestSwapResponse_results(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type RequestSwap", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type BulkRequestSwapResponse", field.Name)
 		},
 	}
 	defer func() {
@@ -3298,15 +4462,15 @@ func (ec *executionContext) fieldContext_Query_getRequestSwap(ctx context.Contex
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getRequestSwap_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_approveRequestSwaps_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query___type(ctx, field)
+func (ec *executionContext) _Mutation_denyRequestSwaps(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_denyRequestSwaps(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3319,50 +4483,37 @@ func (ec *executionContext) _Query___type(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.introspectType(fc.Args["name"].(string))
+		return ec.resolvers.Mutation().DenyRequestSwaps(rctx, fc.Args["ids"].([]string), fc.Args["responseNote"].(*string), fc.Args["authUserId"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(*model.BulkRequestSwapResponse)
 	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNBulkRequestSwapResponse2ᚖrequest_swapsᚋgraphᚋmodelᚐBulkRequestSwapResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_denyRequestSwaps(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "errors":
+				return ec.fieldContext_BulkRequestSwapResponse_errors(ctx, field)
+			case "results":
+				return ec.fieldContext_BulkRequestSwapResponse_results(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type BulkRequestSwapResponse", field.Name)
 		},
 	}
 	defer func() {
@@ -3372,15 +4523,15 @@ func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query___type_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_denyRequestSwaps_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query___schema(ctx, field)
+func (ec *executionContext) _Mutation_addRequestComment(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_addRequestComment(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3393,49 +4544,55 @@ func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.introspectSchema()
+		return ec.resolvers.Mutation().AddRequestComment(rctx, fc.Args["id"].(string), fc.Args["body"].(string), fc.Args["authUserId"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Schema)
+	res := resTmp.(*model.RequestCommentResponse)
 	fc.Result = res
-	return ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema(ctx, field.Selections, res)
+	return ec.marshalNRequestCommentResponse2ᚖrequest_swapsᚋgraphᚋmodelᚐRequestCommentResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query___schema(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_addRequestComment(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "description":
-				return ec.fieldContext___Schema_description(ctx, field)
-			case "types":
-				return ec.fieldContext___Schema_types(ctx, field)
-			case "queryType":
-				return ec.fieldContext___Schema_queryType(ctx, field)
-			case "mutationType":
-				return ec.fieldContext___Schema_mutationType(ctx, field)
-			case "subscriptionType":
-				return ec.fieldContext___Schema_subscriptionType(ctx, field)
-			case "directives":
-				return ec.fieldContext___Schema_directives(ctx, field)
+			case "errors":
+				return ec.fieldContext_RequestCommentResponse_errors(ctx, field)
+			case "comment":
+				return ec.fieldContext_RequestCommentResponse_comment(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type RequestCommentResponse", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_addRequestComment_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RequestResponse_channelId(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RequestResponse_channelId(ctx, field)
+func (ec *executionContext) _Mutation_setRequestExpirySettings(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_setRequestExpirySettings(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3448,7 +4605,7 @@ func (ec *executionContext) _RequestResponse_channelId(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ChannelID, nil
+		return ec.resolvers.Mutation().SetRequestExpirySettings(rctx, fc.Args["channelId"].(string), fc.Args["input"].(model.RequestExpirySettingsInput), fc.Args["authUserId"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3460,67 +4617,43 @@ func (ec *executionContext) _RequestResponse_channelId(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.RequestExpirySettingsResponse)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalNRequestExpirySettingsResponse2ᚖrequest_swapsᚋgraphᚋmodelᚐRequestExpirySettingsResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RequestResponse_channelId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_setRequestExpirySettings(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RequestResponse",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			switch field.Name {
+			case "errors":
+				return ec.fieldContext_RequestExpirySettingsResponse_errors(ctx, field)
+			case "settings":
+				return ec.fieldContext_RequestExpirySettingsResponse_settings(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RequestExpirySettingsResponse", field.Name)
 		},
 	}
-	return fc, nil
-}
-
-func (ec *executionContext) _RequestResponse_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RequestResponse_createdAt(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
 		}
 	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.CreatedAt, nil
-	})
-	if err != nil {
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_setRequestExpirySettings_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*time.Time)
-	fc.Result = res
-	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
-}
-
-func (ec *executionContext) fieldContext_RequestResponse_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "RequestResponse",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
-		},
+		return
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RequestResponse_endTime(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RequestResponse_endTime(ctx, field)
+func (ec *executionContext) _PageInfo_hasNextPage(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PageInfo_hasNextPage(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3533,35 +4666,38 @@ func (ec *executionContext) _RequestResponse_endTime(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.EndTime, nil
+		return obj.HasNextPage, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*time.Time)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RequestResponse_endTime(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PageInfo_hasNextPage(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RequestResponse",
+		Object:     "PageInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RequestResponse_id(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RequestResponse_id(ctx, field)
+func (ec *executionContext) _PageInfo_hasPreviousPage(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PageInfo_hasPreviousPage(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3574,7 +4710,7 @@ func (ec *executionContext) _RequestResponse_id(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.HasPreviousPage, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3586,26 +4722,26 @@ func (ec *executionContext) _RequestResponse_id(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RequestResponse_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PageInfo_hasPreviousPage(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RequestResponse",
+		Object:     "PageInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RequestResponse_isAllDay(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RequestResponse_isAllDay(ctx, field)
+func (ec *executionContext) _PageInfo_startCursor(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PageInfo_startCursor(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3618,7 +4754,7 @@ func (ec *executionContext) _RequestResponse_isAllDay(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsAllDay, nil
+		return obj.StartCursor, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3627,26 +4763,26 @@ func (ec *executionContext) _RequestResponse_isAllDay(ctx context.Context, field
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*bool)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOBoolean2ᚖbool(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RequestResponse_isAllDay(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PageInfo_startCursor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RequestResponse",
+		Object:     "PageInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RequestResponse_reason(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RequestResponse_reason(ctx, field)
+func (ec *executionContext) _PageInfo_endCursor(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PageInfo_endCursor(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3659,7 +4795,7 @@ func (ec *executionContext) _RequestResponse_reason(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Reason, nil
+		return obj.EndCursor, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3673,9 +4809,9 @@ func (ec *executionContext) _RequestResponse_reason(ctx context.Context, field g
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RequestResponse_reason(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PageInfo_endCursor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RequestResponse",
+		Object:     "PageInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3686,8 +4822,8 @@ func (ec *executionContext) fieldContext_RequestResponse_reason(ctx context.Cont
 	return fc, nil
 }
 
-func (ec *executionContext) _RequestResponse_requestId(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RequestResponse_requestId(ctx, field)
+func (ec *executionContext) _Query_getRequestsSwaps(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_getRequestsSwaps(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3700,7 +4836,7 @@ func (ec *executionContext) _RequestResponse_requestId(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.RequestID, nil
+		return ec.resolvers.Query().GetRequestsSwaps(rctx, fc.Args["channelId"].(string), fc.Args["authUserId"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3712,26 +4848,73 @@ func (ec *executionContext) _RequestResponse_requestId(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*model.RequestSwap)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalNRequestSwap2ᚕᚖrequest_swapsᚋgraphᚋmodelᚐRequestSwap(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RequestResponse_requestId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_getRequestsSwaps(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RequestResponse",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_RequestSwap_id(ctx, field)
+			case "channelId":
+				return ec.fieldContext_RequestSwap_channelId(ctx, field)
+			case "requestId":
+				return ec.fieldContext_RequestSwap_requestId(ctx, field)
+			case "userId":
+				return ec.fieldContext_RequestSwap_userId(ctx, field)
+			case "assignedUserShiftId":
+				return ec.fieldContext_RequestSwap_assignedUserShiftId(ctx, field)
+			case "assignedUserShiftIdToSwap":
+				return ec.fieldContext_RequestSwap_assignedUserShiftIdToSwap(ctx, field)
+			case "peerUserId":
+				return ec.fieldContext_RequestSwap_peerUserId(ctx, field)
+			case "requestNote":
+				return ec.fieldContext_RequestSwap_requestNote(ctx, field)
+			case "status":
+				return ec.fieldContext_RequestSwap_status(ctx, field)
+			case "responseNote":
+				return ec.fieldContext_RequestSwap_responseNote(ctx, field)
+			case "responseByUserId":
+				return ec.fieldContext_RequestSwap_responseByUserId(ctx, field)
+			case "responseAt":
+				return ec.fieldContext_RequestSwap_responseAt(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_RequestSwap_createdAt(ctx, field)
+			case "startTime":
+				return ec.fieldContext_RequestSwap_startTime(ctx, field)
+			case "endTime":
+				return ec.fieldContext_RequestSwap_endTime(ctx, field)
+			case "history":
+				return ec.fieldContext_RequestSwap_history(ctx, field)
+			case "comments":
+				return ec.fieldContext_RequestSwap_comments(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RequestSwap", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getRequestsSwaps_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RequestResponse_requestNote(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RequestResponse_requestNote(ctx, field)
+func (ec *executionContext) _Query_getRequestsSwapsByChannelIdRequestId(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_getRequestsSwapsByChannelIdRequestId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3744,35 +4927,85 @@ func (ec *executionContext) _RequestResponse_requestNote(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.RequestNote, nil
+		return ec.resolvers.Query().GetRequestsSwapsByChannelIDRequestID(rctx, fc.Args["channelId"].(string), fc.Args["requestId"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*model.RequestSwap)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNRequestSwap2ᚖrequest_swapsᚋgraphᚋmodelᚐRequestSwap(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RequestResponse_requestNote(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_getRequestsSwapsByChannelIdRequestId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RequestResponse",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_RequestSwap_id(ctx, field)
+			case "channelId":
+				return ec.fieldContext_RequestSwap_channelId(ctx, field)
+			case "requestId":
+				return ec.fieldContext_RequestSwap_requestId(ctx, field)
+			case "userId":
+				return ec.fieldContext_RequestSwap_userId(ctx, field)
+			case "assignedUserShiftId":
+				return ec.fieldContext_RequestSwap_assignedUserShiftId(ctx, field)
+			case "assignedUserShiftIdToSwap":
+				return ec.fieldContext_RequestSwap_assignedUserShiftIdToSwap(ctx, field)
+			case "peerUserId":
+				return ec.fieldContext_RequestSwap_peerUserId(ctx, field)
+			case "requestNote":
+				return ec.fieldContext_RequestSwap_requestNote(ctx, field)
+			case "status":
+				return ec.fieldContext_RequestSwap_status(ctx, field)
+			case "responseNote":
+				return ec.fieldContext_RequestSwap_responseNote(ctx, field)
+			case "responseByUserId":
+				return ec.fieldContext_RequestSwap_responseByUserId(ctx, field)
+			case "responseAt":
+				return ec.fieldContext_RequestSwap_responseAt(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_RequestSwap_createdAt(ctx, field)
+			case "startTime":
+				return ec.fieldContext_RequestSwap_startTime(ctx, field)
+			case "endTime":
+				return ec.fieldContext_RequestSwap_endTime(ctx, field)
+			case "history":
+				return ec.fieldContext_RequestSwap_history(ctx, field)
+			case "comments":
+				return ec.fieldContext_RequestSwap_comments(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RequestSwap", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getRequestsSwapsByChannelIdRequestId_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RequestResponse_responseAt(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RequestResponse_responseAt(ctx, field)
+func (ec *executionContext) _Query_getRequestSwap(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_getRequestSwap(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3785,35 +5018,85 @@ func (ec *executionContext) _RequestResponse_responseAt(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ResponseAt, nil
+		return ec.resolvers.Query().GetRequestSwap(rctx, fc.Args["id"].(string), fc.Args["authUserId"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*time.Time)
+	res := resTmp.(*model.RequestSwap)
 	fc.Result = res
-	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNRequestSwap2ᚖrequest_swapsᚋgraphᚋmodelᚐRequestSwap(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RequestResponse_responseAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_getRequestSwap(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RequestResponse",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_RequestSwap_id(ctx, field)
+			case "channelId":
+				return ec.fieldContext_RequestSwap_channelId(ctx, field)
+			case "requestId":
+				return ec.fieldContext_RequestSwap_requestId(ctx, field)
+			case "userId":
+				return ec.fieldContext_RequestSwap_userId(ctx, field)
+			case "assignedUserShiftId":
+				return ec.fieldContext_RequestSwap_assignedUserShiftId(ctx, field)
+			case "assignedUserShiftIdToSwap":
+				return ec.fieldContext_RequestSwap_assignedUserShiftIdToSwap(ctx, field)
+			case "peerUserId":
+				return ec.fieldContext_RequestSwap_peerUserId(ctx, field)
+			case "requestNote":
+				return ec.fieldContext_RequestSwap_requestNote(ctx, field)
+			case "status":
+				return ec.fieldContext_RequestSwap_status(ctx, field)
+			case "responseNote":
+				return ec.fieldContext_RequestSwap_responseNote(ctx, field)
+			case "responseByUserId":
+				return ec.fieldContext_RequestSwap_responseByUserId(ctx, field)
+			case "responseAt":
+				return ec.fieldContext_RequestSwap_responseAt(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_RequestSwap_createdAt(ctx, field)
+			case "startTime":
+				return ec.fieldContext_RequestSwap_startTime(ctx, field)
+			case "endTime":
+				return ec.fieldContext_RequestSwap_endTime(ctx, field)
+			case "history":
+				return ec.fieldContext_RequestSwap_history(ctx, field)
+			case "comments":
+				return ec.fieldContext_RequestSwap_comments(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RequestSwap", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getRequestSwap_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RequestResponse_responseBy(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RequestResponse_responseBy(ctx, field)
+func (ec *executionContext) _Query_getRequestSwapsConnection(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_getRequestSwapsConnection(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3826,51 +5109,55 @@ func (ec *executionContext) _RequestResponse_responseBy(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ResponseBy, nil
+		return ec.resolvers.Query().GetRequestSwapsConnection(rctx, fc.Args["channelId"].(string), fc.Args["filter"].(*model.RequestFilter), fc.Args["orderBy"].(*model.RequestOrder), fc.Args["first"].(*int), fc.Args["after"].(*string), fc.Args["authUserId"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.User)
+	res := resTmp.(*model.RequestSwapConnection)
 	fc.Result = res
-	return ec.marshalOUser2ᚖrequest_swapsᚋgraphᚋmodelᚐUser(ctx, field.Selections, res)
+	return ec.marshalNRequestSwapConnection2ᚖrequest_swapsᚋgraphᚋmodelᚐRequestSwapConnection(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RequestResponse_responseBy(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_getRequestSwapsConnection(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RequestResponse",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_User_id(ctx, field)
-			case "firstName":
-				return ec.fieldContext_User_firstName(ctx, field)
-			case "lastName":
-				return ec.fieldContext_User_lastName(ctx, field)
-			case "email":
-				return ec.fieldContext_User_email(ctx, field)
-			case "avatar":
-				return ec.fieldContext_User_avatar(ctx, field)
-			case "isActive":
-				return ec.fieldContext_User_isActive(ctx, field)
-			case "isStaff":
-				return ec.fieldContext_User_isStaff(ctx, field)
+			case "edges":
+				return ec.fieldContext_RequestSwapConnection_edges(ctx, field)
+			case "pageInfo":
+				return ec.fieldContext_RequestSwapConnection_pageInfo(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type RequestSwapConnection", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getRequestSwapsConnection_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RequestResponse_responseNote(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RequestResponse_responseNote(ctx, field)
+func (ec *executionContext) _Query_getRequestExpirySettings(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_getRequestExpirySettings(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3883,35 +5170,61 @@ func (ec *executionContext) _RequestResponse_responseNote(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ResponseNote, nil
+		return ec.resolvers.Query().GetRequestExpirySettings(rctx, fc.Args["channelId"].(string), fc.Args["authUserId"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*model.RequestExpirySettings)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNRequestExpirySettings2ᚖrequest_swapsᚋgraphᚋmodelᚐRequestExpirySettings(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RequestResponse_responseNote(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_getRequestExpirySettings(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RequestResponse",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "channelId":
+				return ec.fieldContext_RequestExpirySettings_channelId(ctx, field)
+			case "enabled":
+				return ec.fieldContext_RequestExpirySettings_enabled(ctx, field)
+			case "gracePeriodMinutes":
+				return ec.fieldContext_RequestExpirySettings_gracePeriodMinutes(ctx, field)
+			case "updatedByUserId":
+				return ec.fieldContext_RequestExpirySettings_updatedByUserId(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_RequestExpirySettings_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RequestExpirySettings", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getRequestExpirySettings_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RequestResponse_shiftOfferedTo(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RequestResponse_shiftOfferedTo(ctx, field)
+func (ec *executionContext) _Query_suggestSwapCandidates(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_suggestSwapCandidates(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3924,51 +5237,59 @@ func (ec *executionContext) _RequestResponse_shiftOfferedTo(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ShiftOfferedTo, nil
+		return ec.resolvers.Query().SuggestSwapCandidates(rctx, fc.Args["assignedShiftId"].(string), fc.Args["limit"].(*int), fc.Args["authUserId"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.User)
+	res := resTmp.([]*model.SwapCandidate)
 	fc.Result = res
-	return ec.marshalOUser2ᚖrequest_swapsᚋgraphᚋmodelᚐUser(ctx, field.Selections, res)
+	return ec.marshalNSwapCandidate2ᚕᚖrequest_swapsᚋgraphᚋmodelᚐSwapCandidateᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RequestResponse_shiftOfferedTo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_suggestSwapCandidates(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RequestResponse",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_User_id(ctx, field)
-			case "firstName":
-				return ec.fieldContext_User_firstName(ctx, field)
-			case "lastName":
-				return ec.fieldContext_User_lastName(ctx, field)
-			case "email":
-				return ec.fieldContext_User_email(ctx, field)
-			case "avatar":
-				return ec.fieldContext_User_avatar(ctx, field)
-			case "isActive":
-				return ec.fieldContext_User_isActive(ctx, field)
-			case "isStaff":
-				return ec.fieldContext_User_isStaff(ctx, field)
+			case "userId":
+				return ec.fieldContext_SwapCandidate_userId(ctx, field)
+			case "user":
+				return ec.fieldContext_SwapCandidate_user(ctx, field)
+			case "shift":
+				return ec.fieldContext_SwapCandidate_shift(ctx, field)
+			case "startTimeDifference":
+				return ec.fieldContext_SwapCandidate_startTimeDifference(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type SwapCandidate", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_suggestSwapCandidates_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RequestResponse_shiftToOffer(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RequestResponse_shiftToOffer(ctx, field)
+func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query___type(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3981,7 +5302,7 @@ func (ec *executionContext) _RequestResponse_shiftToOffer(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ShiftToOffer, nil
+		return ec.introspectType(fc.Args["name"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3990,64 +5311,59 @@ func (ec *executionContext) _RequestResponse_shiftToOffer(ctx context.Context, f
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*model.AssignedShift)
+	res := resTmp.(*introspection.Type)
 	fc.Result = res
-	return ec.marshalOAssignedShift2ᚖrequest_swapsᚋgraphᚋmodelᚐAssignedShift(ctx, field.Selections, res)
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RequestResponse_shiftToOffer(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RequestResponse",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
+		IsMethod:   true,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_AssignedShift_id(ctx, field)
-			case "break":
-				return ec.fieldContext_AssignedShift_break(ctx, field)
-			case "color":
-				return ec.fieldContext_AssignedShift_color(ctx, field)
-			case "startTime":
-				return ec.fieldContext_AssignedShift_startTime(ctx, field)
-			case "endTime":
-				return ec.fieldContext_AssignedShift_endTime(ctx, field)
-			case "is24Hours":
-				return ec.fieldContext_AssignedShift_is24Hours(ctx, field)
-			case "label":
-				return ec.fieldContext_AssignedShift_label(ctx, field)
-			case "note":
-				return ec.fieldContext_AssignedShift_note(ctx, field)
-			case "shiftToOffer":
-				return ec.fieldContext_AssignedShift_shiftToOffer(ctx, field)
-			case "shiftToSwap":
-				return ec.fieldContext_AssignedShift_shiftToSwap(ctx, field)
-			case "toSwapWith":
-				return ec.fieldContext_AssignedShift_toSwapWith(ctx, field)
-			case "userId":
-				return ec.fieldContext_AssignedShift_userId(ctx, field)
-			case "channelId":
-				return ec.fieldContext_AssignedShift_channelId(ctx, field)
-			case "shiftGroupId":
-				return ec.fieldContext_AssignedShift_shiftGroupId(ctx, field)
-			case "type":
-				return ec.fieldContext_AssignedShift_type(ctx, field)
-			case "isOpen":
-				return ec.fieldContext_AssignedShift_isOpen(ctx, field)
-			case "isShared":
-				return ec.fieldContext_AssignedShift_isShared(ctx, field)
-			case "ShiftActivities":
-				return ec.fieldContext_AssignedShift_ShiftActivities(ctx, field)
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type AssignedShift", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query___type_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RequestResponse_shiftToSwap(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RequestResponse_shiftToSwap(ctx, field)
+func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query___schema(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4060,7 +5376,7 @@ func (ec *executionContext) _RequestResponse_shiftToSwap(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ShiftToSwap, nil
+		return ec.introspectSchema()
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4069,64 +5385,40 @@ func (ec *executionContext) _RequestResponse_shiftToSwap(ctx context.Context, fi
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*model.AssignedShift)
+	res := resTmp.(*introspection.Schema)
 	fc.Result = res
-	return ec.marshalOAssignedShift2ᚖrequest_swapsᚋgraphᚋmodelᚐAssignedShift(ctx, field.Selections, res)
+	return ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RequestResponse_shiftToSwap(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query___schema(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RequestResponse",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
+		IsMethod:   true,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_AssignedShift_id(ctx, field)
-			case "break":
-				return ec.fieldContext_AssignedShift_break(ctx, field)
-			case "color":
-				return ec.fieldContext_AssignedShift_color(ctx, field)
-			case "startTime":
-				return ec.fieldContext_AssignedShift_startTime(ctx, field)
-			case "endTime":
-				return ec.fieldContext_AssignedShift_endTime(ctx, field)
-			case "is24Hours":
-				return ec.fieldContext_AssignedShift_is24Hours(ctx, field)
-			case "label":
-				return ec.fieldContext_AssignedShift_label(ctx, field)
-			case "note":
-				return ec.fieldContext_AssignedShift_note(ctx, field)
-			case "shiftToOffer":
-				return ec.fieldContext_AssignedShift_shiftToOffer(ctx, field)
-			case "shiftToSwap":
-				return ec.fieldContext_AssignedShift_shiftToSwap(ctx, field)
-			case "toSwapWith":
-				return ec.fieldContext_AssignedShift_toSwapWith(ctx, field)
-			case "userId":
-				return ec.fieldContext_AssignedShift_userId(ctx, field)
-			case "channelId":
-				return ec.fieldContext_AssignedShift_channelId(ctx, field)
-			case "shiftGroupId":
-				return ec.fieldContext_AssignedShift_shiftGroupId(ctx, field)
-			case "type":
-				return ec.fieldContext_AssignedShift_type(ctx, field)
-			case "isOpen":
-				return ec.fieldContext_AssignedShift_isOpen(ctx, field)
-			case "isShared":
-				return ec.fieldContext_AssignedShift_isShared(ctx, field)
-			case "ShiftActivities":
-				return ec.fieldContext_AssignedShift_ShiftActivities(ctx, field)
+			case "description":
+				return ec.fieldContext___Schema_description(ctx, field)
+			case "types":
+				return ec.fieldContext___Schema_types(ctx, field)
+			case "queryType":
+				return ec.fieldContext___Schema_queryType(ctx, field)
+			case "mutationType":
+				return ec.fieldContext___Schema_mutationType(ctx, field)
+			case "subscriptionType":
+				return ec.fieldContext___Schema_subscriptionType(ctx, field)
+			case "directives":
+				return ec.fieldContext___Schema_directives(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type AssignedShift", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RequestResponse_startTime(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RequestResponse_startTime(ctx, field)
+func (ec *executionContext) _RequestComment_id(ctx context.Context, field graphql.CollectedField, obj *model.RequestComment) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestComment_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4139,35 +5431,38 @@ func (ec *executionContext) _RequestResponse_startTime(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.StartTime, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*time.Time)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RequestResponse_startTime(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RequestComment_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RequestResponse",
+		Object:     "RequestComment",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RequestResponse_status(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RequestResponse_status(ctx, field)
+func (ec *executionContext) _RequestComment_userId(ctx context.Context, field graphql.CollectedField, obj *model.RequestComment) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestComment_userId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4180,35 +5475,38 @@ func (ec *executionContext) _RequestResponse_status(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Status, nil
+		return obj.UserID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.RequestStatus)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalORequestStatus2ᚖrequest_swapsᚋgraphᚋmodelᚐRequestStatus(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RequestResponse_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RequestComment_userId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RequestResponse",
+		Object:     "RequestComment",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type RequestStatus does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RequestResponse_toSwapWith(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RequestResponse_toSwapWith(ctx, field)
+func (ec *executionContext) _RequestComment_user(ctx context.Context, field graphql.CollectedField, obj *model.RequestComment) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestComment_user(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4221,7 +5519,7 @@ func (ec *executionContext) _RequestResponse_toSwapWith(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ToSwapWith, nil
+		return ec.resolvers.RequestComment().User(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4230,64 +5528,42 @@ func (ec *executionContext) _RequestResponse_toSwapWith(ctx context.Context, fie
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*model.AssignedShift)
+	res := resTmp.(*model.User)
 	fc.Result = res
-	return ec.marshalOAssignedShift2ᚖrequest_swapsᚋgraphᚋmodelᚐAssignedShift(ctx, field.Selections, res)
+	return ec.marshalOUser2ᚖrequest_swapsᚋgraphᚋmodelᚐUser(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RequestResponse_toSwapWith(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RequestComment_user(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RequestResponse",
+		Object:     "RequestComment",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_AssignedShift_id(ctx, field)
-			case "break":
-				return ec.fieldContext_AssignedShift_break(ctx, field)
-			case "color":
-				return ec.fieldContext_AssignedShift_color(ctx, field)
-			case "startTime":
-				return ec.fieldContext_AssignedShift_startTime(ctx, field)
-			case "endTime":
-				return ec.fieldContext_AssignedShift_endTime(ctx, field)
-			case "is24Hours":
-				return ec.fieldContext_AssignedShift_is24Hours(ctx, field)
-			case "label":
-				return ec.fieldContext_AssignedShift_label(ctx, field)
-			case "note":
-				return ec.fieldContext_AssignedShift_note(ctx, field)
-			case "shiftToOffer":
-				return ec.fieldContext_AssignedShift_shiftToOffer(ctx, field)
-			case "shiftToSwap":
-				return ec.fieldContext_AssignedShift_shiftToSwap(ctx, field)
-			case "toSwapWith":
-				return ec.fieldContext_AssignedShift_toSwapWith(ctx, field)
-			case "userId":
-				return ec.fieldContext_AssignedShift_userId(ctx, field)
-			case "channelId":
-				return ec.fieldContext_AssignedShift_channelId(ctx, field)
-			case "shiftGroupId":
-				return ec.fieldContext_AssignedShift_shiftGroupId(ctx, field)
-			case "type":
-				return ec.fieldContext_AssignedShift_type(ctx, field)
-			case "isOpen":
-				return ec.fieldContext_AssignedShift_isOpen(ctx, field)
-			case "isShared":
-				return ec.fieldContext_AssignedShift_isShared(ctx, field)
-			case "ShiftActivities":
-				return ec.fieldContext_AssignedShift_ShiftActivities(ctx, field)
+				return ec.fieldContext_User_id(ctx, field)
+			case "firstName":
+				return ec.fieldContext_User_firstName(ctx, field)
+			case "lastName":
+				return ec.fieldContext_User_lastName(ctx, field)
+			case "email":
+				return ec.fieldContext_User_email(ctx, field)
+			case "avatar":
+				return ec.fieldContext_User_avatar(ctx, field)
+			case "isActive":
+				return ec.fieldContext_User_isActive(ctx, field)
+			case "isStaff":
+				return ec.fieldContext_User_isStaff(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type AssignedShift", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RequestResponse_type(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RequestResponse_type(ctx, field)
+func (ec *executionContext) _RequestComment_body(ctx context.Context, field graphql.CollectedField, obj *model.RequestComment) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestComment_body(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4300,35 +5576,38 @@ func (ec *executionContext) _RequestResponse_type(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
+		return obj.Body, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.RequestType)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalORequestType2ᚖrequest_swapsᚋgraphᚋmodelᚐRequestType(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RequestResponse_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RequestComment_body(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RequestResponse",
+		Object:     "RequestComment",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type RequestType does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RequestResponse_user(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RequestResponse_user(ctx, field)
+func (ec *executionContext) _RequestComment_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.RequestComment) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestComment_createdAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4341,7 +5620,7 @@ func (ec *executionContext) _RequestResponse_user(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.User, nil
+		return obj.CreatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4353,42 +5632,26 @@ func (ec *executionContext) _RequestResponse_user(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.User)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNUser2ᚖrequest_swapsᚋgraphᚋmodelᚐUser(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RequestResponse_user(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RequestComment_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RequestResponse",
+		Object:     "RequestComment",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_User_id(ctx, field)
-			case "firstName":
-				return ec.fieldContext_User_firstName(ctx, field)
-			case "lastName":
-				return ec.fieldContext_User_lastName(ctx, field)
-			case "email":
-				return ec.fieldContext_User_email(ctx, field)
-			case "avatar":
-				return ec.fieldContext_User_avatar(ctx, field)
-			case "isActive":
-				return ec.fieldContext_User_isActive(ctx, field)
-			case "isStaff":
-				return ec.fieldContext_User_isStaff(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RequestSwap_id(ctx context.Context, field graphql.CollectedField, obj *model.RequestSwap) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RequestSwap_id(ctx, field)
+func (ec *executionContext) _RequestCommentResponse_errors(ctx context.Context, field graphql.CollectedField, obj *model.RequestCommentResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestCommentResponse_errors(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4401,7 +5664,7 @@ func (ec *executionContext) _RequestSwap_id(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.Errors, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4413,26 +5676,34 @@ func (ec *executionContext) _RequestSwap_id(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*model.ShiftError)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalNShiftError2ᚕᚖrequest_swapsᚋgraphᚋmodelᚐShiftErrorᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RequestSwap_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RequestCommentResponse_errors(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RequestSwap",
+		Object:     "RequestCommentResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			switch field.Name {
+			case "code":
+				return ec.fieldContext_ShiftError_code(ctx, field)
+			case "field":
+				return ec.fieldContext_ShiftError_field(ctx, field)
+			case "message":
+				return ec.fieldContext_ShiftError_message(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ShiftError", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RequestSwap_channelId(ctx context.Context, field graphql.CollectedField, obj *model.RequestSwap) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RequestSwap_channelId(ctx, field)
+func (ec *executionContext) _RequestCommentResponse_comment(ctx context.Context, field graphql.CollectedField, obj *model.RequestCommentResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestCommentResponse_comment(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4445,38 +5716,47 @@ func (ec *executionContext) _RequestSwap_channelId(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ChannelID, nil
+		return obj.Comment, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.RequestComment)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalORequestComment2ᚖrequest_swapsᚋgraphᚋmodelᚐRequestComment(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RequestSwap_channelId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RequestCommentResponse_comment(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RequestSwap",
+		Object:     "RequestCommentResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_RequestComment_id(ctx, field)
+			case "userId":
+				return ec.fieldContext_RequestComment_userId(ctx, field)
+			case "user":
+				return ec.fieldContext_RequestComment_user(ctx, field)
+			case "body":
+				return ec.fieldContext_RequestComment_body(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_RequestComment_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RequestComment", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RequestSwap_requestId(ctx context.Context, field graphql.CollectedField, obj *model.RequestSwap) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RequestSwap_requestId(ctx, field)
+func (ec *executionContext) _RequestExpirySettings_channelId(ctx context.Context, field graphql.CollectedField, obj *model.RequestExpirySettings) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestExpirySettings_channelId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4489,23 +5769,26 @@ func (ec *executionContext) _RequestSwap_requestId(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.RequestID, nil
+		return obj.ChannelID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOID2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RequestSwap_requestId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RequestExpirySettings_channelId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RequestSwap",
+		Object:     "RequestExpirySettings",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -4516,8 +5799,8 @@ func (ec *executionContext) fieldContext_RequestSwap_requestId(ctx context.Conte
 	return fc, nil
 }
 
-func (ec *executionContext) _RequestSwap_userId(ctx context.Context, field graphql.CollectedField, obj *model.RequestSwap) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RequestSwap_userId(ctx, field)
+func (ec *executionContext) _RequestExpirySettings_enabled(ctx context.Context, field graphql.CollectedField, obj *model.RequestExpirySettings) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestExpirySettings_enabled(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4530,7 +5813,7 @@ func (ec *executionContext) _RequestSwap_userId(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UserID, nil
+		return obj.Enabled, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4542,26 +5825,26 @@ func (ec *executionContext) _RequestSwap_userId(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RequestSwap_userId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RequestExpirySettings_enabled(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RequestSwap",
+		Object:     "RequestExpirySettings",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RequestSwap_assignedUserShiftId(ctx context.Context, field graphql.CollectedField, obj *model.RequestSwap) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RequestSwap_assignedUserShiftId(ctx, field)
+func (ec *executionContext) _RequestExpirySettings_gracePeriodMinutes(ctx context.Context, field graphql.CollectedField, obj *model.RequestExpirySettings) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestExpirySettings_gracePeriodMinutes(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4574,35 +5857,38 @@ func (ec *executionContext) _RequestSwap_assignedUserShiftId(ctx context.Context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.AssignedUserShiftID, nil
+		return obj.GracePeriodMinutes, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalOID2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RequestSwap_assignedUserShiftId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RequestExpirySettings_gracePeriodMinutes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RequestSwap",
+		Object:     "RequestExpirySettings",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RequestSwap_assignedUserShiftIdToSwap(ctx context.Context, field graphql.CollectedField, obj *model.RequestSwap) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RequestSwap_assignedUserShiftIdToSwap(ctx, field)
+func (ec *executionContext) _RequestExpirySettings_updatedByUserId(ctx context.Context, field graphql.CollectedField, obj *model.RequestExpirySettings) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestExpirySettings_updatedByUserId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4615,7 +5901,7 @@ func (ec *executionContext) _RequestSwap_assignedUserShiftIdToSwap(ctx context.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.AssignedUserShiftIDToSwap, nil
+		return obj.UpdatedByUserID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4629,9 +5915,9 @@ func (ec *executionContext) _RequestSwap_assignedUserShiftIdToSwap(ctx context.C
 	return ec.marshalOID2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RequestSwap_assignedUserShiftIdToSwap(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RequestExpirySettings_updatedByUserId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RequestSwap",
+		Object:     "RequestExpirySettings",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -4642,8 +5928,8 @@ func (ec *executionContext) fieldContext_RequestSwap_assignedUserShiftIdToSwap(c
 	return fc, nil
 }
 
-func (ec *executionContext) _RequestSwap_requestNote(ctx context.Context, field graphql.CollectedField, obj *model.RequestSwap) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RequestSwap_requestNote(ctx, field)
+func (ec *executionContext) _RequestExpirySettings_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.RequestExpirySettings) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestExpirySettings_updatedAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4656,7 +5942,7 @@ func (ec *executionContext) _RequestSwap_requestNote(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.RequestNote, nil
+		return obj.UpdatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4665,26 +5951,26 @@ func (ec *executionContext) _RequestSwap_requestNote(ctx context.Context, field
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*time.Time)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RequestSwap_requestNote(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RequestExpirySettings_updatedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RequestSwap",
+		Object:     "RequestExpirySettings",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RequestSwap_status(ctx context.Context, field graphql.CollectedField, obj *model.RequestSwap) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RequestSwap_status(ctx, field)
+func (ec *executionContext) _RequestExpirySettingsResponse_errors(ctx context.Context, field graphql.CollectedField, obj *model.RequestExpirySettingsResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestExpirySettingsResponse_errors(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4697,7 +5983,7 @@ func (ec *executionContext) _RequestSwap_status(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Status, nil
+		return obj.Errors, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4709,26 +5995,34 @@ func (ec *executionContext) _RequestSwap_status(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.RequestStatus)
+	res := resTmp.([]*model.ShiftError)
 	fc.Result = res
-	return ec.marshalNRequestStatus2request_swapsᚋgraphᚋmodelᚐRequestStatus(ctx, field.Selections, res)
+	return ec.marshalNShiftError2ᚕᚖrequest_swapsᚋgraphᚋmodelᚐShiftErrorᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RequestSwap_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RequestExpirySettingsResponse_errors(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RequestSwap",
+		Object:     "RequestExpirySettingsResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type RequestStatus does not have child fields")
+			switch field.Name {
+			case "code":
+				return ec.fieldContext_ShiftError_code(ctx, field)
+			case "field":
+				return ec.fieldContext_ShiftError_field(ctx, field)
+			case "message":
+				return ec.fieldContext_ShiftError_message(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ShiftError", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RequestSwap_responseNote(ctx context.Context, field graphql.CollectedField, obj *model.RequestSwap) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RequestSwap_responseNote(ctx, field)
+func (ec *executionContext) _RequestExpirySettingsResponse_settings(ctx context.Context, field graphql.CollectedField, obj *model.RequestExpirySettingsResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestExpirySettingsResponse_settings(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4741,7 +6035,7 @@ func (ec *executionContext) _RequestSwap_responseNote(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ResponseNote, nil
+		return obj.Settings, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4750,26 +6044,38 @@ func (ec *executionContext) _RequestSwap_responseNote(ctx context.Context, field
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*model.RequestExpirySettings)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalORequestExpirySettings2ᚖrequest_swapsᚋgraphᚋmodelᚐRequestExpirySettings(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RequestSwap_responseNote(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RequestExpirySettingsResponse_settings(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RequestSwap",
+		Object:     "RequestExpirySettingsResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "channelId":
+				return ec.fieldContext_RequestExpirySettings_channelId(ctx, field)
+			case "enabled":
+				return ec.fieldContext_RequestExpirySettings_enabled(ctx, field)
+			case "gracePeriodMinutes":
+				return ec.fieldContext_RequestExpirySettings_gracePeriodMinutes(ctx, field)
+			case "updatedByUserId":
+				return ec.fieldContext_RequestExpirySettings_updatedByUserId(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_RequestExpirySettings_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RequestExpirySettings", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RequestSwap_responseByUserId(ctx context.Context, field graphql.CollectedField, obj *model.RequestSwap) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RequestSwap_responseByUserId(ctx, field)
+func (ec *executionContext) _RequestHistoryEntry_id(ctx context.Context, field graphql.CollectedField, obj *model.RequestHistoryEntry) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestHistoryEntry_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4782,23 +6088,26 @@ func (ec *executionContext) _RequestSwap_responseByUserId(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ResponseByUserID, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOID2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RequestSwap_responseByUserId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RequestHistoryEntry_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RequestSwap",
+		Object:     "RequestHistoryEntry",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -4809,8 +6118,8 @@ func (ec *executionContext) fieldContext_RequestSwap_responseByUserId(ctx contex
 	return fc, nil
 }
 
-func (ec *executionContext) _RequestSwap_responseAt(ctx context.Context, field graphql.CollectedField, obj *model.RequestSwap) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RequestSwap_responseAt(ctx, field)
+func (ec *executionContext) _RequestHistoryEntry_action(ctx context.Context, field graphql.CollectedField, obj *model.RequestHistoryEntry) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestHistoryEntry_action(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4823,35 +6132,38 @@ func (ec *executionContext) _RequestSwap_responseAt(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ResponseAt, nil
+		return obj.Action, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*time.Time)
+	res := resTmp.(model.RequestHistoryAction)
 	fc.Result = res
-	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNRequestHistoryAction2request_swapsᚋgraphᚋmodelᚐRequestHistoryAction(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RequestSwap_responseAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RequestHistoryEntry_action(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RequestSwap",
+		Object:     "RequestHistoryEntry",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type RequestHistoryAction does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RequestSwap_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.RequestSwap) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RequestSwap_createdAt(ctx, field)
+func (ec *executionContext) _RequestHistoryEntry_field(ctx context.Context, field graphql.CollectedField, obj *model.RequestHistoryEntry) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestHistoryEntry_field(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4864,38 +6176,35 @@ func (ec *executionContext) _RequestSwap_createdAt(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CreatedAt, nil
+		return obj.Field, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RequestSwap_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RequestHistoryEntry_field(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RequestSwap",
+		Object:     "RequestHistoryEntry",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RequestSwapResponse_errors(ctx context.Context, field graphql.CollectedField, obj *model.RequestSwapResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RequestSwapResponse_errors(ctx, field)
+func (ec *executionContext) _RequestHistoryEntry_oldValue(ctx context.Context, field graphql.CollectedField, obj *model.RequestHistoryEntry) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestHistoryEntry_oldValue(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4908,46 +6217,35 @@ func (ec *executionContext) _RequestSwapResponse_errors(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Errors, nil
+		return obj.OldValue, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.ShiftError)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNShiftError2ᚕᚖrequest_swapsᚋgraphᚋmodelᚐShiftErrorᚄ(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RequestSwapResponse_errors(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RequestHistoryEntry_oldValue(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RequestSwapResponse",
+		Object:     "RequestHistoryEntry",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "code":
-				return ec.fieldContext_ShiftError_code(ctx, field)
-			case "field":
-				return ec.fieldContext_ShiftError_field(ctx, field)
-			case "message":
-				return ec.fieldContext_ShiftError_message(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ShiftError", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RequestSwapResponse_request(ctx context.Context, field graphql.CollectedField, obj *model.RequestSwapResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RequestSwapResponse_request(ctx, field)
+func (ec *executionContext) _RequestHistoryEntry_newValue(ctx context.Context, field graphql.CollectedField, obj *model.RequestHistoryEntry) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestHistoryEntry_newValue(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4960,7 +6258,7 @@ func (ec *executionContext) _RequestSwapResponse_request(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Request, nil
+		return obj.NewValue, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4969,66 +6267,26 @@ func (ec *executionContext) _RequestSwapResponse_request(ctx context.Context, fi
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*model.RequestResponse)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalORequestResponse2ᚖrequest_swapsᚋgraphᚋmodelᚐRequestResponse(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RequestSwapResponse_request(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RequestHistoryEntry_newValue(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RequestSwapResponse",
+		Object:     "RequestHistoryEntry",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "channelId":
-				return ec.fieldContext_RequestResponse_channelId(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_RequestResponse_createdAt(ctx, field)
-			case "endTime":
-				return ec.fieldContext_RequestResponse_endTime(ctx, field)
-			case "id":
-				return ec.fieldContext_RequestResponse_id(ctx, field)
-			case "isAllDay":
-				return ec.fieldContext_RequestResponse_isAllDay(ctx, field)
-			case "reason":
-				return ec.fieldContext_RequestResponse_reason(ctx, field)
-			case "requestId":
-				return ec.fieldContext_RequestResponse_requestId(ctx, field)
-			case "requestNote":
-				return ec.fieldContext_RequestResponse_requestNote(ctx, field)
-			case "responseAt":
-				return ec.fieldContext_RequestResponse_responseAt(ctx, field)
-			case "responseBy":
-				return ec.fieldContext_RequestResponse_responseBy(ctx, field)
-			case "responseNote":
-				return ec.fieldContext_RequestResponse_responseNote(ctx, field)
-			case "shiftOfferedTo":
-				return ec.fieldContext_RequestResponse_shiftOfferedTo(ctx, field)
-			case "shiftToOffer":
-				return ec.fieldContext_RequestResponse_shiftToOffer(ctx, field)
-			case "shiftToSwap":
-				return ec.fieldContext_RequestResponse_shiftToSwap(ctx, field)
-			case "startTime":
-				return ec.fieldContext_RequestResponse_startTime(ctx, field)
-			case "status":
-				return ec.fieldContext_RequestResponse_status(ctx, field)
-			case "toSwapWith":
-				return ec.fieldContext_RequestResponse_toSwapWith(ctx, field)
-			case "type":
-				return ec.fieldContext_RequestResponse_type(ctx, field)
-			case "user":
-				return ec.fieldContext_RequestResponse_user(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type RequestResponse", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ShiftError_code(ctx context.Context, field graphql.CollectedField, obj *model.ShiftError) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShiftError_code(ctx, field)
+func (ec *executionContext) _RequestHistoryEntry_actorUserId(ctx context.Context, field graphql.CollectedField, obj *model.RequestHistoryEntry) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestHistoryEntry_actorUserId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5041,38 +6299,35 @@ func (ec *executionContext) _ShiftError_code(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Code, nil
+		return obj.ActorUserID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(model.ShiftErrorCode)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNShiftErrorCode2request_swapsᚋgraphᚋmodelᚐShiftErrorCode(ctx, field.Selections, res)
+	return ec.marshalOID2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ShiftError_code(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RequestHistoryEntry_actorUserId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ShiftError",
+		Object:     "RequestHistoryEntry",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ShiftErrorCode does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ShiftError_field(ctx context.Context, field graphql.CollectedField, obj *model.ShiftError) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShiftError_field(ctx, field)
+func (ec *executionContext) _RequestHistoryEntry_actor(ctx context.Context, field graphql.CollectedField, obj *model.RequestHistoryEntry) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestHistoryEntry_actor(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5085,7 +6340,7 @@ func (ec *executionContext) _ShiftError_field(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Field, nil
+		return ec.resolvers.RequestHistoryEntry().Actor(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5094,26 +6349,42 @@ func (ec *executionContext) _ShiftError_field(ctx context.Context, field graphql
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*model.User)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOUser2ᚖrequest_swapsᚋgraphᚋmodelᚐUser(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ShiftError_field(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RequestHistoryEntry_actor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ShiftError",
+		Object:     "RequestHistoryEntry",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_User_id(ctx, field)
+			case "firstName":
+				return ec.fieldContext_User_firstName(ctx, field)
+			case "lastName":
+				return ec.fieldContext_User_lastName(ctx, field)
+			case "email":
+				return ec.fieldContext_User_email(ctx, field)
+			case "avatar":
+				return ec.fieldContext_User_avatar(ctx, field)
+			case "isActive":
+				return ec.fieldContext_User_isActive(ctx, field)
+			case "isStaff":
+				return ec.fieldContext_User_isStaff(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ShiftError_message(ctx context.Context, field graphql.CollectedField, obj *model.ShiftError) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShiftError_message(ctx, field)
+func (ec *executionContext) _RequestHistoryEntry_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.RequestHistoryEntry) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestHistoryEntry_createdAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5126,35 +6397,38 @@ func (ec *executionContext) _ShiftError_message(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Message, nil
+		return obj.CreatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ShiftError_message(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RequestHistoryEntry_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ShiftError",
+		Object:     "RequestHistoryEntry",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _User_id(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_User_id(ctx, field)
+func (ec *executionContext) _RequestResponse_channelId(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestResponse_channelId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5167,23 +6441,26 @@ func (ec *executionContext) _User_id(ctx context.Context, field graphql.Collecte
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.ChannelID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOID2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_User_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RequestResponse_channelId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "User",
+		Object:     "RequestResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -5194,8 +6471,8 @@ func (ec *executionContext) fieldContext_User_id(ctx context.Context, field grap
 	return fc, nil
 }
 
-func (ec *executionContext) _User_firstName(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_User_firstName(ctx, field)
+func (ec *executionContext) _RequestResponse_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestResponse_createdAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5208,7 +6485,7 @@ func (ec *executionContext) _User_firstName(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.FirstName, nil
+		return obj.CreatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5217,26 +6494,26 @@ func (ec *executionContext) _User_firstName(ctx context.Context, field graphql.C
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*time.Time)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_User_firstName(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RequestResponse_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "User",
+		Object:     "RequestResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _User_lastName(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_User_lastName(ctx, field)
+func (ec *executionContext) _RequestResponse_endTime(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestResponse_endTime(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5249,7 +6526,7 @@ func (ec *executionContext) _User_lastName(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.LastName, nil
+		return obj.EndTime, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5258,26 +6535,26 @@ func (ec *executionContext) _User_lastName(ctx context.Context, field graphql.Co
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*time.Time)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_User_lastName(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RequestResponse_endTime(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "User",
+		Object:     "RequestResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _User_email(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_User_email(ctx, field)
+func (ec *executionContext) _RequestResponse_id(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestResponse_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5290,76 +6567,38 @@ func (ec *executionContext) _User_email(ctx context.Context, field graphql.Colle
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Email, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
-}
-
-func (ec *executionContext) fieldContext_User_email(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "User",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
-
-func (ec *executionContext) _User_avatar(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_User_avatar(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Avatar, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_User_avatar(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RequestResponse_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "User",
+		Object:     "RequestResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _User_isActive(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_User_isActive(ctx, field)
+func (ec *executionContext) _RequestResponse_isAllDay(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestResponse_isAllDay(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5372,7 +6611,7 @@ func (ec *executionContext) _User_isActive(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsActive, nil
+		return obj.IsAllDay, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5386,9 +6625,9 @@ func (ec *executionContext) _User_isActive(ctx context.Context, field graphql.Co
 	return ec.marshalOBoolean2ᚖbool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_User_isActive(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RequestResponse_isAllDay(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "User",
+		Object:     "RequestResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -5399,8 +6638,8 @@ func (ec *executionContext) fieldContext_User_isActive(ctx context.Context, fiel
 	return fc, nil
 }
 
-func (ec *executionContext) _User_isStaff(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_User_isStaff(ctx, field)
+func (ec *executionContext) _RequestResponse_reason(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestResponse_reason(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5413,7 +6652,7 @@ func (ec *executionContext) _User_isStaff(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsStaff, nil
+		return obj.Reason, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5422,26 +6661,26 @@ func (ec *executionContext) _User_isStaff(ctx context.Context, field graphql.Col
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*bool)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOBoolean2ᚖbool(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_User_isStaff(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RequestResponse_reason(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "User",
+		Object:     "RequestResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Directive_name(ctx, field)
+func (ec *executionContext) _RequestResponse_requestId(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestResponse_requestId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5454,7 +6693,7 @@ func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.RequestID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5468,24 +6707,24 @@ func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql
 	}
 	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Directive_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RequestResponse_requestId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "RequestResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Directive_description(ctx, field)
+func (ec *executionContext) _RequestResponse_requestNote(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestResponse_requestNote(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5498,7 +6737,7 @@ func (ec *executionContext) ___Directive_description(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
+		return obj.RequestNote, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5512,11 +6751,11 @@ func (ec *executionContext) ___Directive_description(ctx context.Context, field
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Directive_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RequestResponse_requestNote(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "RequestResponse",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
@@ -5525,8 +6764,8 @@ func (ec *executionContext) fieldContext___Directive_description(ctx context.Con
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_locations(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Directive_locations(ctx, field)
+func (ec *executionContext) _RequestResponse_responseAt(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestResponse_responseAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5539,38 +6778,35 @@ func (ec *executionContext) ___Directive_locations(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Locations, nil
+		return obj.ResponseAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]string)
+	res := resTmp.(*time.Time)
 	fc.Result = res
-	return ec.marshalN__DirectiveLocation2ᚕstringᚄ(ctx, field.Selections, res)
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Directive_locations(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RequestResponse_responseAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "RequestResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type __DirectiveLocation does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Directive_args(ctx, field)
+func (ec *executionContext) _RequestResponse_responseBy(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestResponse_responseBy(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5583,48 +6819,51 @@ func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Args, nil
+		return ec.resolvers.RequestResponse().ResponseBy(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.InputValue)
+	res := resTmp.(*model.User)
 	fc.Result = res
-	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+	return ec.marshalOUser2ᚖrequest_swapsᚋgraphᚋmodelᚐUser(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Directive_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RequestResponse_responseBy(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "RequestResponse",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "name":
-				return ec.fieldContext___InputValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___InputValue_description(ctx, field)
-			case "type":
-				return ec.fieldContext___InputValue_type(ctx, field)
-			case "defaultValue":
-				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			case "id":
+				return ec.fieldContext_User_id(ctx, field)
+			case "firstName":
+				return ec.fieldContext_User_firstName(ctx, field)
+			case "lastName":
+				return ec.fieldContext_User_lastName(ctx, field)
+			case "email":
+				return ec.fieldContext_User_email(ctx, field)
+			case "avatar":
+				return ec.fieldContext_User_avatar(ctx, field)
+			case "isActive":
+				return ec.fieldContext_User_isActive(ctx, field)
+			case "isStaff":
+				return ec.fieldContext_User_isStaff(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_isRepeatable(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Directive_isRepeatable(ctx, field)
+func (ec *executionContext) _RequestResponse_responseNote(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestResponse_responseNote(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5637,38 +6876,35 @@ func (ec *executionContext) ___Directive_isRepeatable(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsRepeatable, nil
+		return obj.ResponseNote, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Directive_isRepeatable(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RequestResponse_responseNote(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "RequestResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___EnumValue_name(ctx, field)
+func (ec *executionContext) _RequestResponse_shiftOfferedTo(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestResponse_shiftOfferedTo(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5681,38 +6917,51 @@ func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return ec.resolvers.RequestResponse().ShiftOfferedTo(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.User)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOUser2ᚖrequest_swapsᚋgraphᚋmodelᚐUser(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___EnumValue_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RequestResponse_shiftOfferedTo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "RequestResponse",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_User_id(ctx, field)
+			case "firstName":
+				return ec.fieldContext_User_firstName(ctx, field)
+			case "lastName":
+				return ec.fieldContext_User_lastName(ctx, field)
+			case "email":
+				return ec.fieldContext_User_email(ctx, field)
+			case "avatar":
+				return ec.fieldContext_User_avatar(ctx, field)
+			case "isActive":
+				return ec.fieldContext_User_isActive(ctx, field)
+			case "isStaff":
+				return ec.fieldContext_User_isStaff(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___EnumValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___EnumValue_description(ctx, field)
+func (ec *executionContext) _RequestResponse_shiftToOffer(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestResponse_shiftToOffer(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5725,7 +6974,7 @@ func (ec *executionContext) ___EnumValue_description(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
+		return ec.resolvers.RequestResponse().ShiftToOffer(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5734,26 +6983,64 @@ func (ec *executionContext) ___EnumValue_description(ctx context.Context, field
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*model.AssignedShift)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOAssignedShift2ᚖrequest_swapsᚋgraphᚋmodelᚐAssignedShift(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___EnumValue_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RequestResponse_shiftToOffer(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "RequestResponse",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AssignedShift_id(ctx, field)
+			case "break":
+				return ec.fieldContext_AssignedShift_break(ctx, field)
+			case "color":
+				return ec.fieldContext_AssignedShift_color(ctx, field)
+			case "startTime":
+				return ec.fieldContext_AssignedShift_startTime(ctx, field)
+			case "endTime":
+				return ec.fieldContext_AssignedShift_endTime(ctx, field)
+			case "is24Hours":
+				return ec.fieldContext_AssignedShift_is24Hours(ctx, field)
+			case "label":
+				return ec.fieldContext_AssignedShift_label(ctx, field)
+			case "note":
+				return ec.fieldContext_AssignedShift_note(ctx, field)
+			case "shiftToOffer":
+				return ec.fieldContext_AssignedShift_shiftToOffer(ctx, field)
+			case "shiftToSwap":
+				return ec.fieldContext_AssignedShift_shiftToSwap(ctx, field)
+			case "toSwapWith":
+				return ec.fieldContext_AssignedShift_toSwapWith(ctx, field)
+			case "userId":
+				return ec.fieldContext_AssignedShift_userId(ctx, field)
+			case "channelId":
+				return ec.fieldContext_AssignedShift_channelId(ctx, field)
+			case "shiftGroupId":
+				return ec.fieldContext_AssignedShift_shiftGroupId(ctx, field)
+			case "type":
+				return ec.fieldContext_AssignedShift_type(ctx, field)
+			case "isOpen":
+				return ec.fieldContext_AssignedShift_isOpen(ctx, field)
+			case "isShared":
+				return ec.fieldContext_AssignedShift_isShared(ctx, field)
+			case "ShiftActivities":
+				return ec.fieldContext_AssignedShift_ShiftActivities(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AssignedShift", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___EnumValue_isDeprecated(ctx, field)
+func (ec *executionContext) _RequestResponse_shiftToSwap(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestResponse_shiftToSwap(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5766,38 +7053,73 @@ func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsDeprecated(), nil
+		return ec.resolvers.RequestResponse().ShiftToSwap(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*model.AssignedShift)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalOAssignedShift2ᚖrequest_swapsᚋgraphᚋmodelᚐAssignedShift(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RequestResponse_shiftToSwap(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "RequestResponse",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AssignedShift_id(ctx, field)
+			case "break":
+				return ec.fieldContext_AssignedShift_break(ctx, field)
+			case "color":
+				return ec.fieldContext_AssignedShift_color(ctx, field)
+			case "startTime":
+				return ec.fieldContext_AssignedShift_startTime(ctx, field)
+			case "endTime":
+				return ec.fieldContext_AssignedShift_endTime(ctx, field)
+			case "is24Hours":
+				return ec.fieldContext_AssignedShift_is24Hours(ctx, field)
+			case "label":
+				return ec.fieldContext_AssignedShift_label(ctx, field)
+			case "note":
+				return ec.fieldContext_AssignedShift_note(ctx, field)
+			case "shiftToOffer":
+				return ec.fieldContext_AssignedShift_shiftToOffer(ctx, field)
+			case "shiftToSwap":
+				return ec.fieldContext_AssignedShift_shiftToSwap(ctx, field)
+			case "toSwapWith":
+				return ec.fieldContext_AssignedShift_toSwapWith(ctx, field)
+			case "userId":
+				return ec.fieldContext_AssignedShift_userId(ctx, field)
+			case "channelId":
+				return ec.fieldContext_AssignedShift_channelId(ctx, field)
+			case "shiftGroupId":
+				return ec.fieldContext_AssignedShift_shiftGroupId(ctx, field)
+			case "type":
+				return ec.fieldContext_AssignedShift_type(ctx, field)
+			case "isOpen":
+				return ec.fieldContext_AssignedShift_isOpen(ctx, field)
+			case "isShared":
+				return ec.fieldContext_AssignedShift_isShared(ctx, field)
+			case "ShiftActivities":
+				return ec.fieldContext_AssignedShift_ShiftActivities(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AssignedShift", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___EnumValue_deprecationReason(ctx, field)
+func (ec *executionContext) _RequestResponse_startTime(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestResponse_startTime(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5810,7 +7132,7 @@ func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DeprecationReason(), nil
+		return obj.StartTime, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5819,26 +7141,26 @@ func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context,
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*time.Time)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RequestResponse_startTime(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "RequestResponse",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Field_name(ctx, field)
+func (ec *executionContext) _RequestResponse_status(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestResponse_status(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5851,38 +7173,35 @@ func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Status, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.RequestStatus)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalORequestStatus2ᚖrequest_swapsᚋgraphᚋmodelᚐRequestStatus(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Field_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RequestResponse_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "RequestResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type RequestStatus does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Field_description(ctx, field)
+func (ec *executionContext) _RequestResponse_toSwapWith(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestResponse_toSwapWith(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5895,7 +7214,7 @@ func (ec *executionContext) ___Field_description(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
+		return ec.resolvers.RequestResponse().ToSwapWith(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5904,26 +7223,64 @@ func (ec *executionContext) ___Field_description(ctx context.Context, field grap
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*model.AssignedShift)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOAssignedShift2ᚖrequest_swapsᚋgraphᚋmodelᚐAssignedShift(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Field_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RequestResponse_toSwapWith(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "RequestResponse",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AssignedShift_id(ctx, field)
+			case "break":
+				return ec.fieldContext_AssignedShift_break(ctx, field)
+			case "color":
+				return ec.fieldContext_AssignedShift_color(ctx, field)
+			case "startTime":
+				return ec.fieldContext_AssignedShift_startTime(ctx, field)
+			case "endTime":
+				return ec.fieldContext_AssignedShift_endTime(ctx, field)
+			case "is24Hours":
+				return ec.fieldContext_AssignedShift_is24Hours(ctx, field)
+			case "label":
+				return ec.fieldContext_AssignedShift_label(ctx, field)
+			case "note":
+				return ec.fieldContext_AssignedShift_note(ctx, field)
+			case "shiftToOffer":
+				return ec.fieldContext_AssignedShift_shiftToOffer(ctx, field)
+			case "shiftToSwap":
+				return ec.fieldContext_AssignedShift_shiftToSwap(ctx, field)
+			case "toSwapWith":
+				return ec.fieldContext_AssignedShift_toSwapWith(ctx, field)
+			case "userId":
+				return ec.fieldContext_AssignedShift_userId(ctx, field)
+			case "channelId":
+				return ec.fieldContext_AssignedShift_channelId(ctx, field)
+			case "shiftGroupId":
+				return ec.fieldContext_AssignedShift_shiftGroupId(ctx, field)
+			case "type":
+				return ec.fieldContext_AssignedShift_type(ctx, field)
+			case "isOpen":
+				return ec.fieldContext_AssignedShift_isOpen(ctx, field)
+			case "isShared":
+				return ec.fieldContext_AssignedShift_isShared(ctx, field)
+			case "ShiftActivities":
+				return ec.fieldContext_AssignedShift_ShiftActivities(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AssignedShift", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Field_args(ctx, field)
+func (ec *executionContext) _RequestResponse_type(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestResponse_type(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5936,48 +7293,35 @@ func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Args, nil
+		return obj.Type, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.InputValue)
+	res := resTmp.(*model.RequestType)
 	fc.Result = res
-	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+	return ec.marshalORequestType2ᚖrequest_swapsᚋgraphᚋmodelᚐRequestType(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Field_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RequestResponse_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "RequestResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___InputValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___InputValue_description(ctx, field)
-			case "type":
-				return ec.fieldContext___InputValue_type(ctx, field)
-			case "defaultValue":
-				return ec.fieldContext___InputValue_defaultValue(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+			return nil, errors.New("field of type RequestType does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Field_type(ctx, field)
+func (ec *executionContext) _RequestResponse_user(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestResponse_user(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5990,7 +7334,7 @@ func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
+		return obj.User, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6002,48 +7346,42 @@ func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(*model.User)
 	fc.Result = res
-	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNUser2ᚖrequest_swapsᚋgraphᚋmodelᚐUser(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Field_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RequestResponse_user(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "RequestResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "id":
+				return ec.fieldContext_User_id(ctx, field)
+			case "firstName":
+				return ec.fieldContext_User_firstName(ctx, field)
+			case "lastName":
+				return ec.fieldContext_User_lastName(ctx, field)
+			case "email":
+				return ec.fieldContext_User_email(ctx, field)
+			case "avatar":
+				return ec.fieldContext_User_avatar(ctx, field)
+			case "isActive":
+				return ec.fieldContext_User_isActive(ctx, field)
+			case "isStaff":
+				return ec.fieldContext_User_isStaff(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Field_isDeprecated(ctx, field)
+func (ec *executionContext) _RequestResponse_history(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestResponse_history(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6056,7 +7394,7 @@ func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsDeprecated(), nil
+		return ec.resolvers.RequestResponse().History(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6068,26 +7406,44 @@ func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.([]*model.RequestHistoryEntry)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNRequestHistoryEntry2ᚕᚖrequest_swapsᚋgraphᚋmodelᚐRequestHistoryEntryᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Field_isDeprecated(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RequestResponse_history(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "RequestResponse",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_RequestHistoryEntry_id(ctx, field)
+			case "action":
+				return ec.fieldContext_RequestHistoryEntry_action(ctx, field)
+			case "field":
+				return ec.fieldContext_RequestHistoryEntry_field(ctx, field)
+			case "oldValue":
+				return ec.fieldContext_RequestHistoryEntry_oldValue(ctx, field)
+			case "newValue":
+				return ec.fieldContext_RequestHistoryEntry_newValue(ctx, field)
+			case "actorUserId":
+				return ec.fieldContext_RequestHistoryEntry_actorUserId(ctx, field)
+			case "actor":
+				return ec.fieldContext_RequestHistoryEntry_actor(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_RequestHistoryEntry_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RequestHistoryEntry", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Field_deprecationReason(ctx, field)
+func (ec *executionContext) _RequestResponse_comments(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestResponse_comments(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6100,35 +7456,50 @@ func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DeprecationReason(), nil
+		return ec.resolvers.RequestResponse().Comments(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.([]*model.RequestComment)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNRequestComment2ᚕᚖrequest_swapsᚋgraphᚋmodelᚐRequestCommentᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Field_deprecationReason(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RequestResponse_comments(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "RequestResponse",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_RequestComment_id(ctx, field)
+			case "userId":
+				return ec.fieldContext_RequestComment_userId(ctx, field)
+			case "user":
+				return ec.fieldContext_RequestComment_user(ctx, field)
+			case "body":
+				return ec.fieldContext_RequestComment_body(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_RequestComment_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RequestComment", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___InputValue_name(ctx, field)
+func (ec *executionContext) _RequestSwap_id(ctx context.Context, field graphql.CollectedField, obj *model.RequestSwap) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestSwap_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6141,7 +7512,7 @@ func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6155,24 +7526,24 @@ func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphq
 	}
 	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___InputValue_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RequestSwap_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "RequestSwap",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___InputValue_description(ctx, field)
+func (ec *executionContext) _RequestSwap_channelId(ctx context.Context, field graphql.CollectedField, obj *model.RequestSwap) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestSwap_channelId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6185,35 +7556,38 @@ func (ec *executionContext) ___InputValue_description(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
+		return obj.ChannelID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___InputValue_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RequestSwap_channelId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "RequestSwap",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___InputValue_type(ctx, field)
+func (ec *executionContext) _RequestSwap_requestId(ctx context.Context, field graphql.CollectedField, obj *model.RequestSwap) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestSwap_requestId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6226,60 +7600,35 @@ func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
+		return obj.RequestID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalOID2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___InputValue_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RequestSwap_requestId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "RequestSwap",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___InputValue_defaultValue(ctx, field)
+func (ec *executionContext) _RequestSwap_userId(ctx context.Context, field graphql.CollectedField, obj *model.RequestSwap) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestSwap_userId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6292,35 +7641,38 @@ func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DefaultValue, nil
+		return obj.UserID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RequestSwap_userId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "RequestSwap",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Schema_description(ctx, field)
+func (ec *executionContext) _RequestSwap_assignedUserShiftId(ctx context.Context, field graphql.CollectedField, obj *model.RequestSwap) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestSwap_assignedUserShiftId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6333,7 +7685,7 @@ func (ec *executionContext) ___Schema_description(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
+		return obj.AssignedUserShiftID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6344,24 +7696,24 @@ func (ec *executionContext) ___Schema_description(ctx context.Context, field gra
 	}
 	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOID2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Schema_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RequestSwap_assignedUserShiftId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "RequestSwap",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Schema_types(ctx, field)
+func (ec *executionContext) _RequestSwap_assignedUserShiftIdToSwap(ctx context.Context, field graphql.CollectedField, obj *model.RequestSwap) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestSwap_assignedUserShiftIdToSwap(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6374,60 +7726,35 @@ func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Types(), nil
+		return obj.AssignedUserShiftIDToSwap, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Type)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+	return ec.marshalOID2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Schema_types(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RequestSwap_assignedUserShiftIdToSwap(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "RequestSwap",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Schema_queryType(ctx, field)
+func (ec *executionContext) _RequestSwap_peerUserId(ctx context.Context, field graphql.CollectedField, obj *model.RequestSwap) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestSwap_peerUserId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6440,60 +7767,35 @@ func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.QueryType(), nil
+		return obj.PeerUserID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalOID2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Schema_queryType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RequestSwap_peerUserId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "RequestSwap",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Schema_mutationType(ctx, field)
+func (ec *executionContext) _RequestSwap_requestNote(ctx context.Context, field graphql.CollectedField, obj *model.RequestSwap) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestSwap_requestNote(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6506,7 +7808,7 @@ func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MutationType(), nil
+		return obj.RequestNote, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6515,48 +7817,26 @@ func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field gr
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Schema_mutationType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RequestSwap_requestNote(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "RequestSwap",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Schema_subscriptionType(ctx, field)
+func (ec *executionContext) _RequestSwap_status(ctx context.Context, field graphql.CollectedField, obj *model.RequestSwap) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestSwap_status(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6569,57 +7849,38 @@ func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SubscriptionType(), nil
+		return obj.Status, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(model.RequestStatus)
 	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNRequestStatus2request_swapsᚋgraphᚋmodelᚐRequestStatus(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RequestSwap_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "RequestSwap",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type RequestStatus does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_directives(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Schema_directives(ctx, field)
+func (ec *executionContext) _RequestSwap_responseNote(ctx context.Context, field graphql.CollectedField, obj *model.RequestSwap) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestSwap_responseNote(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6632,50 +7893,35 @@ func (ec *executionContext) ___Schema_directives(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Directives(), nil
+		return obj.ResponseNote, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Directive)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Schema_directives(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RequestSwap_responseNote(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "RequestSwap",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___Directive_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Directive_description(ctx, field)
-			case "locations":
-				return ec.fieldContext___Directive_locations(ctx, field)
-			case "args":
-				return ec.fieldContext___Directive_args(ctx, field)
-			case "isRepeatable":
-				return ec.fieldContext___Directive_isRepeatable(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Directive", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_kind(ctx, field)
+func (ec *executionContext) _RequestSwap_responseByUserId(ctx context.Context, field graphql.CollectedField, obj *model.RequestSwap) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestSwap_responseByUserId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6688,38 +7934,35 @@ func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.Coll
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Kind(), nil
+		return obj.ResponseByUserID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalN__TypeKind2string(ctx, field.Selections, res)
+	return ec.marshalOID2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_kind(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RequestSwap_responseByUserId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "RequestSwap",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type __TypeKind does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_name(ctx, field)
+func (ec *executionContext) _RequestSwap_responseAt(ctx context.Context, field graphql.CollectedField, obj *model.RequestSwap) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestSwap_responseAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6732,7 +7975,7 @@ func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.Coll
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name(), nil
+		return obj.ResponseAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6741,26 +7984,26 @@ func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.Coll
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*time.Time)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RequestSwap_responseAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "RequestSwap",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_description(ctx, field)
+func (ec *executionContext) _RequestSwap_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.RequestSwap) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestSwap_createdAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6773,35 +8016,38 @@ func (ec *executionContext) ___Type_description(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
+		return obj.CreatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RequestSwap_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "RequestSwap",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_fields(ctx, field)
+func (ec *executionContext) _RequestSwap_startTime(ctx context.Context, field graphql.CollectedField, obj *model.RequestSwap) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestSwap_startTime(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6814,7 +8060,7 @@ func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Fields(fc.Args["includeDeprecated"].(bool)), nil
+		return obj.StartTime, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6823,51 +8069,26 @@ func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.Co
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Field)
+	res := resTmp.(*time.Time)
 	fc.Result = res
-	return ec.marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐFieldᚄ(ctx, field.Selections, res)
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_fields(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RequestSwap_startTime(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "RequestSwap",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___Field_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Field_description(ctx, field)
-			case "args":
-				return ec.fieldContext___Field_args(ctx, field)
-			case "type":
-				return ec.fieldContext___Field_type(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___Field_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___Field_deprecationReason(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Field", field.Name)
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Type_fields_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_interfaces(ctx, field)
+func (ec *executionContext) _RequestSwap_endTime(ctx context.Context, field graphql.CollectedField, obj *model.RequestSwap) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestSwap_endTime(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6880,7 +8101,7 @@ func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Interfaces(), nil
+		return obj.EndTime, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6889,48 +8110,26 @@ func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphq
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Type)
+	res := resTmp.(*time.Time)
 	fc.Result = res
-	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_interfaces(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RequestSwap_endTime(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "RequestSwap",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_possibleTypes(ctx, field)
+func (ec *executionContext) _RequestSwap_history(ctx context.Context, field graphql.CollectedField, obj *model.RequestSwap) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestSwap_history(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6943,57 +8142,56 @@ func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PossibleTypes(), nil
+		return ec.resolvers.RequestSwap().History(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Type)
+	res := resTmp.([]*model.RequestHistoryEntry)
 	fc.Result = res
-	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+	return ec.marshalNRequestHistoryEntry2ᚕᚖrequest_swapsᚋgraphᚋmodelᚐRequestHistoryEntryᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_possibleTypes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RequestSwap_history(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "RequestSwap",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "id":
+				return ec.fieldContext_RequestHistoryEntry_id(ctx, field)
+			case "action":
+				return ec.fieldContext_RequestHistoryEntry_action(ctx, field)
+			case "field":
+				return ec.fieldContext_RequestHistoryEntry_field(ctx, field)
+			case "oldValue":
+				return ec.fieldContext_RequestHistoryEntry_oldValue(ctx, field)
+			case "newValue":
+				return ec.fieldContext_RequestHistoryEntry_newValue(ctx, field)
+			case "actorUserId":
+				return ec.fieldContext_RequestHistoryEntry_actorUserId(ctx, field)
+			case "actor":
+				return ec.fieldContext_RequestHistoryEntry_actor(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_RequestHistoryEntry_createdAt(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type RequestHistoryEntry", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_enumValues(ctx, field)
+func (ec *executionContext) _RequestSwap_comments(ctx context.Context, field graphql.CollectedField, obj *model.RequestSwap) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestSwap_comments(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7006,56 +8204,100 @@ func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.EnumValues(fc.Args["includeDeprecated"].(bool)), nil
+		return ec.resolvers.RequestSwap().Comments(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.EnumValue)
+	res := resTmp.([]*model.RequestComment)
 	fc.Result = res
-	return ec.marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ(ctx, field.Selections, res)
+	return ec.marshalNRequestComment2ᚕᚖrequest_swapsᚋgraphᚋmodelᚐRequestCommentᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_enumValues(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RequestSwap_comments(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "RequestSwap",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "name":
-				return ec.fieldContext___EnumValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___EnumValue_description(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___EnumValue_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___EnumValue_deprecationReason(ctx, field)
+			case "id":
+				return ec.fieldContext_RequestComment_id(ctx, field)
+			case "userId":
+				return ec.fieldContext_RequestComment_userId(ctx, field)
+			case "user":
+				return ec.fieldContext_RequestComment_user(ctx, field)
+			case "body":
+				return ec.fieldContext_RequestComment_body(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_RequestComment_createdAt(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __EnumValue", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type RequestComment", field.Name)
 		},
 	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestSwapConnection_edges(ctx context.Context, field graphql.CollectedField, obj *model.RequestSwapConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestSwapConnection_edges(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
 	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Type_enumValues_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Edges, nil
+	})
+	if err != nil {
 		ec.Error(ctx, err)
-		return
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.RequestSwapEdge)
+	fc.Result = res
+	return ec.marshalNRequestSwapEdge2ᚕᚖrequest_swapsᚋgraphᚋmodelᚐRequestSwapEdgeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestSwapConnection_edges(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestSwapConnection",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "cursor":
+				return ec.fieldContext_RequestSwapEdge_cursor(ctx, field)
+			case "node":
+				return ec.fieldContext_RequestSwapEdge_node(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RequestSwapEdge", field.Name)
+		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_inputFields(ctx, field)
+func (ec *executionContext) _RequestSwapConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.RequestSwapConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestSwapConnection_pageInfo(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7068,45 +8310,48 @@ func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.InputFields(), nil
+		return obj.PageInfo, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.InputValue)
+	res := resTmp.(*model.PageInfo)
 	fc.Result = res
-	return ec.marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+	return ec.marshalNPageInfo2ᚖrequest_swapsᚋgraphᚋmodelᚐPageInfo(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_inputFields(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RequestSwapConnection_pageInfo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "RequestSwapConnection",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "name":
-				return ec.fieldContext___InputValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___InputValue_description(ctx, field)
-			case "type":
-				return ec.fieldContext___InputValue_type(ctx, field)
-			case "defaultValue":
-				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			case "hasNextPage":
+				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
+			case "hasPreviousPage":
+				return ec.fieldContext_PageInfo_hasPreviousPage(ctx, field)
+			case "startCursor":
+				return ec.fieldContext_PageInfo_startCursor(ctx, field)
+			case "endCursor":
+				return ec.fieldContext_PageInfo_endCursor(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_ofType(ctx, field)
+func (ec *executionContext) _RequestSwapEdge_cursor(ctx context.Context, field graphql.CollectedField, obj *model.RequestSwapEdge) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestSwapEdge_cursor(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7119,57 +8364,118 @@ func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.OfType(), nil
+		return obj.Cursor, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_ofType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RequestSwapEdge_cursor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "RequestSwapEdge",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestSwapEdge_node(ctx context.Context, field graphql.CollectedField, obj *model.RequestSwapEdge) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestSwapEdge_node(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Node, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.RequestSwap)
+	fc.Result = res
+	return ec.marshalNRequestSwap2ᚖrequest_swapsᚋgraphᚋmodelᚐRequestSwap(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestSwapEdge_node(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestSwapEdge",
+		Field:      field,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "id":
+				return ec.fieldContext_RequestSwap_id(ctx, field)
+			case "channelId":
+				return ec.fieldContext_RequestSwap_channelId(ctx, field)
+			case "requestId":
+				return ec.fieldContext_RequestSwap_requestId(ctx, field)
+			case "userId":
+				return ec.fieldContext_RequestSwap_userId(ctx, field)
+			case "assignedUserShiftId":
+				return ec.fieldContext_RequestSwap_assignedUserShiftId(ctx, field)
+			case "assignedUserShiftIdToSwap":
+				return ec.fieldContext_RequestSwap_assignedUserShiftIdToSwap(ctx, field)
+			case "peerUserId":
+				return ec.fieldContext_RequestSwap_peerUserId(ctx, field)
+			case "requestNote":
+				return ec.fieldContext_RequestSwap_requestNote(ctx, field)
+			case "status":
+				return ec.fieldContext_RequestSwap_status(ctx, field)
+			case "responseNote":
+				return ec.fieldContext_RequestSwap_responseNote(ctx, field)
+			case "responseByUserId":
+				return ec.fieldContext_RequestSwap_responseByUserId(ctx, field)
+			case "responseAt":
+				return ec.fieldContext_RequestSwap_responseAt(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_RequestSwap_createdAt(ctx, field)
+			case "startTime":
+				return ec.fieldContext_RequestSwap_startTime(ctx, field)
+			case "endTime":
+				return ec.fieldContext_RequestSwap_endTime(ctx, field)
+			case "history":
+				return ec.fieldContext_RequestSwap_history(ctx, field)
+			case "comments":
+				return ec.fieldContext_RequestSwap_comments(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type RequestSwap", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_specifiedByURL(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_specifiedByURL(ctx, field)
+func (ec *executionContext) _RequestSwapResponse_errors(ctx context.Context, field graphql.CollectedField, obj *model.RequestSwapResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestSwapResponse_errors(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7182,228 +8488,3675 @@ func (ec *executionContext) ___Type_specifiedByURL(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SpecifiedByURL(), nil
+		return obj.Errors, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.([]*model.ShiftError)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNShiftError2ᚕᚖrequest_swapsᚋgraphᚋmodelᚐShiftErrorᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_specifiedByURL(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RequestSwapResponse_errors(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "RequestSwapResponse",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "code":
+				return ec.fieldContext_ShiftError_code(ctx, field)
+			case "field":
+				return ec.fieldContext_ShiftError_field(ctx, field)
+			case "message":
+				return ec.fieldContext_ShiftError_message(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ShiftError", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-// endregion **************************** field.gotpl *****************************
-
-// region    **************************** input.gotpl *****************************
+func (ec *executionContext) _RequestSwapResponse_request(ctx context.Context, field graphql.CollectedField, obj *model.RequestSwapResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestSwapResponse_request(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Request, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.RequestResponse)
+	fc.Result = res
+	return ec.marshalORequestResponse2ᚖrequest_swapsᚋgraphᚋmodelᚐRequestResponse(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestSwapResponse_request(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestSwapResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "channelId":
+				return ec.fieldContext_RequestResponse_channelId(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_RequestResponse_createdAt(ctx, field)
+			case "endTime":
+				return ec.fieldContext_RequestResponse_endTime(ctx, field)
+			case "id":
+				return ec.fieldContext_RequestResponse_id(ctx, field)
+			case "isAllDay":
+				return ec.fieldContext_RequestResponse_isAllDay(ctx, field)
+			case "reason":
+				return ec.fieldContext_RequestResponse_reason(ctx, field)
+			case "requestId":
+				return ec.fieldContext_RequestResponse_requestId(ctx, field)
+			case "requestNote":
+				return ec.fieldContext_RequestResponse_requestNote(ctx, field)
+			case "responseAt":
+				return ec.fieldContext_RequestResponse_responseAt(ctx, field)
+			case "responseBy":
+				return ec.fieldContext_RequestResponse_responseBy(ctx, field)
+			case "responseNote":
+				return ec.fieldContext_RequestResponse_responseNote(ctx, field)
+			case "shiftOfferedTo":
+				return ec.fieldContext_RequestResponse_shiftOfferedTo(ctx, field)
+			case "shiftToOffer":
+				return ec.fieldContext_RequestResponse_shiftToOffer(ctx, field)
+			case "shiftToSwap":
+				return ec.fieldContext_RequestResponse_shiftToSwap(ctx, field)
+			case "startTime":
+				return ec.fieldContext_RequestResponse_startTime(ctx, field)
+			case "status":
+				return ec.fieldContext_RequestResponse_status(ctx, field)
+			case "toSwapWith":
+				return ec.fieldContext_RequestResponse_toSwapWith(ctx, field)
+			case "type":
+				return ec.fieldContext_RequestResponse_type(ctx, field)
+			case "user":
+				return ec.fieldContext_RequestResponse_user(ctx, field)
+			case "history":
+				return ec.fieldContext_RequestResponse_history(ctx, field)
+			case "comments":
+				return ec.fieldContext_RequestResponse_comments(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RequestResponse", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestSwapResult_id(ctx context.Context, field graphql.CollectedField, obj *model.RequestSwapResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestSwapResult_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestSwapResult_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestSwapResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestSwapResult_errors(ctx context.Context, field graphql.CollectedField, obj *model.RequestSwapResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestSwapResult_errors(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Errors, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.ShiftError)
+	fc.Result = res
+	return ec.marshalNShiftError2ᚕᚖrequest_swapsᚋgraphᚋmodelᚐShiftErrorᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestSwapResult_errors(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestSwapResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "code":
+				return ec.fieldContext_ShiftError_code(ctx, field)
+			case "field":
+				return ec.fieldContext_ShiftError_field(ctx, field)
+			case "message":
+				return ec.fieldContext_ShiftError_message(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ShiftError", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestSwapResult_request(ctx context.Context, field graphql.CollectedField, obj *model.RequestSwapResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestSwapResult_request(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Request, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.RequestResponse)
+	fc.Result = res
+	return ec.marshalORequestResponse2ᚖrequest_swapsᚋgraphᚋmodelᚐRequestResponse(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestSwapResult_request(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestSwapResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "channelId":
+				return ec.fieldContext_RequestResponse_channelId(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_RequestResponse_createdAt(ctx, field)
+			case "endTime":
+				return ec.fieldContext_RequestResponse_endTime(ctx, field)
+			case "id":
+				return ec.fieldContext_RequestResponse_id(ctx, field)
+			case "isAllDay":
+				return ec.fieldContext_RequestResponse_isAllDay(ctx, field)
+			case "reason":
+				return ec.fieldContext_RequestResponse_reason(ctx, field)
+			case "requestId":
+				return ec.fieldContext_RequestResponse_requestId(ctx, field)
+			case "requestNote":
+				return ec.fieldContext_RequestResponse_requestNote(ctx, field)
+			case "responseAt":
+				return ec.fieldContext_RequestResponse_responseAt(ctx, field)
+			case "responseBy":
+				return ec.fieldContext_RequestResponse_responseBy(ctx, field)
+			case "responseNote":
+				return ec.fieldContext_RequestResponse_responseNote(ctx, field)
+			case "shiftOfferedTo":
+				return ec.fieldContext_RequestResponse_shiftOfferedTo(ctx, field)
+			case "shiftToOffer":
+				return ec.fieldContext_RequestResponse_shiftToOffer(ctx, field)
+			case "shiftToSwap":
+				return ec.fieldContext_RequestResponse_shiftToSwap(ctx, field)
+			case "startTime":
+				return ec.fieldContext_RequestResponse_startTime(ctx, field)
+			case "status":
+				return ec.fieldContext_RequestResponse_status(ctx, field)
+			case "toSwapWith":
+				return ec.fieldContext_RequestResponse_toSwapWith(ctx, field)
+			case "type":
+				return ec.fieldContext_RequestResponse_type(ctx, field)
+			case "user":
+				return ec.fieldContext_RequestResponse_user(ctx, field)
+			case "history":
+				return ec.fieldContext_RequestResponse_history(ctx, field)
+			case "comments":
+				return ec.fieldContext_RequestResponse_comments(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RequestResponse", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShiftError_code(ctx context.Context, field graphql.CollectedField, obj *model.ShiftError) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShiftError_code(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Code, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.ShiftErrorCode)
+	fc.Result = res
+	return ec.marshalNShiftErrorCode2request_swapsᚋgraphᚋmodelᚐShiftErrorCode(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShiftError_code(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShiftError",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ShiftErrorCode does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShiftError_field(ctx context.Context, field graphql.CollectedField, obj *model.ShiftError) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShiftError_field(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Field, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShiftError_field(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShiftError",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShiftError_message(ctx context.Context, field graphql.CollectedField, obj *model.ShiftError) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShiftError_message(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShiftError_message(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShiftError",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SwapCandidate_userId(ctx context.Context, field graphql.CollectedField, obj *model.SwapCandidate) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SwapCandidate_userId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.UserID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SwapCandidate_userId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SwapCandidate",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SwapCandidate_user(ctx context.Context, field graphql.CollectedField, obj *model.SwapCandidate) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SwapCandidate_user(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.SwapCandidate().User(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.User)
+	fc.Result = res
+	return ec.marshalOUser2ᚖrequest_swapsᚋgraphᚋmodelᚐUser(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SwapCandidate_user(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SwapCandidate",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_User_id(ctx, field)
+			case "firstName":
+				return ec.fieldContext_User_firstName(ctx, field)
+			case "lastName":
+				return ec.fieldContext_User_lastName(ctx, field)
+			case "email":
+				return ec.fieldContext_User_email(ctx, field)
+			case "avatar":
+				return ec.fieldContext_User_avatar(ctx, field)
+			case "isActive":
+				return ec.fieldContext_User_isActive(ctx, field)
+			case "isStaff":
+				return ec.fieldContext_User_isStaff(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SwapCandidate_shift(ctx context.Context, field graphql.CollectedField, obj *model.SwapCandidate) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SwapCandidate_shift(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Shift, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.AssignedShift)
+	fc.Result = res
+	return ec.marshalNAssignedShift2ᚖrequest_swapsᚋgraphᚋmodelᚐAssignedShift(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SwapCandidate_shift(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SwapCandidate",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AssignedShift_id(ctx, field)
+			case "break":
+				return ec.fieldContext_AssignedShift_break(ctx, field)
+			case "color":
+				return ec.fieldContext_AssignedShift_color(ctx, field)
+			case "startTime":
+				return ec.fieldContext_AssignedShift_startTime(ctx, field)
+			case "endTime":
+				return ec.fieldContext_AssignedShift_endTime(ctx, field)
+			case "is24Hours":
+				return ec.fieldContext_AssignedShift_is24Hours(ctx, field)
+			case "label":
+				return ec.fieldContext_AssignedShift_label(ctx, field)
+			case "note":
+				return ec.fieldContext_AssignedShift_note(ctx, field)
+			case "shiftToOffer":
+				return ec.fieldContext_AssignedShift_shiftToOffer(ctx, field)
+			case "shiftToSwap":
+				return ec.fieldContext_AssignedShift_shiftToSwap(ctx, field)
+			case "toSwapWith":
+				return ec.fieldContext_AssignedShift_toSwapWith(ctx, field)
+			case "userId":
+				return ec.fieldContext_AssignedShift_userId(ctx, field)
+			case "channelId":
+				return ec.fieldContext_AssignedShift_channelId(ctx, field)
+			case "shiftGroupId":
+				return ec.fieldContext_AssignedShift_shiftGroupId(ctx, field)
+			case "type":
+				return ec.fieldContext_AssignedShift_type(ctx, field)
+			case "isOpen":
+				return ec.fieldContext_AssignedShift_isOpen(ctx, field)
+			case "isShared":
+				return ec.fieldContext_AssignedShift_isShared(ctx, field)
+			case "ShiftActivities":
+				return ec.fieldContext_AssignedShift_ShiftActivities(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AssignedShift", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SwapCandidate_startTimeDifference(ctx context.Context, field graphql.CollectedField, obj *model.SwapCandidate) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SwapCandidate_startTimeDifference(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.StartTimeDifference, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SwapCandidate_startTimeDifference(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SwapCandidate",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _User_id(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_User_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOID2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_User_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _User_firstName(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_User_firstName(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.FirstName, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_User_firstName(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _User_lastName(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_User_lastName(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LastName, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_User_lastName(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _User_email(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_User_email(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Email, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_User_email(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _User_avatar(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_User_avatar(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Avatar, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_User_avatar(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _User_isActive(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_User_isActive(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsActive, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*bool)
+	fc.Result = res
+	return ec.marshalOBoolean2ᚖbool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_User_isActive(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _User_isStaff(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_User_isStaff(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsStaff, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*bool)
+	fc.Result = res
+	return ec.marshalOBoolean2ᚖbool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_User_isStaff(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Directive_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Directive_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Directive_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Directive_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_locations(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Directive_locations(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Locations, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalN__DirectiveLocation2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Directive_locations(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type __DirectiveLocation does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Directive_args(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Args, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.InputValue)
+	fc.Result = res
+	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Directive_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___InputValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___InputValue_description(ctx, field)
+			case "type":
+				return ec.fieldContext___InputValue_type(ctx, field)
+			case "defaultValue":
+				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_isRepeatable(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Directive_isRepeatable(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsRepeatable, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Directive_isRepeatable(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___EnumValue_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___EnumValue_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___EnumValue_isDeprecated(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsDeprecated(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___EnumValue_deprecationReason(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DeprecationReason(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Field_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Field_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Field_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Field_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Field_args(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Args, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.InputValue)
+	fc.Result = res
+	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Field_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___InputValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___InputValue_description(ctx, field)
+			case "type":
+				return ec.fieldContext___InputValue_type(ctx, field)
+			case "defaultValue":
+				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Field_type(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Field_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Field_isDeprecated(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsDeprecated(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Field_isDeprecated(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Field_deprecationReason(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DeprecationReason(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Field_deprecationReason(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___InputValue_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___InputValue_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___InputValue_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___InputValue_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___InputValue_type(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___InputValue_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___InputValue_defaultValue(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DefaultValue, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Schema_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Schema_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Schema_types(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Types(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Schema_types(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Schema_queryType(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.QueryType(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Schema_queryType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Schema_mutationType(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.MutationType(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Schema_mutationType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Schema_subscriptionType(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SubscriptionType(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_directives(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Schema_directives(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Directives(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Directive)
+	fc.Result = res
+	return ec.marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Schema_directives(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___Directive_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Directive_description(ctx, field)
+			case "locations":
+				return ec.fieldContext___Directive_locations(ctx, field)
+			case "args":
+				return ec.fieldContext___Directive_args(ctx, field)
+			case "isRepeatable":
+				return ec.fieldContext___Directive_isRepeatable(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Directive", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_kind(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Kind(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalN__TypeKind2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_kind(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type __TypeKind does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_fields(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Fields(fc.Args["includeDeprecated"].(bool)), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Field)
+	fc.Result = res
+	return ec.marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐFieldᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_fields(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___Field_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Field_description(ctx, field)
+			case "args":
+				return ec.fieldContext___Field_args(ctx, field)
+			case "type":
+				return ec.fieldContext___Field_type(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___Field_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___Field_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Field", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Type_fields_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_interfaces(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Interfaces(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_interfaces(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_possibleTypes(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PossibleTypes(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_possibleTypes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_enumValues(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.EnumValues(fc.Args["includeDeprecated"].(bool)), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.EnumValue)
+	fc.Result = res
+	return ec.marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_enumValues(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___EnumValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___EnumValue_description(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___EnumValue_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___EnumValue_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __EnumValue", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Type_enumValues_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_inputFields(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.InputFields(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.InputValue)
+	fc.Result = res
+	return ec.marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_inputFields(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___InputValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___InputValue_description(ctx, field)
+			case "type":
+				return ec.fieldContext___InputValue_type(ctx, field)
+			case "defaultValue":
+				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_ofType(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.OfType(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_ofType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_specifiedByURL(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_specifiedByURL(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SpecifiedByURL(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_specifiedByURL(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+// endregion **************************** field.gotpl *****************************
+
+// region    **************************** input.gotpl *****************************
+
+func (ec *executionContext) unmarshalInputRequestExpirySettingsInput(ctx context.Context, obj interface{}) (model.RequestExpirySettingsInput, error) {
+	var it model.RequestExpirySettingsInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"enabled", "gracePeriodMinutes"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "enabled":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("enabled"))
+			it.Enabled, err = ec.unmarshalNBoolean2bool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "gracePeriodMinutes":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("gracePeriodMinutes"))
+			it.GracePeriodMinutes, err = ec.unmarshalNInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputRequestFilter(ctx context.Context, obj interface{}) (model.RequestFilter, error) {
+	var it model.RequestFilter
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"status", "userId", "from", "to", "createdAfter", "createdBefore"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "status":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("status"))
+			it.Status, err = ec.unmarshalORequestStatus2ᚕrequest_swapsᚋgraphᚋmodelᚐRequestStatusᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "userId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("userId"))
+			it.UserID, err = ec.unmarshalOID2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "from":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("from"))
+			it.From, err = ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "to":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("to"))
+			it.To, err = ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "createdAfter":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("createdAfter"))
+			it.CreatedAfter, err = ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "createdBefore":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("createdBefore"))
+			it.CreatedBefore, err = ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputRequestOrder(ctx context.Context, obj interface{}) (model.RequestOrder, error) {
+	var it model.RequestOrder
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"field", "direction"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "field":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("field"))
+			it.Field, err = ec.unmarshalNRequestOrderField2request_swapsᚋgraphᚋmodelᚐRequestOrderField(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "direction":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("direction"))
+			it.Direction, err = ec.unmarshalNOrderDirection2request_swapsᚋgraphᚋmodelᚐOrderDirection(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputRequestSwapInput(ctx context.Context, obj interface{}) (model.RequestSwapInput, error) {
+	var it model.RequestSwapInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"channelId", "userId", "assignedUserShiftId", "assignedUserShiftIdToSwap", "requestNote", "responseNote", "responseByUserId", "responseAt"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "channelId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("channelId"))
+			it.ChannelID, err = ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "userId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("userId"))
+			it.UserID, err = ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "assignedUserShiftId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("assignedUserShiftId"))
+			it.AssignedUserShiftID, err = ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "assignedUserShiftIdToSwap":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("assignedUserShiftIdToSwap"))
+			it.AssignedUserShiftIDToSwap, err = ec.unmarshalOID2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "requestNote":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("requestNote"))
+			it.RequestNote, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "responseNote":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("responseNote"))
+			it.ResponseNote, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "responseByUserId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("responseByUserId"))
+			it.ResponseByUserID, err = ec.unmarshalOID2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "responseAt":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("responseAt"))
+			it.ResponseAt, err = ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+// endregion **************************** input.gotpl *****************************
+
+// region    ************************** interface.gotpl ***************************
+
+// endregion ************************** interface.gotpl ***************************
+
+// region    **************************** object.gotpl ****************************
+
+var assignedShiftImplementors = []string{"AssignedShift"}
+
+func (ec *executionContext) _AssignedShift(ctx context.Context, sel ast.SelectionSet, obj *model.AssignedShift) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, assignedShiftImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AssignedShift")
+		case "id":
+
+			out.Values[i] = ec._AssignedShift_id(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "break":
+
+			out.Values[i] = ec._AssignedShift_break(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "color":
+
+			out.Values[i] = ec._AssignedShift_color(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "startTime":
+
+			out.Values[i] = ec._AssignedShift_startTime(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "endTime":
+
+			out.Values[i] = ec._AssignedShift_endTime(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "is24Hours":
+
+			out.Values[i] = ec._AssignedShift_is24Hours(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "label":
+
+			out.Values[i] = ec._AssignedShift_label(ctx, field, obj)
+
+		case "note":
+
+			out.Values[i] = ec._AssignedShift_note(ctx, field, obj)
+
+		case "shiftToOffer":
+
+			out.Values[i] = ec._AssignedShift_shiftToOffer(ctx, field, obj)
+
+		case "shiftToSwap":
+
+			out.Values[i] = ec._AssignedShift_shiftToSwap(ctx, field, obj)
+
+		case "toSwapWith":
+
+			out.Values[i] = ec._AssignedShift_toSwapWith(ctx, field, obj)
+
+		case "userId":
+
+			out.Values[i] = ec._AssignedShift_userId(ctx, field, obj)
+
+		case "channelId":
+
+			out.Values[i] = ec._AssignedShift_channelId(ctx, field, obj)
+
+		case "shiftGroupId":
+
+			out.Values[i] = ec._AssignedShift_shiftGroupId(ctx, field, obj)
+
+		case "type":
+
+			out.Values[i] = ec._AssignedShift_type(ctx, field, obj)
+
+		case "isOpen":
+
+			out.Values[i] = ec._AssignedShift_isOpen(ctx, field, obj)
+
+		case "isShared":
+
+			out.Values[i] = ec._AssignedShift_isShared(ctx, field, obj)
+
+		case "ShiftActivities":
+
+			out.Values[i] = ec._AssignedShift_ShiftActivities(ctx, field, obj)
+
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var assignedShiftActivitiesImplementors = []string{"AssignedShiftActivities"}
+
+func (ec *executionContext) _AssignedShiftActivities(ctx context.Context, sel ast.SelectionSet, obj *model.AssignedShiftActivities) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, assignedShiftActivitiesImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AssignedShiftActivities")
+		case "id":
+
+			out.Values[i] = ec._AssignedShiftActivities_id(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "channelId":
+
+			out.Values[i] = ec._AssignedShiftActivities_channelId(ctx, field, obj)
+
+		case "shiftGroupId":
+
+			out.Values[i] = ec._AssignedShiftActivities_shiftGroupId(ctx, field, obj)
+
+		case "assignedShiftId":
+
+			out.Values[i] = ec._AssignedShiftActivities_assignedShiftId(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "userId":
+
+			out.Values[i] = ec._AssignedShiftActivities_userId(ctx, field, obj)
+
+		case "name":
+
+			out.Values[i] = ec._AssignedShiftActivities_name(ctx, field, obj)
+
+		case "code":
+
+			out.Values[i] = ec._AssignedShiftActivities_code(ctx, field, obj)
+
+		case "color":
+
+			out.Values[i] = ec._AssignedShiftActivities_color(ctx, field, obj)
+
+		case "startTime":
+
+			out.Values[i] = ec._AssignedShiftActivities_startTime(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "endTime":
+
+			out.Values[i] = ec._AssignedShiftActivities_endTime(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "isPaid":
+
+			out.Values[i] = ec._AssignedShiftActivities_isPaid(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var bulkRequestSwapResponseImplementors = []string{"BulkRequestSwapResponse"}
+
+func (ec *executionContext) _BulkRequestSwapResponse(ctx context.Context, sel ast.SelectionSet, obj *model.BulkRequestSwapResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, bulkRequestSwapResponseImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("BulkRequestSwapResponse")
+		case "errors":
+
+			out.Values[i] = ec._BulkRequestSwapResponse_errors(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "results":
+
+			out.Values[i] = ec._BulkRequestSwapResponse_results(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var mutationImplementors = []string{"Mutation"}
+
+func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, mutationImplementors)
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Mutation",
+	})
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
+			Object: field.Name,
+			Field:  field,
+		})
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Mutation")
+		case "createRequestSwap":
+
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_createRequestSwap(ctx, field)
+			})
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "updateRequestSwap":
+
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_updateRequestSwap(ctx, field)
+			})
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "deleteRequestSwap":
+
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_deleteRequestSwap(ctx, field)
+			})
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "cancelRequestSwap":
+
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_cancelRequestSwap(ctx, field)
+			})
+
+		case "approveRequestSwap":
+
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_approveRequestSwap(ctx, field)
+			})
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "denyRequestSwap":
+
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_denyRequestSwap(ctx, field)
+			})
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "acceptRequestSwap":
+
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_acceptRequestSwap(ctx, field)
+			})
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "declineRequestSwap":
+
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_declineRequestSwap(ctx, field)
+			})
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "approveRequestSwaps":
+
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_approveRequestSwaps(ctx, field)
+			})
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "denyRequestSwaps":
+
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_denyRequestSwaps(ctx, field)
+			})
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "addRequestComment":
+
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_addRequestComment(ctx, field)
+			})
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "setRequestExpirySettings":
+
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_setRequestExpirySettings(ctx, field)
+			})
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var pageInfoImplementors = []string{"PageInfo"}
+
+func (ec *executionContext) _PageInfo(ctx context.Context, sel ast.SelectionSet, obj *model.PageInfo) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, pageInfoImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PageInfo")
+		case "hasNextPage":
+
+			out.Values[i] = ec._PageInfo_hasNextPage(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "hasPreviousPage":
+
+			out.Values[i] = ec._PageInfo_hasPreviousPage(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "startCursor":
+
+			out.Values[i] = ec._PageInfo_startCursor(ctx, field, obj)
+
+		case "endCursor":
+
+			out.Values[i] = ec._PageInfo_endCursor(ctx, field, obj)
+
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var queryImplementors = []string{"Query"}
+
+func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, queryImplementors)
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Query",
+	})
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
+			Object: field.Name,
+			Field:  field,
+		})
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Query")
+		case "getRequestsSwaps":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getRequestsSwaps(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return rrm(innerCtx)
+			})
+		case "getRequestsSwapsByChannelIdRequestId":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getRequestsSwapsByChannelIdRequestId(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
 
-func (ec *executionContext) unmarshalInputRequestSwapInput(ctx context.Context, obj interface{}) (model.RequestSwapInput, error) {
-	var it model.RequestSwapInput
-	asMap := map[string]interface{}{}
-	for k, v := range obj.(map[string]interface{}) {
-		asMap[k] = v
-	}
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
+			}
 
-	fieldsInOrder := [...]string{"channelId", "userId", "assignedUserShiftId", "assignedUserShiftIdToSwap", "requestNote", "responseNote", "responseByUserId", "responseAt"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "channelId":
-			var err error
+			out.Concurrently(i, func() graphql.Marshaler {
+				return rrm(innerCtx)
+			})
+		case "getRequestSwap":
+			field := field
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("channelId"))
-			it.ChannelID, err = ec.unmarshalNID2string(ctx, v)
-			if err != nil {
-				return it, err
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getRequestSwap(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
 			}
-		case "userId":
-			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("userId"))
-			it.UserID, err = ec.unmarshalNID2string(ctx, v)
-			if err != nil {
-				return it, err
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
 			}
-		case "assignedUserShiftId":
-			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("assignedUserShiftId"))
-			it.AssignedUserShiftID, err = ec.unmarshalNID2string(ctx, v)
-			if err != nil {
-				return it, err
+			out.Concurrently(i, func() graphql.Marshaler {
+				return rrm(innerCtx)
+			})
+		case "getRequestSwapsConnection":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getRequestSwapsConnection(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
 			}
-		case "assignedUserShiftIdToSwap":
-			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("assignedUserShiftIdToSwap"))
-			it.AssignedUserShiftIDToSwap, err = ec.unmarshalOID2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
 			}
-		case "requestNote":
-			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("requestNote"))
-			it.RequestNote, err = ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
+			out.Concurrently(i, func() graphql.Marshaler {
+				return rrm(innerCtx)
+			})
+		case "getRequestExpirySettings":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getRequestExpirySettings(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
 			}
-		case "responseNote":
-			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("responseNote"))
-			it.ResponseNote, err = ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
 			}
-		case "responseByUserId":
-			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("responseByUserId"))
-			it.ResponseByUserID, err = ec.unmarshalOID2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
+			out.Concurrently(i, func() graphql.Marshaler {
+				return rrm(innerCtx)
+			})
+		case "suggestSwapCandidates":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_suggestSwapCandidates(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
 			}
-		case "responseAt":
-			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("responseAt"))
-			it.ResponseAt, err = ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
-			if err != nil {
-				return it, err
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
 			}
-		}
-	}
 
-	return it, nil
-}
+			out.Concurrently(i, func() graphql.Marshaler {
+				return rrm(innerCtx)
+			})
+		case "__type":
 
-// endregion **************************** input.gotpl *****************************
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Query___type(ctx, field)
+			})
 
-// region    ************************** interface.gotpl ***************************
+		case "__schema":
 
-// endregion ************************** interface.gotpl ***************************
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Query___schema(ctx, field)
+			})
 
-// region    **************************** object.gotpl ****************************
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
 
-var assignedShiftImplementors = []string{"AssignedShift"}
+var requestCommentImplementors = []string{"RequestComment"}
 
-func (ec *executionContext) _AssignedShift(ctx context.Context, sel ast.SelectionSet, obj *model.AssignedShift) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, assignedShiftImplementors)
+func (ec *executionContext) _RequestComment(ctx context.Context, sel ast.SelectionSet, obj *model.RequestComment) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, requestCommentImplementors)
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("AssignedShift")
+			out.Values[i] = graphql.MarshalString("RequestComment")
 		case "id":
 
-			out.Values[i] = ec._AssignedShift_id(ctx, field, obj)
+			out.Values[i] = ec._RequestComment_id(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
-				invalids++
+				atomic.AddUint32(&invalids, 1)
 			}
-		case "break":
+		case "userId":
 
-			out.Values[i] = ec._AssignedShift_break(ctx, field, obj)
+			out.Values[i] = ec._RequestComment_userId(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
-				invalids++
+				atomic.AddUint32(&invalids, 1)
 			}
-		case "color":
-
-			out.Values[i] = ec._AssignedShift_color(ctx, field, obj)
+		case "user":
+			field := field
 
-			if out.Values[i] == graphql.Null {
-				invalids++
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._RequestComment_user(ctx, field, obj)
+				return res
 			}
-		case "startTime":
 
-			out.Values[i] = ec._AssignedShift_startTime(ctx, field, obj)
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
 
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "endTime":
+			})
+		case "body":
 
-			out.Values[i] = ec._AssignedShift_endTime(ctx, field, obj)
+			out.Values[i] = ec._RequestComment_body(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
-				invalids++
+				atomic.AddUint32(&invalids, 1)
 			}
-		case "is24Hours":
+		case "createdAt":
 
-			out.Values[i] = ec._AssignedShift_is24Hours(ctx, field, obj)
+			out.Values[i] = ec._RequestComment_createdAt(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
-				invalids++
+				atomic.AddUint32(&invalids, 1)
 			}
-		case "label":
-
-			out.Values[i] = ec._AssignedShift_label(ctx, field, obj)
-
-		case "note":
-
-			out.Values[i] = ec._AssignedShift_note(ctx, field, obj)
-
-		case "shiftToOffer":
-
-			out.Values[i] = ec._AssignedShift_shiftToOffer(ctx, field, obj)
-
-		case "shiftToSwap":
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
 
-			out.Values[i] = ec._AssignedShift_shiftToSwap(ctx, field, obj)
+var requestCommentResponseImplementors = []string{"RequestCommentResponse"}
 
-		case "toSwapWith":
+func (ec *executionContext) _RequestCommentResponse(ctx context.Context, sel ast.SelectionSet, obj *model.RequestCommentResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, requestCommentResponseImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RequestCommentResponse")
+		case "errors":
 
-			out.Values[i] = ec._AssignedShift_toSwapWith(ctx, field, obj)
+			out.Values[i] = ec._RequestCommentResponse_errors(ctx, field, obj)
 
-		case "userId":
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "comment":
 
-			out.Values[i] = ec._AssignedShift_userId(ctx, field, obj)
+			out.Values[i] = ec._RequestCommentResponse_comment(ctx, field, obj)
 
-		case "channelId":
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
 
-			out.Values[i] = ec._AssignedShift_channelId(ctx, field, obj)
+var requestExpirySettingsImplementors = []string{"RequestExpirySettings"}
 
-		case "shiftGroupId":
+func (ec *executionContext) _RequestExpirySettings(ctx context.Context, sel ast.SelectionSet, obj *model.RequestExpirySettings) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, requestExpirySettingsImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RequestExpirySettings")
+		case "channelId":
 
-			out.Values[i] = ec._AssignedShift_shiftGroupId(ctx, field, obj)
+			out.Values[i] = ec._RequestExpirySettings_channelId(ctx, field, obj)
 
-		case "type":
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "enabled":
 
-			out.Values[i] = ec._AssignedShift_type(ctx, field, obj)
+			out.Values[i] = ec._RequestExpirySettings_enabled(ctx, field, obj)
 
-		case "isOpen":
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "gracePeriodMinutes":
 
-			out.Values[i] = ec._AssignedShift_isOpen(ctx, field, obj)
+			out.Values[i] = ec._RequestExpirySettings_gracePeriodMinutes(ctx, field, obj)
 
-		case "isShared":
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "updatedByUserId":
 
-			out.Values[i] = ec._AssignedShift_isShared(ctx, field, obj)
+			out.Values[i] = ec._RequestExpirySettings_updatedByUserId(ctx, field, obj)
 
-		case "ShiftActivities":
+		case "updatedAt":
 
-			out.Values[i] = ec._AssignedShift_ShiftActivities(ctx, field, obj)
+			out.Values[i] = ec._RequestExpirySettings_updatedAt(ctx, field, obj)
 
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
@@ -7416,74 +12169,101 @@ func (ec *executionContext) _AssignedShift(ctx context.Context, sel ast.Selectio
 	return out
 }
 
-var assignedShiftActivitiesImplementors = []string{"AssignedShiftActivities"}
+var requestExpirySettingsResponseImplementors = []string{"RequestExpirySettingsResponse"}
 
-func (ec *executionContext) _AssignedShiftActivities(ctx context.Context, sel ast.SelectionSet, obj *model.AssignedShiftActivities) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, assignedShiftActivitiesImplementors)
+func (ec *executionContext) _RequestExpirySettingsResponse(ctx context.Context, sel ast.SelectionSet, obj *model.RequestExpirySettingsResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, requestExpirySettingsResponseImplementors)
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("AssignedShiftActivities")
-		case "id":
+			out.Values[i] = graphql.MarshalString("RequestExpirySettingsResponse")
+		case "errors":
 
-			out.Values[i] = ec._AssignedShiftActivities_id(ctx, field, obj)
+			out.Values[i] = ec._RequestExpirySettingsResponse_errors(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "channelId":
+		case "settings":
 
-			out.Values[i] = ec._AssignedShiftActivities_channelId(ctx, field, obj)
+			out.Values[i] = ec._RequestExpirySettingsResponse_settings(ctx, field, obj)
 
-		case "shiftGroupId":
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
 
-			out.Values[i] = ec._AssignedShiftActivities_shiftGroupId(ctx, field, obj)
+var requestHistoryEntryImplementors = []string{"RequestHistoryEntry"}
 
-		case "assignedShiftId":
+func (ec *executionContext) _RequestHistoryEntry(ctx context.Context, sel ast.SelectionSet, obj *model.RequestHistoryEntry) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, requestHistoryEntryImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RequestHistoryEntry")
+		case "id":
 
-			out.Values[i] = ec._AssignedShiftActivities_assignedShiftId(ctx, field, obj)
+			out.Values[i] = ec._RequestHistoryEntry_id(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
-				invalids++
+				atomic.AddUint32(&invalids, 1)
 			}
-		case "userId":
+		case "action":
 
-			out.Values[i] = ec._AssignedShiftActivities_userId(ctx, field, obj)
+			out.Values[i] = ec._RequestHistoryEntry_action(ctx, field, obj)
 
-		case "name":
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "field":
 
-			out.Values[i] = ec._AssignedShiftActivities_name(ctx, field, obj)
+			out.Values[i] = ec._RequestHistoryEntry_field(ctx, field, obj)
 
-		case "code":
+		case "oldValue":
 
-			out.Values[i] = ec._AssignedShiftActivities_code(ctx, field, obj)
+			out.Values[i] = ec._RequestHistoryEntry_oldValue(ctx, field, obj)
 
-		case "color":
+		case "newValue":
 
-			out.Values[i] = ec._AssignedShiftActivities_color(ctx, field, obj)
+			out.Values[i] = ec._RequestHistoryEntry_newValue(ctx, field, obj)
 
-		case "startTime":
+		case "actorUserId":
 
-			out.Values[i] = ec._AssignedShiftActivities_startTime(ctx, field, obj)
+			out.Values[i] = ec._RequestHistoryEntry_actorUserId(ctx, field, obj)
 
-			if out.Values[i] == graphql.Null {
-				invalids++
+		case "actor":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._RequestHistoryEntry_actor(ctx, field, obj)
+				return res
 			}
-		case "endTime":
 
-			out.Values[i] = ec._AssignedShiftActivities_endTime(ctx, field, obj)
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
 
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "isPaid":
+			})
+		case "createdAt":
 
-			out.Values[i] = ec._AssignedShiftActivities_isPaid(ctx, field, obj)
+			out.Values[i] = ec._RequestHistoryEntry_createdAt(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
-				invalids++
+				atomic.AddUint32(&invalids, 1)
 			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
@@ -7496,107 +12276,142 @@ func (ec *executionContext) _AssignedShiftActivities(ctx context.Context, sel as
 	return out
 }
 
-var mutationImplementors = []string{"Mutation"}
-
-func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, mutationImplementors)
-	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
-		Object: "Mutation",
-	})
+var requestResponseImplementors = []string{"RequestResponse"}
 
+func (ec *executionContext) _RequestResponse(ctx context.Context, sel ast.SelectionSet, obj *model.RequestResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, requestResponseImplementors)
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
-		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
-			Object: field.Name,
-			Field:  field,
-		})
-
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Mutation")
-		case "createRequestSwap":
+			out.Values[i] = graphql.MarshalString("RequestResponse")
+		case "channelId":
 
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_createRequestSwap(ctx, field)
-			})
+			out.Values[i] = ec._RequestResponse_channelId(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
-				invalids++
+				atomic.AddUint32(&invalids, 1)
 			}
-		case "updateRequestSwap":
+		case "createdAt":
 
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_updateRequestSwap(ctx, field)
-			})
+			out.Values[i] = ec._RequestResponse_createdAt(ctx, field, obj)
+
+		case "endTime":
+
+			out.Values[i] = ec._RequestResponse_endTime(ctx, field, obj)
+
+		case "id":
+
+			out.Values[i] = ec._RequestResponse_id(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
-				invalids++
+				atomic.AddUint32(&invalids, 1)
 			}
-		case "deleteRequestSwap":
+		case "isAllDay":
 
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_deleteRequestSwap(ctx, field)
-			})
+			out.Values[i] = ec._RequestResponse_isAllDay(ctx, field, obj)
+
+		case "reason":
+
+			out.Values[i] = ec._RequestResponse_reason(ctx, field, obj)
+
+		case "requestId":
+
+			out.Values[i] = ec._RequestResponse_requestId(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
-				invalids++
+				atomic.AddUint32(&invalids, 1)
 			}
-		case "cancelRequestSwap":
+		case "requestNote":
+
+			out.Values[i] = ec._RequestResponse_requestNote(ctx, field, obj)
+
+		case "responseAt":
+
+			out.Values[i] = ec._RequestResponse_responseAt(ctx, field, obj)
+
+		case "responseBy":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._RequestResponse_responseBy(ctx, field, obj)
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
 
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_cancelRequestSwap(ctx, field)
 			})
+		case "responseNote":
 
-		case "approveRequestSwap":
+			out.Values[i] = ec._RequestResponse_responseNote(ctx, field, obj)
+
+		case "shiftOfferedTo":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._RequestResponse_shiftOfferedTo(ctx, field, obj)
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
 
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_approveRequestSwap(ctx, field)
 			})
+		case "shiftToOffer":
+			field := field
 
-			if out.Values[i] == graphql.Null {
-				invalids++
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._RequestResponse_shiftToOffer(ctx, field, obj)
+				return res
 			}
-		case "denyRequestSwap":
 
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_denyRequestSwap(ctx, field)
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
 			})
+		case "shiftToSwap":
+			field := field
 
-			if out.Values[i] == graphql.Null {
-				invalids++
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._RequestResponse_shiftToSwap(ctx, field, obj)
+				return res
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
-	}
-	return out
-}
 
-var queryImplementors = []string{"Query"}
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
 
-func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, queryImplementors)
-	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
-		Object: "Query",
-	})
+			})
+		case "startTime":
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
-			Object: field.Name,
-			Field:  field,
-		})
+			out.Values[i] = ec._RequestResponse_startTime(ctx, field, obj)
 
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Query")
-		case "getRequestsSwaps":
+		case "status":
+
+			out.Values[i] = ec._RequestResponse_status(ctx, field, obj)
+
+		case "toSwapWith":
 			field := field
 
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
@@ -7605,21 +12420,26 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_getRequestsSwaps(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
+				res = ec._RequestResponse_toSwapWith(ctx, field, obj)
 				return res
 			}
 
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
-			}
-
 			out.Concurrently(i, func() graphql.Marshaler {
-				return rrm(innerCtx)
+				return innerFunc(ctx)
+
 			})
-		case "getRequestsSwapsByChannelIdRequestId":
+		case "type":
+
+			out.Values[i] = ec._RequestResponse_type(ctx, field, obj)
+
+		case "user":
+
+			out.Values[i] = ec._RequestResponse_user(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "history":
 			field := field
 
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
@@ -7628,21 +12448,18 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_getRequestsSwapsByChannelIdRequestId(ctx, field)
+				res = ec._RequestResponse_history(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
 				return res
 			}
 
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
-			}
-
 			out.Concurrently(i, func() graphql.Marshaler {
-				return rrm(innerCtx)
+				return innerFunc(ctx)
+
 			})
-		case "getRequestSwap":
+		case "comments":
 			field := field
 
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
@@ -7651,32 +12468,17 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_getRequestSwap(ctx, field)
+				res = ec._RequestResponse_comments(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
 				return res
 			}
 
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
-			}
-
 			out.Concurrently(i, func() graphql.Marshaler {
-				return rrm(innerCtx)
-			})
-		case "__type":
-
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Query___type(ctx, field)
-			})
-
-		case "__schema":
+				return innerFunc(ctx)
 
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Query___schema(ctx, field)
 			})
-
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -7688,104 +12490,131 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 	return out
 }
 
-var requestResponseImplementors = []string{"RequestResponse"}
+var requestSwapImplementors = []string{"RequestSwap"}
 
-func (ec *executionContext) _RequestResponse(ctx context.Context, sel ast.SelectionSet, obj *model.RequestResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, requestResponseImplementors)
+func (ec *executionContext) _RequestSwap(ctx context.Context, sel ast.SelectionSet, obj *model.RequestSwap) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, requestSwapImplementors)
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("RequestResponse")
-		case "channelId":
+			out.Values[i] = graphql.MarshalString("RequestSwap")
+		case "id":
 
-			out.Values[i] = ec._RequestResponse_channelId(ctx, field, obj)
+			out.Values[i] = ec._RequestSwap_id(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
-				invalids++
+				atomic.AddUint32(&invalids, 1)
 			}
-		case "createdAt":
+		case "channelId":
 
-			out.Values[i] = ec._RequestResponse_createdAt(ctx, field, obj)
+			out.Values[i] = ec._RequestSwap_channelId(ctx, field, obj)
 
-		case "endTime":
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "requestId":
 
-			out.Values[i] = ec._RequestResponse_endTime(ctx, field, obj)
+			out.Values[i] = ec._RequestSwap_requestId(ctx, field, obj)
 
-		case "id":
+		case "userId":
 
-			out.Values[i] = ec._RequestResponse_id(ctx, field, obj)
+			out.Values[i] = ec._RequestSwap_userId(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
-				invalids++
+				atomic.AddUint32(&invalids, 1)
 			}
-		case "isAllDay":
+		case "assignedUserShiftId":
 
-			out.Values[i] = ec._RequestResponse_isAllDay(ctx, field, obj)
+			out.Values[i] = ec._RequestSwap_assignedUserShiftId(ctx, field, obj)
 
-		case "reason":
+		case "assignedUserShiftIdToSwap":
 
-			out.Values[i] = ec._RequestResponse_reason(ctx, field, obj)
+			out.Values[i] = ec._RequestSwap_assignedUserShiftIdToSwap(ctx, field, obj)
 
-		case "requestId":
+		case "peerUserId":
 
-			out.Values[i] = ec._RequestResponse_requestId(ctx, field, obj)
+			out.Values[i] = ec._RequestSwap_peerUserId(ctx, field, obj)
 
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
 		case "requestNote":
 
-			out.Values[i] = ec._RequestResponse_requestNote(ctx, field, obj)
-
-		case "responseAt":
-
-			out.Values[i] = ec._RequestResponse_responseAt(ctx, field, obj)
+			out.Values[i] = ec._RequestSwap_requestNote(ctx, field, obj)
 
-		case "responseBy":
+		case "status":
 
-			out.Values[i] = ec._RequestResponse_responseBy(ctx, field, obj)
+			out.Values[i] = ec._RequestSwap_status(ctx, field, obj)
 
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
 		case "responseNote":
 
-			out.Values[i] = ec._RequestResponse_responseNote(ctx, field, obj)
+			out.Values[i] = ec._RequestSwap_responseNote(ctx, field, obj)
 
-		case "shiftOfferedTo":
+		case "responseByUserId":
 
-			out.Values[i] = ec._RequestResponse_shiftOfferedTo(ctx, field, obj)
+			out.Values[i] = ec._RequestSwap_responseByUserId(ctx, field, obj)
 
-		case "shiftToOffer":
+		case "responseAt":
 
-			out.Values[i] = ec._RequestResponse_shiftToOffer(ctx, field, obj)
+			out.Values[i] = ec._RequestSwap_responseAt(ctx, field, obj)
 
-		case "shiftToSwap":
+		case "createdAt":
 
-			out.Values[i] = ec._RequestResponse_shiftToSwap(ctx, field, obj)
+			out.Values[i] = ec._RequestSwap_createdAt(ctx, field, obj)
 
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
 		case "startTime":
 
-			out.Values[i] = ec._RequestResponse_startTime(ctx, field, obj)
+			out.Values[i] = ec._RequestSwap_startTime(ctx, field, obj)
 
-		case "status":
+		case "endTime":
 
-			out.Values[i] = ec._RequestResponse_status(ctx, field, obj)
+			out.Values[i] = ec._RequestSwap_endTime(ctx, field, obj)
 
-		case "toSwapWith":
+		case "history":
+			field := field
 
-			out.Values[i] = ec._RequestResponse_toSwapWith(ctx, field, obj)
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._RequestSwap_history(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
 
-		case "type":
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
 
-			out.Values[i] = ec._RequestResponse_type(ctx, field, obj)
+			})
+		case "comments":
+			field := field
 
-		case "user":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._RequestSwap_comments(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
 
-			out.Values[i] = ec._RequestResponse_user(ctx, field, obj)
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
 
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
+			})
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -7797,79 +12626,136 @@ func (ec *executionContext) _RequestResponse(ctx context.Context, sel ast.Select
 	return out
 }
 
-var requestSwapImplementors = []string{"RequestSwap"}
+var requestSwapConnectionImplementors = []string{"RequestSwapConnection"}
 
-func (ec *executionContext) _RequestSwap(ctx context.Context, sel ast.SelectionSet, obj *model.RequestSwap) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, requestSwapImplementors)
+func (ec *executionContext) _RequestSwapConnection(ctx context.Context, sel ast.SelectionSet, obj *model.RequestSwapConnection) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, requestSwapConnectionImplementors)
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("RequestSwap")
-		case "id":
+			out.Values[i] = graphql.MarshalString("RequestSwapConnection")
+		case "edges":
 
-			out.Values[i] = ec._RequestSwap_id(ctx, field, obj)
+			out.Values[i] = ec._RequestSwapConnection_edges(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "channelId":
+		case "pageInfo":
 
-			out.Values[i] = ec._RequestSwap_channelId(ctx, field, obj)
+			out.Values[i] = ec._RequestSwapConnection_pageInfo(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "requestId":
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
 
-			out.Values[i] = ec._RequestSwap_requestId(ctx, field, obj)
+var requestSwapEdgeImplementors = []string{"RequestSwapEdge"}
 
-		case "userId":
+func (ec *executionContext) _RequestSwapEdge(ctx context.Context, sel ast.SelectionSet, obj *model.RequestSwapEdge) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, requestSwapEdgeImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RequestSwapEdge")
+		case "cursor":
 
-			out.Values[i] = ec._RequestSwap_userId(ctx, field, obj)
+			out.Values[i] = ec._RequestSwapEdge_cursor(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "assignedUserShiftId":
-
-			out.Values[i] = ec._RequestSwap_assignedUserShiftId(ctx, field, obj)
-
-		case "assignedUserShiftIdToSwap":
+		case "node":
 
-			out.Values[i] = ec._RequestSwap_assignedUserShiftIdToSwap(ctx, field, obj)
+			out.Values[i] = ec._RequestSwapEdge_node(ctx, field, obj)
 
-		case "requestNote":
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
 
-			out.Values[i] = ec._RequestSwap_requestNote(ctx, field, obj)
+var requestSwapResponseImplementors = []string{"RequestSwapResponse"}
 
-		case "status":
+func (ec *executionContext) _RequestSwapResponse(ctx context.Context, sel ast.SelectionSet, obj *model.RequestSwapResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, requestSwapResponseImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RequestSwapResponse")
+		case "errors":
 
-			out.Values[i] = ec._RequestSwap_status(ctx, field, obj)
+			out.Values[i] = ec._RequestSwapResponse_errors(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "responseNote":
+		case "request":
 
-			out.Values[i] = ec._RequestSwap_responseNote(ctx, field, obj)
+			out.Values[i] = ec._RequestSwapResponse_request(ctx, field, obj)
 
-		case "responseByUserId":
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
 
-			out.Values[i] = ec._RequestSwap_responseByUserId(ctx, field, obj)
+var requestSwapResultImplementors = []string{"RequestSwapResult"}
 
-		case "responseAt":
+func (ec *executionContext) _RequestSwapResult(ctx context.Context, sel ast.SelectionSet, obj *model.RequestSwapResult) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, requestSwapResultImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RequestSwapResult")
+		case "id":
 
-			out.Values[i] = ec._RequestSwap_responseAt(ctx, field, obj)
+			out.Values[i] = ec._RequestSwapResult_id(ctx, field, obj)
 
-		case "createdAt":
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "errors":
 
-			out.Values[i] = ec._RequestSwap_createdAt(ctx, field, obj)
+			out.Values[i] = ec._RequestSwapResult_errors(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
+		case "request":
+
+			out.Values[i] = ec._RequestSwapResult_request(ctx, field, obj)
+
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -7881,26 +12767,30 @@ func (ec *executionContext) _RequestSwap(ctx context.Context, sel ast.SelectionS
 	return out
 }
 
-var requestSwapResponseImplementors = []string{"RequestSwapResponse"}
+var shiftErrorImplementors = []string{"ShiftError"}
 
-func (ec *executionContext) _RequestSwapResponse(ctx context.Context, sel ast.SelectionSet, obj *model.RequestSwapResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, requestSwapResponseImplementors)
+func (ec *executionContext) _ShiftError(ctx context.Context, sel ast.SelectionSet, obj *model.ShiftError) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, shiftErrorImplementors)
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("RequestSwapResponse")
-		case "errors":
+			out.Values[i] = graphql.MarshalString("ShiftError")
+		case "code":
 
-			out.Values[i] = ec._RequestSwapResponse_errors(ctx, field, obj)
+			out.Values[i] = ec._ShiftError_code(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "request":
+		case "field":
+
+			out.Values[i] = ec._ShiftError_field(ctx, field, obj)
 
-			out.Values[i] = ec._RequestSwapResponse_request(ctx, field, obj)
+		case "message":
+
+			out.Values[i] = ec._ShiftError_message(ctx, field, obj)
 
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
@@ -7913,31 +12803,54 @@ func (ec *executionContext) _RequestSwapResponse(ctx context.Context, sel ast.Se
 	return out
 }
 
-var shiftErrorImplementors = []string{"ShiftError"}
+var swapCandidateImplementors = []string{"SwapCandidate"}
 
-func (ec *executionContext) _ShiftError(ctx context.Context, sel ast.SelectionSet, obj *model.ShiftError) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, shiftErrorImplementors)
+func (ec *executionContext) _SwapCandidate(ctx context.Context, sel ast.SelectionSet, obj *model.SwapCandidate) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, swapCandidateImplementors)
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("ShiftError")
-		case "code":
+			out.Values[i] = graphql.MarshalString("SwapCandidate")
+		case "userId":
 
-			out.Values[i] = ec._ShiftError_code(ctx, field, obj)
+			out.Values[i] = ec._SwapCandidate_userId(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
-				invalids++
+				atomic.AddUint32(&invalids, 1)
 			}
-		case "field":
+		case "user":
+			field := field
 
-			out.Values[i] = ec._ShiftError_field(ctx, field, obj)
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._SwapCandidate_user(ctx, field, obj)
+				return res
+			}
 
-		case "message":
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
 
-			out.Values[i] = ec._ShiftError_message(ctx, field, obj)
+			})
+		case "shift":
+
+			out.Values[i] = ec._SwapCandidate_shift(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "startTimeDifference":
+
+			out.Values[i] = ec._SwapCandidate_startTimeDifference(ctx, field, obj)
 
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -8316,6 +13229,16 @@ func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, o
 
 // region    ***************************** type.gotpl *****************************
 
+func (ec *executionContext) marshalNAssignedShift2ᚖrequest_swapsᚋgraphᚋmodelᚐAssignedShift(ctx context.Context, sel ast.SelectionSet, v *model.AssignedShift) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._AssignedShift(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalNBoolean2bool(ctx context.Context, v interface{}) (bool, error) {
 	res, err := graphql.UnmarshalBoolean(v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -8331,6 +13254,20 @@ func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.Se
 	return res
 }
 
+func (ec *executionContext) marshalNBulkRequestSwapResponse2request_swapsᚋgraphᚋmodelᚐBulkRequestSwapResponse(ctx context.Context, sel ast.SelectionSet, v model.BulkRequestSwapResponse) graphql.Marshaler {
+	return ec._BulkRequestSwapResponse(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNBulkRequestSwapResponse2ᚖrequest_swapsᚋgraphᚋmodelᚐBulkRequestSwapResponse(ctx context.Context, sel ast.SelectionSet, v *model.BulkRequestSwapResponse) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._BulkRequestSwapResponse(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalNID2string(ctx context.Context, v interface{}) (string, error) {
 	res, err := graphql.UnmarshalID(v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -8346,6 +13283,248 @@ func (ec *executionContext) marshalNID2string(ctx context.Context, sel ast.Selec
 	return res
 }
 
+func (ec *executionContext) unmarshalNID2ᚕstringᚄ(ctx context.Context, v interface{}) ([]string, error) {
+	var vSlice []interface{}
+	if v != nil {
+		vSlice = graphql.CoerceList(v)
+	}
+	var err error
+	res := make([]string, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNID2string(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalNID2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNID2string(ctx, sel, v[i])
+	}
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) unmarshalNInt2int(ctx context.Context, v interface{}) (int, error) {
+	res, err := graphql.UnmarshalInt(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNInt2int(ctx context.Context, sel ast.SelectionSet, v int) graphql.Marshaler {
+	res := graphql.MarshalInt(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
+
+func (ec *executionContext) unmarshalNOrderDirection2request_swapsᚋgraphᚋmodelᚐOrderDirection(ctx context.Context, v interface{}) (model.OrderDirection, error) {
+	var res model.OrderDirection
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNOrderDirection2request_swapsᚋgraphᚋmodelᚐOrderDirection(ctx context.Context, sel ast.SelectionSet, v model.OrderDirection) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNPageInfo2ᚖrequest_swapsᚋgraphᚋmodelᚐPageInfo(ctx context.Context, sel ast.SelectionSet, v *model.PageInfo) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._PageInfo(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNRequestComment2ᚕᚖrequest_swapsᚋgraphᚋmodelᚐRequestCommentᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.RequestComment) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNRequestComment2ᚖrequest_swapsᚋgraphᚋmodelᚐRequestComment(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNRequestComment2ᚖrequest_swapsᚋgraphᚋmodelᚐRequestComment(ctx context.Context, sel ast.SelectionSet, v *model.RequestComment) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._RequestComment(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNRequestCommentResponse2request_swapsᚋgraphᚋmodelᚐRequestCommentResponse(ctx context.Context, sel ast.SelectionSet, v model.RequestCommentResponse) graphql.Marshaler {
+	return ec._RequestCommentResponse(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNRequestCommentResponse2ᚖrequest_swapsᚋgraphᚋmodelᚐRequestCommentResponse(ctx context.Context, sel ast.SelectionSet, v *model.RequestCommentResponse) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._RequestCommentResponse(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNRequestExpirySettings2request_swapsᚋgraphᚋmodelᚐRequestExpirySettings(ctx context.Context, sel ast.SelectionSet, v model.RequestExpirySettings) graphql.Marshaler {
+	return ec._RequestExpirySettings(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNRequestExpirySettings2ᚖrequest_swapsᚋgraphᚋmodelᚐRequestExpirySettings(ctx context.Context, sel ast.SelectionSet, v *model.RequestExpirySettings) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._RequestExpirySettings(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNRequestExpirySettingsInput2request_swapsᚋgraphᚋmodelᚐRequestExpirySettingsInput(ctx context.Context, v interface{}) (model.RequestExpirySettingsInput, error) {
+	res, err := ec.unmarshalInputRequestExpirySettingsInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNRequestExpirySettingsResponse2request_swapsᚋgraphᚋmodelᚐRequestExpirySettingsResponse(ctx context.Context, sel ast.SelectionSet, v model.RequestExpirySettingsResponse) graphql.Marshaler {
+	return ec._RequestExpirySettingsResponse(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNRequestExpirySettingsResponse2ᚖrequest_swapsᚋgraphᚋmodelᚐRequestExpirySettingsResponse(ctx context.Context, sel ast.SelectionSet, v *model.RequestExpirySettingsResponse) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._RequestExpirySettingsResponse(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNRequestHistoryAction2request_swapsᚋgraphᚋmodelᚐRequestHistoryAction(ctx context.Context, v interface{}) (model.RequestHistoryAction, error) {
+	var res model.RequestHistoryAction
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNRequestHistoryAction2request_swapsᚋgraphᚋmodelᚐRequestHistoryAction(ctx context.Context, sel ast.SelectionSet, v model.RequestHistoryAction) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNRequestHistoryEntry2ᚕᚖrequest_swapsᚋgraphᚋmodelᚐRequestHistoryEntryᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.RequestHistoryEntry) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNRequestHistoryEntry2ᚖrequest_swapsᚋgraphᚋmodelᚐRequestHistoryEntry(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNRequestHistoryEntry2ᚖrequest_swapsᚋgraphᚋmodelᚐRequestHistoryEntry(ctx context.Context, sel ast.SelectionSet, v *model.RequestHistoryEntry) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._RequestHistoryEntry(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNRequestOrderField2request_swapsᚋgraphᚋmodelᚐRequestOrderField(ctx context.Context, v interface{}) (model.RequestOrderField, error) {
+	var res model.RequestOrderField
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNRequestOrderField2request_swapsᚋgraphᚋmodelᚐRequestOrderField(ctx context.Context, sel ast.SelectionSet, v model.RequestOrderField) graphql.Marshaler {
+	return v
+}
+
 func (ec *executionContext) unmarshalNRequestStatus2request_swapsᚋgraphᚋmodelᚐRequestStatus(ctx context.Context, v interface{}) (model.RequestStatus, error) {
 	var res model.RequestStatus
 	err := res.UnmarshalGQL(v)
@@ -8408,6 +13587,74 @@ func (ec *executionContext) marshalNRequestSwap2ᚖrequest_swapsᚋgraphᚋmodel
 	return ec._RequestSwap(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNRequestSwapConnection2request_swapsᚋgraphᚋmodelᚐRequestSwapConnection(ctx context.Context, sel ast.SelectionSet, v model.RequestSwapConnection) graphql.Marshaler {
+	return ec._RequestSwapConnection(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNRequestSwapConnection2ᚖrequest_swapsᚋgraphᚋmodelᚐRequestSwapConnection(ctx context.Context, sel ast.SelectionSet, v *model.RequestSwapConnection) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._RequestSwapConnection(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNRequestSwapEdge2ᚕᚖrequest_swapsᚋgraphᚋmodelᚐRequestSwapEdgeᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.RequestSwapEdge) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNRequestSwapEdge2ᚖrequest_swapsᚋgraphᚋmodelᚐRequestSwapEdge(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNRequestSwapEdge2ᚖrequest_swapsᚋgraphᚋmodelᚐRequestSwapEdge(ctx context.Context, sel ast.SelectionSet, v *model.RequestSwapEdge) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._RequestSwapEdge(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalNRequestSwapInput2request_swapsᚋgraphᚋmodelᚐRequestSwapInput(ctx context.Context, v interface{}) (model.RequestSwapInput, error) {
 	res, err := ec.unmarshalInputRequestSwapInput(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -8427,6 +13674,60 @@ func (ec *executionContext) marshalNRequestSwapResponse2ᚖrequest_swapsᚋgraph
 	return ec._RequestSwapResponse(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNRequestSwapResult2ᚕᚖrequest_swapsᚋgraphᚋmodelᚐRequestSwapResultᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.RequestSwapResult) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNRequestSwapResult2ᚖrequest_swapsᚋgraphᚋmodelᚐRequestSwapResult(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNRequestSwapResult2ᚖrequest_swapsᚋgraphᚋmodelᚐRequestSwapResult(ctx context.Context, sel ast.SelectionSet, v *model.RequestSwapResult) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._RequestSwapResult(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalNShiftError2ᚕᚖrequest_swapsᚋgraphᚋmodelᚐShiftErrorᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.ShiftError) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
@@ -8506,6 +13807,60 @@ func (ec *executionContext) marshalNString2string(ctx context.Context, sel ast.S
 	return res
 }
 
+func (ec *executionContext) marshalNSwapCandidate2ᚕᚖrequest_swapsᚋgraphᚋmodelᚐSwapCandidateᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.SwapCandidate) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNSwapCandidate2ᚖrequest_swapsᚋgraphᚋmodelᚐSwapCandidate(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNSwapCandidate2ᚖrequest_swapsᚋgraphᚋmodelᚐSwapCandidate(ctx context.Context, sel ast.SelectionSet, v *model.SwapCandidate) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._SwapCandidate(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalNTime2timeᚐTime(ctx context.Context, v interface{}) (time.Time, error) {
 	res, err := graphql.UnmarshalTime(v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -8881,6 +14236,52 @@ func (ec *executionContext) marshalOID2ᚖstring(ctx context.Context, sel ast.Se
 	return res
 }
 
+func (ec *executionContext) unmarshalOInt2ᚖint(ctx context.Context, v interface{}) (*int, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := graphql.UnmarshalInt(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOInt2ᚖint(ctx context.Context, sel ast.SelectionSet, v *int) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	res := graphql.MarshalInt(*v)
+	return res
+}
+
+func (ec *executionContext) marshalORequestComment2ᚖrequest_swapsᚋgraphᚋmodelᚐRequestComment(ctx context.Context, sel ast.SelectionSet, v *model.RequestComment) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._RequestComment(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalORequestExpirySettings2ᚖrequest_swapsᚋgraphᚋmodelᚐRequestExpirySettings(ctx context.Context, sel ast.SelectionSet, v *model.RequestExpirySettings) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._RequestExpirySettings(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalORequestFilter2ᚖrequest_swapsᚋgraphᚋmodelᚐRequestFilter(ctx context.Context, v interface{}) (*model.RequestFilter, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputRequestFilter(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalORequestOrder2ᚖrequest_swapsᚋgraphᚋmodelᚐRequestOrder(ctx context.Context, v interface{}) (*model.RequestOrder, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputRequestOrder(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) marshalORequestResponse2ᚖrequest_swapsᚋgraphᚋmodelᚐRequestResponse(ctx context.Context, sel ast.SelectionSet, v *model.RequestResponse) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -8888,6 +14289,73 @@ func (ec *executionContext) marshalORequestResponse2ᚖrequest_swapsᚋgraphᚋm
 	return ec._RequestResponse(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalORequestStatus2ᚕrequest_swapsᚋgraphᚋmodelᚐRequestStatusᚄ(ctx context.Context, v interface{}) ([]model.RequestStatus, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []interface{}
+	if v != nil {
+		vSlice = graphql.CoerceList(v)
+	}
+	var err error
+	res := make([]model.RequestStatus, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNRequestStatus2request_swapsᚋgraphᚋmodelᚐRequestStatus(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalORequestStatus2ᚕrequest_swapsᚋgraphᚋmodelᚐRequestStatusᚄ(ctx context.Context, sel ast.SelectionSet, v []model.RequestStatus) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNRequestStatus2request_swapsᚋgraphᚋmodelᚐRequestStatus(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
 func (ec *executionContext) unmarshalORequestStatus2ᚖrequest_swapsᚋgraphᚋmodelᚐRequestStatus(ctx context.Context, v interface{}) (*model.RequestStatus, error) {
 	if v == nil {
 		return nil, nil
@@ -0,0 +1,168 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"request_swaps/graph/model"
+	"request_swaps/util"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"gorm.io/gorm"
+)
+
+// maxCommentLength is the most characters a comment may have
+const maxCommentLength = 2000
+
+// newHistoryEntry is an entry of the history of the request swap; a nil
+// actor is the service itself
+func newHistoryEntry(recordID string, action model.RequestHistoryAction, field string, oldValue *string, newValue *string, actorUserID *string) *model.RequestHistoryEntry {
+	entry := &model.RequestHistoryEntry{
+		RecordID:    recordID,
+		Action:      action,
+		OldValue:    oldValue,
+		NewValue:    newValue,
+		ActorUserID: actorUserID,
+		CreatedAt:   time.Now().UTC(),
+	}
+	if field != "" {
+		entry.Field = &field
+	}
+
+	return entry
+}
+
+// newStatusEntry records the move of the request swap from one status to another
+func newStatusEntry(recordID string, from model.RequestStatus, to model.RequestStatus, actorUserID *string) *model.RequestHistoryEntry {
+	oldValue, newValue := from.String(), to.String()
+	return newHistoryEntry(recordID, model.RequestHistoryActionStatusChanged, "status", &oldValue, &newValue, actorUserID)
+}
+
+// recordHistory appends the entries in the transaction of the change they describe
+func recordHistory(tx *gorm.DB, entries ...*model.RequestHistoryEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	return tx.Create(entries).Error
+}
+
+// requestSwapEdits are the history entries of updating requestSwap with
+// changes; like gorm, the empty fields of changes are left as they are
+func requestSwapEdits(requestSwap *model.RequestSwap, changes model.RequestSwap, actorUserID *string) []*model.RequestHistoryEntry {
+	fields := []struct {
+		name     string
+		oldValue *string
+		newValue *string
+	}{
+		{"channelId", &requestSwap.ChannelID, nonEmpty(changes.ChannelID)},
+		{"userId", &requestSwap.UserID, nonEmpty(changes.UserID)},
+		{"assignedUserShiftId", requestSwap.AssignedUserShiftID, changes.AssignedUserShiftID},
+		{"assignedUserShiftIdToSwap", requestSwap.AssignedUserShiftIDToSwap, changes.AssignedUserShiftIDToSwap},
+		{"peerUserId", requestSwap.PeerUserID, changes.PeerUserID},
+		{"requestNote", requestSwap.RequestNote, changes.RequestNote},
+		{"responseNote", requestSwap.ResponseNote, changes.ResponseNote},
+		{"responseByUserId", requestSwap.ResponseByUserID, changes.ResponseByUserID},
+		{"responseAt", formatTime(requestSwap.ResponseAt), formatTime(changes.ResponseAt)},
+		{"startTime", formatTime(requestSwap.StartTime), formatTime(changes.StartTime)},
+		{"endTime", formatTime(requestSwap.EndTime), formatTime(changes.EndTime)},
+	}
+
+	var entries []*model.RequestHistoryEntry
+	for _, field := range fields {
+		if field.newValue == nil || (field.oldValue != nil && *field.oldValue == *field.newValue) {
+			continue
+		}
+
+		entries = append(entries, newHistoryEntry(requestSwap.ID, model.RequestHistoryActionUpdated, field.name, field.oldValue, field.newValue, actorUserID))
+	}
+
+	if changes.Status != "" && changes.Status != requestSwap.Status {
+		entries = append(entries, newStatusEntry(requestSwap.ID, requestSwap.Status, changes.Status, actorUserID))
+	}
+
+	return entries
+}
+
+// getRequestHistory fetches the history of the request swaps, oldest first
+func getRequestHistory(ctx context.Context, ids []string) (map[string][]*model.RequestHistoryEntry, error) {
+	var entries []*model.RequestHistoryEntry
+	err := GetOpenConnection().WithContext(ctx).Where("record_id IN ?", ids).Order("created_at, id").Find(&entries).Error
+	if err != nil {
+		return nil, err
+	}
+
+	history := make(map[string][]*model.RequestHistoryEntry, len(ids))
+	for _, entry := range entries {
+		history[entry.RecordID] = append(history[entry.RecordID], entry)
+	}
+
+	return history, nil
+}
+
+// getRequestComments fetches the comments on the request swaps, oldest first
+func getRequestComments(ctx context.Context, ids []string) (map[string][]*model.RequestComment, error) {
+	var comments []*model.RequestComment
+	err := GetOpenConnection().WithContext(ctx).Where("record_id IN ?", ids).Order("created_at, id").Find(&comments).Error
+	if err != nil {
+		return nil, err
+	}
+
+	byRecord := make(map[string][]*model.RequestComment, len(ids))
+	for _, comment := range comments {
+		byRecord[comment.RecordID] = append(byRecord[comment.RecordID], comment)
+	}
+
+	return byRecord, nil
+}
+
+// addRequestSwapComment adds the comment of userID to the request swap; with
+// participantOnly the user has to be its requester or its peer
+func (r *Resolver) addRequestSwapComment(ctx context.Context, id string, userID string, body string, participantOnly bool) (*model.RequestSwap, *model.RequestComment, error) {
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return nil, nil, util.NewError(util.ErrorCodeValidation, "body is required")
+	}
+	if utf8.RuneCountInString(body) > maxCommentLength {
+		return nil, nil, util.NewError(util.ErrorCodeValidation, fmt.Sprintf("body must be at most %d characters", maxCommentLength))
+	}
+
+	var requestSwap model.RequestSwap
+	if err := r.DB.WithContext(ctx).Where("id = ?", id).First(&requestSwap).Error; err != nil {
+		return nil, nil, err
+	}
+
+	if participantOnly && requestSwap.UserID != userID && (requestSwap.PeerUserID == nil || *requestSwap.PeerUserID != userID) {
+		return nil, nil, util.NewError(util.ErrorCodeForbidden, "Permission denied: request_swap.READ_ALL")
+	}
+
+	comment := &model.RequestComment{
+		RecordID:  requestSwap.ID,
+		UserID:    userID,
+		Body:      body,
+		CreatedAt: time.Now().UTC(),
+	}
+	if err := r.DB.WithContext(ctx).Create(comment).Error; err != nil {
+		return nil, nil, err
+	}
+
+	return &requestSwap, comment, nil
+}
+
+func nonEmpty(value string) *string {
+	if value == "" {
+		return nil
+	}
+
+	return &value
+}
+
+func formatTime(value *time.Time) *string {
+	if value == nil {
+		return nil
+	}
+
+	formatted := value.UTC().Format(time.RFC3339)
+	return &formatted
+}
@@ -0,0 +1,88 @@
+package graph
+
+import (
+	"context"
+	"net/http"
+	"request_swaps/graph/model"
+	"request_swaps/util"
+)
+
+type loadersContextKey struct{}
+
+// Loaders batch and cache the shifts, users, history and comments the field
+// resolvers load during one request
+type Loaders struct {
+	AssignedShifts *util.Loader[*model.AssignedShift]
+	Users          *util.Loader[*model.User]
+	History        *util.Loader[[]*model.RequestHistoryEntry]
+	Comments       *util.Loader[[]*model.RequestComment]
+}
+
+func NewLoaders() *Loaders {
+	return &Loaders{
+		AssignedShifts: util.NewLoader(util.GetAssignedShifts),
+		Users:          util.NewLoader(util.GetUsers),
+		History:        util.NewLoader(getRequestHistory),
+		Comments:       util.NewLoader(getRequestComments),
+	}
+}
+
+// LoaderMiddleware gives every request its own Loaders
+func LoaderMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), loadersContextKey{}, NewLoaders())
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// LoadersFor returns the Loaders of the request; outside of a request each
+// call gets new ones
+func LoadersFor(ctx context.Context) *Loaders {
+	if loaders, ok := ctx.Value(loadersContextKey{}).(*Loaders); ok {
+		return loaders
+	}
+
+	return NewLoaders()
+}
+
+func loadAssignedShift(ctx context.Context, id *string) (*model.AssignedShift, error) {
+	if id == nil || *id == "" {
+		return nil, nil
+	}
+
+	return LoadersFor(ctx).AssignedShifts.Load(ctx, *id)
+}
+
+func loadUser(ctx context.Context, id *string) (*model.User, error) {
+	if id == nil || *id == "" {
+		return nil, nil
+	}
+
+	return LoadersFor(ctx).Users.Load(ctx, *id)
+}
+
+// loadHistory returns the history of the request swap, empty when it has none
+func loadHistory(ctx context.Context, id string) ([]*model.RequestHistoryEntry, error) {
+	history, err := LoadersFor(ctx).History.Load(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if history == nil {
+		history = []*model.RequestHistoryEntry{}
+	}
+
+	return history, nil
+}
+
+// loadComments returns the comments on the request swap, empty when it has none
+func loadComments(ctx context.Context, id string) ([]*model.RequestComment, error) {
+	comments, err := LoadersFor(ctx).Comments.Load(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if comments == nil {
+		comments = []*model.RequestComment{}
+	}
+
+	return comments, nil
+}
@@ -0,0 +1,106 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"request_swaps/database/migrations"
+	"strconv"
+	"syscall"
+	"text/tabwriter"
+	"time"
+
+	"github.com/getsentry/sentry-go"
+)
+
+const migrateUsage = "usage: migrate up | down [steps] | to <version> | status"
+
+// Migrate runs the migrate subcommand against the configured database
+func Migrate(args []string) {
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	if err := migrate(ctx, args); err != nil {
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
+
+		log.Fatalf("error: %v", err)
+	}
+}
+
+func migrate(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf(migrateUsage)
+	}
+
+	_, database, err := connect(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		_ = database.Close()
+	}()
+
+	migrator, err := newMigrator()
+	if err != nil {
+		return err
+	}
+
+	switch args[0] {
+	case "up":
+		return migrator.Up(ctx)
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			steps, err = strconv.Atoi(args[1])
+			if err != nil {
+				return fmt.Errorf("unable to convert steps to int: %w", err)
+			}
+		}
+
+		return migrator.Down(ctx, steps)
+	case "to":
+		if len(args) < 2 {
+			return fmt.Errorf(migrateUsage)
+		}
+
+		version, err := strconv.ParseInt(args[1], 10, 64)
+		if err != nil {
+			return fmt.Errorf("unable to convert version to int: %w", err)
+		}
+
+		return migrator.To(ctx, version)
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+
+		writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(writer, "VERSION\tNAME\tAPPLIED AT")
+		for _, status := range statuses {
+			appliedAt := "pending"
+			if status.AppliedAt != nil {
+				appliedAt = status.AppliedAt.Format(time.RFC3339)
+			}
+			fmt.Fprintf(writer, "%04d\t%s\t%s\n", status.Version, status.Name, appliedAt)
+		}
+
+		return writer.Flush()
+	default:
+		return fmt.Errorf(migrateUsage)
+	}
+}
+
+// newMigrator runs the migrations on the current database connection
+func newMigrator() (*migrations.Migrator, error) {
+	sqlDB, err := GetOpenConnection().DB()
+	if err != nil {
+		return nil, fmt.Errorf("unable to get database connection: %w", err)
+	}
+
+	return migrations.NewMigrator(sqlDB)
+}
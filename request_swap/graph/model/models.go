@@ -1,5 +1,7 @@
 package model
 
+import "time"
+
 type CreateRequestResponse struct {
 	Data struct {
 		CreateRequest struct {
@@ -8,6 +10,18 @@ type CreateRequestResponse struct {
 	} `json:"data"`
 }
 
+// ParentRequest is a request of the request service
+type ParentRequest struct {
+	ID        string    `json:"id"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type GetRequestsResponse struct {
+	Data struct {
+		Requests []*ParentRequest `json:"requests"`
+	} `json:"data"`
+}
+
 type PermissionResponse struct {
 	Data struct {
 		CheckPermission bool `json:"CheckPermission"`
@@ -27,3 +41,173 @@ type UserResponse struct {
 		}
 	} `json:"data"`
 }
+
+type GetAssignedShiftResponse struct {
+	Data struct {
+		GetAssignedShift *AssignedShift `json:"getAssignedShift"`
+	} `json:"data"`
+}
+
+type GetAssignedShiftsResponse struct {
+	Data struct {
+		GetAssignedShiftsByChannelIDShiftGroupIDUserID []*AssignedShift `json:"getAssignedShiftsByChannelIdShiftGroupIdUserId"`
+	} `json:"data"`
+}
+
+type GetAssignedShiftsByShiftGroupResponse struct {
+	Data struct {
+		GetAssignedShiftsByChannelIDShiftGroupID []*AssignedShift `json:"getAssignedShiftsByChannelIdShiftGroupId"`
+	} `json:"data"`
+}
+
+type GetAssignedShiftsByUserResponse struct {
+	Data struct {
+		GetAssignedShiftsByUserID []*AssignedShift `json:"getAssignedShiftsByUserId"`
+	} `json:"data"`
+}
+
+type GetShiftGroupMembersResponse struct {
+	Data struct {
+		GetShiftGroupMembersList []struct {
+			UserID string `json:"userId"`
+		} `json:"getShiftGroupMembersList"`
+	} `json:"data"`
+}
+
+// TimeOff is an approved time off of the request time off service; without
+// EndTime it lasts the day it starts
+type TimeOff struct {
+	UserID    string     `json:"userId"`
+	StartTime time.Time  `json:"startTime"`
+	EndTime   *time.Time `json:"endTime"`
+}
+
+type GetApprovedTimeOffsResponse struct {
+	Data struct {
+		GetApprovedTimeOffs []*TimeOff `json:"getApprovedTimeOffs"`
+	} `json:"data"`
+}
+
+type UpdateAssignedShiftResponse struct {
+	Data struct {
+		UpdateAssignedShift *AssignedShift `json:"updateAssignedShift"`
+	} `json:"data"`
+}
+
+// RequestResponse is bound in gqlgen.yml instead of being generated: it
+// keeps the IDs of the shifts and users a request refers to, which the
+// RequestResponse field resolvers load only when they are queried
+type RequestResponse struct {
+	ChannelID    string         `json:"channelId"`
+	CreatedAt    *time.Time     `json:"createdAt"`
+	EndTime      *time.Time     `json:"endTime"`
+	ID           string         `json:"id"`
+	IsAllDay     *bool          `json:"isAllDay"`
+	Reason       *string        `json:"reason"`
+	RequestID    string         `json:"requestId"`
+	RequestNote  *string        `json:"requestNote"`
+	ResponseAt   *time.Time     `json:"responseAt"`
+	ResponseNote *string        `json:"responseNote"`
+	StartTime    *time.Time     `json:"startTime"`
+	Status       *RequestStatus `json:"status"`
+	Type         *RequestType   `json:"type"`
+	User         *User          `json:"user"`
+
+	ShiftToOfferID   *string `json:"-"`
+	ShiftToSwapID    *string `json:"-"`
+	ShiftOfferedToID *string `json:"-"`
+	ResponseByID     *string `json:"-"`
+}
+
+type RequestSagaKind string
+
+const (
+	RequestSagaKindCreate RequestSagaKind = "create"
+	RequestSagaKindDelete RequestSagaKind = "delete"
+)
+
+type RequestSagaStatus string
+
+const (
+	// RequestSagaStatusPending is set while the saga runs; a pending saga
+	// left behind by a crash is picked up by the reconciler
+	RequestSagaStatusPending      RequestSagaStatus = "pending"
+	RequestSagaStatusCompensating RequestSagaStatus = "compensating"
+	RequestSagaStatusCompleted    RequestSagaStatus = "completed"
+	RequestSagaStatusCompensated  RequestSagaStatus = "compensated"
+	RequestSagaStatusFailed       RequestSagaStatus = "failed"
+)
+
+// RequestSaga is the outbox row of the creation or deletion of a parent
+// request in the request service together with the local row (RecordID)
+type RequestSaga struct {
+	ID        string            `json:"id" gorm:"primaryKey;type:uuid;default:uuid_generate_v4()"`
+	Kind      RequestSagaKind   `json:"kind" gorm:"type:varchar(16); not null"`
+	Status    RequestSagaStatus `json:"status" gorm:"type:varchar(16); not null"`
+	RecordID  string            `json:"recordId" gorm:"type:uuid; not null"`
+	RequestID *string           `json:"requestId" gorm:"type:uuid"`
+	ChannelID string            `json:"channelId" gorm:"type:varchar(64)"`
+	UserID    string            `json:"userId" gorm:"type:varchar(64)"`
+	Attempts  int               `json:"attempts" gorm:"not null;default:0"`
+	LastError *string           `json:"lastError"`
+	CreatedAt time.Time         `json:"createdAt" gorm:"default:now()"`
+	UpdatedAt time.Time         `json:"updatedAt" gorm:"default:now()"`
+}
+
+// requestStatusTransitions is the state machine of the request status: the
+// statuses a request can move to from each status. The other statuses are final.
+var requestStatusTransitions = map[RequestStatus][]RequestStatus{
+	// the peer accepts (PENDING) or declines before a manager sees the request
+	RequestStatusAwaitingPeer: {RequestStatusPending, RequestStatusPeerDeclined, RequestStatusCancelled, RequestStatusExpired},
+	RequestStatusPending:      {RequestStatusApproved, RequestStatusDenied, RequestStatusCancelled, RequestStatusExpired},
+}
+
+// ExpiringRequestStatuses are the statuses of the requests still waiting for
+// an answer, which the expiry scheduler moves to EXPIRED once it is too late
+var ExpiringRequestStatuses = []RequestStatus{RequestStatusAwaitingPeer, RequestStatusPending}
+
+// IsFinal reports whether a request in status s cannot move anymore
+func (s RequestStatus) IsFinal() bool {
+	return len(requestStatusTransitions[s]) == 0
+}
+
+// CanTransitionTo reports whether a request in status s may move to next
+func (s RequestStatus) CanTransitionTo(next RequestStatus) bool {
+	for _, status := range requestStatusTransitions[s] {
+		if status == next {
+			return true
+		}
+	}
+
+	return false
+}
+
+// RequestHistoryEntry is one change of a request swap; entries are only
+// ever appended
+type RequestHistoryEntry struct {
+	ID          string               `json:"id" gorm:"primaryKey;type:uuid;default:uuid_generate_v4()"`
+	RecordID    string               `json:"-" gorm:"type:uuid;not null"`
+	Action      RequestHistoryAction `json:"action" gorm:"type:varchar(16);not null"`
+	Field       *string              `json:"field" gorm:"type:varchar(64)"`
+	OldValue    *string              `json:"oldValue"`
+	NewValue    *string              `json:"newValue"`
+	ActorUserID *string              `json:"actorUserId" gorm:"type:varchar(64)"`
+	CreatedAt   time.Time            `json:"createdAt" gorm:"default:now()"`
+}
+
+func (RequestHistoryEntry) TableName() string {
+	return "request_history"
+}
+
+// RequestComment is a comment on a request swap
+type RequestComment struct {
+	ID        string    `json:"id" gorm:"primaryKey;type:uuid;default:uuid_generate_v4()"`
+	RecordID  string    `json:"-" gorm:"type:uuid;not null"`
+	UserID    string    `json:"userId" gorm:"type:varchar(64);not null"`
+	Body      string    `json:"body" gorm:"type:text;not null"`
+	CreatedAt time.Time `json:"createdAt" gorm:"default:now()"`
+}
+
+func (RequestComment) TableName() string {
+	return "request_comments"
+}
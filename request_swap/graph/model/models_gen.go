@@ -42,41 +42,92 @@ type AssignedShiftActivities struct {
 	IsPaid          bool      `json:"isPaid"`
 }
 
-type RequestResponse struct {
-	ChannelID      string         `json:"channelId"`
-	CreatedAt      *time.Time     `json:"createdAt"`
-	EndTime        *time.Time     `json:"endTime"`
-	ID             string         `json:"id"`
-	IsAllDay       *bool          `json:"isAllDay"`
-	Reason         *string        `json:"reason"`
-	RequestID      string         `json:"requestId"`
-	RequestNote    *string        `json:"requestNote"`
-	ResponseAt     *time.Time     `json:"responseAt"`
-	ResponseBy     *User          `json:"responseBy"`
-	ResponseNote   *string        `json:"responseNote"`
-	ShiftOfferedTo *User          `json:"shiftOfferedTo"`
-	ShiftToOffer   *AssignedShift `json:"shiftToOffer"`
-	ShiftToSwap    *AssignedShift `json:"shiftToSwap"`
-	StartTime      *time.Time     `json:"startTime"`
-	Status         *RequestStatus `json:"status"`
-	ToSwapWith     *AssignedShift `json:"toSwapWith"`
-	Type           *RequestType   `json:"type"`
-	User           *User          `json:"user"`
+// errors are about the whole call; each request swap has its own result, in the order of the ids
+type BulkRequestSwapResponse struct {
+	Errors  []*ShiftError        `json:"errors"`
+	Results []*RequestSwapResult `json:"results"`
+}
+
+type PageInfo struct {
+	HasNextPage     bool    `json:"hasNextPage"`
+	HasPreviousPage bool    `json:"hasPreviousPage"`
+	StartCursor     *string `json:"startCursor"`
+	EndCursor       *string `json:"endCursor"`
+}
+
+type RequestCommentResponse struct {
+	Errors  []*ShiftError   `json:"errors"`
+	Comment *RequestComment `json:"comment"`
+}
+
+// How the expiry scheduler treats the waiting request swaps of a channel
+type RequestExpirySettings struct {
+	ChannelID string `json:"channelId" gorm:"primaryKey;type:varchar(64)"`
+	Enabled   bool   `json:"enabled" gorm:"not null"`
+	// Minutes after the start of its shifts a waiting request swap expires
+	GracePeriodMinutes int        `json:"gracePeriodMinutes" gorm:"not null"`
+	UpdatedByUserID    *string    `json:"updatedByUserId" gorm:"type:varchar(64)"`
+	UpdatedAt          *time.Time `json:"updatedAt"`
+}
+
+type RequestExpirySettingsInput struct {
+	Enabled            bool `json:"enabled"`
+	GracePeriodMinutes int  `json:"gracePeriodMinutes"`
+}
+
+type RequestExpirySettingsResponse struct {
+	Errors   []*ShiftError          `json:"errors"`
+	Settings *RequestExpirySettings `json:"settings"`
+}
+
+type RequestFilter struct {
+	Status []RequestStatus `json:"status"`
+	UserID *string         `json:"userId"`
+	// Only requests whose shifts overlap the period between from and to
+	From          *time.Time `json:"from"`
+	To            *time.Time `json:"to"`
+	CreatedAfter  *time.Time `json:"createdAfter"`
+	CreatedBefore *time.Time `json:"createdBefore"`
+}
+
+type RequestOrder struct {
+	Field     RequestOrderField `json:"field"`
+	Direction OrderDirection    `json:"direction"`
 }
 
 type RequestSwap struct {
-	ID                        string        `json:"id" gorm:"primaryKey;type:uuid;default:uuid_generate_v4()"`
-	ChannelID                 string        `json:"channelId" gorm:"type:varchar(64)"`
-	RequestID                 *string       `json:"requestId" gorm:"type:uuid; not null"`
-	UserID                    string        `json:"userId" gorm:"type:varchar(64); not null"`
-	AssignedUserShiftID       *string       `json:"assignedUserShiftId" gorm:"type:uuid; not null"`
-	AssignedUserShiftIDToSwap *string       `json:"assignedUserShiftIdToSwap" gorm:"type:uuid; not null"`
-	RequestNote               *string       `json:"requestNote"`
-	Status                    RequestStatus `json:"status" gorm:"type:varchar(16); not null"`
-	ResponseNote              *string       `json:"responseNote"`
-	ResponseByUserID          *string       `json:"responseByUserId"`
-	ResponseAt                *time.Time    `json:"responseAt"`
-	CreatedAt                 time.Time     `json:"createdAt" gorm:"default:now()"`
+	ID                        string  `json:"id" gorm:"primaryKey;type:uuid;default:uuid_generate_v4()"`
+	ChannelID                 string  `json:"channelId" gorm:"type:varchar(64)"`
+	RequestID                 *string `json:"requestId" gorm:"type:uuid; not null"`
+	UserID                    string  `json:"userId" gorm:"type:varchar(64); not null"`
+	AssignedUserShiftID       *string `json:"assignedUserShiftId" gorm:"type:uuid; not null"`
+	AssignedUserShiftIDToSwap *string `json:"assignedUserShiftIdToSwap" gorm:"type:uuid; not null"`
+	// Owner of the shift to swap, who accepts or declines the swap before a manager approves it
+	PeerUserID       *string       `json:"peerUserId" gorm:"type:varchar(64)"`
+	RequestNote      *string       `json:"requestNote"`
+	Status           RequestStatus `json:"status" gorm:"type:varchar(16); not null"`
+	ResponseNote     *string       `json:"responseNote"`
+	ResponseByUserID *string       `json:"responseByUserId"`
+	ResponseAt       *time.Time    `json:"responseAt"`
+	CreatedAt        time.Time     `json:"createdAt" gorm:"default:now()"`
+	// Start of the earliest shift of the swap, recorded when it is created or updated
+	StartTime *time.Time `json:"startTime"`
+	// End of the latest shift of the swap, recorded when it is created or updated
+	EndTime *time.Time `json:"endTime"`
+	// Every change of the request swap, oldest first
+	History []*RequestHistoryEntry `json:"history" gorm:"-"`
+	// Comments on the request swap, oldest first
+	Comments []*RequestComment `json:"comments" gorm:"-"`
+}
+
+type RequestSwapConnection struct {
+	Edges    []*RequestSwapEdge `json:"edges"`
+	PageInfo *PageInfo          `json:"pageInfo"`
+}
+
+type RequestSwapEdge struct {
+	Cursor string       `json:"cursor"`
+	Node   *RequestSwap `json:"node"`
 }
 
 type RequestSwapInput struct {
@@ -95,12 +146,28 @@ type RequestSwapResponse struct {
 	Request *RequestResponse `json:"request"`
 }
 
+// Outcome of a bulk mutation for one request swap
+type RequestSwapResult struct {
+	ID      string           `json:"id"`
+	Errors  []*ShiftError    `json:"errors"`
+	Request *RequestResponse `json:"request"`
+}
+
 type ShiftError struct {
 	Code    ShiftErrorCode `json:"code"`
 	Field   *string        `json:"field"`
 	Message *string        `json:"message"`
 }
 
+// A coworker and one of their shifts that could be swapped with a given shift
+type SwapCandidate struct {
+	UserID string         `json:"userId"`
+	User   *User          `json:"user"`
+	Shift  *AssignedShift `json:"shift"`
+	// Minutes between the start of the candidate's shift and the start of the given shift
+	StartTimeDifference int `json:"startTimeDifference"`
+}
+
 type User struct {
 	ID        *string `json:"id"`
 	FirstName *string `json:"firstName"`
@@ -111,25 +178,159 @@ type User struct {
 	IsStaff   *bool   `json:"isStaff"`
 }
 
+type OrderDirection string
+
+const (
+	OrderDirectionAsc  OrderDirection = "ASC"
+	OrderDirectionDesc OrderDirection = "DESC"
+)
+
+var AllOrderDirection = []OrderDirection{
+	OrderDirectionAsc,
+	OrderDirectionDesc,
+}
+
+func (e OrderDirection) IsValid() bool {
+	switch e {
+	case OrderDirectionAsc, OrderDirectionDesc:
+		return true
+	}
+	return false
+}
+
+func (e OrderDirection) String() string {
+	return string(e)
+}
+
+func (e *OrderDirection) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = OrderDirection(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid OrderDirection", str)
+	}
+	return nil
+}
+
+func (e OrderDirection) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type RequestHistoryAction string
+
+const (
+	RequestHistoryActionCreated       RequestHistoryAction = "CREATED"
+	RequestHistoryActionUpdated       RequestHistoryAction = "UPDATED"
+	RequestHistoryActionStatusChanged RequestHistoryAction = "STATUS_CHANGED"
+)
+
+var AllRequestHistoryAction = []RequestHistoryAction{
+	RequestHistoryActionCreated,
+	RequestHistoryActionUpdated,
+	RequestHistoryActionStatusChanged,
+}
+
+func (e RequestHistoryAction) IsValid() bool {
+	switch e {
+	case RequestHistoryActionCreated, RequestHistoryActionUpdated, RequestHistoryActionStatusChanged:
+		return true
+	}
+	return false
+}
+
+func (e RequestHistoryAction) String() string {
+	return string(e)
+}
+
+func (e *RequestHistoryAction) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = RequestHistoryAction(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RequestHistoryAction", str)
+	}
+	return nil
+}
+
+func (e RequestHistoryAction) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type RequestOrderField string
+
+const (
+	RequestOrderFieldCreatedAt RequestOrderField = "CREATED_AT"
+	RequestOrderFieldStartTime RequestOrderField = "START_TIME"
+)
+
+var AllRequestOrderField = []RequestOrderField{
+	RequestOrderFieldCreatedAt,
+	RequestOrderFieldStartTime,
+}
+
+func (e RequestOrderField) IsValid() bool {
+	switch e {
+	case RequestOrderFieldCreatedAt, RequestOrderFieldStartTime:
+		return true
+	}
+	return false
+}
+
+func (e RequestOrderField) String() string {
+	return string(e)
+}
+
+func (e *RequestOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = RequestOrderField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RequestOrderField", str)
+	}
+	return nil
+}
+
+func (e RequestOrderField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type RequestStatus string
 
 const (
-	RequestStatusPending   RequestStatus = "PENDING"
-	RequestStatusApproved  RequestStatus = "APPROVED"
-	RequestStatusDenied    RequestStatus = "DENIED"
-	RequestStatusCancelled RequestStatus = "CANCELLED"
+	// Waiting for the owner of the shift to swap to accept or decline
+	RequestStatusAwaitingPeer RequestStatus = "AWAITING_PEER"
+	// Declined by the owner of the shift to swap
+	RequestStatusPeerDeclined RequestStatus = "PEER_DECLINED"
+	RequestStatusPending      RequestStatus = "PENDING"
+	RequestStatusApproved     RequestStatus = "APPROVED"
+	RequestStatusDenied       RequestStatus = "DENIED"
+	RequestStatusCancelled    RequestStatus = "CANCELLED"
+	// Still waiting when its shifts started, moved here by the expiry scheduler
+	RequestStatusExpired RequestStatus = "EXPIRED"
 )
 
 var AllRequestStatus = []RequestStatus{
+	RequestStatusAwaitingPeer,
+	RequestStatusPeerDeclined,
 	RequestStatusPending,
 	RequestStatusApproved,
 	RequestStatusDenied,
 	RequestStatusCancelled,
+	RequestStatusExpired,
 }
 
 func (e RequestStatus) IsValid() bool {
 	switch e {
-	case RequestStatusPending, RequestStatusApproved, RequestStatusDenied, RequestStatusCancelled:
+	case RequestStatusAwaitingPeer, RequestStatusPeerDeclined, RequestStatusPending, RequestStatusApproved, RequestStatusDenied, RequestStatusCancelled, RequestStatusExpired:
 		return true
 	}
 	return false
@@ -199,25 +400,38 @@ func (e RequestType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+// Stable error codes, also set as the "code" extension of GraphQL errors
 type ShiftErrorCode string
 
 const (
-	ShiftErrorCodeGraphqlError ShiftErrorCode = "GRAPHQL_ERROR"
-	ShiftErrorCodeInvalid      ShiftErrorCode = "INVALID"
-	ShiftErrorCodeNotFound     ShiftErrorCode = "NOT_FOUND"
-	ShiftErrorCodeRequired     ShiftErrorCode = "REQUIRED"
+	ShiftErrorCodeUnauthenticated     ShiftErrorCode = "UNAUTHENTICATED"
+	ShiftErrorCodeForbidden           ShiftErrorCode = "FORBIDDEN"
+	ShiftErrorCodeNotFound            ShiftErrorCode = "NOT_FOUND"
+	ShiftErrorCodeValidation          ShiftErrorCode = "VALIDATION"
+	ShiftErrorCodeConflict            ShiftErrorCode = "CONFLICT"
+	ShiftErrorCodeUpstreamUnavailable ShiftErrorCode = "UPSTREAM_UNAVAILABLE"
+	ShiftErrorCodeInternal            ShiftErrorCode = "INTERNAL"
+	ShiftErrorCodeGraphqlError        ShiftErrorCode = "GRAPHQL_ERROR"
+	ShiftErrorCodeInvalid             ShiftErrorCode = "INVALID"
+	ShiftErrorCodeRequired            ShiftErrorCode = "REQUIRED"
 )
 
 var AllShiftErrorCode = []ShiftErrorCode{
+	ShiftErrorCodeUnauthenticated,
+	ShiftErrorCodeForbidden,
+	ShiftErrorCodeNotFound,
+	ShiftErrorCodeValidation,
+	ShiftErrorCodeConflict,
+	ShiftErrorCodeUpstreamUnavailable,
+	ShiftErrorCodeInternal,
 	ShiftErrorCodeGraphqlError,
 	ShiftErrorCodeInvalid,
-	ShiftErrorCodeNotFound,
 	ShiftErrorCodeRequired,
 }
 
 func (e ShiftErrorCode) IsValid() bool {
 	switch e {
-	case ShiftErrorCodeGraphqlError, ShiftErrorCodeInvalid, ShiftErrorCodeNotFound, ShiftErrorCodeRequired:
+	case ShiftErrorCodeUnauthenticated, ShiftErrorCodeForbidden, ShiftErrorCodeNotFound, ShiftErrorCodeValidation, ShiftErrorCodeConflict, ShiftErrorCodeUpstreamUnavailable, ShiftErrorCodeInternal, ShiftErrorCodeGraphqlError, ShiftErrorCodeInvalid, ShiftErrorCodeRequired:
 		return true
 	}
 	return false
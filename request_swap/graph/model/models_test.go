@@ -0,0 +1,35 @@
+package model
+
+import "testing"
+
+func TestCanTransitionTo(t *testing.T) {
+	tests := []struct {
+		from    RequestStatus
+		allowed []RequestStatus
+	}{
+		{RequestStatusAwaitingPeer, []RequestStatus{RequestStatusPending, RequestStatusPeerDeclined, RequestStatusCancelled, RequestStatusExpired}},
+		{RequestStatusPending, []RequestStatus{RequestStatusApproved, RequestStatusDenied, RequestStatusCancelled, RequestStatusExpired}},
+		{RequestStatusPeerDeclined, nil},
+		{RequestStatusApproved, nil},
+		{RequestStatusDenied, nil},
+		{RequestStatusCancelled, nil},
+		{RequestStatusExpired, nil},
+	}
+
+	for _, test := range tests {
+		allowed := map[RequestStatus]bool{}
+		for _, status := range test.allowed {
+			allowed[status] = true
+		}
+
+		for _, next := range AllRequestStatus {
+			if got := test.from.CanTransitionTo(next); got != allowed[next] {
+				t.Errorf("%s.CanTransitionTo(%s) = %v, want %v", test.from, next, got, allowed[next])
+			}
+		}
+
+		if got := test.from.IsFinal(); got != (len(test.allowed) == 0) {
+			t.Errorf("%s.IsFinal() = %v, want %v", test.from, got, len(test.allowed) == 0)
+		}
+	}
+}
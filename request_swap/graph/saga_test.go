@@ -0,0 +1,48 @@
+package graph
+
+import (
+	"request_swaps/graph/model"
+	"testing"
+	"time"
+)
+
+func TestLeftoverRequest(t *testing.T) {
+	createdAt := time.Date(2024, 3, 4, 9, 0, 0, 0, time.UTC)
+	saga := &model.RequestSaga{ID: "saga", CreatedAt: createdAt}
+	request := func(id string, seconds int) *model.ParentRequest {
+		return &model.ParentRequest{ID: id, CreatedAt: createdAt.Add(time.Duration(seconds) * time.Second)}
+	}
+
+	tests := []struct {
+		name       string
+		requests   []*model.ParentRequest
+		referenced map[string]bool
+		want       string
+		wantErr    bool
+	}{
+		{name: "no requests"},
+		{name: "created with the saga", requests: []*model.ParentRequest{request("a", 2)}, want: "a"},
+		{name: "created before the saga", requests: []*model.ParentRequest{request("a", -60)}},
+		{name: "created long after the saga", requests: []*model.ParentRequest{request("a", 60)}},
+		{name: "referenced", requests: []*model.ParentRequest{request("a", 2)}, referenced: map[string]bool{"a": true}},
+		{
+			name:       "one left besides referenced ones",
+			requests:   []*model.ParentRequest{request("a", 1), request("b", 3), request("c", -3600)},
+			referenced: map[string]bool{"a": true},
+			want:       "b",
+		},
+		{name: "several candidates", requests: []*model.ParentRequest{request("a", 1), request("b", 3)}, wantErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := leftoverRequest(saga, test.requests, test.referenced)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("got error %v, want an error: %v", err, test.wantErr)
+			}
+			if got != test.want {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
+		})
+	}
+}
@@ -2,8 +2,8 @@ package graph
 
 import (
 	"context"
-	"errors"
 	"fmt"
+	"request_swaps/events"
 	"request_swaps/graph/generated"
 	"request_swaps/graph/model"
 	"request_swaps/util"
@@ -11,6 +11,8 @@ import (
 
 	sentry "github.com/getsentry/sentry-go"
 	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // CreateRequestSwap is the resolver for the createRequestSwap field.
@@ -19,10 +21,11 @@ func (r *mutationResolver) CreateRequestSwap(ctx context.Context, input model.Re
 	fieldError := "Create Request Swap"
 	errorMessage := "Something went wrong while adding the Request Swap." // default error message
 
+	authUserID = AuthUserID(ctx, authUserID)
 	if authUserID == nil || *authUserID == string("") {
 		errorMessage = "Authenticated user id is required"
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeRequired,
+			Code:    model.ShiftErrorCodeUnauthenticated,
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -37,14 +40,14 @@ func (r *mutationResolver) CreateRequestSwap(ctx context.Context, input model.Re
 	permission := false
 
 	// validate permission
-	permission, err = util.CheckPermission("request_swap", "WRITE_ALL", *authUserID)
+	permission, err = util.CheckAnyPermission(ctx, "request_swap", []string{"WRITE_ALL", "WRITE"}, *authUserID)
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
 		errorMessage = err.Error()
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeInvalid,
+			Code:    util.ShiftErrorCodeOf(err),
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -55,30 +58,10 @@ func (r *mutationResolver) CreateRequestSwap(ctx context.Context, input model.Re
 		}, nil
 	}
 
-	if !permission {
-		permission, err = util.CheckPermission("request_swap", "WRITE", *authUserID)
-		if err != nil {
-			sentry.CaptureException(err)
-			defer sentry.Flush(2 * time.Second)
-
-			errorMessage = err.Error()
-			shiftError = append(shiftError, &model.ShiftError{
-				Code:    model.ShiftErrorCodeInvalid,
-				Field:   &fieldError,
-				Message: &errorMessage,
-			})
-
-			return &model.RequestSwapResponse{
-				Errors:  shiftError,
-				Request: nil,
-			}, nil
-		}
-	}
-
 	if !permission {
 		errorMessage = "Permission denied: request_swap.WRITE, request_swap.WRITE_ALL"
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeRequired,
+			Code:    model.ShiftErrorCodeForbidden,
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -89,14 +72,17 @@ func (r *mutationResolver) CreateRequestSwap(ctx context.Context, input model.Re
 		}, nil
 	}
 
-	requestId, err := util.CreateRequest(&input.ChannelID, &input.UserID)
+	// get the user first, so nothing is created when it fails
+	user, err := util.GetUser(ctx, input.UserID)
+
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
 		errorMessage = err.Error()
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeInvalid,
+
+			Code:    util.ShiftErrorCodeOf(err),
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -107,10 +93,11 @@ func (r *mutationResolver) CreateRequestSwap(ctx context.Context, input model.Re
 		}, nil
 	}
 
-	if requestId == "" {
-		errorMessage = "Something went wrong while creating Request."
+	if user.ID == nil || *user.ID == string("") {
+		errorMessage = "User not found"
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeInvalid,
+
+			Code:    model.ShiftErrorCodeNotFound,
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -121,30 +108,15 @@ func (r *mutationResolver) CreateRequestSwap(ctx context.Context, input model.Re
 		}, nil
 	}
 
-	requestSwap := &model.RequestSwap{
-		ID:                        uuid.New().String(),
-		ChannelID:                 input.ChannelID,
-		RequestID:                 &requestId,
-		UserID:                    input.UserID,
-		AssignedUserShiftID:       &input.AssignedUserShiftID,
-		AssignedUserShiftIDToSwap: input.AssignedUserShiftIDToSwap,
-		Status:                    model.RequestStatusPending,
-		RequestNote:               input.RequestNote,
-		ResponseNote:              input.ResponseNote,
-		ResponseByUserID:          input.ResponseByUserID,
-		ResponseAt:                input.ResponseAt,
-		CreatedAt:                 time.Now().UTC(),
-	}
-
-	err = r.DB.Create(&requestSwap).Error
+	// the shifts are checked before anything is created
+	shiftToOffer, shiftToSwap, shiftErrors, err := validateRequestSwap(ctx, input)
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
 		errorMessage = err.Error()
 		shiftError = append(shiftError, &model.ShiftError{
-
-			Code:    model.ShiftErrorCodeInvalid,
+			Code:    util.ShiftErrorCodeOf(err),
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -155,32 +127,54 @@ func (r *mutationResolver) CreateRequestSwap(ctx context.Context, input model.Re
 		}, nil
 	}
 
-	// get the user from the user service and return it
-	user, err := util.GetUser(input.UserID)
+	if len(shiftErrors) > 0 {
+		return &model.RequestSwapResponse{
+			Errors:  shiftErrors,
+			Request: nil,
+		}, nil
+	}
+
+	// a swap with the shift of a coworker waits for that coworker (the peer) first
+	status := model.RequestStatusPending
+	var peerUserID *string
+	if shiftToSwap != nil {
+		status = model.RequestStatusAwaitingPeer
+		peerUserID = shiftToSwap.UserID
+	}
+
+	startTime, endTime := shiftsPeriod(shiftToOffer, shiftToSwap)
+
+	requestSwap := &model.RequestSwap{
+		ID:                        uuid.New().String(),
+		ChannelID:                 input.ChannelID,
+		UserID:                    input.UserID,
+		AssignedUserShiftID:       &input.AssignedUserShiftID,
+		AssignedUserShiftIDToSwap: input.AssignedUserShiftIDToSwap,
+		PeerUserID:                peerUserID,
+		Status:                    status,
+		RequestNote:               input.RequestNote,
+		ResponseNote:              input.ResponseNote,
+		CreatedAt:                 time.Now().UTC(),
+		StartTime:                 startTime,
+		EndTime:                   endTime,
+	}
+
+	// the parent request and the request swap are created together or not at all
+	_, err = r.Sagas.CreateRequest(ctx, requestSwap.ID, input.ChannelID, input.UserID, func(tx *gorm.DB, requestID string) error {
+		requestSwap.RequestID = &requestID
+		if err := tx.Create(requestSwap).Error; err != nil {
+			return err
+		}
 
+		return recordHistory(tx, newHistoryEntry(requestSwap.ID, model.RequestHistoryActionCreated, "", nil, nil, authUserID))
+	})
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
 		errorMessage = err.Error()
 		shiftError = append(shiftError, &model.ShiftError{
-
-			Code:    model.ShiftErrorCodeInvalid,
-			Field:   &fieldError,
-			Message: &errorMessage,
-		})
-
-		return &model.RequestSwapResponse{
-			Errors:  shiftError,
-			Request: nil,
-		}, nil
-	}
-
-	if user.ID == nil || *user.ID == string("") {
-		errorMessage = "User not found"
-		shiftError = append(shiftError, &model.ShiftError{
-
-			Code:    model.ShiftErrorCodeInvalid,
+			Code:    util.ShiftErrorCodeOf(err),
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -191,7 +185,7 @@ func (r *mutationResolver) CreateRequestSwap(ctx context.Context, input model.Re
 		}, nil
 	}
 
-	/* TODO: get offered shift and assigned to user shift */
+	r.publish(ctx, events.NewRequestSwapEvent(events.RequestSwapCreated, *requestSwap, nil))
 
 	requestResponse := model.RequestResponse{
 		ID:           requestSwap.ID,
@@ -203,6 +197,11 @@ func (r *mutationResolver) CreateRequestSwap(ctx context.Context, input model.Re
 		ResponseAt:   requestSwap.ResponseAt,
 		CreatedAt:    &requestSwap.CreatedAt,
 		User:         user,
+
+		ShiftToOfferID:   requestSwap.AssignedUserShiftID,
+		ShiftToSwapID:    requestSwap.AssignedUserShiftIDToSwap,
+		ShiftOfferedToID: requestSwap.PeerUserID,
+		ResponseByID:     requestSwap.ResponseByUserID,
 	}
 
 	return &model.RequestSwapResponse{
@@ -217,10 +216,11 @@ func (r *mutationResolver) UpdateRequestSwap(ctx context.Context, id string, inp
 	fieldError := "Update Request Swap"
 	errorMessage := "Something went wrong while updating the Request Swap." // default error message
 
+	authUserID = AuthUserID(ctx, authUserID)
 	if authUserID == nil || *authUserID == string("") {
 		errorMessage = "Authenticated user id is required"
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeRequired,
+			Code:    model.ShiftErrorCodeUnauthenticated,
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -235,14 +235,14 @@ func (r *mutationResolver) UpdateRequestSwap(ctx context.Context, id string, inp
 	permission := false
 
 	// validate permission
-	permission, err = util.CheckPermission("request_swap", "WRITE_ALL", *authUserID)
+	permission, err = util.CheckAnyPermission(ctx, "request_swap", []string{"WRITE_ALL", "WRITE"}, *authUserID)
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
 		errorMessage = err.Error()
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeInvalid,
+			Code:    util.ShiftErrorCodeOf(err),
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -254,30 +254,10 @@ func (r *mutationResolver) UpdateRequestSwap(ctx context.Context, id string, inp
 
 	}
 
-	if !permission {
-		permission, err = util.CheckPermission("request_swap", "WRITE", *authUserID)
-		if err != nil {
-			sentry.CaptureException(err)
-			defer sentry.Flush(2 * time.Second)
-
-			errorMessage = err.Error()
-			shiftError = append(shiftError, &model.ShiftError{
-				Code:    model.ShiftErrorCodeRequired,
-				Field:   &fieldError,
-				Message: &errorMessage,
-			})
-
-			return &model.RequestSwapResponse{
-				Errors:  shiftError,
-				Request: nil,
-			}, nil
-		}
-	}
-
 	if !permission {
 		errorMessage = "Permission denied: request_swap.WRITE, request_swap.WRITE_ALL"
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeRequired,
+			Code:    model.ShiftErrorCodeForbidden,
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -291,7 +271,26 @@ func (r *mutationResolver) UpdateRequestSwap(ctx context.Context, id string, inp
 	if id == "" {
 		errorMessage = "Request Swap id is required"
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeRequired,
+			Code:    model.ShiftErrorCodeValidation,
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.RequestSwapResponse{
+			Errors:  shiftError,
+			Request: nil,
+		}, nil
+	}
+
+	// the shifts are checked like in createRequestSwap
+	shiftToOffer, shiftToSwap, shiftErrors, err := validateRequestSwap(ctx, input)
+	if err != nil {
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
+
+		errorMessage = err.Error()
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    util.ShiftErrorCodeOf(err),
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -302,22 +301,63 @@ func (r *mutationResolver) UpdateRequestSwap(ctx context.Context, id string, inp
 		}, nil
 	}
 
-	err = r.DB.Model(&model.RequestSwap{}).Where("id = ?", id).Updates(model.RequestSwap{
+	if len(shiftErrors) > 0 {
+		return &model.RequestSwapResponse{
+			Errors:  shiftErrors,
+			Request: nil,
+		}, nil
+	}
+
+	// the peer is the owner of the shift to swap, who answers the request swap
+	peerUserID := shiftToSwap.UserID
+
+	// the period of the shifts is kept for the listings
+	startTime, endTime := shiftsPeriod(shiftToOffer, shiftToSwap)
+
+	// the status only changes through the status mutations
+	changes := model.RequestSwap{
 		ChannelID:                 input.ChannelID,
 		UserID:                    input.UserID,
 		AssignedUserShiftID:       &input.AssignedUserShiftID,
 		AssignedUserShiftIDToSwap: input.AssignedUserShiftIDToSwap,
-		Status:                    model.RequestStatusPending,
 		RequestNote:               input.RequestNote,
 		ResponseNote:              input.ResponseNote,
-		ResponseByUserID:          input.ResponseByUserID,
-		ResponseAt:                input.ResponseAt,
-	}).Error
+		StartTime:                 startTime,
+		EndTime:                   endTime,
+	}
+
+	// the request swap is locked so the history records the values it replaces
+	err = r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var previous model.RequestSwap
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", id).First(&previous).Error
+		if err != nil {
+			return err
+		}
+
+		// a request swap that was answered, cancelled or expired is final
+		if previous.Status.IsFinal() {
+			return util.NewError(util.ErrorCodeConflict, "Request swap is "+string(previous.Status)+" and cannot be updated anymore")
+		}
+
+		// a request swap waiting for the peer waits for the owner of its new shift to swap; once
+		// the peer accepted, what they agreed to cannot change without a new request swap
+		if previous.Status == model.RequestStatusAwaitingPeer {
+			changes.PeerUserID = peerUserID
+		} else if field := agreedFieldChanged(&previous, changes); field != "" {
+			return util.NewError(util.ErrorCodeConflict, field+" can only be changed while the request swap is AWAITING_PEER")
+		}
+
+		if err := tx.Model(&model.RequestSwap{}).Where("id = ?", id).Updates(changes).Error; err != nil {
+			return err
+		}
+
+		return recordHistory(tx, requestSwapEdits(&previous, changes, authUserID)...)
+	})
 
 	if err != nil {
 		errorMessage = "Error updating Request Swap: " + err.Error()
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeInvalid,
+			Code:    util.ShiftErrorCodeOf(err),
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -337,7 +377,7 @@ func (r *mutationResolver) UpdateRequestSwap(ctx context.Context, id string, inp
 
 		errorMessage = err.Error()
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeNotFound,
+			Code:    util.ShiftErrorCodeOf(err),
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -348,7 +388,7 @@ func (r *mutationResolver) UpdateRequestSwap(ctx context.Context, id string, inp
 		}, nil
 	}
 
-	user, err := util.GetUser(input.UserID)
+	user, err := util.GetUser(ctx, input.UserID)
 
 	if err != nil {
 		sentry.CaptureException(err)
@@ -357,7 +397,7 @@ func (r *mutationResolver) UpdateRequestSwap(ctx context.Context, id string, inp
 		errorMessage = err.Error()
 		shiftError = append(shiftError, &model.ShiftError{
 
-			Code:    model.ShiftErrorCodeInvalid,
+			Code:    util.ShiftErrorCodeOf(err),
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -372,7 +412,7 @@ func (r *mutationResolver) UpdateRequestSwap(ctx context.Context, id string, inp
 		errorMessage = "User not found"
 		shiftError = append(shiftError, &model.ShiftError{
 
-			Code:    model.ShiftErrorCodeInvalid,
+			Code:    model.ShiftErrorCodeNotFound,
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -383,8 +423,6 @@ func (r *mutationResolver) UpdateRequestSwap(ctx context.Context, id string, inp
 		}, nil
 	}
 
-	/* TODO: get offered shift and assigned to user shift */
-
 	requestResponse := model.RequestResponse{
 		ID:           requestSwap.ID,
 		ChannelID:    requestSwap.ChannelID,
@@ -395,6 +433,11 @@ func (r *mutationResolver) UpdateRequestSwap(ctx context.Context, id string, inp
 		ResponseAt:   requestSwap.ResponseAt,
 		CreatedAt:    &requestSwap.CreatedAt,
 		User:         user,
+
+		ShiftToOfferID:   requestSwap.AssignedUserShiftID,
+		ShiftToSwapID:    requestSwap.AssignedUserShiftIDToSwap,
+		ShiftOfferedToID: requestSwap.PeerUserID,
+		ResponseByID:     requestSwap.ResponseByUserID,
 	}
 
 	return &model.RequestSwapResponse{
@@ -409,10 +452,11 @@ func (r *mutationResolver) DeleteRequestSwap(ctx context.Context, id string, aut
 	fieldError := "Delete Request Swap"
 	errorMessage := "Something went wrong while deleting the Request Swap." // default error message
 
+	authUserID = AuthUserID(ctx, authUserID)
 	if authUserID == nil || *authUserID == string("") {
 		errorMessage = "Authenticated user id is required"
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeRequired,
+			Code:    model.ShiftErrorCodeUnauthenticated,
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -427,14 +471,14 @@ func (r *mutationResolver) DeleteRequestSwap(ctx context.Context, id string, aut
 	permission := false
 
 	// validate permission
-	permission, err = util.CheckPermission("request_swap", "WRITE_ALL", *authUserID)
+	permission, err = util.CheckAnyPermission(ctx, "request_swap", []string{"WRITE_ALL", "WRITE"}, *authUserID)
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
 		errorMessage = err.Error()
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeInvalid,
+			Code:    util.ShiftErrorCodeOf(err),
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -445,30 +489,10 @@ func (r *mutationResolver) DeleteRequestSwap(ctx context.Context, id string, aut
 		}, nil
 	}
 
-	if !permission {
-		permission, err = util.CheckPermission("request_swap", "WRITE", *authUserID)
-		if err != nil {
-			sentry.CaptureException(err)
-			defer sentry.Flush(2 * time.Second)
-
-			errorMessage = err.Error()
-			shiftError = append(shiftError, &model.ShiftError{
-				Code:    model.ShiftErrorCodeInvalid,
-				Field:   &fieldError,
-				Message: &errorMessage,
-			})
-
-			return &model.RequestSwapResponse{
-				Errors:  shiftError,
-				Request: nil,
-			}, nil
-		}
-	}
-
 	if !permission {
 		errorMessage = "Permission denied: request_swap.WRITE, request_swap.WRITE_ALL"
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeRequired,
+			Code:    model.ShiftErrorCodeForbidden,
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -479,19 +503,9 @@ func (r *mutationResolver) DeleteRequestSwap(ctx context.Context, id string, aut
 		}, nil
 	}
 	if id == "" {
-		return nil, errors.New("id is required")
-	}
-
-	var requestSwap model.RequestSwap
-	// get request swap	by id
-	err = r.DB.First(&requestSwap, "id= ?", id).Error
-	if err != nil {
-		sentry.CaptureException(err)
-		defer sentry.Flush(2 * time.Second)
-
-		errorMessage = err.Error()
+		errorMessage = "Request Swap id is required"
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeNotFound,
+			Code:    model.ShiftErrorCodeValidation,
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -502,16 +516,16 @@ func (r *mutationResolver) DeleteRequestSwap(ctx context.Context, id string, aut
 		}, nil
 	}
 
-	// delete request swap
-	err = r.DB.Delete(&requestSwap, "id = ?", id).Error
-
+	var requestSwap model.RequestSwap
+	// get request swap	by id
+	err = r.DB.First(&requestSwap, "id= ?", id).Error
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
 		errorMessage = err.Error()
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeInvalid,
+			Code:    util.ShiftErrorCodeOf(err),
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -522,18 +536,17 @@ func (r *mutationResolver) DeleteRequestSwap(ctx context.Context, id string, aut
 		}, nil
 	}
 
-	// delete request
-	deleted, err := util.DeleteRequest(requestSwap.RequestID)
+	// get the user first, so nothing is deleted when it fails
+	user, err := util.GetUser(ctx, requestSwap.UserID)
+
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
-		return nil, err
-	}
-	if !deleted {
-		errorMessage = "There was an error deleting the request in Request API"
+		errorMessage = err.Error()
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeInvalid,
+
+			Code:    util.ShiftErrorCodeOf(err),
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -544,16 +557,11 @@ func (r *mutationResolver) DeleteRequestSwap(ctx context.Context, id string, aut
 		}, nil
 	}
 
-	user, err := util.GetUser(requestSwap.UserID)
-
-	if err != nil {
-		sentry.CaptureException(err)
-		defer sentry.Flush(2 * time.Second)
-
-		errorMessage = err.Error()
+	if user.ID == nil || *user.ID == string("") {
+		errorMessage = "User not found"
 		shiftError = append(shiftError, &model.ShiftError{
 
-			Code:    model.ShiftErrorCodeInvalid,
+			Code:    model.ShiftErrorCodeNotFound,
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -564,11 +572,19 @@ func (r *mutationResolver) DeleteRequestSwap(ctx context.Context, id string, aut
 		}, nil
 	}
 
-	if user.ID == nil || *user.ID == string("") {
-		errorMessage = "User not found"
-		shiftError = append(shiftError, &model.ShiftError{
+	// the request swap and its parent request are deleted together or not at all
+	err = r.Sagas.DeleteRequest(ctx, requestSwap.ID, *requestSwap.RequestID, func(tx *gorm.DB) error {
+		return tx.Delete(&requestSwap, "id = ?", id).Error
+	}, func(tx *gorm.DB) error {
+		return tx.Create(&requestSwap).Error
+	})
+	if err != nil {
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
 
-			Code:    model.ShiftErrorCodeInvalid,
+		errorMessage = err.Error()
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    util.ShiftErrorCodeOf(err),
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -579,8 +595,6 @@ func (r *mutationResolver) DeleteRequestSwap(ctx context.Context, id string, aut
 		}, nil
 	}
 
-	/* TODO: get offered shift and assigned to user shift */
-
 	requestResponse := model.RequestResponse{
 		ID:           requestSwap.ID,
 		ChannelID:    requestSwap.ChannelID,
@@ -591,6 +605,11 @@ func (r *mutationResolver) DeleteRequestSwap(ctx context.Context, id string, aut
 		ResponseAt:   requestSwap.ResponseAt,
 		CreatedAt:    &requestSwap.CreatedAt,
 		User:         user,
+
+		ShiftToOfferID:   requestSwap.AssignedUserShiftID,
+		ShiftToSwapID:    requestSwap.AssignedUserShiftIDToSwap,
+		ShiftOfferedToID: requestSwap.PeerUserID,
+		ResponseByID:     requestSwap.ResponseByUserID,
 	}
 
 	return &model.RequestSwapResponse{
@@ -607,10 +626,11 @@ func (r *mutationResolver) CancelRequestSwap(ctx context.Context, channelID stri
 	fieldError := "Cancel Request Swap"
 	errorMessage := "Something went wrong while canceling the Request Swap." // default error message
 
+	authUserID = AuthUserID(ctx, authUserID)
 	if authUserID == nil || *authUserID == string("") {
 		errorMessage = "Authenticated user id is required"
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeRequired,
+			Code:    model.ShiftErrorCodeUnauthenticated,
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -625,14 +645,14 @@ func (r *mutationResolver) CancelRequestSwap(ctx context.Context, channelID stri
 	permission := false
 
 	// validate permission
-	permission, err = util.CheckPermission("request_swap", "READ_ALL", *authUserID)
+	permission, err = util.CheckAnyPermission(ctx, "request_swap", []string{"READ_ALL", "READ"}, *authUserID)
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
 		errorMessage = err.Error()
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeInvalid,
+			Code:    util.ShiftErrorCodeOf(err),
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -643,30 +663,10 @@ func (r *mutationResolver) CancelRequestSwap(ctx context.Context, channelID stri
 		}, nil
 	}
 
-	if !permission {
-		permission, err = util.CheckPermission("request_swap", "READ", *authUserID)
-		if err != nil {
-			sentry.CaptureException(err)
-			defer sentry.Flush(2 * time.Second)
-
-			errorMessage = err.Error()
-			shiftError = append(shiftError, &model.ShiftError{
-				Code:    model.ShiftErrorCodeInvalid,
-				Field:   &fieldError,
-				Message: &errorMessage,
-			})
-
-			return &model.RequestSwapResponse{
-				Errors:  shiftError,
-				Request: nil,
-			}, nil
-		}
-	}
-
 	if !permission {
 		errorMessage = "Permission denied: request_swap.READ_ALL, request_swap.READ"
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeRequired,
+			Code:    model.ShiftErrorCodeForbidden,
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -678,22 +678,9 @@ func (r *mutationResolver) CancelRequestSwap(ctx context.Context, channelID stri
 	}
 
 	if channelID == "" || requestID == "" {
-		return nil, errors.New("channelID and requestID are required")
-	}
-
-	var requestSwap model.RequestSwap
-	status := model.RequestStatusCancelled
-
-	// update the request swap and set the status to cancelled
-	err = r.DB.Model(&model.RequestSwap{}).Where("channel_id = ? AND request_id = ?", channelID, requestID).Update("status", status).Error
-
-	if err != nil {
-		sentry.CaptureException(err)
-		defer sentry.Flush(2 * time.Second)
-
-		errorMessage = err.Error()
+		errorMessage = "channelId and requestId are required"
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeInvalid,
+			Code:    model.ShiftErrorCodeValidation,
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -704,15 +691,15 @@ func (r *mutationResolver) CancelRequestSwap(ctx context.Context, channelID stri
 		}, nil
 	}
 
-	// get the updated request swap
-	err = r.DB.Where("channel_id = ? AND request_id = ?", channelID, requestID).First(&requestSwap).Error
+	// cancel the request swap, the state machine decides whether it still can be
+	requestSwap, err := r.changeRequestSwapStatus(ctx, model.RequestStatusCancelled, *authUserID, nil, "channel_id = ? AND request_id = ?", channelID, requestID)
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
 		errorMessage = err.Error()
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeNotFound,
+			Code:    util.ShiftErrorCodeOf(err),
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -723,8 +710,10 @@ func (r *mutationResolver) CancelRequestSwap(ctx context.Context, channelID stri
 		}, nil
 	}
 
+	r.publish(ctx, events.NewRequestSwapEvent(events.RequestSwapCancelled, *requestSwap, authUserID))
+
 	// get the user from the user service and return it
-	user, err := util.GetUser(*authUserID)
+	user, err := util.GetUser(ctx, *authUserID)
 
 	if err != nil {
 		sentry.CaptureException(err)
@@ -733,7 +722,7 @@ func (r *mutationResolver) CancelRequestSwap(ctx context.Context, channelID stri
 		errorMessage = err.Error()
 		shiftError = append(shiftError, &model.ShiftError{
 
-			Code:    model.ShiftErrorCodeInvalid,
+			Code:    util.ShiftErrorCodeOf(err),
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -748,7 +737,7 @@ func (r *mutationResolver) CancelRequestSwap(ctx context.Context, channelID stri
 		errorMessage = "User not found"
 		shiftError = append(shiftError, &model.ShiftError{
 
-			Code:    model.ShiftErrorCodeInvalid,
+			Code:    model.ShiftErrorCodeNotFound,
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -759,8 +748,6 @@ func (r *mutationResolver) CancelRequestSwap(ctx context.Context, channelID stri
 		}, nil
 	}
 
-	/* TODO: get offered shift and assigned to user shift */
-
 	requestResponse := model.RequestResponse{
 		ID:           requestSwap.ID,
 		ChannelID:    requestSwap.ChannelID,
@@ -771,6 +758,11 @@ func (r *mutationResolver) CancelRequestSwap(ctx context.Context, channelID stri
 		ResponseAt:   requestSwap.ResponseAt,
 		CreatedAt:    &requestSwap.CreatedAt,
 		User:         user,
+
+		ShiftToOfferID:   requestSwap.AssignedUserShiftID,
+		ShiftToSwapID:    requestSwap.AssignedUserShiftIDToSwap,
+		ShiftOfferedToID: requestSwap.PeerUserID,
+		ResponseByID:     requestSwap.ResponseByUserID,
 	}
 
 	return &model.RequestSwapResponse{
@@ -785,10 +777,11 @@ func (r *mutationResolver) ApproveRequestSwap(ctx context.Context, id string, re
 	fieldError := "Approve Request Swap"
 	errorMessage := "Something went wrong while approving the Request Swap." // default error message
 
+	authUserID = AuthUserID(ctx, authUserID)
 	if authUserID == nil || *authUserID == string("") {
 		errorMessage = "Authenticated user id is required"
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeRequired,
+			Code:    model.ShiftErrorCodeUnauthenticated,
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -803,14 +796,14 @@ func (r *mutationResolver) ApproveRequestSwap(ctx context.Context, id string, re
 	permission := false
 
 	// validate permission
-	permission, err = util.CheckPermission("request_swap", "MANAGE_ALL", *authUserID)
+	permission, err = util.CheckAnyPermission(ctx, "request_swap", []string{"MANAGE_ALL", "MANAGE"}, *authUserID)
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
 		errorMessage = err.Error()
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeInvalid,
+			Code:    util.ShiftErrorCodeOf(err),
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -821,30 +814,10 @@ func (r *mutationResolver) ApproveRequestSwap(ctx context.Context, id string, re
 		}, nil
 	}
 
-	if !permission {
-		permission, err = util.CheckPermission("request_swap", "MANAGE", *authUserID)
-		if err != nil {
-			sentry.CaptureException(err)
-			defer sentry.Flush(2 * time.Second)
-
-			errorMessage = err.Error()
-			shiftError = append(shiftError, &model.ShiftError{
-				Code:    model.ShiftErrorCodeInvalid,
-				Field:   &fieldError,
-				Message: &errorMessage,
-			})
-
-			return &model.RequestSwapResponse{
-				Errors:  shiftError,
-				Request: nil,
-			}, nil
-		}
-	}
-
 	if !permission {
 		errorMessage = "Permission denied: request_swap.MANAGE, request_swap.MANAGE_ALL"
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeRequired,
+			Code:    model.ShiftErrorCodeForbidden,
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -856,25 +829,9 @@ func (r *mutationResolver) ApproveRequestSwap(ctx context.Context, id string, re
 	}
 
 	if id == "" {
-		return nil, errors.New("id is required")
-	}
-
-	// approve the request swap
-	var requestSwap model.RequestSwap
-	status := model.RequestStatusApproved
-	approve := &model.RequestSwap{
-		Status:       status,
-		ResponseNote: responseNote,
-	}
-	// update the request swap and set the status to approved
-	err = r.DB.Model(&model.RequestSwap{}).Where("id = ?", id).Updates(approve).Error
-	if err != nil {
-		sentry.CaptureException(err)
-		defer sentry.Flush(2 * time.Second)
-
-		errorMessage = err.Error()
+		errorMessage = "Request Swap id is required"
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeInvalid,
+			Code:    model.ShiftErrorCodeValidation,
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -885,15 +842,15 @@ func (r *mutationResolver) ApproveRequestSwap(ctx context.Context, id string, re
 		}, nil
 	}
 
-	// get the updated request swap
-	err = r.DB.Where("id = ?", id).First(&requestSwap).Error
+	// approve the request swap and give each of its shifts to the owner of the other one
+	requestSwap, shifts, err := r.approveRequestSwap(ctx, id, *authUserID, responseNote)
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
 		errorMessage = err.Error()
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeNotFound,
+			Code:    util.ShiftErrorCodeOf(err),
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -904,7 +861,14 @@ func (r *mutationResolver) ApproveRequestSwap(ctx context.Context, id string, re
 		}, nil
 	}
 
-	user, err := util.GetUser(requestSwap.UserID)
+	// shiftToOffer and shiftToSwap resolve to the swapped shifts without fetching them again
+	loaders := LoadersFor(ctx)
+	loaders.AssignedShifts.Prime(shifts.ShiftToOffer.ID, shifts.ShiftToOffer)
+	loaders.AssignedShifts.Prime(shifts.ShiftToSwap.ID, shifts.ShiftToSwap)
+
+	r.publish(ctx, events.NewRequestSwapEvent(events.RequestSwapApproved, *requestSwap, authUserID))
+
+	user, err := util.GetUser(ctx, requestSwap.UserID)
 
 	if err != nil {
 		sentry.CaptureException(err)
@@ -913,7 +877,7 @@ func (r *mutationResolver) ApproveRequestSwap(ctx context.Context, id string, re
 		errorMessage = err.Error()
 		shiftError = append(shiftError, &model.ShiftError{
 
-			Code:    model.ShiftErrorCodeInvalid,
+			Code:    util.ShiftErrorCodeOf(err),
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -928,7 +892,7 @@ func (r *mutationResolver) ApproveRequestSwap(ctx context.Context, id string, re
 		errorMessage = "User not found"
 		shiftError = append(shiftError, &model.ShiftError{
 
-			Code:    model.ShiftErrorCodeInvalid,
+			Code:    model.ShiftErrorCodeNotFound,
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -939,8 +903,6 @@ func (r *mutationResolver) ApproveRequestSwap(ctx context.Context, id string, re
 		}, nil
 	}
 
-	/* TODO: get offered shift and assigned to user shift */
-
 	requestResponse := model.RequestResponse{
 		ID:           requestSwap.ID,
 		ChannelID:    requestSwap.ChannelID,
@@ -951,6 +913,11 @@ func (r *mutationResolver) ApproveRequestSwap(ctx context.Context, id string, re
 		ResponseAt:   requestSwap.ResponseAt,
 		CreatedAt:    &requestSwap.CreatedAt,
 		User:         user,
+
+		ShiftToOfferID:   requestSwap.AssignedUserShiftID,
+		ShiftToSwapID:    requestSwap.AssignedUserShiftIDToSwap,
+		ShiftOfferedToID: requestSwap.PeerUserID,
+		ResponseByID:     requestSwap.ResponseByUserID,
 	}
 
 	return &model.RequestSwapResponse{
@@ -965,10 +932,11 @@ func (r *mutationResolver) DenyRequestSwap(ctx context.Context, id string, respo
 	fieldError := "Deny Request Swap"
 	errorMessage := "Something went wrong while denying the Request Swap." // default error message
 
+	authUserID = AuthUserID(ctx, authUserID)
 	if authUserID == nil || *authUserID == string("") {
 		errorMessage = "Authenticated user id is required"
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeRequired,
+			Code:    model.ShiftErrorCodeUnauthenticated,
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -983,14 +951,14 @@ func (r *mutationResolver) DenyRequestSwap(ctx context.Context, id string, respo
 	permission := false
 
 	// validate permission
-	permission, err = util.CheckPermission("request_swap", "MANAGE_ALL", *authUserID)
+	permission, err = util.CheckAnyPermission(ctx, "request_swap", []string{"MANAGE_ALL", "MANAGE"}, *authUserID)
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
 		errorMessage = err.Error()
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeInvalid,
+			Code:    util.ShiftErrorCodeOf(err),
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -1002,157 +970,798 @@ func (r *mutationResolver) DenyRequestSwap(ctx context.Context, id string, respo
 	}
 
 	if !permission {
-		permission, err = util.CheckPermission("request_swap", "MANAGE", *authUserID)
-		if err != nil {
-
-			sentry.CaptureException(err)
-			defer sentry.Flush(2 * time.Second)
+		errorMessage = "Permission denied: request_swap.MANAGE, request_swap.MANAGE_ALL"
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    model.ShiftErrorCodeForbidden,
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.RequestSwapResponse{
+			Errors:  shiftError,
+			Request: nil,
+		}, nil
+	}
+
+	if id == "" {
+		errorMessage = "Request Swap id is required"
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    model.ShiftErrorCodeValidation,
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.RequestSwapResponse{
+			Errors:  shiftError,
+			Request: nil,
+		}, nil
+	}
+
+	// deny the request swap, only a pending one can be denied
+	requestSwap, err := r.changeRequestSwapStatus(ctx, model.RequestStatusDenied, *authUserID, responseNote, "id = ?", id)
+	if err != nil {
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
+
+		errorMessage = err.Error()
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    util.ShiftErrorCodeOf(err),
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.RequestSwapResponse{
+			Errors:  shiftError,
+			Request: nil,
+		}, nil
+	}
+
+	r.publish(ctx, events.NewRequestSwapEvent(events.RequestSwapDenied, *requestSwap, authUserID))
+
+	user, err := util.GetUser(ctx, requestSwap.UserID)
+
+	if err != nil {
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
+
+		errorMessage = err.Error()
+		shiftError = append(shiftError, &model.ShiftError{
+
+			Code:    util.ShiftErrorCodeOf(err),
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.RequestSwapResponse{
+			Errors:  shiftError,
+			Request: nil,
+		}, nil
+	}
+
+	if user.ID == nil || *user.ID == string("") {
+		errorMessage = "User not found"
+		shiftError = append(shiftError, &model.ShiftError{
+
+			Code:    model.ShiftErrorCodeNotFound,
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.RequestSwapResponse{
+			Errors:  shiftError,
+			Request: nil,
+		}, nil
+	}
+
+	requestResponse := model.RequestResponse{
+		ID:           requestSwap.ID,
+		ChannelID:    requestSwap.ChannelID,
+		RequestID:    *requestSwap.RequestID,
+		RequestNote:  requestSwap.RequestNote,
+		Status:       &requestSwap.Status,
+		ResponseNote: requestSwap.ResponseNote,
+		ResponseAt:   requestSwap.ResponseAt,
+		CreatedAt:    &requestSwap.CreatedAt,
+		User:         user,
+
+		ShiftToOfferID:   requestSwap.AssignedUserShiftID,
+		ShiftToSwapID:    requestSwap.AssignedUserShiftIDToSwap,
+		ShiftOfferedToID: requestSwap.PeerUserID,
+		ResponseByID:     requestSwap.ResponseByUserID,
+	}
+
+	return &model.RequestSwapResponse{
+		Errors:  nil,
+		Request: &requestResponse,
+	}, nil
+}
+
+// AcceptRequestSwap is the resolver for the acceptRequestSwap field.
+func (r *mutationResolver) AcceptRequestSwap(ctx context.Context, id string, responseNote *string, authUserID *string) (*model.RequestSwapResponse, error) {
+	var shiftError []*model.ShiftError
+	fieldError := "Accept Request Swap"
+	errorMessage := "Something went wrong while accepting the Request Swap." // default error message
+
+	authUserID = AuthUserID(ctx, authUserID)
+	if authUserID == nil || *authUserID == string("") {
+		errorMessage = "Authenticated user id is required"
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    model.ShiftErrorCodeUnauthenticated,
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.RequestSwapResponse{
+			Errors:  shiftError,
+			Request: nil,
+		}, nil
+	}
+
+	var err error
+	permission := false
+
+	// validate permission
+	permission, err = util.CheckAnyPermission(ctx, "request_swap", []string{"WRITE_ALL", "WRITE"}, *authUserID)
+	if err != nil {
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
+
+		errorMessage = err.Error()
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    util.ShiftErrorCodeOf(err),
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.RequestSwapResponse{
+			Errors:  shiftError,
+			Request: nil,
+		}, nil
+	}
+
+	if !permission {
+		errorMessage = "Permission denied: request_swap.WRITE, request_swap.WRITE_ALL"
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    model.ShiftErrorCodeForbidden,
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.RequestSwapResponse{
+			Errors:  shiftError,
+			Request: nil,
+		}, nil
+	}
+
+	if id == "" {
+		errorMessage = "Request Swap id is required"
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    model.ShiftErrorCodeValidation,
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.RequestSwapResponse{
+			Errors:  shiftError,
+			Request: nil,
+		}, nil
+	}
+
+	// only the owner of the shift to swap can accept it, then it waits for a manager
+	requestSwap, err := r.respondAsPeer(ctx, id, *authUserID, model.RequestStatusPending, responseNote)
+	if err != nil {
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
+
+		errorMessage = err.Error()
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    util.ShiftErrorCodeOf(err),
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.RequestSwapResponse{
+			Errors:  shiftError,
+			Request: nil,
+		}, nil
+	}
+
+	r.publish(ctx, events.NewRequestSwapEvent(events.RequestSwapPeerAccepted, *requestSwap, authUserID))
+
+	user, err := util.GetUser(ctx, requestSwap.UserID)
+
+	if err != nil {
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
+
+		errorMessage = err.Error()
+		shiftError = append(shiftError, &model.ShiftError{
+
+			Code:    util.ShiftErrorCodeOf(err),
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.RequestSwapResponse{
+			Errors:  shiftError,
+			Request: nil,
+		}, nil
+	}
+
+	if user.ID == nil || *user.ID == string("") {
+		errorMessage = "User not found"
+		shiftError = append(shiftError, &model.ShiftError{
+
+			Code:    model.ShiftErrorCodeNotFound,
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.RequestSwapResponse{
+			Errors:  shiftError,
+			Request: nil,
+		}, nil
+	}
+
+	requestResponse := model.RequestResponse{
+		ID:           requestSwap.ID,
+		ChannelID:    requestSwap.ChannelID,
+		RequestID:    *requestSwap.RequestID,
+		RequestNote:  requestSwap.RequestNote,
+		Status:       &requestSwap.Status,
+		ResponseNote: requestSwap.ResponseNote,
+		ResponseAt:   requestSwap.ResponseAt,
+		CreatedAt:    &requestSwap.CreatedAt,
+		User:         user,
+
+		ShiftToOfferID:   requestSwap.AssignedUserShiftID,
+		ShiftToSwapID:    requestSwap.AssignedUserShiftIDToSwap,
+		ShiftOfferedToID: requestSwap.PeerUserID,
+		ResponseByID:     requestSwap.ResponseByUserID,
+	}
+
+	return &model.RequestSwapResponse{
+		Errors:  nil,
+		Request: &requestResponse,
+	}, nil
+}
+
+// DeclineRequestSwap is the resolver for the declineRequestSwap field.
+func (r *mutationResolver) DeclineRequestSwap(ctx context.Context, id string, responseNote *string, authUserID *string) (*model.RequestSwapResponse, error) {
+	var shiftError []*model.ShiftError
+	fieldError := "Decline Request Swap"
+	errorMessage := "Something went wrong while declining the Request Swap." // default error message
+
+	authUserID = AuthUserID(ctx, authUserID)
+	if authUserID == nil || *authUserID == string("") {
+		errorMessage = "Authenticated user id is required"
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    model.ShiftErrorCodeUnauthenticated,
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.RequestSwapResponse{
+			Errors:  shiftError,
+			Request: nil,
+		}, nil
+	}
+
+	var err error
+	permission := false
+
+	// validate permission
+	permission, err = util.CheckAnyPermission(ctx, "request_swap", []string{"WRITE_ALL", "WRITE"}, *authUserID)
+	if err != nil {
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
+
+		errorMessage = err.Error()
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    util.ShiftErrorCodeOf(err),
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.RequestSwapResponse{
+			Errors:  shiftError,
+			Request: nil,
+		}, nil
+	}
+
+	if !permission {
+		errorMessage = "Permission denied: request_swap.WRITE, request_swap.WRITE_ALL"
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    model.ShiftErrorCodeForbidden,
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.RequestSwapResponse{
+			Errors:  shiftError,
+			Request: nil,
+		}, nil
+	}
+
+	if id == "" {
+		errorMessage = "Request Swap id is required"
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    model.ShiftErrorCodeValidation,
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.RequestSwapResponse{
+			Errors:  shiftError,
+			Request: nil,
+		}, nil
+	}
+
+	// only the owner of the shift to swap can decline it, which ends the request swap
+	requestSwap, err := r.respondAsPeer(ctx, id, *authUserID, model.RequestStatusPeerDeclined, responseNote)
+	if err != nil {
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
+
+		errorMessage = err.Error()
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    util.ShiftErrorCodeOf(err),
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.RequestSwapResponse{
+			Errors:  shiftError,
+			Request: nil,
+		}, nil
+	}
+
+	r.publish(ctx, events.NewRequestSwapEvent(events.RequestSwapPeerDeclined, *requestSwap, authUserID))
+
+	user, err := util.GetUser(ctx, requestSwap.UserID)
+
+	if err != nil {
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
+
+		errorMessage = err.Error()
+		shiftError = append(shiftError, &model.ShiftError{
+
+			Code:    util.ShiftErrorCodeOf(err),
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.RequestSwapResponse{
+			Errors:  shiftError,
+			Request: nil,
+		}, nil
+	}
+
+	if user.ID == nil || *user.ID == string("") {
+		errorMessage = "User not found"
+		shiftError = append(shiftError, &model.ShiftError{
+
+			Code:    model.ShiftErrorCodeNotFound,
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.RequestSwapResponse{
+			Errors:  shiftError,
+			Request: nil,
+		}, nil
+	}
+
+	requestResponse := model.RequestResponse{
+		ID:           requestSwap.ID,
+		ChannelID:    requestSwap.ChannelID,
+		RequestID:    *requestSwap.RequestID,
+		RequestNote:  requestSwap.RequestNote,
+		Status:       &requestSwap.Status,
+		ResponseNote: requestSwap.ResponseNote,
+		ResponseAt:   requestSwap.ResponseAt,
+		CreatedAt:    &requestSwap.CreatedAt,
+		User:         user,
+
+		ShiftToOfferID:   requestSwap.AssignedUserShiftID,
+		ShiftToSwapID:    requestSwap.AssignedUserShiftIDToSwap,
+		ShiftOfferedToID: requestSwap.PeerUserID,
+		ResponseByID:     requestSwap.ResponseByUserID,
+	}
+
+	return &model.RequestSwapResponse{
+		Errors:  nil,
+		Request: &requestResponse,
+	}, nil
+}
+
+// ApproveRequestSwaps is the resolver for the approveRequestSwaps field.
+func (r *mutationResolver) ApproveRequestSwaps(ctx context.Context, ids []string, responseNote *string, authUserID *string) (*model.BulkRequestSwapResponse, error) {
+	var shiftError []*model.ShiftError
+	fieldError := "Approve Request Swaps"
+	errorMessage := "Something went wrong while approving the Request Swaps." // default error message
+
+	authUserID = AuthUserID(ctx, authUserID)
+	if authUserID == nil || *authUserID == string("") {
+		errorMessage = "Authenticated user id is required"
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    model.ShiftErrorCodeUnauthenticated,
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.BulkRequestSwapResponse{
+			Errors:  shiftError,
+			Results: nil,
+		}, nil
+	}
+
+	// validate permission, once for all request swaps
+	permission, err := util.CheckAnyPermission(ctx, "request_swap", []string{"MANAGE_ALL", "MANAGE"}, *authUserID)
+	if err != nil {
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
+
+		errorMessage = err.Error()
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    util.ShiftErrorCodeOf(err),
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.BulkRequestSwapResponse{
+			Errors:  shiftError,
+			Results: nil,
+		}, nil
+	}
+
+	if !permission {
+		errorMessage = "Permission denied: request_swap.MANAGE, request_swap.MANAGE_ALL"
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    model.ShiftErrorCodeForbidden,
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.BulkRequestSwapResponse{
+			Errors:  shiftError,
+			Results: nil,
+		}, nil
+	}
+
+	ids, err = bulkIDs(ids)
+	if err != nil {
+		errorMessage = err.Error()
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    util.ShiftErrorCodeOf(err),
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.BulkRequestSwapResponse{
+			Errors:  shiftError,
+			Results: nil,
+		}, nil
+	}
+
+	// each request swap is approved and its shifts swapped on its own, like approveRequestSwap
+	loaders := LoadersFor(ctx)
+	return r.changeRequestSwaps(ctx, ids, fieldError, func(ctx context.Context, id string) (*model.RequestSwap, error) {
+		requestSwap, shifts, err := r.approveRequestSwap(ctx, id, *authUserID, responseNote)
+		if err != nil {
+			return nil, err
+		}
+
+		loaders.AssignedShifts.Prime(shifts.ShiftToOffer.ID, shifts.ShiftToOffer)
+		loaders.AssignedShifts.Prime(shifts.ShiftToSwap.ID, shifts.ShiftToSwap)
+
+		r.publish(ctx, events.NewRequestSwapEvent(events.RequestSwapApproved, *requestSwap, authUserID))
+		return requestSwap, nil
+	}), nil
+}
+
+// DenyRequestSwaps is the resolver for the denyRequestSwaps field.
+func (r *mutationResolver) DenyRequestSwaps(ctx context.Context, ids []string, responseNote *string, authUserID *string) (*model.BulkRequestSwapResponse, error) {
+	var shiftError []*model.ShiftError
+	fieldError := "Deny Request Swaps"
+	errorMessage := "Something went wrong while denying the Request Swaps." // default error message
+
+	authUserID = AuthUserID(ctx, authUserID)
+	if authUserID == nil || *authUserID == string("") {
+		errorMessage = "Authenticated user id is required"
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    model.ShiftErrorCodeUnauthenticated,
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.BulkRequestSwapResponse{
+			Errors:  shiftError,
+			Results: nil,
+		}, nil
+	}
+
+	// validate permission, once for all request swaps
+	permission, err := util.CheckAnyPermission(ctx, "request_swap", []string{"MANAGE_ALL", "MANAGE"}, *authUserID)
+	if err != nil {
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
+
+		errorMessage = err.Error()
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    util.ShiftErrorCodeOf(err),
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.BulkRequestSwapResponse{
+			Errors:  shiftError,
+			Results: nil,
+		}, nil
+	}
+
+	if !permission {
+		errorMessage = "Permission denied: request_swap.MANAGE, request_swap.MANAGE_ALL"
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    model.ShiftErrorCodeForbidden,
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.BulkRequestSwapResponse{
+			Errors:  shiftError,
+			Results: nil,
+		}, nil
+	}
+
+	ids, err = bulkIDs(ids)
+	if err != nil {
+		errorMessage = err.Error()
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    util.ShiftErrorCodeOf(err),
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.BulkRequestSwapResponse{
+			Errors:  shiftError,
+			Results: nil,
+		}, nil
+	}
+
+	// each request swap is denied on its own, only a pending one can be denied
+	return r.changeRequestSwaps(ctx, ids, fieldError, func(ctx context.Context, id string) (*model.RequestSwap, error) {
+		requestSwap, err := r.changeRequestSwapStatus(ctx, model.RequestStatusDenied, *authUserID, responseNote, "id = ?", id)
+		if err != nil {
+			return nil, err
+		}
+
+		r.publish(ctx, events.NewRequestSwapEvent(events.RequestSwapDenied, *requestSwap, authUserID))
+		return requestSwap, nil
+	}), nil
+}
+
+// AddRequestComment is the resolver for the addRequestComment field.
+func (r *mutationResolver) AddRequestComment(ctx context.Context, id string, body string, authUserID *string) (*model.RequestCommentResponse, error) {
+	var shiftError []*model.ShiftError
+	fieldError := "Add Request Comment"
+	errorMessage := "Something went wrong while adding the comment." // default error message
+
+	authUserID = AuthUserID(ctx, authUserID)
+	if authUserID == nil || *authUserID == string("") {
+		errorMessage = "Authenticated user id is required"
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    model.ShiftErrorCodeUnauthenticated,
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.RequestCommentResponse{
+			Errors:  shiftError,
+			Comment: nil,
+		}, nil
+	}
+
+	// validate permission; managers and users reading all request swaps comment on any of them
+	privileged, err := util.CheckAnyPermission(ctx, "request_swap", []string{"READ_ALL", "MANAGE_ALL", "MANAGE"}, *authUserID)
+	if err != nil {
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
+
+		errorMessage = err.Error()
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    util.ShiftErrorCodeOf(err),
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.RequestCommentResponse{
+			Errors:  shiftError,
+			Comment: nil,
+		}, nil
+	}
+
+	permission := privileged
+	if !permission {
+		permission, err = util.CheckPermission(ctx, "request_swap", "READ", *authUserID)
+		if err != nil {
+			sentry.CaptureException(err)
+			defer sentry.Flush(2 * time.Second)
 
 			errorMessage = err.Error()
 			shiftError = append(shiftError, &model.ShiftError{
-				Code:    model.ShiftErrorCodeInvalid,
+				Code:    util.ShiftErrorCodeOf(err),
 				Field:   &fieldError,
 				Message: &errorMessage,
 			})
 
-			return &model.RequestSwapResponse{
+			return &model.RequestCommentResponse{
 				Errors:  shiftError,
-				Request: nil,
+				Comment: nil,
 			}, nil
 		}
 	}
 
 	if !permission {
-		errorMessage = "Permission denied: request_swap.MANAGE, request_swap.MANAGE_ALL"
+		errorMessage = "Permission denied: request_swap.READ, request_swap.READ_ALL"
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeInvalid,
+			Code:    model.ShiftErrorCodeForbidden,
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
 
-		return &model.RequestSwapResponse{
+		return &model.RequestCommentResponse{
 			Errors:  shiftError,
-			Request: nil,
+			Comment: nil,
 		}, nil
 	}
 
 	if id == "" {
-		return nil, errors.New("id is required")
-	}
+		errorMessage = "Request Swap id is required"
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    model.ShiftErrorCodeValidation,
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
 
-	// approve the request swap
-	var requestSwap model.RequestSwap
-	status := model.RequestStatusDenied
-	
-	deny := &model.RequestSwap{
-		Status:       status,
-		ResponseNote: responseNote,
+		return &model.RequestCommentResponse{
+			Errors:  shiftError,
+			Comment: nil,
+		}, nil
 	}
 
-	// update the request swap and set the status to approved
-	err = r.DB.Model(&model.RequestSwap{}).Where("id = ?", id).Updates(deny).Error
+	// the others only comment on the request swaps they take part in
+	requestSwap, comment, err := r.addRequestSwapComment(ctx, id, *authUserID, body, !privileged)
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
 		errorMessage = err.Error()
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeInvalid,
+			Code:    util.ShiftErrorCodeOf(err),
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
 
-		return &model.RequestSwapResponse{
+		return &model.RequestCommentResponse{
 			Errors:  shiftError,
-			Request: nil,
+			Comment: nil,
 		}, nil
 	}
 
-	// get the updated request swap
-	err = r.DB.Where("id = ?", id).First(&requestSwap).Error
-	if err != nil {
-		sentry.CaptureException(err)
-		defer sentry.Flush(2 * time.Second)
+	r.publish(ctx, events.NewRequestSwapCommentEvent(*requestSwap, *comment))
 
-		errorMessage = err.Error()
+	return &model.RequestCommentResponse{
+		Errors:  nil,
+		Comment: comment,
+	}, nil
+}
+
+// SetRequestExpirySettings is the resolver for the setRequestExpirySettings field.
+func (r *mutationResolver) SetRequestExpirySettings(ctx context.Context, channelID string, input model.RequestExpirySettingsInput, authUserID *string) (*model.RequestExpirySettingsResponse, error) {
+	var shiftError []*model.ShiftError
+	fieldError := "Set Request Expiry Settings"
+	errorMessage := "Something went wrong while setting the Request Expiry Settings." // default error message
+
+	authUserID = AuthUserID(ctx, authUserID)
+	if authUserID == nil || *authUserID == string("") {
+		errorMessage = "Authenticated user id is required"
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeNotFound,
+			Code:    model.ShiftErrorCodeUnauthenticated,
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
 
-		return &model.RequestSwapResponse{
-			Errors:  shiftError,
-			Request: nil,
+		return &model.RequestExpirySettingsResponse{
+			Errors:   shiftError,
+			Settings: nil,
 		}, nil
 	}
 
-	user, err := util.GetUser(requestSwap.UserID)
-
+	// validate permission
+	permission, err := util.CheckPermission(ctx, "request_swap", "WRITE_ALL", *authUserID)
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
 		errorMessage = err.Error()
 		shiftError = append(shiftError, &model.ShiftError{
-
-			Code:    model.ShiftErrorCodeInvalid,
+			Code:    util.ShiftErrorCodeOf(err),
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
 
-		return &model.RequestSwapResponse{
-			Errors:  shiftError,
-			Request: nil,
+		return &model.RequestExpirySettingsResponse{
+			Errors:   shiftError,
+			Settings: nil,
 		}, nil
 	}
 
-	if user.ID == nil || *user.ID == string("") {
-		errorMessage = "User not found"
+	if !permission {
+		errorMessage = "Permission denied: request_swap.WRITE_ALL"
 		shiftError = append(shiftError, &model.ShiftError{
+			Code:    model.ShiftErrorCodeForbidden,
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.RequestExpirySettingsResponse{
+			Errors:   shiftError,
+			Settings: nil,
+		}, nil
+	}
 
-			Code:    model.ShiftErrorCodeInvalid,
+	if channelID == "" || input.GracePeriodMinutes < 0 {
+		errorMessage = "channelId and a gracePeriodMinutes of at least 0 are required"
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    model.ShiftErrorCodeValidation,
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
 
-		return &model.RequestSwapResponse{
-			Errors:  shiftError,
-			Request: nil,
+		return &model.RequestExpirySettingsResponse{
+			Errors:   shiftError,
+			Settings: nil,
 		}, nil
 	}
 
-	/* TODO: get offered shift and assigned to user shift */
+	settings, err := r.Expiry.SetSettings(ctx, channelID, input, *authUserID)
+	if err != nil {
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
 
-	requestResponse := model.RequestResponse{
-		ID:           requestSwap.ID,
-		ChannelID:    requestSwap.ChannelID,
-		RequestID:    *requestSwap.RequestID,
-		RequestNote:  requestSwap.RequestNote,
-		Status:       &requestSwap.Status,
-		ResponseNote: requestSwap.ResponseNote,
-		ResponseAt:   requestSwap.ResponseAt,
-		CreatedAt:    &requestSwap.CreatedAt,
-		User:         user,
+		errorMessage = err.Error()
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    util.ShiftErrorCodeOf(err),
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.RequestExpirySettingsResponse{
+			Errors:   shiftError,
+			Settings: nil,
+		}, nil
 	}
 
-	return &model.RequestSwapResponse{
-		Errors:  nil,
-		Request: &requestResponse,
+	return &model.RequestExpirySettingsResponse{
+		Errors:   nil,
+		Settings: settings,
 	}, nil
 }
 
 // GetRequestsSwaps is the resolver for the getRequestsSwaps field.
 func (r *queryResolver) GetRequestsSwaps(ctx context.Context, channelID string, authUserID *string) ([]*model.RequestSwap, error) {
+	authUserID = AuthUserID(ctx, authUserID)
 	if authUserID == nil || *authUserID == string("") {
-		return nil, fmt.Errorf("Authenticated user id is required")
+		return nil, util.NewError(util.ErrorCodeUnauthenticated, "Authenticated user id is required")
 	}
 
 	var err error
 	permission := false
 
 	// validate permission
-	permission, err = util.CheckPermission("request_swap", "READ_ALL", *authUserID)
+	permission, err = util.CheckPermission(ctx, "request_swap", "READ_ALL", *authUserID)
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
@@ -1161,18 +1770,18 @@ func (r *queryResolver) GetRequestsSwaps(ctx context.Context, channelID string,
 	}
 
 	// if !permission {
-	// 	permission, err = util.CheckPermission("request_swap", "READ", *authUserID)
+	// 	permission, err = util.CheckPermission(ctx, "request_swap", "READ", *authUserID)
 	// 	if err != nil {
 	// 		return nil, err
 	// 	}
 	// }
 
 	if !permission {
-		return nil, fmt.Errorf("Permission denied: request_swap.READ_ALL")
+		return nil, util.NewError(util.ErrorCodeForbidden, "Permission denied: request_swap.READ_ALL")
 	}
 
 	if channelID == "" {
-		return nil, errors.New("channelID is required")
+		return nil, util.NewError(util.ErrorCodeValidation, "channelID is required")
 	}
 
 	var requestSwaps []*model.RequestSwap
@@ -1188,14 +1797,93 @@ func (r *queryResolver) GetRequestsSwaps(ctx context.Context, channelID string,
 	return requestSwaps, nil
 }
 
+// GetRequestSwapsConnection is the resolver for the getRequestSwapsConnection field.
+func (r *queryResolver) GetRequestSwapsConnection(ctx context.Context, channelID string, filter *model.RequestFilter, orderBy *model.RequestOrder, first *int, after *string, authUserID *string) (*model.RequestSwapConnection, error) {
+	authUserID = AuthUserID(ctx, authUserID)
+	if authUserID == nil || *authUserID == string("") {
+		return nil, util.NewError(util.ErrorCodeUnauthenticated, "Authenticated user id is required")
+	}
+
+	// validate permission
+	readAll, err := util.CheckPermission(ctx, "request_swap", "READ_ALL", *authUserID)
+	if err != nil {
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
+
+		return nil, err
+	}
+
+	permission := readAll
+	if !permission {
+		permission, err = util.CheckPermission(ctx, "request_swap", "READ", *authUserID)
+		if err != nil {
+			sentry.CaptureException(err)
+			defer sentry.Flush(2 * time.Second)
+
+			return nil, err
+		}
+	}
+
+	if !permission {
+		return nil, util.NewError(util.ErrorCodeForbidden, "Permission denied: request_swap.READ, request_swap.READ_ALL")
+	}
+
+	if channelID == "" {
+		return nil, util.NewError(util.ErrorCodeValidation, "channelID is required")
+	}
+
+	// without READ_ALL users only list their own request swaps
+	if !readAll {
+		if filter == nil {
+			filter = &model.RequestFilter{}
+		}
+		if filter.UserID != nil && *filter.UserID != *authUserID {
+			return nil, util.NewError(util.ErrorCodeForbidden, "Permission denied: request_swap.READ_ALL")
+		}
+		filter.UserID = authUserID
+	}
+
+	page, err := newPage(orderBy, first, after)
+	if err != nil {
+		return nil, err
+	}
+
+	var requestSwaps []*model.RequestSwap
+	query := applyRequestFilter(r.DB.Where("channel_id = ?", channelID), filter, "start_time", "end_time")
+	err = page.apply(query).Find(&requestSwaps).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
+
+		return nil, err
+	}
+
+	count, pageInfo := page.pageInfo(len(requestSwaps), func(i int) string {
+		return page.cursor(page.orderValue(requestSwaps[i]), requestSwaps[i].ID)
+	})
+
+	edges := make([]*model.RequestSwapEdge, 0, count)
+	for _, requestSwap := range requestSwaps[:count] {
+		edges = append(edges, &model.RequestSwapEdge{
+			Cursor: page.cursor(page.orderValue(requestSwap), requestSwap.ID),
+			Node:   requestSwap,
+		})
+	}
+
+	return &model.RequestSwapConnection{
+		Edges:    edges,
+		PageInfo: pageInfo,
+	}, nil
+}
+
 // GetRequestsSwapsByChannelIDRequestID is the resolver for the getRequestsSwapsByChannelIdRequestId field.
 func (r *queryResolver) GetRequestsSwapsByChannelIDRequestID(ctx context.Context, channelID string, requestID string) (*model.RequestSwap, error) {
 	if channelID == "" {
-		return nil, errors.New("channel ID is required")
+		return nil, util.NewError(util.ErrorCodeValidation, "channel ID is required")
 	}
 
 	if requestID == "" {
-		return nil, errors.New("request ID is required")
+		return nil, util.NewError(util.ErrorCodeValidation, "request ID is required")
 	}
 
 	var requestSwaps *model.RequestSwap
@@ -1213,15 +1901,87 @@ func (r *queryResolver) GetRequestsSwapsByChannelIDRequestID(ctx context.Context
 
 // GetRequestSwap is the resolver for the getRequestSwap field.
 func (r *queryResolver) GetRequestSwap(ctx context.Context, id string, authUserID *string) (*model.RequestSwap, error) {
+	authUserID = AuthUserID(ctx, authUserID)
 	if authUserID == nil || *authUserID == string("") {
-		return nil, fmt.Errorf("Authenticated user id is required")
+		return nil, util.NewError(util.ErrorCodeUnauthenticated, "Authenticated user id is required")
 	}
 
 	var err error
 	permission := false
 
 	// validate permission
-	permission, err = util.CheckPermission("request_swap", "READ", *authUserID)
+	permission, err = util.CheckAnyPermission(ctx, "request_swap", []string{"READ", "READ_ALL"}, *authUserID)
+	if err != nil {
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
+
+		return nil, err
+	}
+
+	if !permission {
+		return nil, util.NewError(util.ErrorCodeForbidden, "Permission denied: request_swap.READ, request_swap.READ_ALL")
+	}
+
+	if id == "" {
+		return nil, util.NewError(util.ErrorCodeValidation, "id is required")
+	}
+
+	var requestSwap model.RequestSwap
+	err = r.DB.Where("id = ?", id).First(&requestSwap).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
+
+		return nil, err
+	}
+
+	return &requestSwap, nil
+}
+
+// GetRequestExpirySettings is the resolver for the getRequestExpirySettings field.
+func (r *queryResolver) GetRequestExpirySettings(ctx context.Context, channelID string, authUserID *string) (*model.RequestExpirySettings, error) {
+	authUserID = AuthUserID(ctx, authUserID)
+	if authUserID == nil || *authUserID == string("") {
+		return nil, util.NewError(util.ErrorCodeUnauthenticated, "Authenticated user id is required")
+	}
+
+	// validate permission
+	permission, err := util.CheckAnyPermission(ctx, "request_swap", []string{"READ", "READ_ALL"}, *authUserID)
+	if err != nil {
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
+
+		return nil, err
+	}
+
+	if !permission {
+		return nil, util.NewError(util.ErrorCodeForbidden, "Permission denied: request_swap.READ, request_swap.READ_ALL")
+	}
+
+	if channelID == "" {
+		return nil, util.NewError(util.ErrorCodeValidation, "channelID is required")
+	}
+
+	settings, err := r.Expiry.Settings(ctx, channelID)
+	if err != nil {
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
+
+		return nil, err
+	}
+
+	return settings, nil
+}
+
+// SuggestSwapCandidates is the resolver for the suggestSwapCandidates field.
+func (r *queryResolver) SuggestSwapCandidates(ctx context.Context, assignedShiftID string, limit *int, authUserID *string) ([]*model.SwapCandidate, error) {
+	authUserID = AuthUserID(ctx, authUserID)
+	if authUserID == nil || *authUserID == string("") {
+		return nil, util.NewError(util.ErrorCodeUnauthenticated, "Authenticated user id is required")
+	}
+
+	// validate permission
+	readAll, err := util.CheckPermission(ctx, "request_swap", "READ_ALL", *authUserID)
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
@@ -1229,8 +1989,9 @@ func (r *queryResolver) GetRequestSwap(ctx context.Context, id string, authUserI
 		return nil, err
 	}
 
+	permission := readAll
 	if !permission {
-		permission, err = util.CheckPermission("request_swap", "READ_ALL", *authUserID)
+		permission, err = util.CheckPermission(ctx, "request_swap", "READ", *authUserID)
 		if err != nil {
 			sentry.CaptureException(err)
 			defer sentry.Flush(2 * time.Second)
@@ -1240,15 +2001,44 @@ func (r *queryResolver) GetRequestSwap(ctx context.Context, id string, authUserI
 	}
 
 	if !permission {
-		return nil, fmt.Errorf("Permission denied: request_swap.READ, request_swap.READ_ALL")
+		return nil, util.NewError(util.ErrorCodeForbidden, "Permission denied: request_swap.READ, request_swap.READ_ALL")
 	}
 
-	if id == "" {
-		return nil, errors.New("id is required")
+	if assignedShiftID == "" {
+		return nil, util.NewError(util.ErrorCodeValidation, "assignedShiftId is required")
 	}
 
-	var requestSwap model.RequestSwap
-	err = r.DB.Where("id = ?", id).First(&requestSwap).Error
+	count := defaultSwapCandidateLimit
+	if limit != nil {
+		count = *limit
+	}
+	if count < 1 || count > maxSwapCandidateLimit {
+		return nil, util.NewError(util.ErrorCodeValidation, fmt.Sprintf("limit must be between 1 and %d", maxSwapCandidateLimit))
+	}
+
+	shift, err := util.GetAssignedShift(ctx, assignedShiftID)
+	if err != nil {
+		return nil, err
+	}
+
+	if shift.UserID == nil || *shift.UserID == "" {
+		return nil, util.NewError(util.ErrorCodeValidation, "The shift is not assigned to anyone")
+	}
+
+	// without READ_ALL users only look for candidates for their own shifts
+	if !readAll && *shift.UserID != *authUserID {
+		return nil, util.NewError(util.ErrorCodeForbidden, "Permission denied: request_swap.READ_ALL")
+	}
+
+	if shift.ChannelID == nil || shift.ShiftGroupID == nil {
+		return nil, util.NewError(util.ErrorCodeValidation, "The shift is not in a shift group")
+	}
+
+	if !shift.StartTime.After(time.Now()) {
+		return nil, util.NewError(util.ErrorCodeValidation, "The shift has already started")
+	}
+
+	candidates, err := suggestSwapCandidates(ctx, shift, count, *authUserID)
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
@@ -1256,7 +2046,68 @@ func (r *queryResolver) GetRequestSwap(ctx context.Context, id string, authUserI
 		return nil, err
 	}
 
-	return &requestSwap, nil
+	return candidates, nil
+}
+
+// User is the resolver for the user field.
+func (r *requestCommentResolver) User(ctx context.Context, obj *model.RequestComment) (*model.User, error) {
+	return loadUser(ctx, &obj.UserID)
+}
+
+// Actor is the resolver for the actor field.
+func (r *requestHistoryEntryResolver) Actor(ctx context.Context, obj *model.RequestHistoryEntry) (*model.User, error) {
+	return loadUser(ctx, obj.ActorUserID)
+}
+
+// ResponseBy is the resolver for the responseBy field.
+func (r *requestResponseResolver) ResponseBy(ctx context.Context, obj *model.RequestResponse) (*model.User, error) {
+	return loadUser(ctx, obj.ResponseByID)
+}
+
+// ShiftOfferedTo is the resolver for the shiftOfferedTo field.
+func (r *requestResponseResolver) ShiftOfferedTo(ctx context.Context, obj *model.RequestResponse) (*model.User, error) {
+	return loadUser(ctx, obj.ShiftOfferedToID)
+}
+
+// ShiftToOffer is the resolver for the shiftToOffer field.
+func (r *requestResponseResolver) ShiftToOffer(ctx context.Context, obj *model.RequestResponse) (*model.AssignedShift, error) {
+	return loadAssignedShift(ctx, obj.ShiftToOfferID)
+}
+
+// ShiftToSwap is the resolver for the shiftToSwap field.
+func (r *requestResponseResolver) ShiftToSwap(ctx context.Context, obj *model.RequestResponse) (*model.AssignedShift, error) {
+	return loadAssignedShift(ctx, obj.ShiftToSwapID)
+}
+
+// ToSwapWith is the resolver for the toSwapWith field.
+func (r *requestResponseResolver) ToSwapWith(ctx context.Context, obj *model.RequestResponse) (*model.AssignedShift, error) {
+	// the shift the request swaps with, the same as shiftToSwap
+	return loadAssignedShift(ctx, obj.ShiftToSwapID)
+}
+
+// History is the resolver for the history field.
+func (r *requestResponseResolver) History(ctx context.Context, obj *model.RequestResponse) ([]*model.RequestHistoryEntry, error) {
+	return loadHistory(ctx, obj.ID)
+}
+
+// Comments is the resolver for the comments field.
+func (r *requestResponseResolver) Comments(ctx context.Context, obj *model.RequestResponse) ([]*model.RequestComment, error) {
+	return loadComments(ctx, obj.ID)
+}
+
+// History is the resolver for the history field.
+func (r *requestSwapResolver) History(ctx context.Context, obj *model.RequestSwap) ([]*model.RequestHistoryEntry, error) {
+	return loadHistory(ctx, obj.ID)
+}
+
+// Comments is the resolver for the comments field.
+func (r *requestSwapResolver) Comments(ctx context.Context, obj *model.RequestSwap) ([]*model.RequestComment, error) {
+	return loadComments(ctx, obj.ID)
+}
+
+// User is the resolver for the user field.
+func (r *swapCandidateResolver) User(ctx context.Context, obj *model.SwapCandidate) (*model.User, error) {
+	return loadUser(ctx, &obj.UserID)
 }
 
 // Mutation returns generated.MutationResolver implementation.
@@ -1265,5 +2116,31 @@ func (r *Resolver) Mutation() generated.MutationResolver { return &mutationResol
 // Query returns generated.QueryResolver implementation.
 func (r *Resolver) Query() generated.QueryResolver { return &queryResolver{r} }
 
+// RequestComment returns generated.RequestCommentResolver implementation.
+func (r *Resolver) RequestComment() generated.RequestCommentResolver {
+	return &requestCommentResolver{r}
+}
+
+// RequestHistoryEntry returns generated.RequestHistoryEntryResolver implementation.
+func (r *Resolver) RequestHistoryEntry() generated.RequestHistoryEntryResolver {
+	return &requestHistoryEntryResolver{r}
+}
+
+// RequestResponse returns generated.RequestResponseResolver implementation.
+func (r *Resolver) RequestResponse() generated.RequestResponseResolver {
+	return &requestResponseResolver{r}
+}
+
+// RequestSwap returns generated.RequestSwapResolver implementation.
+func (r *Resolver) RequestSwap() generated.RequestSwapResolver { return &requestSwapResolver{r} }
+
+// SwapCandidate returns generated.SwapCandidateResolver implementation.
+func (r *Resolver) SwapCandidate() generated.SwapCandidateResolver { return &swapCandidateResolver{r} }
+
 type mutationResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
+type requestCommentResolver struct{ *Resolver }
+type requestHistoryEntryResolver struct{ *Resolver }
+type requestResponseResolver struct{ *Resolver }
+type requestSwapResolver struct{ *Resolver }
+type swapCandidateResolver struct{ *Resolver }
@@ -0,0 +1,72 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"request_swaps/graph/model"
+	"request_swaps/util"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// setRequestSwapStatus moves the requestSwap, locked by the caller, to status when
+// the state machine allows it, stamps the acting user and the time and records
+// the change in the history
+func setRequestSwapStatus(tx *gorm.DB, requestSwap *model.RequestSwap, status model.RequestStatus, responseByUserID string, responseNote *string) error {
+	if !requestSwap.Status.CanTransitionTo(status) {
+		return util.NewError(util.ErrorCodeConflict, fmt.Sprintf("Request swap is %s and cannot become %s", requestSwap.Status, status))
+	}
+
+	responseAt := time.Now().UTC()
+	updates := map[string]interface{}{
+		"status":              status,
+		"response_by_user_id": responseByUserID,
+		"response_at":         responseAt,
+	}
+	if responseNote != nil {
+		updates["response_note"] = *responseNote
+	}
+
+	if err := tx.Model(requestSwap).Updates(updates).Error; err != nil {
+		return err
+	}
+
+	entries := []*model.RequestHistoryEntry{newStatusEntry(requestSwap.ID, requestSwap.Status, status, &responseByUserID)}
+	if responseNote != nil && (requestSwap.ResponseNote == nil || *requestSwap.ResponseNote != *responseNote) {
+		entries = append(entries, newHistoryEntry(requestSwap.ID, model.RequestHistoryActionUpdated, "responseNote", requestSwap.ResponseNote, responseNote, &responseByUserID))
+	}
+	if err := recordHistory(tx, entries...); err != nil {
+		return err
+	}
+
+	requestSwap.Status = status
+	requestSwap.ResponseByUserID = &responseByUserID
+	requestSwap.ResponseAt = &responseAt
+	if responseNote != nil {
+		requestSwap.ResponseNote = responseNote
+	}
+
+	return nil
+}
+
+// changeRequestSwapStatus locks the requestSwap matching the query while it is
+// moved to status, so concurrent changes cannot skip the state machine
+func (r *Resolver) changeRequestSwapStatus(ctx context.Context, status model.RequestStatus, responseByUserID string, responseNote *string, query string, args ...interface{}) (*model.RequestSwap, error) {
+	var requestSwap model.RequestSwap
+
+	err := r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where(query, args...).First(&requestSwap).Error
+		if err != nil {
+			return err
+		}
+
+		return setRequestSwapStatus(tx, &requestSwap, status, responseByUserID, responseNote)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &requestSwap, nil
+}
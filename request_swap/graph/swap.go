@@ -0,0 +1,336 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"request_swaps/graph/model"
+	"request_swaps/util"
+	"time"
+
+	"github.com/getsentry/sentry-go"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// SwappedShifts are the shifts of an approved request swap after they were
+// reassigned: ShiftToOffer now belongs to the owner of ShiftToSwap and the
+// other way around
+type SwappedShifts struct {
+	ShiftToOffer *model.AssignedShift
+	ShiftToSwap  *model.AssignedShift
+}
+
+// approveRequestSwap approves the pending request swap and reassigns its shifts in
+// the assigned-shift service. The request swap stays locked meanwhile; when
+// a reassignment fails the status change is rolled back and the shifts that
+// were reassigned already are given back.
+func (r *Resolver) approveRequestSwap(ctx context.Context, id string, responseByUserID string, responseNote *string) (*model.RequestSwap, *SwappedShifts, error) {
+	var requestSwap model.RequestSwap
+	var shifts *SwappedShifts
+	var undo func(ctx context.Context) error
+
+	err := r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", id).First(&requestSwap).Error
+		if err != nil {
+			return err
+		}
+
+		if requestSwap.AssignedUserShiftID == nil || requestSwap.AssignedUserShiftIDToSwap == nil {
+			return util.NewError(util.ErrorCodeValidation, "The request swap has no shift to swap with")
+		}
+
+		err = setRequestSwapStatus(tx, &requestSwap, model.RequestStatusApproved, responseByUserID, responseNote)
+		if err != nil {
+			return err
+		}
+
+		shifts, undo, err = swapAssignedShifts(ctx, &requestSwap)
+		return err
+	})
+	if err != nil {
+		// the shifts were swapped but the approval could not be committed
+		if undo != nil {
+			compensationContext, cancel := context.WithTimeout(context.Background(), compensationTimeout)
+			defer cancel()
+
+			if undoErr := undo(compensationContext); undoErr != nil {
+				sentry.CaptureException(undoErr)
+				log.Printf("request swap %s: unable to give the shifts back: %v", id, undoErr)
+			}
+		}
+
+		return nil, nil, err
+	}
+
+	return &requestSwap, shifts, nil
+}
+
+// respondAsPeer moves the request swap awaiting the peer to status. Only the
+// peer, the owner of the shift to swap, may answer it.
+func (r *Resolver) respondAsPeer(ctx context.Context, id string, peerUserID string, status model.RequestStatus, responseNote *string) (*model.RequestSwap, error) {
+	var requestSwap model.RequestSwap
+
+	err := r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", id).First(&requestSwap).Error
+		if err != nil {
+			return err
+		}
+
+		if requestSwap.PeerUserID == nil || *requestSwap.PeerUserID != peerUserID {
+			return util.NewError(util.ErrorCodeForbidden, "Only the owner of the shift to swap can answer the request swap")
+		}
+
+		return setRequestSwapStatus(tx, &requestSwap, status, peerUserID, responseNote)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &requestSwap, nil
+}
+
+// swapAssignedShifts gives each shift of the request swap to the owner of
+// the other one. The shifts must still belong to the requester and the peer;
+// a shift reassigned since the request was made is a conflict. It returns the
+// updated shifts and a function swapping them back.
+func swapAssignedShifts(ctx context.Context, requestSwap *model.RequestSwap) (*SwappedShifts, func(ctx context.Context) error, error) {
+	shiftToOffer, err := util.GetAssignedShift(ctx, *requestSwap.AssignedUserShiftID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	shiftToSwap, err := util.GetAssignedShift(ctx, *requestSwap.AssignedUserShiftIDToSwap)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if shiftToOffer.UserID == nil || shiftToSwap.UserID == nil {
+		return nil, nil, util.NewError(util.ErrorCodeValidation, "Both shifts must be assigned to a user to be swapped")
+	}
+
+	if *shiftToOffer.UserID != requestSwap.UserID {
+		return nil, nil, util.NewError(util.ErrorCodeConflict, fmt.Sprintf("The shift to offer is not assigned to user %s anymore", requestSwap.UserID))
+	}
+	// request swaps made before the peer was recorded only require another owner
+	if requestSwap.PeerUserID != nil && *shiftToSwap.UserID != *requestSwap.PeerUserID {
+		return nil, nil, util.NewError(util.ErrorCodeConflict, fmt.Sprintf("The shift to swap is not assigned to user %s anymore", *requestSwap.PeerUserID))
+	}
+	if *shiftToSwap.UserID == requestSwap.UserID {
+		return nil, nil, util.NewError(util.ErrorCodeConflict, "The shift to swap is assigned to the requester")
+	}
+
+	offeringUserID, swappingUserID := *shiftToOffer.UserID, *shiftToSwap.UserID
+
+	offered, err := util.ReassignAssignedShift(ctx, shiftToOffer.ID, swappingUserID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	swapped, err := util.ReassignAssignedShift(ctx, shiftToSwap.ID, offeringUserID)
+	if err != nil {
+		compensationContext, cancel := context.WithTimeout(context.Background(), compensationTimeout)
+		defer cancel()
+
+		if _, undoErr := util.ReassignAssignedShift(compensationContext, shiftToOffer.ID, offeringUserID); undoErr != nil {
+			sentry.CaptureException(undoErr)
+			defer sentry.Flush(2 * time.Second)
+
+			log.Printf("assigned shift %s: unable to give it back to user %s: %v", shiftToOffer.ID, offeringUserID, undoErr)
+			return nil, nil, fmt.Errorf("%w (and assigned shift %s could not be given back: %v)", err, shiftToOffer.ID, undoErr)
+		}
+
+		return nil, nil, err
+	}
+
+	undo := func(ctx context.Context) error {
+		if _, err := util.ReassignAssignedShift(ctx, shiftToOffer.ID, offeringUserID); err != nil {
+			return err
+		}
+
+		_, err := util.ReassignAssignedShift(ctx, shiftToSwap.ID, swappingUserID)
+		return err
+	}
+
+	return &SwappedShifts{ShiftToOffer: offered, ShiftToSwap: swapped}, undo, nil
+}
+
+const (
+	shiftToOfferField = "assignedUserShiftId"
+	shiftToSwapField  = "assignedUserShiftIdToSwap"
+)
+
+// validateRequestSwap checks the shifts of a new request swap against the
+// assigned-shift service: they exist, the shift to offer belongs to the
+// requester and the shift to swap to someone else, both are in the channel,
+// neither has started and after the swap neither user works two shifts at
+// the same time. Each problem is a ShiftError on its input field; err is
+// only set when the service could not answer. It returns the shift to offer
+// and the shift to swap, if any.
+func validateRequestSwap(ctx context.Context, input model.RequestSwapInput) (*model.AssignedShift, *model.AssignedShift, []*model.ShiftError, error) {
+	var shiftErrors []*model.ShiftError
+	addError := func(code model.ShiftErrorCode, field string, message string) {
+		shiftErrors = append(shiftErrors, &model.ShiftError{
+			Code:    code,
+			Field:   &field,
+			Message: &message,
+		})
+	}
+
+	now := time.Now()
+	checkShift := func(shift *model.AssignedShift, field string, name string) {
+		if shift.ChannelID == nil || *shift.ChannelID != input.ChannelID {
+			addError(model.ShiftErrorCodeValidation, field, fmt.Sprintf("The %s is not in channel %s", name, input.ChannelID))
+		}
+
+		if !shift.StartTime.After(now) {
+			addError(model.ShiftErrorCodeValidation, field, fmt.Sprintf("The %s has already started", name))
+		}
+	}
+
+	shiftToOffer, err := findAssignedShift(ctx, input.AssignedUserShiftID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if shiftToOffer == nil {
+		addError(model.ShiftErrorCodeNotFound, shiftToOfferField, "The shift to offer does not exist")
+	} else {
+		if shiftToOffer.UserID == nil || *shiftToOffer.UserID != input.UserID {
+			addError(model.ShiftErrorCodeValidation, shiftToOfferField, "The shift to offer is not assigned to the requester")
+		}
+
+		checkShift(shiftToOffer, shiftToOfferField, "shift to offer")
+	}
+
+	// a request swap is always with the shift of a coworker, approving it exchanges both shifts
+	if input.AssignedUserShiftIDToSwap == nil || *input.AssignedUserShiftIDToSwap == "" {
+		addError(model.ShiftErrorCodeValidation, shiftToSwapField, "The shift to swap is required")
+		return shiftToOffer, nil, shiftErrors, nil
+	}
+
+	shiftToSwap, err := findAssignedShift(ctx, *input.AssignedUserShiftIDToSwap)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if shiftToSwap == nil {
+		addError(model.ShiftErrorCodeNotFound, shiftToSwapField, "The shift to swap does not exist")
+		return shiftToOffer, nil, shiftErrors, nil
+	}
+
+	switch {
+	case shiftToSwap.UserID == nil || *shiftToSwap.UserID == "":
+		addError(model.ShiftErrorCodeValidation, shiftToSwapField, "The shift to swap is not assigned to anyone")
+	case *shiftToSwap.UserID == input.UserID:
+		addError(model.ShiftErrorCodeValidation, shiftToSwapField, "The shift to swap is already assigned to the requester")
+	}
+
+	checkShift(shiftToSwap, shiftToSwapField, "shift to swap")
+
+	if len(shiftErrors) > 0 {
+		return shiftToOffer, shiftToSwap, shiftErrors, nil
+	}
+
+	// each user gets the shift of the other one and gives their own away
+	overlapping, err := overlappingShift(ctx, input.UserID, shiftToSwap, shiftToOffer.ID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if overlapping != nil {
+		addError(model.ShiftErrorCodeValidation, shiftToSwapField, fmt.Sprintf("The shift to swap overlaps shift %s of the requester", overlapping.ID))
+	}
+
+	overlapping, err = overlappingShift(ctx, *shiftToSwap.UserID, shiftToOffer, shiftToSwap.ID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if overlapping != nil {
+		addError(model.ShiftErrorCodeValidation, shiftToOfferField, fmt.Sprintf("The shift to offer overlaps shift %s of the peer", overlapping.ID))
+	}
+
+	return shiftToOffer, shiftToSwap, shiftErrors, nil
+}
+
+// agreedFieldChanged is the input field of the first change to what the peer
+// agreed to, the requester and both shifts, or "" when they stay the same
+func agreedFieldChanged(requestSwap *model.RequestSwap, changes model.RequestSwap) string {
+	changed := func(previous *string, next *string) bool {
+		return next != nil && *next != "" && (previous == nil || *previous != *next)
+	}
+
+	switch {
+	case changes.UserID != "" && changes.UserID != requestSwap.UserID:
+		return "userId"
+	case changed(requestSwap.AssignedUserShiftID, changes.AssignedUserShiftID):
+		return shiftToOfferField
+	case changed(requestSwap.AssignedUserShiftIDToSwap, changes.AssignedUserShiftIDToSwap):
+		return shiftToSwapField
+	}
+
+	return ""
+}
+
+// shiftsPeriod is the period from the earliest start to the latest end of
+// the shifts, leaving out the ones that are nil
+func shiftsPeriod(shifts ...*model.AssignedShift) (*time.Time, *time.Time) {
+	var startTime, endTime *time.Time
+	for _, shift := range shifts {
+		if shift == nil {
+			continue
+		}
+
+		if startTime == nil || shift.StartTime.Before(*startTime) {
+			start := shift.StartTime
+			startTime = &start
+		}
+		if endTime == nil || shift.EndTime.After(*endTime) {
+			end := shift.EndTime
+			endTime = &end
+		}
+	}
+
+	return startTime, endTime
+}
+
+// findAssignedShift is util.GetAssignedShift returning nil for a shift that
+// does not exist
+func findAssignedShift(ctx context.Context, id string) (*model.AssignedShift, error) {
+	shift, err := util.GetAssignedShift(ctx, id)
+	if err != nil && util.ErrorCodeOf(err) == util.ErrorCodeNotFound {
+		return nil, nil
+	}
+
+	return shift, err
+}
+
+// overlappingShift returns a shift of the user, in any channel or shift
+// group, that overlaps shift, leaving out the shift the user gives away
+func overlappingShift(ctx context.Context, userID string, shift *model.AssignedShift, givenAwayID string) (*model.AssignedShift, error) {
+	shifts, err := util.GetAssignedShiftsByUserID(ctx, userID, shift.StartTime, shift.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	return findOverlap(shifts, userID, shift, givenAwayID), nil
+}
+
+// findOverlap returns the first of shifts assigned to the user that overlaps
+// shift, leaving out shift itself and the shift the user gives away
+func findOverlap(shifts []*model.AssignedShift, userID string, shift *model.AssignedShift, givenAwayID string) *model.AssignedShift {
+	for _, other := range shifts {
+		if other.ID == givenAwayID || other.ID == shift.ID {
+			continue
+		}
+
+		if other.UserID == nil || *other.UserID != userID {
+			continue
+		}
+
+		if other.StartTime.Before(shift.EndTime) && shift.StartTime.Before(other.EndTime) {
+			return other
+		}
+	}
+
+	return nil
+}
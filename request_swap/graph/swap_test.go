@@ -0,0 +1,31 @@
+package graph
+
+import (
+	"request_swaps/graph/model"
+	"testing"
+)
+
+func TestAgreedFieldChanged(t *testing.T) {
+	id := func(value string) *string { return &value }
+	requestSwap := &model.RequestSwap{UserID: "requester", AssignedUserShiftID: id("offer"), AssignedUserShiftIDToSwap: id("swap")}
+
+	tests := []struct {
+		name    string
+		changes model.RequestSwap
+		want    string
+	}{
+		{name: "same values", changes: model.RequestSwap{UserID: "requester", AssignedUserShiftID: id("offer"), AssignedUserShiftIDToSwap: id("swap")}, want: ""},
+		{name: "only a note", changes: model.RequestSwap{RequestNote: id("note")}, want: ""},
+		{name: "other requester", changes: model.RequestSwap{UserID: "other"}, want: "userId"},
+		{name: "other shift to offer", changes: model.RequestSwap{AssignedUserShiftID: id("other")}, want: shiftToOfferField},
+		{name: "other shift to swap", changes: model.RequestSwap{AssignedUserShiftIDToSwap: id("other")}, want: shiftToSwapField},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := agreedFieldChanged(requestSwap, test.changes); got != test.want {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
+		})
+	}
+}
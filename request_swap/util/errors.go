@@ -0,0 +1,104 @@
+package util
+
+import (
+	"context"
+	"errors"
+	"net"
+	"request_swaps/graph/model"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+)
+
+// ErrorCode is the stable code of an error. It is exposed as the "code"
+// extension of GraphQL errors and as the code of the payload errors, so the
+// front-ends can branch on it instead of parsing messages.
+type ErrorCode string
+
+const (
+	ErrorCodeUnauthenticated     ErrorCode = "UNAUTHENTICATED"
+	ErrorCodeForbidden           ErrorCode = "FORBIDDEN"
+	ErrorCodeNotFound            ErrorCode = "NOT_FOUND"
+	ErrorCodeValidation          ErrorCode = "VALIDATION"
+	ErrorCodeConflict            ErrorCode = "CONFLICT"
+	ErrorCodeUpstreamUnavailable ErrorCode = "UPSTREAM_UNAVAILABLE"
+	ErrorCodeInternal            ErrorCode = "INTERNAL"
+)
+
+// uniqueViolation is the postgres error code of a duplicate key
+const uniqueViolation = "23505"
+
+var errorCodes = map[ErrorCode]bool{
+	ErrorCodeUnauthenticated:     true,
+	ErrorCodeForbidden:           true,
+	ErrorCodeNotFound:            true,
+	ErrorCodeValidation:          true,
+	ErrorCodeConflict:            true,
+	ErrorCodeUpstreamUnavailable: true,
+	ErrorCodeInternal:            true,
+}
+
+// Error is an error with one of the codes above
+type Error struct {
+	Code    ErrorCode
+	Message string
+	Err     error
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+func NewError(code ErrorCode, message string) *Error {
+	return &Error{Code: code, Message: message}
+}
+
+// WrapError gives err a code, keeping its message
+func WrapError(code ErrorCode, err error) *Error {
+	return &Error{Code: code, Message: err.Error(), Err: err}
+}
+
+// ErrorCodeOf classifies any error returned by the resolvers: coded errors
+// keep their code, the codes of other services are passed through, failed
+// calls to other services are UPSTREAM_UNAVAILABLE, missing records are
+// NOT_FOUND, duplicates are CONFLICT and the rest is INTERNAL
+func ErrorCodeOf(err error) ErrorCode {
+	var codedError *Error
+	if errors.As(err, &codedError) {
+		return codedError.Code
+	}
+
+	var graphQLErrors GraphQLErrors
+	if errors.As(err, &graphQLErrors) {
+		for _, graphQLError := range graphQLErrors {
+			if code, ok := graphQLError.Extensions["code"].(string); ok && errorCodes[ErrorCode(code)] {
+				return ErrorCode(code)
+			}
+		}
+
+		return ErrorCodeUpstreamUnavailable
+	}
+
+	var httpStatusError *HTTPStatusError
+	var netError net.Error
+	var pgError *pgconn.PgError
+	switch {
+	case errors.As(err, &httpStatusError), errors.As(err, &netError), errors.Is(err, context.DeadlineExceeded):
+		return ErrorCodeUpstreamUnavailable
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return ErrorCodeNotFound
+	case errors.As(err, &pgError) && pgError.Code == uniqueViolation:
+		return ErrorCodeConflict
+	}
+
+	return ErrorCodeInternal
+}
+
+// ShiftErrorCodeOf is the ErrorCodeOf the error as a payload error code
+func ShiftErrorCodeOf(err error) model.ShiftErrorCode {
+	return model.ShiftErrorCode(ErrorCodeOf(err))
+}
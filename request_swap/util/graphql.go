@@ -0,0 +1,144 @@
+package util
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+// GraphQLClient sends operations to another service's GraphQL API. The
+// arguments are always passed as variables, never formatted into the document.
+type GraphQLClient struct {
+	url       string
+	daprAppID string
+	client    *http.Client
+}
+
+// GraphQLError is one entry of the "errors" array of a GraphQL response
+type GraphQLError struct {
+	Message    string                 `json:"message"`
+	Path       []interface{}          `json:"path,omitempty"`
+	Extensions map[string]interface{} `json:"extensions,omitempty"`
+}
+
+// GraphQLErrors is returned when the response contains an "errors" array
+type GraphQLErrors []GraphQLError
+
+func (e GraphQLErrors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, graphQLError := range e {
+		messages = append(messages, graphQLError.Message)
+	}
+
+	return "graphql: " + strings.Join(messages, "; ")
+}
+
+// HTTPStatusError is returned when the service answers with a non 2xx status
+type HTTPStatusError struct {
+	URL        string
+	StatusCode int
+	Body       string
+}
+
+func (e *HTTPStatusError) Error() string {
+	return fmt.Sprintf("%s responded with status %d: %s", e.URL, e.StatusCode, e.Body)
+}
+
+var graphQLHTTPClient = &http.Client{Timeout: time.Second * 5}
+
+type idempotencyKeyContextKey struct{}
+
+// WithIdempotencyKey makes the calls made with ctx send key in the
+// Idempotency-Key header, so that the other service answers a repeated call
+// with the result of the first one instead of doing it again
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyContextKey{}, key)
+}
+
+func NewGraphQLClient(url string, daprAppID string) *GraphQLClient {
+	return &GraphQLClient{
+		url:       url,
+		daprAppID: daprAppID,
+		client:    graphQLHTTPClient,
+	}
+}
+
+type graphQLRequest struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables,omitempty"`
+}
+
+type graphQLResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors GraphQLErrors   `json:"errors"`
+}
+
+// Do sends the operation and decodes the "data" object into data. When the
+// response also carries errors, the partial data is decoded and the errors
+// are returned as GraphQLErrors.
+func (c *GraphQLClient) Do(ctx context.Context, query string, variables map[string]interface{}, data interface{}) error {
+	if c.url == "" {
+		return fmt.Errorf("graphql client: url is not configured")
+	}
+
+	body, err := json.Marshal(graphQLRequest{Query: query, Variables: variables})
+	if err != nil {
+		return fmt.Errorf("unable to marshal graphql request: %w", err)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("unable to create graphql request: %w", err)
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+	// DapR header
+	if c.daprAppID != "" {
+		request.Header.Add("dapr-app-id", c.daprAppID)
+	}
+	if key, ok := ctx.Value(idempotencyKeyContextKey{}).(string); ok && key != "" {
+		request.Header.Set("Idempotency-Key", key)
+	}
+	// service-to-service calls are trusted to pass the authUserId argument
+	if serviceToken := os.Getenv("SERVICE_TOKEN"); serviceToken != "" {
+		request.Header.Set("X-Service-Token", serviceToken)
+	}
+
+	response, err := c.client.Do(request)
+	if err != nil {
+		return fmt.Errorf("unable to execute graphql request to %s: %w", c.url, err)
+	}
+	defer response.Body.Close()
+
+	responseData, err := io.ReadAll(response.Body)
+	if err != nil {
+		return fmt.Errorf("unable to read graphql response from %s: %w", c.url, err)
+	}
+
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		return &HTTPStatusError{URL: c.url, StatusCode: response.StatusCode, Body: string(responseData)}
+	}
+
+	var graphQLResponse graphQLResponse
+	if err := json.Unmarshal(responseData, &graphQLResponse); err != nil {
+		return fmt.Errorf("unable to unmarshal graphql response from %s: %w", c.url, err)
+	}
+
+	if data != nil && len(graphQLResponse.Data) > 0 && string(graphQLResponse.Data) != "null" {
+		if err := json.Unmarshal(graphQLResponse.Data, data); err != nil {
+			return fmt.Errorf("unable to unmarshal graphql data from %s: %w", c.url, err)
+		}
+	}
+
+	if len(graphQLResponse.Errors) > 0 {
+		return graphQLResponse.Errors
+	}
+
+	return nil
+}
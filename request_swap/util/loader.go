@@ -0,0 +1,120 @@
+package util
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const (
+	defaultLoaderWait     = 2 * time.Millisecond
+	defaultLoaderMaxBatch = 100
+)
+
+// Loader batches the loads of the keys asked for within a short wait into
+// one call of fetch and caches the results, so a GraphQL request resolving
+// the same field on many objects calls the other service once. A loader
+// lives as long as one request; it is not meant to be shared.
+type Loader[V any] struct {
+	fetch    func(ctx context.Context, keys []string) (map[string]V, error)
+	wait     time.Duration
+	maxBatch int
+
+	cache map[string]*loaderResult[V]
+	batch *loaderBatch[V]
+	mutex sync.Mutex
+}
+
+type loaderResult[V any] struct {
+	done  chan struct{}
+	value V
+	err   error
+}
+
+type loaderBatch[V any] struct {
+	keys       []string
+	results    []*loaderResult[V]
+	dispatched bool
+}
+
+// NewLoader creates a loader around fetch, which returns the values of the
+// keys it found; a key missing from its result loads the zero value
+func NewLoader[V any](fetch func(ctx context.Context, keys []string) (map[string]V, error)) *Loader[V] {
+	return &Loader[V]{
+		fetch:    fetch,
+		wait:     defaultLoaderWait,
+		maxBatch: defaultLoaderMaxBatch,
+		cache:    map[string]*loaderResult[V]{},
+	}
+}
+
+// Load returns the value of the key, waiting for the batch it joins
+func (l *Loader[V]) Load(ctx context.Context, key string) (V, error) {
+	l.mutex.Lock()
+
+	result, ok := l.cache[key]
+	if !ok {
+		result = &loaderResult[V]{done: make(chan struct{})}
+		l.cache[key] = result
+
+		if l.batch == nil {
+			batch := &loaderBatch[V]{}
+			l.batch = batch
+			time.AfterFunc(l.wait, func() { l.dispatch(ctx, batch) })
+		}
+
+		l.batch.keys = append(l.batch.keys, key)
+		l.batch.results = append(l.batch.results, result)
+
+		// a full batch does not wait any longer
+		if len(l.batch.keys) >= l.maxBatch {
+			go l.dispatch(ctx, l.batch)
+			l.batch = nil
+		}
+	}
+
+	l.mutex.Unlock()
+
+	select {
+	case <-result.done:
+		return result.value, result.err
+	case <-ctx.Done():
+		var zero V
+		return zero, ctx.Err()
+	}
+}
+
+// Prime caches a value that is known already, such as one just written
+func (l *Loader[V]) Prime(key string, value V) {
+	/* */ l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if _, ok := l.cache[key]; ok {
+		return
+	}
+
+	result := &loaderResult[V]{done: make(chan struct{}), value: value}
+	close(result.done)
+	l.cache[key] = result
+}
+
+func (l *Loader[V]) dispatch(ctx context.Context, batch *loaderBatch[V]) {
+	l.mutex.Lock()
+	if l.batch == batch {
+		l.batch = nil
+	}
+	// a full batch is dispatched before its timer fires
+	if batch.dispatched {
+		l.mutex.Unlock()
+		return
+	}
+	batch.dispatched = true
+	l.mutex.Unlock()
+
+	values, err := l.fetch(ctx, batch.keys)
+	for i, key := range batch.keys {
+		result := batch.results[i]
+		result.value, result.err = values[key], err
+		close(result.done)
+	}
+}
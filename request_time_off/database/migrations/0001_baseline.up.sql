@@ -0,0 +1,19 @@
+CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
+
+CREATE TABLE IF NOT EXISTS request_time_offs (
+    id uuid DEFAULT uuid_generate_v4(),
+    user_id varchar(64) NOT NULL,
+    channel_id varchar(64) NOT NULL,
+    request_id uuid NOT NULL,
+    start_time timestamp with time zone,
+    end_time timestamp with time zone,
+    is24_hours boolean,
+    reason text,
+    request_note text,
+    status varchar(16) NOT NULL,
+    response_note text,
+    response_by_user_id varchar(64) NOT NULL,
+    response_at timestamp with time zone,
+    created_at timestamp with time zone DEFAULT now(),
+    PRIMARY KEY (id)
+);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS request_time_offs_user_id_idx;
+DROP INDEX IF EXISTS request_time_offs_channel_id_request_id_idx;
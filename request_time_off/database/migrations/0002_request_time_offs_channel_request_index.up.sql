@@ -0,0 +1,2 @@
+CREATE INDEX IF NOT EXISTS request_time_offs_channel_id_request_id_idx ON request_time_offs (channel_id, request_id);
+CREATE INDEX IF NOT EXISTS request_time_offs_user_id_idx ON request_time_offs (user_id);
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS request_time_offs_approved_idx;
@@ -0,0 +1 @@
+CREATE INDEX IF NOT EXISTS request_time_offs_approved_idx ON request_time_offs (channel_id, user_id, start_time) WHERE status = 'APPROVED';
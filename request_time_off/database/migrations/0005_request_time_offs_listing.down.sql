@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS request_time_offs_channel_id_user_id_created_at_idx;
+DROP INDEX IF EXISTS request_time_offs_channel_id_start_time_idx;
+DROP INDEX IF EXISTS request_time_offs_channel_id_created_at_idx;
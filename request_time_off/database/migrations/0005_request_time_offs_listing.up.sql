@@ -0,0 +1,3 @@
+CREATE INDEX IF NOT EXISTS request_time_offs_channel_id_created_at_idx ON request_time_offs (channel_id, created_at, id);
+CREATE INDEX IF NOT EXISTS request_time_offs_channel_id_start_time_idx ON request_time_offs (channel_id, start_time, id);
+CREATE INDEX IF NOT EXISTS request_time_offs_channel_id_user_id_created_at_idx ON request_time_offs (channel_id, user_id, created_at, id);
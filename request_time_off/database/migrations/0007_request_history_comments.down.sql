@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS request_comments;
+
+DROP TABLE IF EXISTS request_history;
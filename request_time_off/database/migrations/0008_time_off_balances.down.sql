@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS time_off_accrual_policies;
+
+DROP TABLE IF EXISTS time_off_ledger;
+
+DROP TABLE IF EXISTS time_off_balances;
+
+ALTER TABLE request_time_offs DROP COLUMN IF EXISTS leave_type;
@@ -0,0 +1,54 @@
+ALTER TABLE request_time_offs ADD COLUMN IF NOT EXISTS leave_type varchar(64) NOT NULL DEFAULT 'DEFAULT';
+
+CREATE TABLE IF NOT EXISTS time_off_balances (
+    id uuid NOT NULL DEFAULT uuid_generate_v4(),
+    channel_id varchar(64) NOT NULL,
+    user_id varchar(64) NOT NULL,
+    leave_type varchar(64) NOT NULL,
+    balance_hours numeric(10,2) NOT NULL DEFAULT 0,
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    updated_at timestamp with time zone NOT NULL DEFAULT now(),
+    PRIMARY KEY (id),
+    UNIQUE (channel_id, user_id, leave_type)
+);
+
+CREATE INDEX IF NOT EXISTS time_off_balances_user_idx ON time_off_balances (user_id);
+
+CREATE TABLE IF NOT EXISTS time_off_ledger (
+    id uuid NOT NULL DEFAULT uuid_generate_v4(),
+    balance_id uuid NOT NULL REFERENCES time_off_balances (id) ON DELETE CASCADE,
+    kind varchar(32) NOT NULL,
+    hours numeric(10,2) NOT NULL,
+    balance_after numeric(10,2) NOT NULL,
+    request_time_off_id uuid,
+    period varchar(64),
+    note text,
+    actor_user_id varchar(64),
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX IF NOT EXISTS time_off_ledger_balance_idx ON time_off_ledger (balance_id, created_at);
+CREATE INDEX IF NOT EXISTS time_off_ledger_request_idx ON time_off_ledger (request_time_off_id) WHERE request_time_off_id IS NOT NULL;
+-- an accrual, grant or expiry of a period is posted once
+CREATE UNIQUE INDEX IF NOT EXISTS time_off_ledger_period_idx ON time_off_ledger (balance_id, kind, period) WHERE period IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS time_off_accrual_policies (
+    channel_id varchar(64) NOT NULL,
+    leave_type varchar(64) NOT NULL,
+    method varchar(32) NOT NULL,
+    hours numeric(10,2) NOT NULL DEFAULT 0,
+    rate numeric(10,4) NOT NULL DEFAULT 0,
+    period_days integer NOT NULL DEFAULT 14,
+    period_start timestamp with time zone,
+    grant_month integer NOT NULL DEFAULT 1,
+    grant_day integer NOT NULL DEFAULT 1,
+    carry_over_cap numeric(10,2),
+    hours_per_day numeric(4,2) NOT NULL DEFAULT 8,
+    include_weekends boolean NOT NULL DEFAULT false,
+    enabled boolean NOT NULL,
+    updated_by_user_id varchar(64),
+    updated_at timestamp with time zone,
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    PRIMARY KEY (channel_id, leave_type)
+);
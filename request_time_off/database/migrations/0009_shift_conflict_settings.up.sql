@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS shift_conflict_settings (
+    channel_id varchar(64) NOT NULL,
+    policy varchar(16) NOT NULL,
+    updated_by_user_id varchar(64),
+    updated_at timestamp with time zone,
+    PRIMARY KEY (channel_id)
+);
@@ -0,0 +1,3 @@
+ALTER TABLE request_time_offs DROP COLUMN IF EXISTS attachment_url;
+
+DROP TABLE IF EXISTS leave_types;
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS leave_types (
+    channel_id varchar(64) NOT NULL,
+    code varchar(64) NOT NULL,
+    name varchar(128) NOT NULL,
+    color varchar(32),
+    paid boolean NOT NULL,
+    requires_attachment boolean NOT NULL DEFAULT false,
+    minimum_notice_hours integer NOT NULL DEFAULT 0,
+    max_consecutive_days integer,
+    auto_approve boolean NOT NULL DEFAULT false,
+    archived boolean NOT NULL DEFAULT false,
+    updated_by_user_id varchar(64),
+    updated_at timestamp with time zone,
+    PRIMARY KEY (channel_id, code)
+);
+
+ALTER TABLE request_time_offs ADD COLUMN IF NOT EXISTS attachment_url text;
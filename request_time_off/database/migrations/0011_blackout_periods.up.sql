@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS blackout_periods (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    channel_id varchar(64) NOT NULL,
+    shift_group_id varchar(64),
+    name varchar(128) NOT NULL,
+    start_time timestamp with time zone NOT NULL,
+    end_time timestamp with time zone NOT NULL,
+    created_by_user_id varchar(64),
+    created_at timestamp with time zone DEFAULT now(),
+    updated_at timestamp with time zone
+);
+
+CREATE INDEX IF NOT EXISTS blackout_periods_channel_id_start_time_idx ON blackout_periods (channel_id, start_time);
+
+ALTER TABLE request_time_offs ADD COLUMN IF NOT EXISTS blackout_override_reason text;
+ALTER TABLE request_time_offs ADD COLUMN IF NOT EXISTS blackout_override_by_user_id varchar(64);
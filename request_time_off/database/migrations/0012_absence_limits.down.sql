@@ -0,0 +1,4 @@
+ALTER TABLE request_time_offs DROP COLUMN IF EXISTS capacity_override_by_user_id;
+ALTER TABLE request_time_offs DROP COLUMN IF EXISTS capacity_override_reason;
+
+DROP TABLE IF EXISTS absence_limits;
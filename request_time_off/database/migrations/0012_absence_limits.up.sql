@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS absence_limits (
+    channel_id varchar(64) NOT NULL,
+    shift_group_id varchar(64) NOT NULL,
+    max_absences integer,
+    max_absence_percent double precision,
+    updated_by_user_id varchar(64),
+    updated_at timestamp with time zone,
+    PRIMARY KEY (channel_id, shift_group_id)
+);
+
+ALTER TABLE request_time_offs ADD COLUMN IF NOT EXISTS capacity_override_reason text;
+ALTER TABLE request_time_offs ADD COLUMN IF NOT EXISTS capacity_override_by_user_id varchar(64);
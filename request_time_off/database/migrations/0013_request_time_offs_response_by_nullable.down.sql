@@ -0,0 +1,3 @@
+-- time offs created since have no responder; the requester stands in for it
+UPDATE request_time_offs SET response_by_user_id = user_id WHERE response_by_user_id IS NULL;
+ALTER TABLE request_time_offs ALTER COLUMN response_by_user_id SET NOT NULL;
@@ -0,0 +1 @@
+ALTER TABLE request_time_offs ALTER COLUMN response_by_user_id DROP NOT NULL;
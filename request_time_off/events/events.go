@@ -0,0 +1,45 @@
+package events
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	specVersion     = "1.0"
+	dataContentType = "application/json"
+)
+
+// CloudEvent is the CloudEvents 1.0 envelope in structured JSON mode. Type
+// is also the pub/sub topic the event is published to.
+type CloudEvent struct {
+	SpecVersion     string      `json:"specversion"`
+	ID              string      `json:"id"`
+	Source          string      `json:"source"`
+	Type            string      `json:"type"`
+	Subject         string      `json:"subject,omitempty"`
+	Time            time.Time   `json:"time"`
+	DataContentType string      `json:"datacontenttype"`
+	Data            interface{} `json:"data"`
+}
+
+// New creates an event of the type about subject (the record ID)
+func New(eventType string, subject string, data interface{}) CloudEvent {
+	return CloudEvent{
+		SpecVersion:     specVersion,
+		ID:              uuid.New().String(),
+		Source:          Source,
+		Type:            eventType,
+		Subject:         subject,
+		Time:            time.Now().UTC(),
+		DataContentType: dataContentType,
+		Data:            data,
+	}
+}
+
+// Publisher publishes events on the topic of their type
+type Publisher interface {
+	Publish(ctx context.Context, event CloudEvent) error
+}
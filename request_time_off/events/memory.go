@@ -0,0 +1,77 @@
+package events
+
+import (
+	"context"
+	"sync"
+)
+
+// MemoryBroker keeps the published events in memory and hands them to the
+// subscribers of their type right away. It replaces the Dapr sidecar in
+// tests and local runs.
+type MemoryBroker struct {
+	events   []CloudEvent
+	handlers map[string][]func(ctx context.Context, event CloudEvent)
+	mutex    sync.Mutex
+}
+
+func NewMemoryBroker() *MemoryBroker {
+	return &MemoryBroker{
+		handlers: map[string][]func(ctx context.Context, event CloudEvent){},
+	}
+}
+
+func (b *MemoryBroker) Publish(ctx context.Context, event CloudEvent) error {
+	b.mutex.Lock()
+	b.events = append(b.events, event)
+	handlers := append([]func(ctx context.Context, event CloudEvent){}, b.handlers[event.Type]...)
+	b.mutex.Unlock()
+
+	// the handlers run without the lock, so they may publish themselves
+	for _, handler := range handlers {
+		handler(ctx, event)
+	}
+
+	return nil
+}
+
+// Subscribe calls handler with every event of the type published from now on
+func (b *MemoryBroker) Subscribe(eventType string, handler func(ctx context.Context, event CloudEvent)) {
+	/* */ b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.handlers[eventType] = append(b.handlers[eventType], handler)
+}
+
+// Events returns the published events of the types, or all of them when no
+// type is given, in the order they were published
+func (b *MemoryBroker) Events(eventTypes ...string) []CloudEvent {
+	/* */ b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	var events []CloudEvent
+	for _, event := range b.events {
+		if len(eventTypes) == 0 || contains(eventTypes, event.Type) {
+			events = append(events, event)
+		}
+	}
+
+	return events
+}
+
+// Reset forgets the published events; the subscriptions are kept
+func (b *MemoryBroker) Reset() {
+	/* */ b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.events = nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,85 @@
+package events
+
+import (
+	"request_time_offs/graph/model"
+	"time"
+)
+
+// Source identifies this service as the producer of the events
+const Source = "shifts/request-time-off"
+
+const (
+	RequestTimeOffCreated   = "request.timeoff.created"
+	RequestTimeOffApproved  = "request.timeoff.approved"
+	RequestTimeOffDenied    = "request.timeoff.denied"
+	RequestTimeOffCancelled = "request.timeoff.cancelled"
+	RequestTimeOffExpired   = "request.timeoff.expired"
+
+	RequestTimeOffCommented = "request.timeoff.commented"
+)
+
+// RequestTimeOffData is the data of the request.timeoff.* events
+type RequestTimeOffData struct {
+	ID               string              `json:"id"`
+	RequestID        *string             `json:"requestId"`
+	ChannelID        *string             `json:"channelId"`
+	UserID           string              `json:"userId"`
+	StartTime        time.Time           `json:"startTime"`
+	EndTime          *time.Time          `json:"endTime"`
+	Is24Hours        *bool               `json:"is24Hours"`
+	Reason           *string             `json:"reason"`
+	Status           model.RequestStatus `json:"status"`
+	RequestNote      *string             `json:"requestNote"`
+	ResponseNote     *string             `json:"responseNote"`
+	ResponseByUserID *string             `json:"responseByUserId"`
+	ResponseAt       *time.Time          `json:"responseAt"`
+}
+
+// NewRequestTimeOffEvent creates an event about the request time off;
+// responseByUserID is the user that caused the change
+func NewRequestTimeOffEvent(eventType string, requestTimeOff model.RequestTimeOff, responseByUserID *string) CloudEvent {
+	if responseByUserID == nil {
+		responseByUserID = requestTimeOff.ResponseByUserID
+	}
+
+	return New(eventType, requestTimeOff.ID, RequestTimeOffData{
+		ID:               requestTimeOff.ID,
+		RequestID:        requestTimeOff.RequestID,
+		ChannelID:        requestTimeOff.ChannelID,
+		UserID:           requestTimeOff.UserID,
+		StartTime:        requestTimeOff.StartTime,
+		EndTime:          requestTimeOff.EndTime,
+		Is24Hours:        requestTimeOff.Is24Hours,
+		Reason:           requestTimeOff.Reason,
+		Status:           requestTimeOff.Status,
+		RequestNote:      requestTimeOff.RequestNote,
+		ResponseNote:     requestTimeOff.ResponseNote,
+		ResponseByUserID: responseByUserID,
+		ResponseAt:       requestTimeOff.ResponseAt,
+	})
+}
+
+// RequestTimeOffCommentData is the data of the request.timeoff.commented event
+type RequestTimeOffCommentData struct {
+	ID               string    `json:"id"`
+	RequestTimeOffID string    `json:"requestTimeOffId"`
+	RequestID        *string   `json:"requestId"`
+	ChannelID        *string   `json:"channelId"`
+	UserID           string    `json:"userId"`
+	Body             string    `json:"body"`
+	CreatedAt        time.Time `json:"createdAt"`
+}
+
+// NewRequestTimeOffCommentEvent creates the event about a comment on the
+// request time off
+func NewRequestTimeOffCommentEvent(requestTimeOff model.RequestTimeOff, comment model.RequestComment) CloudEvent {
+	return New(RequestTimeOffCommented, requestTimeOff.ID, RequestTimeOffCommentData{
+		ID:               comment.ID,
+		RequestTimeOffID: requestTimeOff.ID,
+		RequestID:        requestTimeOff.RequestID,
+		ChannelID:        requestTimeOff.ChannelID,
+		UserID:           comment.UserID,
+		Body:             comment.Body,
+		CreatedAt:        comment.CreatedAt,
+	})
+}
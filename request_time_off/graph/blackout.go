@@ -0,0 +1,199 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"request_time_offs/graph/model"
+	"request_time_offs/util"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Blackouts keeps the blackout periods of the channels, the windows in which
+// no time off can be requested. A period with a shift group only blocks the
+// members of the group; one without blocks the whole channel.
+type Blackouts struct {
+	db *gorm.DB
+}
+
+func NewBlackouts(db *gorm.DB) *Blackouts {
+	return &Blackouts{db: db}
+}
+
+// Periods are the blackout periods of the channel, earliest first, only the
+// ones of the shift group (and the channel-wide ones) with shiftGroupID and
+// only the ones overlapping from and to when given
+func (b *Blackouts) Periods(ctx context.Context, channelID string, shiftGroupID *string, from *time.Time, to *time.Time) ([]*model.BlackoutPeriod, error) {
+	query := b.db.WithContext(ctx).Where("channel_id = ?", channelID)
+	if shiftGroupID != nil && *shiftGroupID != "" {
+		query = query.Where("shift_group_id IS NULL OR shift_group_id = ?", *shiftGroupID)
+	}
+	if from != nil {
+		query = query.Where("end_time > ?", *from)
+	}
+	if to != nil {
+		query = query.Where("start_time < ?", *to)
+	}
+
+	periods := []*model.BlackoutPeriod{}
+	if err := query.Order("start_time, name").Find(&periods).Error; err != nil {
+		return nil, err
+	}
+
+	return periods, nil
+}
+
+// Create stores a new blackout period
+func (b *Blackouts) Create(ctx context.Context, input model.BlackoutPeriodInput, createdByUserID string) (*model.BlackoutPeriod, error) {
+	period := &model.BlackoutPeriod{
+		ID:              uuid.New().String(),
+		CreatedByUserID: &createdByUserID,
+		CreatedAt:       time.Now().UTC(),
+	}
+	applyBlackoutPeriodInput(period, input)
+
+	if err := validateBlackoutPeriod(period); err != nil {
+		return nil, err
+	}
+
+	if err := b.db.WithContext(ctx).Create(period).Error; err != nil {
+		return nil, err
+	}
+
+	return period, nil
+}
+
+// Update replaces the blackout period with the input
+func (b *Blackouts) Update(ctx context.Context, id string, input model.BlackoutPeriodInput) (*model.BlackoutPeriod, error) {
+	var period model.BlackoutPeriod
+	if err := b.db.WithContext(ctx).Where("id = ?", id).First(&period).Error; err != nil {
+		return nil, err
+	}
+
+	updatedAt := time.Now().UTC()
+	applyBlackoutPeriodInput(&period, input)
+	period.UpdatedAt = &updatedAt
+
+	if err := validateBlackoutPeriod(&period); err != nil {
+		return nil, err
+	}
+
+	if err := b.db.WithContext(ctx).Save(&period).Error; err != nil {
+		return nil, err
+	}
+
+	return &period, nil
+}
+
+// Delete removes the blackout period and returns it
+func (b *Blackouts) Delete(ctx context.Context, id string) (*model.BlackoutPeriod, error) {
+	var period model.BlackoutPeriod
+	if err := b.db.WithContext(ctx).Where("id = ?", id).First(&period).Error; err != nil {
+		return nil, err
+	}
+
+	if err := b.db.WithContext(ctx).Delete(&period).Error; err != nil {
+		return nil, err
+	}
+
+	return &period, nil
+}
+
+func applyBlackoutPeriodInput(period *model.BlackoutPeriod, input model.BlackoutPeriodInput) {
+	period.ChannelID = input.ChannelID
+	period.ShiftGroupID = input.ShiftGroupID
+	if period.ShiftGroupID != nil && *period.ShiftGroupID == "" {
+		period.ShiftGroupID = nil
+	}
+	period.Name = strings.TrimSpace(input.Name)
+	period.StartTime = input.StartTime
+	period.EndTime = input.EndTime
+}
+
+func validateBlackoutPeriod(period *model.BlackoutPeriod) error {
+	if period.ChannelID == "" {
+		return util.NewError(util.ErrorCodeValidation, "channelId is required")
+	}
+	if period.Name == "" || utf8.RuneCountInString(period.Name) > 128 {
+		return util.NewError(util.ErrorCodeValidation, "name is required and must be at most 128 characters")
+	}
+	if !period.EndTime.After(period.StartTime) {
+		return util.NewError(util.ErrorCodeValidation, "endTime must be after startTime")
+	}
+
+	return nil
+}
+
+// Check returns the blackout periods the time off overlaps for its user. A
+// time off without an end lasts the day it starts, see timeOffPeriod. With an
+// override reason, a user with MANAGE_ALL requests it anyway: the reason and
+// the user are recorded on the time off and nothing blocks it.
+func (b *Blackouts) Check(ctx context.Context, requestTimeOff *model.RequestTimeOff, overrideReason *string, authUserID string) ([]*model.BlackoutPeriod, error) {
+	if requestTimeOff.ChannelID == nil {
+		return nil, nil
+	}
+
+	start, end := timeOffPeriod(requestTimeOff)
+
+	periods, err := b.Periods(ctx, *requestTimeOff.ChannelID, nil, &start, &end)
+	if err != nil {
+		return nil, err
+	}
+
+	// shift group periods only block the members of the group
+	blocking := []*model.BlackoutPeriod{}
+	members := map[string]bool{}
+	for _, period := range periods {
+		if period.ShiftGroupID != nil {
+			isMember, fetched := members[*period.ShiftGroupID]
+			if !fetched {
+				userIDs, err := util.GetShiftGroupMemberUserIDs(ctx, period.ChannelID, *period.ShiftGroupID, authUserID)
+				if err != nil {
+					return nil, err
+				}
+				for _, userID := range userIDs {
+					isMember = isMember || userID == requestTimeOff.UserID
+				}
+				members[*period.ShiftGroupID] = isMember
+			}
+			if !isMember {
+				continue
+			}
+		}
+
+		blocking = append(blocking, period)
+	}
+
+	if len(blocking) == 0 || overrideReason == nil || strings.TrimSpace(*overrideReason) == "" {
+		return blocking, nil
+	}
+
+	permission, err := util.CheckPermission(ctx, "request_time_off", "MANAGE_ALL", authUserID)
+	if err != nil {
+		return nil, err
+	}
+	if !permission {
+		return nil, util.NewError(util.ErrorCodeForbidden, "Permission denied: request_time_off.MANAGE_ALL is required to override a blackout period")
+	}
+
+	reason := strings.TrimSpace(*overrideReason)
+	requestTimeOff.BlackoutOverrideReason = &reason
+	requestTimeOff.BlackoutOverrideByUserID = &authUserID
+
+	return nil, nil
+}
+
+// blackoutMessage is the message of the error of a time off blocked by the
+// blackout periods
+func blackoutMessage(periods []*model.BlackoutPeriod) string {
+	names := make([]string, 0, len(periods))
+	for _, period := range periods {
+		names = append(names, fmt.Sprintf("%s (%s - %s)", period.Name, period.StartTime.UTC().Format(time.RFC3339), period.EndTime.UTC().Format(time.RFC3339)))
+	}
+
+	return fmt.Sprintf("Request time off overlaps the blackout period(s) %s", strings.Join(names, ", "))
+}
@@ -0,0 +1,121 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"request_time_offs/graph/model"
+	"request_time_offs/util"
+	"time"
+
+	"github.com/getsentry/sentry-go"
+)
+
+// maxBulkSize is the most time offs a bulk mutation changes at once
+const maxBulkSize = 100
+
+// bulkIDs drops the empty and repeated ids, keeping the order of the others
+func bulkIDs(ids []string) ([]string, error) {
+	seen := make(map[string]bool, len(ids))
+	unique := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if id == "" || seen[id] {
+			continue
+		}
+
+		seen[id] = true
+		unique = append(unique, id)
+	}
+
+	if len(unique) == 0 || len(unique) > maxBulkSize {
+		return nil, util.NewError(util.ErrorCodeValidation, fmt.Sprintf("between 1 and %d ids are required", maxBulkSize))
+	}
+
+	return unique, nil
+}
+
+// changeRequestTimeOffs runs change for each request time off on its own,
+// so one that fails does not hold the others back, and returns a result per
+// id. The users of the changed time offs are fetched in one call.
+func (r *Resolver) changeRequestTimeOffs(ctx context.Context, ids []string, fieldError string, change func(ctx context.Context, id string) (*model.RequestTimeOff, error)) *model.BulkTimeOffResponse {
+	defer sentry.Flush(2 * time.Second)
+
+	results := make([]*model.RequestTimeOffResult, 0, len(ids))
+	changed := map[string]*model.RequestTimeOff{}
+	seenUsers := map[string]bool{}
+	var userIDs []string
+
+	for _, id := range ids {
+		requestTimeOff, err := change(ctx, id)
+		if err != nil {
+			sentry.CaptureException(err)
+
+			errorMessage := err.Error()
+			results = append(results, &model.RequestTimeOffResult{
+				ID: id,
+				Errors: []*model.ShiftError{{
+					Code:    util.ShiftErrorCodeOf(err),
+					Field:   &fieldError,
+					Message: &errorMessage,
+				}},
+			})
+			continue
+		}
+
+		changed[id] = requestTimeOff
+		if !seenUsers[requestTimeOff.UserID] {
+			seenUsers[requestTimeOff.UserID] = true
+			userIDs = append(userIDs, requestTimeOff.UserID)
+		}
+		results = append(results, &model.RequestTimeOffResult{ID: id})
+	}
+
+	response := &model.BulkTimeOffResponse{Results: results}
+	if len(changed) == 0 {
+		return response
+	}
+
+	// the time offs are changed already, a user that cannot be fetched only leaves user empty
+	users, err := util.GetUsers(ctx, userIDs)
+	if err != nil {
+		sentry.CaptureException(err)
+
+		errorMessage := err.Error()
+		response.Errors = append(response.Errors, &model.ShiftError{
+			Code:    util.ShiftErrorCodeOf(err),
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+	}
+
+	for _, result := range results {
+		if requestTimeOff, ok := changed[result.ID]; ok {
+			result.Request = newRequestTimeOffResponse(requestTimeOff, users[requestTimeOff.UserID])
+		}
+	}
+
+	return response
+}
+
+// newRequestTimeOffResponse is the RequestResponse of the request time off
+func newRequestTimeOffResponse(requestTimeOff *model.RequestTimeOff, user *model.User) *model.RequestResponse {
+	var channelID, requestID string
+	if requestTimeOff.ChannelID != nil {
+		channelID = *requestTimeOff.ChannelID
+	}
+	if requestTimeOff.RequestID != nil {
+		requestID = *requestTimeOff.RequestID
+	}
+
+	return &model.RequestResponse{
+		ID:           requestTimeOff.ID,
+		ChannelID:    channelID,
+		RequestID:    requestID,
+		RequestNote:  requestTimeOff.RequestNote,
+		Status:       &requestTimeOff.Status,
+		ResponseNote: requestTimeOff.ResponseNote,
+		ResponseAt:   requestTimeOff.ResponseAt,
+		CreatedAt:    &requestTimeOff.CreatedAt,
+		User:         user,
+		ResponseByID: requestTimeOff.ResponseByUserID,
+	}
+}
@@ -0,0 +1,284 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"request_time_offs/graph/model"
+	"request_time_offs/util"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/getsentry/sentry-go"
+	"gorm.io/gorm"
+)
+
+// absenceLockID seeds the advisory locks held per shift group while a time
+// off is approved, so that concurrent approvals cannot both take the last
+// place of a shift group
+const absenceLockID = 8150727144
+
+// Capacity keeps the absence limits of the shift groups, the most members of
+// a shift group that can be off on the same day. The limit is checked against
+// the approved time offs of the members, looked up in the shift group member
+// service.
+type Capacity struct {
+	db *gorm.DB
+}
+
+func NewCapacity(db *gorm.DB) *Capacity {
+	return &Capacity{db: db}
+}
+
+// Limits are the absence limits of the shift groups of the channel
+func (c *Capacity) Limits(ctx context.Context, channelID string) ([]*model.AbsenceLimit, error) {
+	limits := []*model.AbsenceLimit{}
+	err := c.db.WithContext(ctx).Where("channel_id = ?", channelID).Order("shift_group_id").Find(&limits).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return limits, nil
+}
+
+// SetLimit stores the absence limit of the shift group; without maxAbsences
+// and maxAbsencePercent the shift group has no limit
+func (c *Capacity) SetLimit(ctx context.Context, channelID string, shiftGroupID string, input model.AbsenceLimitInput, updatedByUserID string) (*model.AbsenceLimit, error) {
+	if shiftGroupID == "" {
+		return nil, util.NewError(util.ErrorCodeValidation, "shiftGroupId is required")
+	}
+	if input.MaxAbsences != nil && input.MaxAbsencePercent != nil {
+		return nil, util.NewError(util.ErrorCodeValidation, "only one of maxAbsences and maxAbsencePercent can be set")
+	}
+	if input.MaxAbsences != nil && *input.MaxAbsences < 0 {
+		return nil, util.NewError(util.ErrorCodeValidation, "maxAbsences must be at least 0")
+	}
+	if input.MaxAbsencePercent != nil && (*input.MaxAbsencePercent < 0 || *input.MaxAbsencePercent > 100) {
+		return nil, util.NewError(util.ErrorCodeValidation, "maxAbsencePercent must be between 0 and 100")
+	}
+
+	updatedAt := time.Now().UTC()
+	limit := &model.AbsenceLimit{
+		ChannelID:         channelID,
+		ShiftGroupID:      shiftGroupID,
+		MaxAbsences:       input.MaxAbsences,
+		MaxAbsencePercent: input.MaxAbsencePercent,
+		UpdatedByUserID:   &updatedByUserID,
+		UpdatedAt:         &updatedAt,
+	}
+
+	if err := c.db.WithContext(ctx).Save(limit).Error; err != nil {
+		return nil, err
+	}
+
+	return limit, nil
+}
+
+// absenceGroup is a shift group with a limit that the user of a time off is
+// a member of
+type absenceGroup struct {
+	channelID    string
+	shiftGroupID string
+	memberIDs    []string
+	limit        int
+}
+
+// groups are the shift groups of the channel of the time off with a limit
+// that its user is a member of. A percentage is of the members of the shift
+// group, rounded down.
+func (c *Capacity) groups(ctx context.Context, requestTimeOff *model.RequestTimeOff, authUserID string) ([]*absenceGroup, error) {
+	if requestTimeOff.ChannelID == nil {
+		return nil, nil
+	}
+
+	limits, err := c.Limits(ctx, *requestTimeOff.ChannelID)
+	if err != nil {
+		return nil, err
+	}
+
+	var groups []*absenceGroup
+	for _, limit := range limits {
+		if limit.MaxAbsences == nil && limit.MaxAbsencePercent == nil {
+			continue
+		}
+
+		memberIDs, err := util.GetShiftGroupMemberUserIDs(ctx, limit.ChannelID, limit.ShiftGroupID, authUserID)
+		if err != nil {
+			return nil, err
+		}
+
+		isMember := false
+		for _, userID := range memberIDs {
+			isMember = isMember || userID == requestTimeOff.UserID
+		}
+		if !isMember {
+			continue
+		}
+
+		group := &absenceGroup{channelID: limit.ChannelID, shiftGroupID: limit.ShiftGroupID, memberIDs: memberIDs}
+		if limit.MaxAbsences != nil {
+			group.limit = *limit.MaxAbsences
+		} else {
+			group.limit = int(math.Floor(*limit.MaxAbsencePercent * float64(len(memberIDs)) / 100))
+		}
+		groups = append(groups, group)
+	}
+
+	return groups, nil
+}
+
+// Warnings are the days on which the time off would put more members of a
+// shift group of its user off than its limit, if it were approved
+func (c *Capacity) Warnings(ctx context.Context, requestTimeOff *model.RequestTimeOff, authUserID string) ([]*model.CapacityWarning, error) {
+	groups, err := c.groups(ctx, requestTimeOff, authUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return capacityWarnings(c.db.WithContext(ctx), requestTimeOff, groups)
+}
+
+// warnCapacity is the Warnings of the new time off. The time off is created
+// already, so warnings that cannot be computed are reported and left out.
+func (r *Resolver) warnCapacity(ctx context.Context, requestTimeOff *model.RequestTimeOff, authUserID string) []*model.CapacityWarning {
+	warnings, err := r.Capacity.Warnings(ctx, requestTimeOff, authUserID)
+	if err != nil {
+		sentry.CaptureException(err)
+		log.Printf("unable to check the absence limits of request time off %s: %v", requestTimeOff.ID, err)
+		return nil
+	}
+
+	return warnings
+}
+
+// lockAbsenceGroups holds the advisory locks of the shift groups until the
+// transaction ends, in the same order for every approval
+func lockAbsenceGroups(tx *gorm.DB, groups []*absenceGroup) error {
+	keys := make([]string, 0, len(groups))
+	for _, group := range groups {
+		keys = append(keys, group.channelID+"/"+group.shiftGroupID)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(hashtextextended(?, ?))", key, absenceLockID).Error; err != nil {
+			return fmt.Errorf("unable to acquire the absence lock: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// capacityWarnings counts, for each day (UTC) of the time off, the other
+// members of each shift group with an approved time off that day. A time off
+// without an end lasts the day it starts, see timeOffPeriod.
+func capacityWarnings(tx *gorm.DB, requestTimeOff *model.RequestTimeOff, groups []*absenceGroup) ([]*model.CapacityWarning, error) {
+	warnings := []*model.CapacityWarning{}
+	if len(groups) == 0 {
+		return warnings, nil
+	}
+
+	start, end := timeOffPeriod(requestTimeOff)
+
+	for _, group := range groups {
+		otherIDs := make([]string, 0, len(group.memberIDs))
+		for _, userID := range group.memberIDs {
+			if userID != requestTimeOff.UserID {
+				otherIDs = append(otherIDs, userID)
+			}
+		}
+
+		var approved []*model.RequestTimeOff
+		if len(otherIDs) > 0 {
+			err := tx.Where("channel_id = ? AND status = ? AND user_id IN ? AND id <> ?", *requestTimeOff.ChannelID, model.RequestStatusApproved, otherIDs, requestTimeOff.ID).
+				Where("start_time < ? AND "+timeOffEndColumn+" > ?", end, start).
+				Find(&approved).Error
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		warnings = append(warnings, groupWarnings(requestTimeOff, group, approved)...)
+	}
+
+	return warnings, nil
+}
+
+// groupWarnings are the days (UTC) of the time off on which the members of
+// the shift group off with the approved time offs, plus its user, exceed the
+// limit of the group. A member off with several time offs counts once.
+func groupWarnings(requestTimeOff *model.RequestTimeOff, group *absenceGroup, approved []*model.RequestTimeOff) []*model.CapacityWarning {
+	start, end := timeOffPeriod(requestTimeOff)
+	last := end.UTC().Add(-time.Nanosecond)
+	firstDay := time.Date(start.UTC().Year(), start.UTC().Month(), start.UTC().Day(), 0, 0, 0, 0, time.UTC)
+
+	var warnings []*model.CapacityWarning
+	for day := firstDay; !day.After(last); day = day.Add(24 * time.Hour) {
+		off := map[string]bool{}
+		for _, other := range approved {
+			otherStart, otherEnd := timeOffPeriod(other)
+			if otherStart.Before(day.Add(24*time.Hour)) && otherEnd.After(day) {
+				off[other.UserID] = true
+			}
+		}
+
+		if len(off)+1 > group.limit {
+			warnings = append(warnings, &model.CapacityWarning{
+				ShiftGroupID: group.shiftGroupID,
+				Date:         day,
+				Absences:     len(off),
+				Limit:        group.limit,
+			})
+		}
+	}
+
+	return warnings
+}
+
+// timeOffEndColumn is the end of a time off in SQL, like timeOffPeriod
+const timeOffEndColumn = "COALESCE(end_time, (date_trunc('day', start_time AT TIME ZONE 'UTC') + interval '1 day') AT TIME ZONE 'UTC')"
+
+// timeOffPeriod is the start and the end of the time off; one without an end
+// lasts until the end of the day (UTC) it starts
+func timeOffPeriod(requestTimeOff *model.RequestTimeOff) (time.Time, time.Time) {
+	if requestTimeOff.EndTime == nil {
+		start := requestTimeOff.StartTime.UTC()
+		return requestTimeOff.StartTime, time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC).AddDate(0, 0, 1)
+	}
+
+	return requestTimeOff.StartTime, *requestTimeOff.EndTime
+}
+
+// capacityMessage is the message of the error of a time off that would exceed
+// the absence limits
+func capacityMessage(warnings []*model.CapacityWarning) string {
+	days := make([]string, 0, len(warnings))
+	for _, warning := range warnings {
+		days = append(days, fmt.Sprintf("%s in shift group %s (%d already off, limit %d)", warning.Date.Format("2006-01-02"), warning.ShiftGroupID, warning.Absences, warning.Limit))
+	}
+
+	return fmt.Sprintf("Request time off would exceed the absence limit on %s", strings.Join(days, ", "))
+}
+
+// recordCapacityOverride stores why the time off, locked by the caller, was
+// approved over the absence limits and records it in the history
+func recordCapacityOverride(tx *gorm.DB, requestTimeOff *model.RequestTimeOff, reason string, userID string) error {
+	updates := map[string]interface{}{
+		"capacity_override_reason":     reason,
+		"capacity_override_by_user_id": userID,
+	}
+	if err := tx.Model(requestTimeOff).Updates(updates).Error; err != nil {
+		return err
+	}
+
+	entry := newHistoryEntry(requestTimeOff.ID, model.RequestHistoryActionUpdated, "capacityOverrideReason", requestTimeOff.CapacityOverrideReason, &reason, &userID)
+	if err := recordHistory(tx, entry); err != nil {
+		return err
+	}
+
+	requestTimeOff.CapacityOverrideReason = &reason
+	requestTimeOff.CapacityOverrideByUserID = &userID
+	return nil
+}
@@ -0,0 +1,69 @@
+package graph
+
+import (
+	"request_time_offs/graph/model"
+	"testing"
+	"time"
+)
+
+func TestGroupWarnings(t *testing.T) {
+	at := func(days int, hours int) *time.Time {
+		value := monday.AddDate(0, 0, days).Add(time.Duration(hours) * time.Hour)
+		return &value
+	}
+	timeOff := func(userID string, start *time.Time, end *time.Time) *model.RequestTimeOff {
+		return &model.RequestTimeOff{UserID: userID, StartTime: *start, EndTime: end}
+	}
+
+	// the time off checked lasts two days, monday and tuesday
+	requestTimeOff := timeOff("requester", at(0, 0), at(2, 0))
+
+	type warning struct {
+		day      int
+		absences int
+	}
+
+	tests := []struct {
+		name     string
+		limit    int
+		approved []*model.RequestTimeOff
+		want     []warning
+	}{
+		{name: "nobody off", limit: 1},
+		{name: "no place at all", limit: 0, want: []warning{{0, 0}, {1, 0}}},
+		{name: "one day full", limit: 1, approved: []*model.RequestTimeOff{timeOff("a", at(1, 9), at(1, 17))}, want: []warning{{1, 1}}},
+		{name: "without an end", limit: 1, approved: []*model.RequestTimeOff{timeOff("a", at(0, 0), nil)}, want: []warning{{0, 1}}},
+		{name: "without an end the day before", limit: 1, approved: []*model.RequestTimeOff{timeOff("a", at(-1, 9), nil)}},
+		{name: "without an end late in the day", limit: 1, approved: []*model.RequestTimeOff{timeOff("a", at(0, 20), nil)}, want: []warning{{0, 1}}},
+		{name: "ending at midnight", limit: 1, approved: []*model.RequestTimeOff{timeOff("a", at(-1, 0), at(0, 0))}},
+		{name: "room left", limit: 2, approved: []*model.RequestTimeOff{timeOff("a", at(0, 0), at(2, 0))}},
+		{
+			name:     "a member counts once",
+			limit:    2,
+			approved: []*model.RequestTimeOff{timeOff("a", at(0, 8), at(0, 12)), timeOff("a", at(0, 13), at(0, 17))},
+		},
+		{
+			name:     "several members",
+			limit:    2,
+			approved: []*model.RequestTimeOff{timeOff("a", at(0, 0), at(2, 0)), timeOff("b", at(1, 0), at(3, 0))},
+			want:     []warning{{1, 2}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			group := &absenceGroup{shiftGroupID: "group", limit: test.limit}
+			warnings := groupWarnings(requestTimeOff, group, test.approved)
+			if len(warnings) != len(test.want) {
+				t.Fatalf("got %d warnings, want %d", len(warnings), len(test.want))
+			}
+
+			for i, got := range warnings {
+				want := test.want[i]
+				if !got.Date.Equal(*at(want.day, 0)) || got.Absences != want.absences || got.Limit != test.limit || got.ShiftGroupID != "group" {
+					t.Errorf("warning %d is %s %d/%d, want %s %d/%d", i, got.Date.Format("2006-01-02"), got.Absences, got.Limit, at(want.day, 0).Format("2006-01-02"), want.absences, test.limit)
+				}
+			}
+		})
+	}
+}
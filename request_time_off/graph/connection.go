@@ -0,0 +1,183 @@
+package graph
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"request_time_offs/graph/model"
+	"request_time_offs/util"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+// orderColumns are the columns behind the order fields; the id breaks ties
+var orderColumns = map[model.RequestOrderField]string{
+	model.RequestOrderFieldCreatedAt: "created_at",
+	model.RequestOrderFieldStartTime: "start_time",
+}
+
+// cursor is the position of a row in a listing: the value of the order
+// column and the id of the row. It is handed out as opaque base64 JSON.
+type cursor struct {
+	Field model.RequestOrderField `json:"f"`
+	Value time.Time               `json:"v"`
+	ID    string                  `json:"id"`
+}
+
+func encodeCursor(field model.RequestOrderField, value time.Time, id string) string {
+	encoded, _ := json.Marshal(cursor{Field: field, Value: value, ID: id})
+	return base64.RawURLEncoding.EncodeToString(encoded)
+}
+
+// decodeCursor reads a cursor handed out for the same order field
+func decodeCursor(value string, field model.RequestOrderField) (*cursor, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, util.NewError(util.ErrorCodeValidation, "after is not a valid cursor")
+	}
+
+	var position cursor
+	if err := json.Unmarshal(decoded, &position); err != nil || position.ID == "" {
+		return nil, util.NewError(util.ErrorCodeValidation, "after is not a valid cursor")
+	}
+
+	if position.Field != field {
+		return nil, util.NewError(util.ErrorCodeValidation, fmt.Sprintf("after is a cursor for the order %s, not %s", position.Field, field))
+	}
+
+	return &position, nil
+}
+
+// page is a validated page request: the order and where to start
+type page struct {
+	field      model.RequestOrderField
+	column     string
+	descending bool
+	size       int
+	after      *cursor
+}
+
+// newPage checks the pagination arguments; without orderBy the newest
+// requests come first
+func newPage(orderBy *model.RequestOrder, first *int, after *string) (*page, error) {
+	p := &page{
+		field:      model.RequestOrderFieldCreatedAt,
+		descending: true,
+		size:       defaultPageSize,
+	}
+
+	if orderBy != nil {
+		p.field = orderBy.Field
+		p.descending = orderBy.Direction == model.OrderDirectionDesc
+	}
+	p.column = orderColumns[p.field]
+
+	if first != nil {
+		if *first < 1 || *first > maxPageSize {
+			return nil, util.NewError(util.ErrorCodeValidation, fmt.Sprintf("first must be between 1 and %d", maxPageSize))
+		}
+		p.size = *first
+	}
+
+	if after != nil && *after != "" {
+		position, err := decodeCursor(*after, p.field)
+		if err != nil {
+			return nil, err
+		}
+		p.after = position
+	}
+
+	return p, nil
+}
+
+// apply orders the query, starts it after the cursor and fetches one row
+// more than the page size to tell whether there is a next page
+func (p *page) apply(query *gorm.DB) *gorm.DB {
+	direction, comparison := "ASC", ">"
+	if p.descending {
+		direction, comparison = "DESC", "<"
+	}
+
+	if p.after != nil {
+		query = query.Where(fmt.Sprintf("(%s, id) %s (?, ?)", p.column, comparison), p.after.Value, p.after.ID)
+	}
+
+	return query.
+		Order(fmt.Sprintf("%s %s, id %s", p.column, direction, direction)).
+		Limit(p.size + 1)
+}
+
+// cursor is the cursor of a row with value in the order column
+func (p *page) cursor(value time.Time, id string) string {
+	return encodeCursor(p.field, value, id)
+}
+
+// pageInfo describes the page of count rows fetched with apply and returns
+// how many of them belong to it; cursorOf is the cursor of the i-th row
+func (p *page) pageInfo(count int, cursorOf func(i int) string) (int, *model.PageInfo) {
+	info := &model.PageInfo{
+		HasNextPage:     count > p.size,
+		HasPreviousPage: p.after != nil,
+	}
+
+	if count > p.size {
+		count = p.size
+	}
+
+	if count > 0 {
+		startCursor, endCursor := cursorOf(0), cursorOf(count-1)
+		info.StartCursor = &startCursor
+		info.EndCursor = &endCursor
+	}
+
+	return count, info
+}
+
+// orderValue is the value of the order column of the time off
+func (p *page) orderValue(requestTimeOff *model.RequestTimeOff) time.Time {
+	if p.field == model.RequestOrderFieldStartTime {
+		return requestTimeOff.StartTime
+	}
+
+	return requestTimeOff.CreatedAt
+}
+
+// applyRequestFilter narrows the query to the filter; startColumn and
+// endColumn hold the period of a request
+func applyRequestFilter(query *gorm.DB, filter *model.RequestFilter, startColumn string, endColumn string) *gorm.DB {
+	if filter == nil {
+		return query
+	}
+
+	if len(filter.Status) > 0 {
+		query = query.Where("status IN ?", filter.Status)
+	}
+
+	if filter.UserID != nil && *filter.UserID != "" {
+		query = query.Where("user_id = ?", *filter.UserID)
+	}
+
+	if filter.From != nil {
+		query = query.Where(fmt.Sprintf("%s > ?", endColumn), *filter.From)
+	}
+
+	if filter.To != nil {
+		query = query.Where(fmt.Sprintf("%s < ?", startColumn), *filter.To)
+	}
+
+	if filter.CreatedAfter != nil {
+		query = query.Where("created_at >= ?", *filter.CreatedAfter)
+	}
+
+	if filter.CreatedBefore != nil {
+		query = query.Where("created_at < ?", *filter.CreatedBefore)
+	}
+
+	return query
+}
@@ -0,0 +1,109 @@
+package graph
+
+import (
+	"encoding/base64"
+	"request_time_offs/graph/model"
+	"request_time_offs/util"
+	"testing"
+	"time"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	value := time.Date(2024, 3, 1, 9, 30, 0, 123, time.UTC)
+
+	tests := []struct {
+		name  string
+		field model.RequestOrderField
+		value time.Time
+		id    string
+	}{
+		{name: "created at", field: model.RequestOrderFieldCreatedAt, value: value, id: "0b6f0d5e-8a43-4d2c-9f3e-2a1b5c7d9e01"},
+		{name: "zero time", field: model.RequestOrderFieldCreatedAt, value: time.Time{}, id: "1"},
+		{name: "start time", field: model.RequestOrderFieldStartTime, value: value, id: "0b6f0d5e-8a43-4d2c-9f3e-2a1b5c7d9e01"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			position, err := decodeCursor(encodeCursor(test.field, test.value, test.id), test.field)
+			if err != nil {
+				t.Fatalf("decodeCursor: %v", err)
+			}
+			if position.Field != test.field || !position.Value.Equal(test.value) || position.ID != test.id {
+				t.Errorf("got %+v, want %s %s %s", position, test.field, test.value, test.id)
+			}
+		})
+	}
+}
+
+func TestDecodeCursorInvalid(t *testing.T) {
+	encode := func(value string) string {
+		return base64.RawURLEncoding.EncodeToString([]byte(value))
+	}
+
+	tests := []struct {
+		name   string
+		cursor string
+		field  model.RequestOrderField
+	}{
+		{name: "not base64", cursor: "not a cursor!", field: model.RequestOrderFieldCreatedAt},
+		{name: "not json", cursor: encode("created_at"), field: model.RequestOrderFieldCreatedAt},
+		{name: "without id", cursor: encode(`{"f":"CREATED_AT","v":"2024-03-01T09:30:00Z"}`), field: model.RequestOrderFieldCreatedAt},
+		{name: "other order", cursor: encode(`{"f":"START_TIME","v":"2024-03-01T09:30:00Z","id":"1"}`), field: model.RequestOrderFieldCreatedAt},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := decodeCursor(test.cursor, test.field)
+			if util.ErrorCodeOf(err) != util.ErrorCodeValidation {
+				t.Errorf("got %v, want a %s error", err, util.ErrorCodeValidation)
+			}
+		})
+	}
+}
+
+func TestNewPage(t *testing.T) {
+	intPtr := func(value int) *int { return &value }
+	after := encodeCursor(model.RequestOrderFieldCreatedAt, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), "1")
+	invalid := "!"
+
+	tests := []struct {
+		name       string
+		orderBy    *model.RequestOrder
+		first      *int
+		after      *string
+		size       int
+		descending bool
+		wantErr    bool
+	}{
+		{name: "defaults", size: defaultPageSize, descending: true},
+		{name: "ascending", orderBy: &model.RequestOrder{Field: model.RequestOrderFieldCreatedAt, Direction: model.OrderDirectionAsc}, size: defaultPageSize},
+		{name: "first", first: intPtr(5), size: 5, descending: true},
+		{name: "largest page", first: intPtr(maxPageSize), size: maxPageSize, descending: true},
+		{name: "first too small", first: intPtr(0), wantErr: true},
+		{name: "first too large", first: intPtr(maxPageSize + 1), wantErr: true},
+		{name: "after", after: &after, size: defaultPageSize, descending: true},
+		{name: "invalid after", after: &invalid, wantErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p, err := newPage(test.orderBy, test.first, test.after)
+			if test.wantErr {
+				if util.ErrorCodeOf(err) != util.ErrorCodeValidation {
+					t.Errorf("got %v, want a %s error", err, util.ErrorCodeValidation)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("newPage: %v", err)
+			}
+
+			if p.size != test.size || p.descending != test.descending {
+				t.Errorf("got size %d descending %v, want size %d descending %v", p.size, p.descending, test.size, test.descending)
+			}
+			if (p.after != nil) != (test.after != nil) {
+				t.Errorf("got after %v, want one: %v", p.after, test.after != nil)
+			}
+		})
+	}
+}
@@ -0,0 +1,241 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"request_time_offs/events"
+	"request_time_offs/graph/model"
+	"strconv"
+	"time"
+
+	"github.com/getsentry/sentry-go"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultExpiryInterval = 5 * time.Minute
+
+	expiryBatchSize = 100
+
+	// expiryLockID is the advisory lock held by an expiry run, so that only
+	// one replica at a time expires time offs
+	expiryLockID = 8150727142
+)
+
+// Expirer moves the time offs still pending when they start (plus the grace
+// period of the channel) to EXPIRED. Channels without settings use the
+// defaults of the service.
+type Expirer struct {
+	db     *gorm.DB
+	events events.Publisher
+
+	enabled     bool
+	gracePeriod time.Duration
+}
+
+func NewExpirer(db *gorm.DB, publisher events.Publisher) *Expirer {
+	return &Expirer{
+		db:      db,
+		events:  publisher,
+		enabled: true,
+	}
+}
+
+// Settings are the expiry settings of the channel, or the defaults when the
+// channel has none
+func (e *Expirer) Settings(ctx context.Context, channelID string) (*model.RequestExpirySettings, error) {
+	var settings []*model.RequestExpirySettings
+	err := e.db.WithContext(ctx).Where("channel_id = ?", channelID).Limit(1).Find(&settings).Error
+	if err != nil {
+		return nil, err
+	}
+
+	if len(settings) > 0 {
+		return settings[0], nil
+	}
+
+	return &model.RequestExpirySettings{
+		ChannelID:          channelID,
+		Enabled:            e.enabled,
+		GracePeriodMinutes: int(e.gracePeriod / time.Minute),
+	}, nil
+}
+
+// SetSettings stores the expiry settings of the channel
+func (e *Expirer) SetSettings(ctx context.Context, channelID string, input model.RequestExpirySettingsInput, updatedByUserID string) (*model.RequestExpirySettings, error) {
+	updatedAt := time.Now().UTC()
+	settings := &model.RequestExpirySettings{
+		ChannelID:          channelID,
+		Enabled:            input.Enabled,
+		GracePeriodMinutes: input.GracePeriodMinutes,
+		UpdatedByUserID:    &updatedByUserID,
+		UpdatedAt:          &updatedAt,
+	}
+
+	if err := e.db.WithContext(ctx).Save(settings).Error; err != nil {
+		return nil, err
+	}
+
+	return settings, nil
+}
+
+// RunExpirer expires time offs every interval until ctx is done
+func (e *Expirer) RunExpirer(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := e.Expire(ctx); err != nil {
+				sentry.CaptureException(err)
+				log.Printf("request time off expirer: %v", err)
+			}
+		}
+	}
+}
+
+// Expire expires the time offs that are due, a batch at a time, and
+// returns how many it expired. When another replica is expiring already it
+// returns right away.
+func (e *Expirer) Expire(ctx context.Context) (int, error) {
+	expired := 0
+
+	for {
+		requestTimeOffs, locked, err := e.expireBatch(ctx)
+		if err != nil {
+			return expired, err
+		}
+		if !locked {
+			return expired, nil
+		}
+
+		for _, requestTimeOff := range requestTimeOffs {
+			e.publish(ctx, events.NewRequestTimeOffEvent(events.RequestTimeOffExpired, *requestTimeOff, nil))
+		}
+
+		expired += len(requestTimeOffs)
+		if len(requestTimeOffs) < expiryBatchSize {
+			return expired, nil
+		}
+	}
+}
+
+// expireBatch expires up to expiryBatchSize time offs in a transaction
+// holding the expiry lock; locked is false when another replica holds it
+func (e *Expirer) expireBatch(ctx context.Context) ([]*model.RequestTimeOff, bool, error) {
+	var requestTimeOffs []*model.RequestTimeOff
+	locked := false
+
+	err := e.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Raw("SELECT pg_try_advisory_xact_lock(?)", expiryLockID).Scan(&locked).Error; err != nil {
+			return fmt.Errorf("unable to acquire the expiry lock: %w", err)
+		}
+		if !locked {
+			return nil
+		}
+
+		err := tx.Raw(`
+			SELECT request_time_offs.* FROM request_time_offs
+			LEFT JOIN request_expiry_settings ON request_expiry_settings.channel_id = request_time_offs.channel_id
+			WHERE request_time_offs.status IN ?
+				AND request_time_offs.start_time IS NOT NULL
+				AND COALESCE(request_expiry_settings.enabled, ?)
+				AND request_time_offs.start_time + make_interval(mins => COALESCE(request_expiry_settings.grace_period_minutes, ?)) <= now()
+			ORDER BY request_time_offs.start_time
+			LIMIT ?
+			FOR UPDATE OF request_time_offs SKIP LOCKED`,
+			model.ExpiringRequestStatuses, e.enabled, int(e.gracePeriod/time.Minute), expiryBatchSize,
+		).Scan(&requestTimeOffs).Error
+		if err != nil {
+			return fmt.Errorf("unable to list the time offs to expire: %w", err)
+		}
+
+		if len(requestTimeOffs) == 0 {
+			return nil
+		}
+
+		ids := make([]string, 0, len(requestTimeOffs))
+		entries := make([]*model.RequestHistoryEntry, 0, len(requestTimeOffs))
+		for _, requestTimeOff := range requestTimeOffs {
+			ids = append(ids, requestTimeOff.ID)
+			entries = append(entries, newStatusEntry(requestTimeOff.ID, requestTimeOff.Status, model.RequestStatusExpired, nil))
+		}
+
+		responseAt := time.Now().UTC()
+		err = tx.Model(&model.RequestTimeOff{}).Where("id IN ?", ids).Updates(map[string]interface{}{
+			"status":      model.RequestStatusExpired,
+			"response_at": responseAt,
+		}).Error
+		if err != nil {
+			return fmt.Errorf("unable to expire time offs: %w", err)
+		}
+
+		// the service expires them, the entries have no actor
+		if err := recordHistory(tx, entries...); err != nil {
+			return fmt.Errorf("unable to record the expiry of time offs: %w", err)
+		}
+
+		for _, requestTimeOff := range requestTimeOffs {
+			requestTimeOff.Status = model.RequestStatusExpired
+			requestTimeOff.ResponseAt = &responseAt
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	return requestTimeOffs, locked, nil
+}
+
+// publish reports a failure to publish without failing the expiry, like
+// Resolver.publish
+func (e *Expirer) publish(ctx context.Context, event events.CloudEvent) {
+	if e.events == nil {
+		return
+	}
+
+	if err := e.events.Publish(ctx, event); err != nil {
+		sentry.CaptureException(err)
+		log.Printf("unable to publish %s event %s: %v", event.Type, event.ID, err)
+	}
+}
+
+// ConfigureFromEnv reads REQUEST_EXPIRY_ENABLED and
+// REQUEST_EXPIRY_GRACE_PERIOD (minutes), the defaults for channels without
+// settings, and returns the expiry interval REQUEST_EXPIRY_INTERVAL (seconds)
+func (e *Expirer) ConfigureFromEnv() (time.Duration, error) {
+	interval := defaultExpiryInterval
+
+	if value := os.Getenv("REQUEST_EXPIRY_INTERVAL"); value != "" {
+		seconds, err := strconv.Atoi(value)
+		if err != nil {
+			return 0, fmt.Errorf("unable to convert REQUEST_EXPIRY_INTERVAL to int: %w", err)
+		}
+		interval = time.Duration(seconds) * time.Second
+	}
+
+	if value := os.Getenv("REQUEST_EXPIRY_ENABLED"); value != "" {
+		enabled, err := strconv.ParseBool(value)
+		if err != nil {
+			return 0, fmt.Errorf("unable to convert REQUEST_EXPIRY_ENABLED to bool: %w", err)
+		}
+		e.enabled = enabled
+	}
+
+	if value := os.Getenv("REQUEST_EXPIRY_GRACE_PERIOD"); value != "" {
+		minutes, err := strconv.Atoi(value)
+		if err != nil {
+			return 0, fmt.Errorf("unable to convert REQUEST_EXPIRY_GRACE_PERIOD to int: %w", err)
+		}
+		e.gracePeriod = time.Duration(minutes) * time.Minute
+	}
+
+	return interval, nil
+}
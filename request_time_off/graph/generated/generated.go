@@ -39,12 +39,31 @@ type Config struct {
 type ResolverRoot interface {
 	Mutation() MutationResolver
 	Query() QueryResolver
+	RequestComment() RequestCommentResolver
+	RequestHistoryEntry() RequestHistoryEntryResolver
+	RequestResponse() RequestResponseResolver
+	RequestTimeOff() RequestTimeOffResolver
+	TimeOffBalance() TimeOffBalanceResolver
 }
 
 type DirectiveRoot struct {
 }
 
 type ComplexityRoot struct {
+	AbsenceLimit struct {
+		ChannelID         func(childComplexity int) int
+		MaxAbsencePercent func(childComplexity int) int
+		MaxAbsences       func(childComplexity int) int
+		ShiftGroupID      func(childComplexity int) int
+		UpdatedAt         func(childComplexity int) int
+		UpdatedByUserID   func(childComplexity int) int
+	}
+
+	AbsenceLimitResponse struct {
+		AbsenceLimit func(childComplexity int) int
+		Errors       func(childComplexity int) int
+	}
+
 	AssignedShift struct {
 		Break           func(childComplexity int) int
 		ChannelID       func(childComplexity int) int
@@ -80,25 +99,142 @@ type ComplexityRoot struct {
 		UserID          func(childComplexity int) int
 	}
 
+	BlackoutPeriod struct {
+		ChannelID       func(childComplexity int) int
+		CreatedAt       func(childComplexity int) int
+		CreatedByUserID func(childComplexity int) int
+		EndTime         func(childComplexity int) int
+		ID              func(childComplexity int) int
+		Name            func(childComplexity int) int
+		ShiftGroupID    func(childComplexity int) int
+		StartTime       func(childComplexity int) int
+		UpdatedAt       func(childComplexity int) int
+	}
+
+	BlackoutPeriodResponse struct {
+		BlackoutPeriod func(childComplexity int) int
+		Errors         func(childComplexity int) int
+	}
+
+	BulkTimeOffResponse struct {
+		Errors  func(childComplexity int) int
+		Results func(childComplexity int) int
+	}
+
+	CapacityWarning struct {
+		Absences     func(childComplexity int) int
+		Date         func(childComplexity int) int
+		Limit        func(childComplexity int) int
+		ShiftGroupID func(childComplexity int) int
+	}
+
+	LeaveType struct {
+		Archived           func(childComplexity int) int
+		AutoApprove        func(childComplexity int) int
+		ChannelID          func(childComplexity int) int
+		Code               func(childComplexity int) int
+		Color              func(childComplexity int) int
+		MaxConsecutiveDays func(childComplexity int) int
+		MinimumNoticeHours func(childComplexity int) int
+		Name               func(childComplexity int) int
+		Paid               func(childComplexity int) int
+		RequiresAttachment func(childComplexity int) int
+		UpdatedAt          func(childComplexity int) int
+		UpdatedByUserID    func(childComplexity int) int
+	}
+
+	LeaveTypeResponse struct {
+		Errors    func(childComplexity int) int
+		LeaveType func(childComplexity int) int
+	}
+
 	Mutation struct {
-		ApproveRequestTimeOff func(childComplexity int, id string, responseNote *string, authUserID *string) int
-		CancelRequestTimeOff  func(childComplexity int, channelID string, requestID string, authUserID *string) int
-		CreateRequestTimeOff  func(childComplexity int, input model.RequestTimeOffInput, authUserID *string) int
-		DeleteRequestTimeOff  func(childComplexity int, id string, authUserID *string) int
-		DenyRequestTimeOff    func(childComplexity int, id string, responseNote *string, authUserID *string) int
-		UpdateRequestTimeOff  func(childComplexity int, id string, input model.RequestTimeOffInput, authUserID *string) int
+		AddRequestComment        func(childComplexity int, id string, body string, authUserID *string) int
+		AdjustTimeOffBalance     func(childComplexity int, channelID string, userID string, leaveType string, hours float64, note *string, authUserID *string) int
+		ApproveRequestTimeOff    func(childComplexity int, id string, responseNote *string, capacityOverrideReason *string, authUserID *string) int
+		ApproveRequestTimeOffs   func(childComplexity int, ids []string, responseNote *string, authUserID *string) int
+		CancelRequestTimeOff     func(childComplexity int, channelID string, requestID string, authUserID *string) int
+		CreateBlackoutPeriod     func(childComplexity int, input model.BlackoutPeriodInput, authUserID *string) int
+		CreateRequestTimeOff     func(childComplexity int, input model.RequestTimeOffInput, authUserID *string) int
+		DeleteBlackoutPeriod     func(childComplexity int, id string, authUserID *string) int
+		DeleteRequestTimeOff     func(childComplexity int, id string, authUserID *string) int
+		DenyRequestTimeOff       func(childComplexity int, id string, responseNote *string, authUserID *string) int
+		DenyRequestTimeOffs      func(childComplexity int, ids []string, responseNote *string, authUserID *string) int
+		RecordHoursWorked        func(childComplexity int, channelID string, userID string, hours float64, workedUntil time.Time, authUserID *string) int
+		SetAbsenceLimit          func(childComplexity int, channelID string, shiftGroupID string, input model.AbsenceLimitInput, authUserID *string) int
+		SetLeaveType             func(childComplexity int, channelID string, input model.LeaveTypeInput, authUserID *string) int
+		SetRequestExpirySettings func(childComplexity int, channelID string, input model.RequestExpirySettingsInput, authUserID *string) int
+		SetShiftConflictSettings func(childComplexity int, channelID string, input model.ShiftConflictSettingsInput, authUserID *string) int
+		SetTimeOffAccrualPolicy  func(childComplexity int, channelID string, input model.TimeOffAccrualPolicyInput, authUserID *string) int
+		UpdateBlackoutPeriod     func(childComplexity int, id string, input model.BlackoutPeriodInput, authUserID *string) int
+		UpdateRequestTimeOff     func(childComplexity int, id string, input model.RequestTimeOffInput, authUserID *string) int
+	}
+
+	PageInfo struct {
+		EndCursor       func(childComplexity int) int
+		HasNextPage     func(childComplexity int) int
+		HasPreviousPage func(childComplexity int) int
+		StartCursor     func(childComplexity int) int
 	}
 
 	Query struct {
+		GetAbsenceLimits                       func(childComplexity int, channelID string, authUserID *string) int
+		GetApprovedTimeOffs                    func(childComplexity int, channelID string, userIds []string, startTime time.Time, endTime time.Time, authUserID *string) int
+		GetBlackoutPeriods                     func(childComplexity int, channelID string, shiftGroupID *string, from *time.Time, to *time.Time, authUserID *string) int
+		GetLeaveTypes                          func(childComplexity int, channelID string, includeArchived *bool, authUserID *string) int
+		GetRequestExpirySettings               func(childComplexity int, channelID string, authUserID *string) int
 		GetRequestTimeOff                      func(childComplexity int, id string, authUserID *string) int
-		GetRequestTimeOffs                     func(childComplexity int, authUserID *string) int
+		GetRequestTimeOffs                     func(childComplexity int, channelID *string, authUserID *string) int
 		GetRequestTimeOffsByChannelIDRequestID func(childComplexity int, channelID string, requestID string) int
+		GetRequestTimeOffsConnection           func(childComplexity int, channelID string, filter *model.RequestFilter, orderBy *model.RequestOrder, first *int, after *string, authUserID *string) int
+		GetShiftConflictSettings               func(childComplexity int, channelID string, authUserID *string) int
+		GetTimeOffAccrualPolicies              func(childComplexity int, channelID string, authUserID *string) int
+		TimeOffBalance                         func(childComplexity int, userID string, channelID *string, leaveType *string, authUserID *string) int
+	}
+
+	RequestComment struct {
+		Body      func(childComplexity int) int
+		CreatedAt func(childComplexity int) int
+		ID        func(childComplexity int) int
+		User      func(childComplexity int) int
+		UserID    func(childComplexity int) int
+	}
+
+	RequestCommentResponse struct {
+		Comment func(childComplexity int) int
+		Errors  func(childComplexity int) int
+	}
+
+	RequestExpirySettings struct {
+		ChannelID          func(childComplexity int) int
+		Enabled            func(childComplexity int) int
+		GracePeriodMinutes func(childComplexity int) int
+		UpdatedAt          func(childComplexity int) int
+		UpdatedByUserID    func(childComplexity int) int
+	}
+
+	RequestExpirySettingsResponse struct {
+		Errors   func(childComplexity int) int
+		Settings func(childComplexity int) int
+	}
+
+	RequestHistoryEntry struct {
+		Action      func(childComplexity int) int
+		Actor       func(childComplexity int) int
+		ActorUserID func(childComplexity int) int
+		CreatedAt   func(childComplexity int) int
+		Field       func(childComplexity int) int
+		ID          func(childComplexity int) int
+		NewValue    func(childComplexity int) int
+		OldValue    func(childComplexity int) int
 	}
 
 	RequestResponse struct {
 		ChannelID      func(childComplexity int) int
+		Comments       func(childComplexity int) int
 		CreatedAt      func(childComplexity int) int
 		EndTime        func(childComplexity int) int
+		History        func(childComplexity int) int
 		ID             func(childComplexity int) int
 		IsAllDay       func(childComplexity int) int
 		Reason         func(childComplexity int) int
@@ -118,20 +254,64 @@ type ComplexityRoot struct {
 	}
 
 	RequestTimeOff struct {
-		ChannelID        func(childComplexity int) int
-		CreatedAt        func(childComplexity int) int
-		EndTime          func(childComplexity int) int
+		AttachmentURL            func(childComplexity int) int
+		BlackoutOverrideByUserID func(childComplexity int) int
+		BlackoutOverrideReason   func(childComplexity int) int
+		CapacityOverrideByUserID func(childComplexity int) int
+		CapacityOverrideReason   func(childComplexity int) int
+		ChannelID                func(childComplexity int) int
+		Comments                 func(childComplexity int) int
+		CreatedAt                func(childComplexity int) int
+		EndTime                  func(childComplexity int) int
+		History                  func(childComplexity int) int
+		ID                       func(childComplexity int) int
+		Is24Hours                func(childComplexity int) int
+		LeaveType                func(childComplexity int) int
+		LeaveTypeDetails         func(childComplexity int) int
+		Reason                   func(childComplexity int) int
+		RequestID                func(childComplexity int) int
+		RequestNote              func(childComplexity int) int
+		ResponseAt               func(childComplexity int) int
+		ResponseByUserID         func(childComplexity int) int
+		ResponseNote             func(childComplexity int) int
+		StartTime                func(childComplexity int) int
+		Status                   func(childComplexity int) int
+		UserID                   func(childComplexity int) int
+	}
+
+	RequestTimeOffConnection struct {
+		Edges    func(childComplexity int) int
+		PageInfo func(childComplexity int) int
+	}
+
+	RequestTimeOffEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
+	RequestTimeOffResult struct {
+		CapacityWarnings func(childComplexity int) int
+		Errors           func(childComplexity int) int
 		ID               func(childComplexity int) int
-		Is24Hours        func(childComplexity int) int
-		Reason           func(childComplexity int) int
-		RequestID        func(childComplexity int) int
-		RequestNote      func(childComplexity int) int
-		ResponseAt       func(childComplexity int) int
-		ResponseByUserID func(childComplexity int) int
-		ResponseNote     func(childComplexity int) int
-		StartTime        func(childComplexity int) int
-		Status           func(childComplexity int) int
-		UserID           func(childComplexity int) int
+		Request          func(childComplexity int) int
+		ShiftConflicts   func(childComplexity int) int
+	}
+
+	ShiftConflict struct {
+		Converted func(childComplexity int) int
+		Shift     func(childComplexity int) int
+	}
+
+	ShiftConflictSettings struct {
+		ChannelID       func(childComplexity int) int
+		Policy          func(childComplexity int) int
+		UpdatedAt       func(childComplexity int) int
+		UpdatedByUserID func(childComplexity int) int
+	}
+
+	ShiftConflictSettingsResponse struct {
+		Errors   func(childComplexity int) int
+		Settings func(childComplexity int) int
 	}
 
 	ShiftError struct {
@@ -140,9 +320,62 @@ type ComplexityRoot struct {
 		Message func(childComplexity int) int
 	}
 
+	TimeOffAccrualPolicy struct {
+		CarryOverCap    func(childComplexity int) int
+		ChannelID       func(childComplexity int) int
+		Enabled         func(childComplexity int) int
+		GrantDay        func(childComplexity int) int
+		GrantMonth      func(childComplexity int) int
+		Hours           func(childComplexity int) int
+		HoursPerDay     func(childComplexity int) int
+		IncludeWeekends func(childComplexity int) int
+		LeaveType       func(childComplexity int) int
+		Method          func(childComplexity int) int
+		PeriodDays      func(childComplexity int) int
+		PeriodStart     func(childComplexity int) int
+		Rate            func(childComplexity int) int
+		UpdatedAt       func(childComplexity int) int
+		UpdatedByUserID func(childComplexity int) int
+	}
+
+	TimeOffAccrualPolicyResponse struct {
+		Errors func(childComplexity int) int
+		Policy func(childComplexity int) int
+	}
+
+	TimeOffBalance struct {
+		BalanceHours func(childComplexity int) int
+		ChannelID    func(childComplexity int) int
+		CreatedAt    func(childComplexity int) int
+		History      func(childComplexity int) int
+		ID           func(childComplexity int) int
+		LeaveType    func(childComplexity int) int
+		UpdatedAt    func(childComplexity int) int
+		UserID       func(childComplexity int) int
+	}
+
+	TimeOffBalanceResponse struct {
+		Balances func(childComplexity int) int
+		Errors   func(childComplexity int) int
+	}
+
+	TimeOffLedgerEntry struct {
+		ActorUserID      func(childComplexity int) int
+		BalanceAfter     func(childComplexity int) int
+		CreatedAt        func(childComplexity int) int
+		Hours            func(childComplexity int) int
+		ID               func(childComplexity int) int
+		Kind             func(childComplexity int) int
+		Note             func(childComplexity int) int
+		Period           func(childComplexity int) int
+		RequestTimeOffID func(childComplexity int) int
+	}
+
 	TimeOffResponse struct {
-		Errors  func(childComplexity int) int
-		Request func(childComplexity int) int
+		CapacityWarnings func(childComplexity int) int
+		Errors           func(childComplexity int) int
+		Request          func(childComplexity int) int
+		ShiftConflicts   func(childComplexity int) int
 	}
 
 	User struct {
@@ -161,13 +394,56 @@ type MutationResolver interface {
 	UpdateRequestTimeOff(ctx context.Context, id string, input model.RequestTimeOffInput, authUserID *string) (*model.TimeOffResponse, error)
 	DeleteRequestTimeOff(ctx context.Context, id string, authUserID *string) (*model.TimeOffResponse, error)
 	CancelRequestTimeOff(ctx context.Context, channelID string, requestID string, authUserID *string) (*model.TimeOffResponse, error)
-	ApproveRequestTimeOff(ctx context.Context, id string, responseNote *string, authUserID *string) (*model.TimeOffResponse, error)
+	ApproveRequestTimeOff(ctx context.Context, id string, responseNote *string, capacityOverrideReason *string, authUserID *string) (*model.TimeOffResponse, error)
 	DenyRequestTimeOff(ctx context.Context, id string, responseNote *string, authUserID *string) (*model.TimeOffResponse, error)
+	ApproveRequestTimeOffs(ctx context.Context, ids []string, responseNote *string, authUserID *string) (*model.BulkTimeOffResponse, error)
+	DenyRequestTimeOffs(ctx context.Context, ids []string, responseNote *string, authUserID *string) (*model.BulkTimeOffResponse, error)
+	AddRequestComment(ctx context.Context, id string, body string, authUserID *string) (*model.RequestCommentResponse, error)
+	SetRequestExpirySettings(ctx context.Context, channelID string, input model.RequestExpirySettingsInput, authUserID *string) (*model.RequestExpirySettingsResponse, error)
+	SetTimeOffAccrualPolicy(ctx context.Context, channelID string, input model.TimeOffAccrualPolicyInput, authUserID *string) (*model.TimeOffAccrualPolicyResponse, error)
+	AdjustTimeOffBalance(ctx context.Context, channelID string, userID string, leaveType string, hours float64, note *string, authUserID *string) (*model.TimeOffBalanceResponse, error)
+	RecordHoursWorked(ctx context.Context, channelID string, userID string, hours float64, workedUntil time.Time, authUserID *string) (*model.TimeOffBalanceResponse, error)
+	SetShiftConflictSettings(ctx context.Context, channelID string, input model.ShiftConflictSettingsInput, authUserID *string) (*model.ShiftConflictSettingsResponse, error)
+	SetLeaveType(ctx context.Context, channelID string, input model.LeaveTypeInput, authUserID *string) (*model.LeaveTypeResponse, error)
+	CreateBlackoutPeriod(ctx context.Context, input model.BlackoutPeriodInput, authUserID *string) (*model.BlackoutPeriodResponse, error)
+	UpdateBlackoutPeriod(ctx context.Context, id string, input model.BlackoutPeriodInput, authUserID *string) (*model.BlackoutPeriodResponse, error)
+	DeleteBlackoutPeriod(ctx context.Context, id string, authUserID *string) (*model.BlackoutPeriodResponse, error)
+	SetAbsenceLimit(ctx context.Context, channelID string, shiftGroupID string, input model.AbsenceLimitInput, authUserID *string) (*model.AbsenceLimitResponse, error)
 }
 type QueryResolver interface {
 	GetRequestTimeOff(ctx context.Context, id string, authUserID *string) (*model.RequestTimeOff, error)
-	GetRequestTimeOffs(ctx context.Context, authUserID *string) ([]*model.RequestTimeOff, error)
+	GetRequestTimeOffs(ctx context.Context, channelID *string, authUserID *string) ([]*model.RequestTimeOff, error)
+	GetRequestTimeOffsConnection(ctx context.Context, channelID string, filter *model.RequestFilter, orderBy *model.RequestOrder, first *int, after *string, authUserID *string) (*model.RequestTimeOffConnection, error)
 	GetRequestTimeOffsByChannelIDRequestID(ctx context.Context, channelID string, requestID string) (*model.RequestTimeOff, error)
+	GetRequestExpirySettings(ctx context.Context, channelID string, authUserID *string) (*model.RequestExpirySettings, error)
+	TimeOffBalance(ctx context.Context, userID string, channelID *string, leaveType *string, authUserID *string) ([]*model.TimeOffBalance, error)
+	GetTimeOffAccrualPolicies(ctx context.Context, channelID string, authUserID *string) ([]*model.TimeOffAccrualPolicy, error)
+	GetShiftConflictSettings(ctx context.Context, channelID string, authUserID *string) (*model.ShiftConflictSettings, error)
+	GetLeaveTypes(ctx context.Context, channelID string, includeArchived *bool, authUserID *string) ([]*model.LeaveType, error)
+	GetBlackoutPeriods(ctx context.Context, channelID string, shiftGroupID *string, from *time.Time, to *time.Time, authUserID *string) ([]*model.BlackoutPeriod, error)
+	GetAbsenceLimits(ctx context.Context, channelID string, authUserID *string) ([]*model.AbsenceLimit, error)
+	GetApprovedTimeOffs(ctx context.Context, channelID string, userIds []string, startTime time.Time, endTime time.Time, authUserID *string) ([]*model.RequestTimeOff, error)
+}
+type RequestCommentResolver interface {
+	User(ctx context.Context, obj *model.RequestComment) (*model.User, error)
+}
+type RequestHistoryEntryResolver interface {
+	Actor(ctx context.Context, obj *model.RequestHistoryEntry) (*model.User, error)
+}
+type RequestResponseResolver interface {
+	ResponseBy(ctx context.Context, obj *model.RequestResponse) (*model.User, error)
+
+	History(ctx context.Context, obj *model.RequestResponse) ([]*model.RequestHistoryEntry, error)
+	Comments(ctx context.Context, obj *model.RequestResponse) ([]*model.RequestComment, error)
+}
+type RequestTimeOffResolver interface {
+	LeaveTypeDetails(ctx context.Context, obj *model.RequestTimeOff) (*model.LeaveType, error)
+
+	History(ctx context.Context, obj *model.RequestTimeOff) ([]*model.RequestHistoryEntry, error)
+	Comments(ctx context.Context, obj *model.RequestTimeOff) ([]*model.RequestComment, error)
+}
+type TimeOffBalanceResolver interface {
+	History(ctx context.Context, obj *model.TimeOffBalance) ([]*model.TimeOffLedgerEntry, error)
 }
 
 type executableSchema struct {
@@ -185,6 +461,62 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 	_ = ec
 	switch typeName + "." + field {
 
+	case "AbsenceLimit.channelId":
+		if e.complexity.AbsenceLimit.ChannelID == nil {
+			break
+		}
+
+		return e.complexity.AbsenceLimit.ChannelID(childComplexity), true
+
+	case "AbsenceLimit.maxAbsencePercent":
+		if e.complexity.AbsenceLimit.MaxAbsencePercent == nil {
+			break
+		}
+
+		return e.complexity.AbsenceLimit.MaxAbsencePercent(childComplexity), true
+
+	case "AbsenceLimit.maxAbsences":
+		if e.complexity.AbsenceLimit.MaxAbsences == nil {
+			break
+		}
+
+		return e.complexity.AbsenceLimit.MaxAbsences(childComplexity), true
+
+	case "AbsenceLimit.shiftGroupId":
+		if e.complexity.AbsenceLimit.ShiftGroupID == nil {
+			break
+		}
+
+		return e.complexity.AbsenceLimit.ShiftGroupID(childComplexity), true
+
+	case "AbsenceLimit.updatedAt":
+		if e.complexity.AbsenceLimit.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.AbsenceLimit.UpdatedAt(childComplexity), true
+
+	case "AbsenceLimit.updatedByUserId":
+		if e.complexity.AbsenceLimit.UpdatedByUserID == nil {
+			break
+		}
+
+		return e.complexity.AbsenceLimit.UpdatedByUserID(childComplexity), true
+
+	case "AbsenceLimitResponse.absenceLimit":
+		if e.complexity.AbsenceLimitResponse.AbsenceLimit == nil {
+			break
+		}
+
+		return e.complexity.AbsenceLimitResponse.AbsenceLimit(childComplexity), true
+
+	case "AbsenceLimitResponse.errors":
+		if e.complexity.AbsenceLimitResponse.Errors == nil {
+			break
+		}
+
+		return e.complexity.AbsenceLimitResponse.Errors(childComplexity), true
+
 	case "AssignedShift.break":
 		if e.complexity.AssignedShift.Break == nil {
 			break
@@ -388,6180 +720,17180 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.AssignedShiftActivities.UserID(childComplexity), true
 
-	case "Mutation.approveRequestTimeOff":
-		if e.complexity.Mutation.ApproveRequestTimeOff == nil {
+	case "BlackoutPeriod.channelId":
+		if e.complexity.BlackoutPeriod.ChannelID == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_approveRequestTimeOff_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
-		}
-
-		return e.complexity.Mutation.ApproveRequestTimeOff(childComplexity, args["id"].(string), args["responseNote"].(*string), args["authUserId"].(*string)), true
+		return e.complexity.BlackoutPeriod.ChannelID(childComplexity), true
 
-	case "Mutation.cancelRequestTimeOff":
-		if e.complexity.Mutation.CancelRequestTimeOff == nil {
+	case "BlackoutPeriod.createdAt":
+		if e.complexity.BlackoutPeriod.CreatedAt == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_cancelRequestTimeOff_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
-		}
-
-		return e.complexity.Mutation.CancelRequestTimeOff(childComplexity, args["channelId"].(string), args["requestId"].(string), args["authUserId"].(*string)), true
+		return e.complexity.BlackoutPeriod.CreatedAt(childComplexity), true
 
-	case "Mutation.createRequestTimeOff":
-		if e.complexity.Mutation.CreateRequestTimeOff == nil {
+	case "BlackoutPeriod.createdByUserId":
+		if e.complexity.BlackoutPeriod.CreatedByUserID == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_createRequestTimeOff_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
-		}
-
-		return e.complexity.Mutation.CreateRequestTimeOff(childComplexity, args["input"].(model.RequestTimeOffInput), args["authUserId"].(*string)), true
+		return e.complexity.BlackoutPeriod.CreatedByUserID(childComplexity), true
 
-	case "Mutation.deleteRequestTimeOff":
-		if e.complexity.Mutation.DeleteRequestTimeOff == nil {
+	case "BlackoutPeriod.endTime":
+		if e.complexity.BlackoutPeriod.EndTime == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_deleteRequestTimeOff_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
-		}
-
-		return e.complexity.Mutation.DeleteRequestTimeOff(childComplexity, args["id"].(string), args["authUserId"].(*string)), true
+		return e.complexity.BlackoutPeriod.EndTime(childComplexity), true
 
-	case "Mutation.denyRequestTimeOff":
-		if e.complexity.Mutation.DenyRequestTimeOff == nil {
+	case "BlackoutPeriod.id":
+		if e.complexity.BlackoutPeriod.ID == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_denyRequestTimeOff_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
-		}
-
-		return e.complexity.Mutation.DenyRequestTimeOff(childComplexity, args["id"].(string), args["responseNote"].(*string), args["authUserId"].(*string)), true
+		return e.complexity.BlackoutPeriod.ID(childComplexity), true
 
-	case "Mutation.updateRequestTimeOff":
-		if e.complexity.Mutation.UpdateRequestTimeOff == nil {
+	case "BlackoutPeriod.name":
+		if e.complexity.BlackoutPeriod.Name == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_updateRequestTimeOff_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
-		}
-
-		return e.complexity.Mutation.UpdateRequestTimeOff(childComplexity, args["id"].(string), args["input"].(model.RequestTimeOffInput), args["authUserId"].(*string)), true
+		return e.complexity.BlackoutPeriod.Name(childComplexity), true
 
-	case "Query.getRequestTimeOff":
-		if e.complexity.Query.GetRequestTimeOff == nil {
+	case "BlackoutPeriod.shiftGroupId":
+		if e.complexity.BlackoutPeriod.ShiftGroupID == nil {
 			break
 		}
 
-		args, err := ec.field_Query_getRequestTimeOff_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
-		}
-
-		return e.complexity.Query.GetRequestTimeOff(childComplexity, args["id"].(string), args["authUserId"].(*string)), true
+		return e.complexity.BlackoutPeriod.ShiftGroupID(childComplexity), true
 
-	case "Query.getRequestTimeOffs":
-		if e.complexity.Query.GetRequestTimeOffs == nil {
+	case "BlackoutPeriod.startTime":
+		if e.complexity.BlackoutPeriod.StartTime == nil {
 			break
 		}
 
-		args, err := ec.field_Query_getRequestTimeOffs_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
-		}
-
-		return e.complexity.Query.GetRequestTimeOffs(childComplexity, args["authUserId"].(*string)), true
+		return e.complexity.BlackoutPeriod.StartTime(childComplexity), true
 
-	case "Query.getRequestTimeOffsByChannelIdRequestId":
-		if e.complexity.Query.GetRequestTimeOffsByChannelIDRequestID == nil {
+	case "BlackoutPeriod.updatedAt":
+		if e.complexity.BlackoutPeriod.UpdatedAt == nil {
 			break
 		}
 
-		args, err := ec.field_Query_getRequestTimeOffsByChannelIdRequestId_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
-		}
+		return e.complexity.BlackoutPeriod.UpdatedAt(childComplexity), true
 
-		return e.complexity.Query.GetRequestTimeOffsByChannelIDRequestID(childComplexity, args["channelId"].(string), args["requestId"].(string)), true
-
-	case "RequestResponse.channelId":
-		if e.complexity.RequestResponse.ChannelID == nil {
+	case "BlackoutPeriodResponse.blackoutPeriod":
+		if e.complexity.BlackoutPeriodResponse.BlackoutPeriod == nil {
 			break
 		}
 
-		return e.complexity.RequestResponse.ChannelID(childComplexity), true
+		return e.complexity.BlackoutPeriodResponse.BlackoutPeriod(childComplexity), true
 
-	case "RequestResponse.createdAt":
-		if e.complexity.RequestResponse.CreatedAt == nil {
+	case "BlackoutPeriodResponse.errors":
+		if e.complexity.BlackoutPeriodResponse.Errors == nil {
 			break
 		}
 
-		return e.complexity.RequestResponse.CreatedAt(childComplexity), true
+		return e.complexity.BlackoutPeriodResponse.Errors(childComplexity), true
 
-	case "RequestResponse.endTime":
-		if e.complexity.RequestResponse.EndTime == nil {
+	case "BulkTimeOffResponse.errors":
+		if e.complexity.BulkTimeOffResponse.Errors == nil {
 			break
 		}
 
-		return e.complexity.RequestResponse.EndTime(childComplexity), true
+		return e.complexity.BulkTimeOffResponse.Errors(childComplexity), true
 
-	case "RequestResponse.id":
-		if e.complexity.RequestResponse.ID == nil {
+	case "BulkTimeOffResponse.results":
+		if e.complexity.BulkTimeOffResponse.Results == nil {
 			break
 		}
 
-		return e.complexity.RequestResponse.ID(childComplexity), true
+		return e.complexity.BulkTimeOffResponse.Results(childComplexity), true
 
-	case "RequestResponse.isAllDay":
-		if e.complexity.RequestResponse.IsAllDay == nil {
+	case "CapacityWarning.absences":
+		if e.complexity.CapacityWarning.Absences == nil {
 			break
 		}
 
-		return e.complexity.RequestResponse.IsAllDay(childComplexity), true
+		return e.complexity.CapacityWarning.Absences(childComplexity), true
 
-	case "RequestResponse.reason":
-		if e.complexity.RequestResponse.Reason == nil {
+	case "CapacityWarning.date":
+		if e.complexity.CapacityWarning.Date == nil {
 			break
 		}
 
-		return e.complexity.RequestResponse.Reason(childComplexity), true
+		return e.complexity.CapacityWarning.Date(childComplexity), true
 
-	case "RequestResponse.requestId":
-		if e.complexity.RequestResponse.RequestID == nil {
+	case "CapacityWarning.limit":
+		if e.complexity.CapacityWarning.Limit == nil {
 			break
 		}
 
-		return e.complexity.RequestResponse.RequestID(childComplexity), true
+		return e.complexity.CapacityWarning.Limit(childComplexity), true
 
-	case "RequestResponse.requestNote":
-		if e.complexity.RequestResponse.RequestNote == nil {
+	case "CapacityWarning.shiftGroupId":
+		if e.complexity.CapacityWarning.ShiftGroupID == nil {
 			break
 		}
 
-		return e.complexity.RequestResponse.RequestNote(childComplexity), true
+		return e.complexity.CapacityWarning.ShiftGroupID(childComplexity), true
 
-	case "RequestResponse.responseAt":
-		if e.complexity.RequestResponse.ResponseAt == nil {
+	case "LeaveType.archived":
+		if e.complexity.LeaveType.Archived == nil {
 			break
 		}
 
-		return e.complexity.RequestResponse.ResponseAt(childComplexity), true
+		return e.complexity.LeaveType.Archived(childComplexity), true
 
-	case "RequestResponse.responseBy":
-		if e.complexity.RequestResponse.ResponseBy == nil {
+	case "LeaveType.autoApprove":
+		if e.complexity.LeaveType.AutoApprove == nil {
 			break
 		}
 
-		return e.complexity.RequestResponse.ResponseBy(childComplexity), true
+		return e.complexity.LeaveType.AutoApprove(childComplexity), true
 
-	case "RequestResponse.responseNote":
-		if e.complexity.RequestResponse.ResponseNote == nil {
+	case "LeaveType.channelId":
+		if e.complexity.LeaveType.ChannelID == nil {
 			break
 		}
 
-		return e.complexity.RequestResponse.ResponseNote(childComplexity), true
+		return e.complexity.LeaveType.ChannelID(childComplexity), true
 
-	case "RequestResponse.shiftOfferedTo":
-		if e.complexity.RequestResponse.ShiftOfferedTo == nil {
+	case "LeaveType.code":
+		if e.complexity.LeaveType.Code == nil {
 			break
 		}
 
-		return e.complexity.RequestResponse.ShiftOfferedTo(childComplexity), true
+		return e.complexity.LeaveType.Code(childComplexity), true
 
-	case "RequestResponse.shiftToOffer":
-		if e.complexity.RequestResponse.ShiftToOffer == nil {
+	case "LeaveType.color":
+		if e.complexity.LeaveType.Color == nil {
 			break
 		}
 
-		return e.complexity.RequestResponse.ShiftToOffer(childComplexity), true
+		return e.complexity.LeaveType.Color(childComplexity), true
 
-	case "RequestResponse.shiftToSwap":
-		if e.complexity.RequestResponse.ShiftToSwap == nil {
+	case "LeaveType.maxConsecutiveDays":
+		if e.complexity.LeaveType.MaxConsecutiveDays == nil {
 			break
 		}
 
-		return e.complexity.RequestResponse.ShiftToSwap(childComplexity), true
+		return e.complexity.LeaveType.MaxConsecutiveDays(childComplexity), true
 
-	case "RequestResponse.startTime":
-		if e.complexity.RequestResponse.StartTime == nil {
+	case "LeaveType.minimumNoticeHours":
+		if e.complexity.LeaveType.MinimumNoticeHours == nil {
 			break
 		}
 
-		return e.complexity.RequestResponse.StartTime(childComplexity), true
+		return e.complexity.LeaveType.MinimumNoticeHours(childComplexity), true
 
-	case "RequestResponse.status":
-		if e.complexity.RequestResponse.Status == nil {
+	case "LeaveType.name":
+		if e.complexity.LeaveType.Name == nil {
 			break
 		}
 
-		return e.complexity.RequestResponse.Status(childComplexity), true
+		return e.complexity.LeaveType.Name(childComplexity), true
 
-	case "RequestResponse.toSwapWith":
-		if e.complexity.RequestResponse.ToSwapWith == nil {
+	case "LeaveType.paid":
+		if e.complexity.LeaveType.Paid == nil {
 			break
 		}
 
-		return e.complexity.RequestResponse.ToSwapWith(childComplexity), true
+		return e.complexity.LeaveType.Paid(childComplexity), true
 
-	case "RequestResponse.type":
-		if e.complexity.RequestResponse.Type == nil {
+	case "LeaveType.requiresAttachment":
+		if e.complexity.LeaveType.RequiresAttachment == nil {
 			break
 		}
 
-		return e.complexity.RequestResponse.Type(childComplexity), true
+		return e.complexity.LeaveType.RequiresAttachment(childComplexity), true
 
-	case "RequestResponse.user":
-		if e.complexity.RequestResponse.User == nil {
+	case "LeaveType.updatedAt":
+		if e.complexity.LeaveType.UpdatedAt == nil {
 			break
 		}
 
-		return e.complexity.RequestResponse.User(childComplexity), true
+		return e.complexity.LeaveType.UpdatedAt(childComplexity), true
 
-	case "RequestTimeOff.channelId":
-		if e.complexity.RequestTimeOff.ChannelID == nil {
+	case "LeaveType.updatedByUserId":
+		if e.complexity.LeaveType.UpdatedByUserID == nil {
 			break
 		}
 
-		return e.complexity.RequestTimeOff.ChannelID(childComplexity), true
+		return e.complexity.LeaveType.UpdatedByUserID(childComplexity), true
 
-	case "RequestTimeOff.createdAt":
-		if e.complexity.RequestTimeOff.CreatedAt == nil {
+	case "LeaveTypeResponse.errors":
+		if e.complexity.LeaveTypeResponse.Errors == nil {
 			break
 		}
 
-		return e.complexity.RequestTimeOff.CreatedAt(childComplexity), true
+		return e.complexity.LeaveTypeResponse.Errors(childComplexity), true
 
-	case "RequestTimeOff.endTime":
-		if e.complexity.RequestTimeOff.EndTime == nil {
+	case "LeaveTypeResponse.leaveType":
+		if e.complexity.LeaveTypeResponse.LeaveType == nil {
 			break
 		}
 
-		return e.complexity.RequestTimeOff.EndTime(childComplexity), true
+		return e.complexity.LeaveTypeResponse.LeaveType(childComplexity), true
 
-	case "RequestTimeOff.id":
-		if e.complexity.RequestTimeOff.ID == nil {
+	case "Mutation.addRequestComment":
+		if e.complexity.Mutation.AddRequestComment == nil {
 			break
 		}
 
-		return e.complexity.RequestTimeOff.ID(childComplexity), true
-
-	case "RequestTimeOff.is24Hours":
-		if e.complexity.RequestTimeOff.Is24Hours == nil {
-			break
+		args, err := ec.field_Mutation_addRequestComment_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.RequestTimeOff.Is24Hours(childComplexity), true
+		return e.complexity.Mutation.AddRequestComment(childComplexity, args["id"].(string), args["body"].(string), args["authUserId"].(*string)), true
 
-	case "RequestTimeOff.reason":
-		if e.complexity.RequestTimeOff.Reason == nil {
+	case "Mutation.adjustTimeOffBalance":
+		if e.complexity.Mutation.AdjustTimeOffBalance == nil {
 			break
 		}
 
-		return e.complexity.RequestTimeOff.Reason(childComplexity), true
-
-	case "RequestTimeOff.requestId":
-		if e.complexity.RequestTimeOff.RequestID == nil {
-			break
+		args, err := ec.field_Mutation_adjustTimeOffBalance_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.RequestTimeOff.RequestID(childComplexity), true
+		return e.complexity.Mutation.AdjustTimeOffBalance(childComplexity, args["channelId"].(string), args["userId"].(string), args["leaveType"].(string), args["hours"].(float64), args["note"].(*string), args["authUserId"].(*string)), true
 
-	case "RequestTimeOff.requestNote":
-		if e.complexity.RequestTimeOff.RequestNote == nil {
+	case "Mutation.approveRequestTimeOff":
+		if e.complexity.Mutation.ApproveRequestTimeOff == nil {
 			break
 		}
 
-		return e.complexity.RequestTimeOff.RequestNote(childComplexity), true
+		args, err := ec.field_Mutation_approveRequestTimeOff_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "RequestTimeOff.responseAt":
-		if e.complexity.RequestTimeOff.ResponseAt == nil {
+		return e.complexity.Mutation.ApproveRequestTimeOff(childComplexity, args["id"].(string), args["responseNote"].(*string), args["capacityOverrideReason"].(*string), args["authUserId"].(*string)), true
+
+	case "Mutation.approveRequestTimeOffs":
+		if e.complexity.Mutation.ApproveRequestTimeOffs == nil {
 			break
 		}
 
-		return e.complexity.RequestTimeOff.ResponseAt(childComplexity), true
+		args, err := ec.field_Mutation_approveRequestTimeOffs_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "RequestTimeOff.responseByUserId":
-		if e.complexity.RequestTimeOff.ResponseByUserID == nil {
+		return e.complexity.Mutation.ApproveRequestTimeOffs(childComplexity, args["ids"].([]string), args["responseNote"].(*string), args["authUserId"].(*string)), true
+
+	case "Mutation.cancelRequestTimeOff":
+		if e.complexity.Mutation.CancelRequestTimeOff == nil {
 			break
 		}
 
-		return e.complexity.RequestTimeOff.ResponseByUserID(childComplexity), true
+		args, err := ec.field_Mutation_cancelRequestTimeOff_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "RequestTimeOff.responseNote":
-		if e.complexity.RequestTimeOff.ResponseNote == nil {
+		return e.complexity.Mutation.CancelRequestTimeOff(childComplexity, args["channelId"].(string), args["requestId"].(string), args["authUserId"].(*string)), true
+
+	case "Mutation.createBlackoutPeriod":
+		if e.complexity.Mutation.CreateBlackoutPeriod == nil {
 			break
 		}
 
-		return e.complexity.RequestTimeOff.ResponseNote(childComplexity), true
+		args, err := ec.field_Mutation_createBlackoutPeriod_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "RequestTimeOff.startTime":
-		if e.complexity.RequestTimeOff.StartTime == nil {
+		return e.complexity.Mutation.CreateBlackoutPeriod(childComplexity, args["input"].(model.BlackoutPeriodInput), args["authUserId"].(*string)), true
+
+	case "Mutation.createRequestTimeOff":
+		if e.complexity.Mutation.CreateRequestTimeOff == nil {
 			break
 		}
 
-		return e.complexity.RequestTimeOff.StartTime(childComplexity), true
+		args, err := ec.field_Mutation_createRequestTimeOff_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "RequestTimeOff.status":
-		if e.complexity.RequestTimeOff.Status == nil {
+		return e.complexity.Mutation.CreateRequestTimeOff(childComplexity, args["input"].(model.RequestTimeOffInput), args["authUserId"].(*string)), true
+
+	case "Mutation.deleteBlackoutPeriod":
+		if e.complexity.Mutation.DeleteBlackoutPeriod == nil {
 			break
 		}
 
-		return e.complexity.RequestTimeOff.Status(childComplexity), true
+		args, err := ec.field_Mutation_deleteBlackoutPeriod_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "RequestTimeOff.userId":
-		if e.complexity.RequestTimeOff.UserID == nil {
+		return e.complexity.Mutation.DeleteBlackoutPeriod(childComplexity, args["id"].(string), args["authUserId"].(*string)), true
+
+	case "Mutation.deleteRequestTimeOff":
+		if e.complexity.Mutation.DeleteRequestTimeOff == nil {
 			break
 		}
 
-		return e.complexity.RequestTimeOff.UserID(childComplexity), true
+		args, err := ec.field_Mutation_deleteRequestTimeOff_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "ShiftError.code":
-		if e.complexity.ShiftError.Code == nil {
+		return e.complexity.Mutation.DeleteRequestTimeOff(childComplexity, args["id"].(string), args["authUserId"].(*string)), true
+
+	case "Mutation.denyRequestTimeOff":
+		if e.complexity.Mutation.DenyRequestTimeOff == nil {
 			break
 		}
 
-		return e.complexity.ShiftError.Code(childComplexity), true
+		args, err := ec.field_Mutation_denyRequestTimeOff_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "ShiftError.field":
-		if e.complexity.ShiftError.Field == nil {
+		return e.complexity.Mutation.DenyRequestTimeOff(childComplexity, args["id"].(string), args["responseNote"].(*string), args["authUserId"].(*string)), true
+
+	case "Mutation.denyRequestTimeOffs":
+		if e.complexity.Mutation.DenyRequestTimeOffs == nil {
 			break
 		}
 
-		return e.complexity.ShiftError.Field(childComplexity), true
+		args, err := ec.field_Mutation_denyRequestTimeOffs_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "ShiftError.message":
-		if e.complexity.ShiftError.Message == nil {
+		return e.complexity.Mutation.DenyRequestTimeOffs(childComplexity, args["ids"].([]string), args["responseNote"].(*string), args["authUserId"].(*string)), true
+
+	case "Mutation.recordHoursWorked":
+		if e.complexity.Mutation.RecordHoursWorked == nil {
 			break
 		}
 
-		return e.complexity.ShiftError.Message(childComplexity), true
+		args, err := ec.field_Mutation_recordHoursWorked_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "TimeOffResponse.errors":
-		if e.complexity.TimeOffResponse.Errors == nil {
+		return e.complexity.Mutation.RecordHoursWorked(childComplexity, args["channelId"].(string), args["userId"].(string), args["hours"].(float64), args["workedUntil"].(time.Time), args["authUserId"].(*string)), true
+
+	case "Mutation.setAbsenceLimit":
+		if e.complexity.Mutation.SetAbsenceLimit == nil {
 			break
 		}
 
-		return e.complexity.TimeOffResponse.Errors(childComplexity), true
+		args, err := ec.field_Mutation_setAbsenceLimit_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "TimeOffResponse.request":
-		if e.complexity.TimeOffResponse.Request == nil {
+		return e.complexity.Mutation.SetAbsenceLimit(childComplexity, args["channelId"].(string), args["shiftGroupId"].(string), args["input"].(model.AbsenceLimitInput), args["authUserId"].(*string)), true
+
+	case "Mutation.setLeaveType":
+		if e.complexity.Mutation.SetLeaveType == nil {
 			break
 		}
 
-		return e.complexity.TimeOffResponse.Request(childComplexity), true
+		args, err := ec.field_Mutation_setLeaveType_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "User.avatar":
-		if e.complexity.User.Avatar == nil {
+		return e.complexity.Mutation.SetLeaveType(childComplexity, args["channelId"].(string), args["input"].(model.LeaveTypeInput), args["authUserId"].(*string)), true
+
+	case "Mutation.setRequestExpirySettings":
+		if e.complexity.Mutation.SetRequestExpirySettings == nil {
 			break
 		}
 
-		return e.complexity.User.Avatar(childComplexity), true
+		args, err := ec.field_Mutation_setRequestExpirySettings_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "User.email":
-		if e.complexity.User.Email == nil {
+		return e.complexity.Mutation.SetRequestExpirySettings(childComplexity, args["channelId"].(string), args["input"].(model.RequestExpirySettingsInput), args["authUserId"].(*string)), true
+
+	case "Mutation.setShiftConflictSettings":
+		if e.complexity.Mutation.SetShiftConflictSettings == nil {
 			break
 		}
 
-		return e.complexity.User.Email(childComplexity), true
+		args, err := ec.field_Mutation_setShiftConflictSettings_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "User.firstName":
-		if e.complexity.User.FirstName == nil {
+		return e.complexity.Mutation.SetShiftConflictSettings(childComplexity, args["channelId"].(string), args["input"].(model.ShiftConflictSettingsInput), args["authUserId"].(*string)), true
+
+	case "Mutation.setTimeOffAccrualPolicy":
+		if e.complexity.Mutation.SetTimeOffAccrualPolicy == nil {
 			break
 		}
 
-		return e.complexity.User.FirstName(childComplexity), true
+		args, err := ec.field_Mutation_setTimeOffAccrualPolicy_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "User.id":
-		if e.complexity.User.ID == nil {
+		return e.complexity.Mutation.SetTimeOffAccrualPolicy(childComplexity, args["channelId"].(string), args["input"].(model.TimeOffAccrualPolicyInput), args["authUserId"].(*string)), true
+
+	case "Mutation.updateBlackoutPeriod":
+		if e.complexity.Mutation.UpdateBlackoutPeriod == nil {
 			break
 		}
 
-		return e.complexity.User.ID(childComplexity), true
+		args, err := ec.field_Mutation_updateBlackoutPeriod_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "User.isActive":
-		if e.complexity.User.IsActive == nil {
+		return e.complexity.Mutation.UpdateBlackoutPeriod(childComplexity, args["id"].(string), args["input"].(model.BlackoutPeriodInput), args["authUserId"].(*string)), true
+
+	case "Mutation.updateRequestTimeOff":
+		if e.complexity.Mutation.UpdateRequestTimeOff == nil {
 			break
 		}
 
-		return e.complexity.User.IsActive(childComplexity), true
+		args, err := ec.field_Mutation_updateRequestTimeOff_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "User.isStaff":
-		if e.complexity.User.IsStaff == nil {
+		return e.complexity.Mutation.UpdateRequestTimeOff(childComplexity, args["id"].(string), args["input"].(model.RequestTimeOffInput), args["authUserId"].(*string)), true
+
+	case "PageInfo.endCursor":
+		if e.complexity.PageInfo.EndCursor == nil {
 			break
 		}
 
-		return e.complexity.User.IsStaff(childComplexity), true
+		return e.complexity.PageInfo.EndCursor(childComplexity), true
 
-	case "User.lastName":
-		if e.complexity.User.LastName == nil {
+	case "PageInfo.hasNextPage":
+		if e.complexity.PageInfo.HasNextPage == nil {
 			break
 		}
 
-		return e.complexity.User.LastName(childComplexity), true
+		return e.complexity.PageInfo.HasNextPage(childComplexity), true
 
-	}
-	return 0, false
-}
+	case "PageInfo.hasPreviousPage":
+		if e.complexity.PageInfo.HasPreviousPage == nil {
+			break
+		}
 
-func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
-	rc := graphql.GetOperationContext(ctx)
-	ec := executionContext{rc, e}
-	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
-		ec.unmarshalInputRequestTimeOffInput,
-		ec.unmarshalInputRequestsInput,
-	)
-	first := true
+		return e.complexity.PageInfo.HasPreviousPage(childComplexity), true
 
-	switch rc.Operation.Operation {
-	case ast.Query:
-		return func(ctx context.Context) *graphql.Response {
-			if !first {
-				return nil
-			}
-			first = false
-			ctx = graphql.WithUnmarshalerMap(ctx, inputUnmarshalMap)
-			data := ec._Query(ctx, rc.Operation.SelectionSet)
-			var buf bytes.Buffer
-			data.MarshalGQL(&buf)
+	case "PageInfo.startCursor":
+		if e.complexity.PageInfo.StartCursor == nil {
+			break
+		}
 
-			return &graphql.Response{
-				Data: buf.Bytes(),
-			}
+		return e.complexity.PageInfo.StartCursor(childComplexity), true
+
+	case "Query.getAbsenceLimits":
+		if e.complexity.Query.GetAbsenceLimits == nil {
+			break
 		}
-	case ast.Mutation:
-		return func(ctx context.Context) *graphql.Response {
-			if !first {
-				return nil
-			}
-			first = false
-			ctx = graphql.WithUnmarshalerMap(ctx, inputUnmarshalMap)
-			data := ec._Mutation(ctx, rc.Operation.SelectionSet)
-			var buf bytes.Buffer
-			data.MarshalGQL(&buf)
 
-			return &graphql.Response{
-				Data: buf.Bytes(),
-			}
+		args, err := ec.field_Query_getAbsenceLimits_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-	default:
-		return graphql.OneShot(graphql.ErrorResponse(ctx, "unsupported GraphQL operation"))
-	}
-}
+		return e.complexity.Query.GetAbsenceLimits(childComplexity, args["channelId"].(string), args["authUserId"].(*string)), true
 
-type executionContext struct {
-	*graphql.OperationContext
-	*executableSchema
-}
+	case "Query.getApprovedTimeOffs":
+		if e.complexity.Query.GetApprovedTimeOffs == nil {
+			break
+		}
 
-func (ec *executionContext) introspectSchema() (*introspection.Schema, error) {
-	if ec.DisableIntrospection {
-		return nil, errors.New("introspection disabled")
-	}
-	return introspection.WrapSchema(parsedSchema), nil
-}
+		args, err := ec.field_Query_getApprovedTimeOffs_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) introspectType(name string) (*introspection.Type, error) {
-	if ec.DisableIntrospection {
-		return nil, errors.New("introspection disabled")
-	}
-	return introspection.WrapTypeFromDef(parsedSchema, parsedSchema.Types[name]), nil
-}
+		return e.complexity.Query.GetApprovedTimeOffs(childComplexity, args["channelId"].(string), args["userIds"].([]string), args["startTime"].(time.Time), args["endTime"].(time.Time), args["authUserId"].(*string)), true
 
-var sources = []*ast.Source{
-	{Name: "../schema.graphqls", Input: `type RequestTimeOff {
-  id: ID!
-  userId: ID!
-  channelId: ID
-  requestId: ID
-  startTime: Time!
-  endTime: Time
-  is24Hours: Boolean
-  reason: String
-  requestNote: String
-  status: RequestStatus!
-  responseNote: String
-  responseByUserId: ID
-  responseAt: Time
-  createdAt: Time!
-}
+	case "Query.getBlackoutPeriods":
+		if e.complexity.Query.GetBlackoutPeriods == nil {
+			break
+		}
 
-input RequestTimeOffInput {
-  userId: ID!
-  channelId: ID!
-  startTime: Time!
-  endTime: Time
-  is24Hours: Boolean!
-  reason: String
-  requestNote: String
-  responseNote: String
-  responseByUserId: ID
-  responseAt: Time
-}
+		args, err := ec.field_Query_getBlackoutPeriods_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-input RequestsInput {
-  channelId: ID
-  userId: ID
-  recipientId: ID
-  type: String
-}
+		return e.complexity.Query.GetBlackoutPeriods(childComplexity, args["channelId"].(string), args["shiftGroupId"].(*string), args["from"].(*time.Time), args["to"].(*time.Time), args["authUserId"].(*string)), true
 
-type User {
-  id: ID
-  firstName: String
-  lastName: String
-  email: String
-  avatar: String
-  isActive: Boolean
-  isStaff: Boolean
-}
-
-type AssignedShift {
-  id: ID!
-  break: String!
-  color: String!
-  startTime: Time!
-  endTime: Time!
-  is24Hours: Boolean!
-  label: String
-  note: String
-  shiftToOffer: AssignedShift
-  shiftToSwap: AssignedShift
-  toSwapWith: AssignedShift
-  userId: ID
-  channelId: ID
-  shiftGroupId: ID
-  type: String
-  isOpen: Boolean
-  isShared: Boolean
-  ShiftActivities: [AssignedShiftActivities]
-}
+	case "Query.getLeaveTypes":
+		if e.complexity.Query.GetLeaveTypes == nil {
+			break
+		}
 
-type AssignedShiftActivities {
-  id: ID!
-  channelId: ID
-  shiftGroupId: ID
-  assignedShiftId: ID!
-  userId: ID
-  name: String
-  code: String
-  color: String
-  startTime: Time!
-  endTime: Time!
-  isPaid: Boolean!
-}
+		args, err := ec.field_Query_getLeaveTypes_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-type TimeOffResponse {
-  errors: [ShiftError!]!
-  request: RequestResponse
-}
+		return e.complexity.Query.GetLeaveTypes(childComplexity, args["channelId"].(string), args["includeArchived"].(*bool), args["authUserId"].(*string)), true
 
-type ShiftError {
-  code: ShiftErrorCode!
-  field: String
-  message: String
-}
+	case "Query.getRequestExpirySettings":
+		if e.complexity.Query.GetRequestExpirySettings == nil {
+			break
+		}
 
-enum ShiftErrorCode {
-  GRAPHQL_ERROR
-  INVALID
-  NOT_FOUND
-  REQUIRED
-}
+		args, err := ec.field_Query_getRequestExpirySettings_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-type RequestResponse {
-  channelId: ID!
-  createdAt: Time
-  endTime: Time
-  id: ID!
-  isAllDay: Boolean
-  reason: String
-  requestId: ID!
-  requestNote: String
-  responseAt: Time
-  responseBy: User
-  responseNote: String
-  shiftOfferedTo: User
-  shiftToOffer: AssignedShift
-  shiftToSwap: AssignedShift
-  startTime: Time
-  status: RequestStatus
-  toSwapWith: AssignedShift
-  type: RequestType
-  user: User!
-}
+		return e.complexity.Query.GetRequestExpirySettings(childComplexity, args["channelId"].(string), args["authUserId"].(*string)), true
 
-enum RequestStatus {
-  PENDING
-  APPROVED
-  DENIED
-  CANCELLED
-}
+	case "Query.getRequestTimeOff":
+		if e.complexity.Query.GetRequestTimeOff == nil {
+			break
+		}
 
-enum RequestType {
-  OFFER
-  SWAP
-  TIMEOFF
-}
+		args, err := ec.field_Query_getRequestTimeOff_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-scalar Time
+		return e.complexity.Query.GetRequestTimeOff(childComplexity, args["id"].(string), args["authUserId"].(*string)), true
 
-type Query {
-  getRequestTimeOff(id: ID!, authUserId: ID): RequestTimeOff!
-  getRequestTimeOffs(authUserId: ID): [RequestTimeOff]!
-  getRequestTimeOffsByChannelIdRequestId(
-    channelId: ID!
-    requestId: ID!
-  ): RequestTimeOff!
-}
+	case "Query.getRequestTimeOffs":
+		if e.complexity.Query.GetRequestTimeOffs == nil {
+			break
+		}
 
-type Mutation {
-  createRequestTimeOff(
-    input: RequestTimeOffInput!
-    authUserId: ID
-  ): TimeOffResponse
-  updateRequestTimeOff(
-    id: ID!
-    input: RequestTimeOffInput!
-    authUserId: ID
-  ): TimeOffResponse
-  deleteRequestTimeOff(id: ID!, authUserId: ID): TimeOffResponse
-  cancelRequestTimeOff(
-    channelId: ID!
-    requestId: ID!
-    authUserId: ID
-  ): TimeOffResponse
-  approveRequestTimeOff(
-    id: ID!
-    responseNote: String
-    authUserId: ID
-  ): TimeOffResponse
-  denyRequestTimeOff(
-    id: ID!
-    responseNote: String
-    authUserId: ID
-  ): TimeOffResponse
-}
-`, BuiltIn: false},
-}
-var parsedSchema = gqlparser.MustLoadSchema(sources...)
+		args, err := ec.field_Query_getRequestTimeOffs_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-// endregion ************************** generated!.gotpl **************************
+		return e.complexity.Query.GetRequestTimeOffs(childComplexity, args["channelId"].(*string), args["authUserId"].(*string)), true
 
-// region    ***************************** args.gotpl *****************************
+	case "Query.getRequestTimeOffsByChannelIdRequestId":
+		if e.complexity.Query.GetRequestTimeOffsByChannelIDRequestID == nil {
+			break
+		}
 
-func (ec *executionContext) field_Mutation_approveRequestTimeOff_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 string
-	if tmp, ok := rawArgs["id"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
-		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		args, err := ec.field_Query_getRequestTimeOffsByChannelIdRequestId_args(context.TODO(), rawArgs)
 		if err != nil {
-			return nil, err
+			return 0, false
 		}
-	}
-	args["id"] = arg0
-	var arg1 *string
-	if tmp, ok := rawArgs["responseNote"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("responseNote"))
-		arg1, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
-		if err != nil {
-			return nil, err
+
+		return e.complexity.Query.GetRequestTimeOffsByChannelIDRequestID(childComplexity, args["channelId"].(string), args["requestId"].(string)), true
+
+	case "Query.getRequestTimeOffsConnection":
+		if e.complexity.Query.GetRequestTimeOffsConnection == nil {
+			break
 		}
-	}
-	args["responseNote"] = arg1
-	var arg2 *string
-	if tmp, ok := rawArgs["authUserId"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authUserId"))
-		arg2, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
+
+		args, err := ec.field_Query_getRequestTimeOffsConnection_args(context.TODO(), rawArgs)
 		if err != nil {
-			return nil, err
+			return 0, false
 		}
-	}
-	args["authUserId"] = arg2
-	return args, nil
-}
 
-func (ec *executionContext) field_Mutation_cancelRequestTimeOff_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 string
-	if tmp, ok := rawArgs["channelId"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("channelId"))
-		arg0, err = ec.unmarshalNID2string(ctx, tmp)
-		if err != nil {
-			return nil, err
+		return e.complexity.Query.GetRequestTimeOffsConnection(childComplexity, args["channelId"].(string), args["filter"].(*model.RequestFilter), args["orderBy"].(*model.RequestOrder), args["first"].(*int), args["after"].(*string), args["authUserId"].(*string)), true
+
+	case "Query.getShiftConflictSettings":
+		if e.complexity.Query.GetShiftConflictSettings == nil {
+			break
 		}
-	}
-	args["channelId"] = arg0
-	var arg1 string
-	if tmp, ok := rawArgs["requestId"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("requestId"))
-		arg1, err = ec.unmarshalNID2string(ctx, tmp)
+
+		args, err := ec.field_Query_getShiftConflictSettings_args(context.TODO(), rawArgs)
 		if err != nil {
-			return nil, err
+			return 0, false
 		}
-	}
-	args["requestId"] = arg1
-	var arg2 *string
-	if tmp, ok := rawArgs["authUserId"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authUserId"))
-		arg2, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
-		if err != nil {
-			return nil, err
+
+		return e.complexity.Query.GetShiftConflictSettings(childComplexity, args["channelId"].(string), args["authUserId"].(*string)), true
+
+	case "Query.getTimeOffAccrualPolicies":
+		if e.complexity.Query.GetTimeOffAccrualPolicies == nil {
+			break
 		}
-	}
-	args["authUserId"] = arg2
-	return args, nil
-}
 
-func (ec *executionContext) field_Mutation_createRequestTimeOff_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 model.RequestTimeOffInput
-	if tmp, ok := rawArgs["input"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
-		arg0, err = ec.unmarshalNRequestTimeOffInput2request_time_offsᚋgraphᚋmodelᚐRequestTimeOffInput(ctx, tmp)
+		args, err := ec.field_Query_getTimeOffAccrualPolicies_args(context.TODO(), rawArgs)
 		if err != nil {
-			return nil, err
+			return 0, false
 		}
-	}
-	args["input"] = arg0
-	var arg1 *string
-	if tmp, ok := rawArgs["authUserId"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authUserId"))
-		arg1, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
-		if err != nil {
-			return nil, err
+
+		return e.complexity.Query.GetTimeOffAccrualPolicies(childComplexity, args["channelId"].(string), args["authUserId"].(*string)), true
+
+	case "Query.timeOffBalance":
+		if e.complexity.Query.TimeOffBalance == nil {
+			break
 		}
-	}
-	args["authUserId"] = arg1
-	return args, nil
-}
 
-func (ec *executionContext) field_Mutation_deleteRequestTimeOff_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 string
-	if tmp, ok := rawArgs["id"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
-		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		args, err := ec.field_Query_timeOffBalance_args(context.TODO(), rawArgs)
 		if err != nil {
-			return nil, err
+			return 0, false
 		}
-	}
-	args["id"] = arg0
-	var arg1 *string
-	if tmp, ok := rawArgs["authUserId"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authUserId"))
-		arg1, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
-		if err != nil {
-			return nil, err
+
+		return e.complexity.Query.TimeOffBalance(childComplexity, args["userId"].(string), args["channelId"].(*string), args["leaveType"].(*string), args["authUserId"].(*string)), true
+
+	case "RequestComment.body":
+		if e.complexity.RequestComment.Body == nil {
+			break
 		}
-	}
-	args["authUserId"] = arg1
-	return args, nil
-}
 
-func (ec *executionContext) field_Mutation_denyRequestTimeOff_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 string
-	if tmp, ok := rawArgs["id"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
-		arg0, err = ec.unmarshalNID2string(ctx, tmp)
-		if err != nil {
-			return nil, err
+		return e.complexity.RequestComment.Body(childComplexity), true
+
+	case "RequestComment.createdAt":
+		if e.complexity.RequestComment.CreatedAt == nil {
+			break
 		}
-	}
-	args["id"] = arg0
-	var arg1 *string
-	if tmp, ok := rawArgs["responseNote"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("responseNote"))
-		arg1, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
-		if err != nil {
-			return nil, err
+
+		return e.complexity.RequestComment.CreatedAt(childComplexity), true
+
+	case "RequestComment.id":
+		if e.complexity.RequestComment.ID == nil {
+			break
 		}
-	}
-	args["responseNote"] = arg1
-	var arg2 *string
-	if tmp, ok := rawArgs["authUserId"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authUserId"))
-		arg2, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
-		if err != nil {
-			return nil, err
+
+		return e.complexity.RequestComment.ID(childComplexity), true
+
+	case "RequestComment.user":
+		if e.complexity.RequestComment.User == nil {
+			break
 		}
-	}
-	args["authUserId"] = arg2
-	return args, nil
-}
 
-func (ec *executionContext) field_Mutation_updateRequestTimeOff_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 string
-	if tmp, ok := rawArgs["id"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
-		arg0, err = ec.unmarshalNID2string(ctx, tmp)
-		if err != nil {
-			return nil, err
+		return e.complexity.RequestComment.User(childComplexity), true
+
+	case "RequestComment.userId":
+		if e.complexity.RequestComment.UserID == nil {
+			break
 		}
-	}
-	args["id"] = arg0
-	var arg1 model.RequestTimeOffInput
-	if tmp, ok := rawArgs["input"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
-		arg1, err = ec.unmarshalNRequestTimeOffInput2request_time_offsᚋgraphᚋmodelᚐRequestTimeOffInput(ctx, tmp)
-		if err != nil {
-			return nil, err
+
+		return e.complexity.RequestComment.UserID(childComplexity), true
+
+	case "RequestCommentResponse.comment":
+		if e.complexity.RequestCommentResponse.Comment == nil {
+			break
 		}
-	}
-	args["input"] = arg1
-	var arg2 *string
-	if tmp, ok := rawArgs["authUserId"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authUserId"))
-		arg2, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
-		if err != nil {
-			return nil, err
+
+		return e.complexity.RequestCommentResponse.Comment(childComplexity), true
+
+	case "RequestCommentResponse.errors":
+		if e.complexity.RequestCommentResponse.Errors == nil {
+			break
 		}
-	}
-	args["authUserId"] = arg2
-	return args, nil
-}
 
-func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 string
-	if tmp, ok := rawArgs["name"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
-		arg0, err = ec.unmarshalNString2string(ctx, tmp)
-		if err != nil {
-			return nil, err
+		return e.complexity.RequestCommentResponse.Errors(childComplexity), true
+
+	case "RequestExpirySettings.channelId":
+		if e.complexity.RequestExpirySettings.ChannelID == nil {
+			break
 		}
-	}
-	args["name"] = arg0
-	return args, nil
-}
 
-func (ec *executionContext) field_Query_getRequestTimeOff_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 string
-	if tmp, ok := rawArgs["id"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
-		arg0, err = ec.unmarshalNID2string(ctx, tmp)
-		if err != nil {
-			return nil, err
+		return e.complexity.RequestExpirySettings.ChannelID(childComplexity), true
+
+	case "RequestExpirySettings.enabled":
+		if e.complexity.RequestExpirySettings.Enabled == nil {
+			break
 		}
-	}
-	args["id"] = arg0
-	var arg1 *string
-	if tmp, ok := rawArgs["authUserId"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authUserId"))
-		arg1, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
-		if err != nil {
-			return nil, err
+
+		return e.complexity.RequestExpirySettings.Enabled(childComplexity), true
+
+	case "RequestExpirySettings.gracePeriodMinutes":
+		if e.complexity.RequestExpirySettings.GracePeriodMinutes == nil {
+			break
 		}
-	}
-	args["authUserId"] = arg1
-	return args, nil
-}
 
-func (ec *executionContext) field_Query_getRequestTimeOffsByChannelIdRequestId_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 string
-	if tmp, ok := rawArgs["channelId"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("channelId"))
-		arg0, err = ec.unmarshalNID2string(ctx, tmp)
-		if err != nil {
-			return nil, err
+		return e.complexity.RequestExpirySettings.GracePeriodMinutes(childComplexity), true
+
+	case "RequestExpirySettings.updatedAt":
+		if e.complexity.RequestExpirySettings.UpdatedAt == nil {
+			break
 		}
-	}
-	args["channelId"] = arg0
-	var arg1 string
-	if tmp, ok := rawArgs["requestId"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("requestId"))
-		arg1, err = ec.unmarshalNID2string(ctx, tmp)
-		if err != nil {
-			return nil, err
+
+		return e.complexity.RequestExpirySettings.UpdatedAt(childComplexity), true
+
+	case "RequestExpirySettings.updatedByUserId":
+		if e.complexity.RequestExpirySettings.UpdatedByUserID == nil {
+			break
 		}
-	}
-	args["requestId"] = arg1
-	return args, nil
-}
 
-func (ec *executionContext) field_Query_getRequestTimeOffs_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 *string
-	if tmp, ok := rawArgs["authUserId"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authUserId"))
-		arg0, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
-		if err != nil {
-			return nil, err
+		return e.complexity.RequestExpirySettings.UpdatedByUserID(childComplexity), true
+
+	case "RequestExpirySettingsResponse.errors":
+		if e.complexity.RequestExpirySettingsResponse.Errors == nil {
+			break
 		}
-	}
-	args["authUserId"] = arg0
-	return args, nil
-}
 
-func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 bool
-	if tmp, ok := rawArgs["includeDeprecated"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("includeDeprecated"))
-		arg0, err = ec.unmarshalOBoolean2bool(ctx, tmp)
-		if err != nil {
-			return nil, err
+		return e.complexity.RequestExpirySettingsResponse.Errors(childComplexity), true
+
+	case "RequestExpirySettingsResponse.settings":
+		if e.complexity.RequestExpirySettingsResponse.Settings == nil {
+			break
 		}
-	}
-	args["includeDeprecated"] = arg0
-	return args, nil
-}
 
-func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 bool
-	if tmp, ok := rawArgs["includeDeprecated"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("includeDeprecated"))
-		arg0, err = ec.unmarshalOBoolean2bool(ctx, tmp)
-		if err != nil {
-			return nil, err
+		return e.complexity.RequestExpirySettingsResponse.Settings(childComplexity), true
+
+	case "RequestHistoryEntry.action":
+		if e.complexity.RequestHistoryEntry.Action == nil {
+			break
 		}
-	}
-	args["includeDeprecated"] = arg0
-	return args, nil
-}
 
-// endregion ***************************** args.gotpl *****************************
+		return e.complexity.RequestHistoryEntry.Action(childComplexity), true
 
-// region    ************************** directives.gotpl **************************
+	case "RequestHistoryEntry.actor":
+		if e.complexity.RequestHistoryEntry.Actor == nil {
+			break
+		}
 
-// endregion ************************** directives.gotpl **************************
+		return e.complexity.RequestHistoryEntry.Actor(childComplexity), true
 
-// region    **************************** field.gotpl *****************************
+	case "RequestHistoryEntry.actorUserId":
+		if e.complexity.RequestHistoryEntry.ActorUserID == nil {
+			break
+		}
 
-func (ec *executionContext) _AssignedShift_id(ctx context.Context, field graphql.CollectedField, obj *model.AssignedShift) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_AssignedShift_id(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.RequestHistoryEntry.ActorUserID(childComplexity), true
+
+	case "RequestHistoryEntry.createdAt":
+		if e.complexity.RequestHistoryEntry.CreatedAt == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.RequestHistoryEntry.CreatedAt(childComplexity), true
+
+	case "RequestHistoryEntry.field":
+		if e.complexity.RequestHistoryEntry.Field == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_AssignedShift_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AssignedShift",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.RequestHistoryEntry.Field(childComplexity), true
 
-func (ec *executionContext) _AssignedShift_break(ctx context.Context, field graphql.CollectedField, obj *model.AssignedShift) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_AssignedShift_break(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "RequestHistoryEntry.id":
+		if e.complexity.RequestHistoryEntry.ID == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Break, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.RequestHistoryEntry.ID(childComplexity), true
+
+	case "RequestHistoryEntry.newValue":
+		if e.complexity.RequestHistoryEntry.NewValue == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_AssignedShift_break(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AssignedShift",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.RequestHistoryEntry.NewValue(childComplexity), true
 
-func (ec *executionContext) _AssignedShift_color(ctx context.Context, field graphql.CollectedField, obj *model.AssignedShift) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_AssignedShift_color(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "RequestHistoryEntry.oldValue":
+		if e.complexity.RequestHistoryEntry.OldValue == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Color, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.RequestHistoryEntry.OldValue(childComplexity), true
+
+	case "RequestResponse.channelId":
+		if e.complexity.RequestResponse.ChannelID == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_AssignedShift_color(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AssignedShift",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.RequestResponse.ChannelID(childComplexity), true
 
-func (ec *executionContext) _AssignedShift_startTime(ctx context.Context, field graphql.CollectedField, obj *model.AssignedShift) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_AssignedShift_startTime(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "RequestResponse.comments":
+		if e.complexity.RequestResponse.Comments == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.StartTime, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.RequestResponse.Comments(childComplexity), true
+
+	case "RequestResponse.createdAt":
+		if e.complexity.RequestResponse.CreatedAt == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(time.Time)
-	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_AssignedShift_startTime(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AssignedShift",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.RequestResponse.CreatedAt(childComplexity), true
 
-func (ec *executionContext) _AssignedShift_endTime(ctx context.Context, field graphql.CollectedField, obj *model.AssignedShift) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_AssignedShift_endTime(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "RequestResponse.endTime":
+		if e.complexity.RequestResponse.EndTime == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.EndTime, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.RequestResponse.EndTime(childComplexity), true
+
+	case "RequestResponse.history":
+		if e.complexity.RequestResponse.History == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(time.Time)
-	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_AssignedShift_endTime(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AssignedShift",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.RequestResponse.History(childComplexity), true
 
-func (ec *executionContext) _AssignedShift_is24Hours(ctx context.Context, field graphql.CollectedField, obj *model.AssignedShift) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_AssignedShift_is24Hours(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "RequestResponse.id":
+		if e.complexity.RequestResponse.ID == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Is24Hours, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.RequestResponse.ID(childComplexity), true
+
+	case "RequestResponse.isAllDay":
+		if e.complexity.RequestResponse.IsAllDay == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(bool)
-	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_AssignedShift_is24Hours(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AssignedShift",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.RequestResponse.IsAllDay(childComplexity), true
 
-func (ec *executionContext) _AssignedShift_label(ctx context.Context, field graphql.CollectedField, obj *model.AssignedShift) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_AssignedShift_label(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "RequestResponse.reason":
+		if e.complexity.RequestResponse.Reason == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Label, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_AssignedShift_label(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AssignedShift",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.RequestResponse.Reason(childComplexity), true
 
-func (ec *executionContext) _AssignedShift_note(ctx context.Context, field graphql.CollectedField, obj *model.AssignedShift) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_AssignedShift_note(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "RequestResponse.requestId":
+		if e.complexity.RequestResponse.RequestID == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Note, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_AssignedShift_note(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AssignedShift",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.RequestResponse.RequestID(childComplexity), true
 
-func (ec *executionContext) _AssignedShift_shiftToOffer(ctx context.Context, field graphql.CollectedField, obj *model.AssignedShift) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_AssignedShift_shiftToOffer(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "RequestResponse.requestNote":
+		if e.complexity.RequestResponse.RequestNote == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.ShiftToOffer, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*model.AssignedShift)
-	fc.Result = res
-	return ec.marshalOAssignedShift2ᚖrequest_time_offsᚋgraphᚋmodelᚐAssignedShift(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_AssignedShift_shiftToOffer(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AssignedShift",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_AssignedShift_id(ctx, field)
-			case "break":
-				return ec.fieldContext_AssignedShift_break(ctx, field)
-			case "color":
-				return ec.fieldContext_AssignedShift_color(ctx, field)
-			case "startTime":
-				return ec.fieldContext_AssignedShift_startTime(ctx, field)
-			case "endTime":
-				return ec.fieldContext_AssignedShift_endTime(ctx, field)
-			case "is24Hours":
-				return ec.fieldContext_AssignedShift_is24Hours(ctx, field)
-			case "label":
-				return ec.fieldContext_AssignedShift_label(ctx, field)
-			case "note":
-				return ec.fieldContext_AssignedShift_note(ctx, field)
-			case "shiftToOffer":
-				return ec.fieldContext_AssignedShift_shiftToOffer(ctx, field)
-			case "shiftToSwap":
-				return ec.fieldContext_AssignedShift_shiftToSwap(ctx, field)
-			case "toSwapWith":
-				return ec.fieldContext_AssignedShift_toSwapWith(ctx, field)
-			case "userId":
-				return ec.fieldContext_AssignedShift_userId(ctx, field)
-			case "channelId":
-				return ec.fieldContext_AssignedShift_channelId(ctx, field)
-			case "shiftGroupId":
-				return ec.fieldContext_AssignedShift_shiftGroupId(ctx, field)
-			case "type":
-				return ec.fieldContext_AssignedShift_type(ctx, field)
-			case "isOpen":
-				return ec.fieldContext_AssignedShift_isOpen(ctx, field)
-			case "isShared":
-				return ec.fieldContext_AssignedShift_isShared(ctx, field)
-			case "ShiftActivities":
-				return ec.fieldContext_AssignedShift_ShiftActivities(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type AssignedShift", field.Name)
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.RequestResponse.RequestNote(childComplexity), true
 
-func (ec *executionContext) _AssignedShift_shiftToSwap(ctx context.Context, field graphql.CollectedField, obj *model.AssignedShift) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_AssignedShift_shiftToSwap(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "RequestResponse.responseAt":
+		if e.complexity.RequestResponse.ResponseAt == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.ShiftToSwap, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*model.AssignedShift)
-	fc.Result = res
-	return ec.marshalOAssignedShift2ᚖrequest_time_offsᚋgraphᚋmodelᚐAssignedShift(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_AssignedShift_shiftToSwap(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AssignedShift",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_AssignedShift_id(ctx, field)
-			case "break":
-				return ec.fieldContext_AssignedShift_break(ctx, field)
-			case "color":
-				return ec.fieldContext_AssignedShift_color(ctx, field)
-			case "startTime":
-				return ec.fieldContext_AssignedShift_startTime(ctx, field)
-			case "endTime":
-				return ec.fieldContext_AssignedShift_endTime(ctx, field)
-			case "is24Hours":
-				return ec.fieldContext_AssignedShift_is24Hours(ctx, field)
-			case "label":
-				return ec.fieldContext_AssignedShift_label(ctx, field)
-			case "note":
-				return ec.fieldContext_AssignedShift_note(ctx, field)
-			case "shiftToOffer":
-				return ec.fieldContext_AssignedShift_shiftToOffer(ctx, field)
-			case "shiftToSwap":
-				return ec.fieldContext_AssignedShift_shiftToSwap(ctx, field)
-			case "toSwapWith":
-				return ec.fieldContext_AssignedShift_toSwapWith(ctx, field)
-			case "userId":
-				return ec.fieldContext_AssignedShift_userId(ctx, field)
-			case "channelId":
-				return ec.fieldContext_AssignedShift_channelId(ctx, field)
-			case "shiftGroupId":
-				return ec.fieldContext_AssignedShift_shiftGroupId(ctx, field)
-			case "type":
-				return ec.fieldContext_AssignedShift_type(ctx, field)
-			case "isOpen":
-				return ec.fieldContext_AssignedShift_isOpen(ctx, field)
-			case "isShared":
-				return ec.fieldContext_AssignedShift_isShared(ctx, field)
-			case "ShiftActivities":
-				return ec.fieldContext_AssignedShift_ShiftActivities(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type AssignedShift", field.Name)
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.RequestResponse.ResponseAt(childComplexity), true
 
-func (ec *executionContext) _AssignedShift_toSwapWith(ctx context.Context, field graphql.CollectedField, obj *model.AssignedShift) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_AssignedShift_toSwapWith(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "RequestResponse.responseBy":
+		if e.complexity.RequestResponse.ResponseBy == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.ToSwapWith, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*model.AssignedShift)
-	fc.Result = res
-	return ec.marshalOAssignedShift2ᚖrequest_time_offsᚋgraphᚋmodelᚐAssignedShift(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_AssignedShift_toSwapWith(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AssignedShift",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_AssignedShift_id(ctx, field)
-			case "break":
-				return ec.fieldContext_AssignedShift_break(ctx, field)
-			case "color":
-				return ec.fieldContext_AssignedShift_color(ctx, field)
-			case "startTime":
-				return ec.fieldContext_AssignedShift_startTime(ctx, field)
-			case "endTime":
-				return ec.fieldContext_AssignedShift_endTime(ctx, field)
-			case "is24Hours":
-				return ec.fieldContext_AssignedShift_is24Hours(ctx, field)
-			case "label":
-				return ec.fieldContext_AssignedShift_label(ctx, field)
-			case "note":
-				return ec.fieldContext_AssignedShift_note(ctx, field)
-			case "shiftToOffer":
-				return ec.fieldContext_AssignedShift_shiftToOffer(ctx, field)
-			case "shiftToSwap":
-				return ec.fieldContext_AssignedShift_shiftToSwap(ctx, field)
-			case "toSwapWith":
-				return ec.fieldContext_AssignedShift_toSwapWith(ctx, field)
-			case "userId":
-				return ec.fieldContext_AssignedShift_userId(ctx, field)
-			case "channelId":
-				return ec.fieldContext_AssignedShift_channelId(ctx, field)
-			case "shiftGroupId":
-				return ec.fieldContext_AssignedShift_shiftGroupId(ctx, field)
-			case "type":
-				return ec.fieldContext_AssignedShift_type(ctx, field)
-			case "isOpen":
-				return ec.fieldContext_AssignedShift_isOpen(ctx, field)
-			case "isShared":
-				return ec.fieldContext_AssignedShift_isShared(ctx, field)
-			case "ShiftActivities":
-				return ec.fieldContext_AssignedShift_ShiftActivities(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type AssignedShift", field.Name)
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.RequestResponse.ResponseBy(childComplexity), true
 
-func (ec *executionContext) _AssignedShift_userId(ctx context.Context, field graphql.CollectedField, obj *model.AssignedShift) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_AssignedShift_userId(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "RequestResponse.responseNote":
+		if e.complexity.RequestResponse.ResponseNote == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.UserID, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOID2ᚖstring(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_AssignedShift_userId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AssignedShift",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.RequestResponse.ResponseNote(childComplexity), true
 
-func (ec *executionContext) _AssignedShift_channelId(ctx context.Context, field graphql.CollectedField, obj *model.AssignedShift) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_AssignedShift_channelId(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "RequestResponse.shiftOfferedTo":
+		if e.complexity.RequestResponse.ShiftOfferedTo == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.ChannelID, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOID2ᚖstring(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_AssignedShift_channelId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AssignedShift",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.RequestResponse.ShiftOfferedTo(childComplexity), true
 
-func (ec *executionContext) _AssignedShift_shiftGroupId(ctx context.Context, field graphql.CollectedField, obj *model.AssignedShift) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_AssignedShift_shiftGroupId(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "RequestResponse.shiftToOffer":
+		if e.complexity.RequestResponse.ShiftToOffer == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.ShiftGroupID, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOID2ᚖstring(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_AssignedShift_shiftGroupId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AssignedShift",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.RequestResponse.ShiftToOffer(childComplexity), true
 
-func (ec *executionContext) _AssignedShift_type(ctx context.Context, field graphql.CollectedField, obj *model.AssignedShift) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_AssignedShift_type(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "RequestResponse.shiftToSwap":
+		if e.complexity.RequestResponse.ShiftToSwap == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_AssignedShift_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AssignedShift",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.RequestResponse.ShiftToSwap(childComplexity), true
 
-func (ec *executionContext) _AssignedShift_isOpen(ctx context.Context, field graphql.CollectedField, obj *model.AssignedShift) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_AssignedShift_isOpen(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "RequestResponse.startTime":
+		if e.complexity.RequestResponse.StartTime == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.IsOpen, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*bool)
-	fc.Result = res
-	return ec.marshalOBoolean2ᚖbool(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_AssignedShift_isOpen(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AssignedShift",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.RequestResponse.StartTime(childComplexity), true
 
-func (ec *executionContext) _AssignedShift_isShared(ctx context.Context, field graphql.CollectedField, obj *model.AssignedShift) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_AssignedShift_isShared(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "RequestResponse.status":
+		if e.complexity.RequestResponse.Status == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.IsShared, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*bool)
-	fc.Result = res
-	return ec.marshalOBoolean2ᚖbool(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_AssignedShift_isShared(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AssignedShift",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.RequestResponse.Status(childComplexity), true
 
-func (ec *executionContext) _AssignedShift_ShiftActivities(ctx context.Context, field graphql.CollectedField, obj *model.AssignedShift) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_AssignedShift_ShiftActivities(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "RequestResponse.toSwapWith":
+		if e.complexity.RequestResponse.ToSwapWith == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.ShiftActivities, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.([]*model.AssignedShiftActivities)
-	fc.Result = res
-	return ec.marshalOAssignedShiftActivities2ᚕᚖrequest_time_offsᚋgraphᚋmodelᚐAssignedShiftActivities(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_AssignedShift_ShiftActivities(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AssignedShift",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_AssignedShiftActivities_id(ctx, field)
-			case "channelId":
-				return ec.fieldContext_AssignedShiftActivities_channelId(ctx, field)
-			case "shiftGroupId":
-				return ec.fieldContext_AssignedShiftActivities_shiftGroupId(ctx, field)
-			case "assignedShiftId":
-				return ec.fieldContext_AssignedShiftActivities_assignedShiftId(ctx, field)
-			case "userId":
-				return ec.fieldContext_AssignedShiftActivities_userId(ctx, field)
-			case "name":
-				return ec.fieldContext_AssignedShiftActivities_name(ctx, field)
-			case "code":
-				return ec.fieldContext_AssignedShiftActivities_code(ctx, field)
-			case "color":
-				return ec.fieldContext_AssignedShiftActivities_color(ctx, field)
-			case "startTime":
-				return ec.fieldContext_AssignedShiftActivities_startTime(ctx, field)
-			case "endTime":
-				return ec.fieldContext_AssignedShiftActivities_endTime(ctx, field)
-			case "isPaid":
-				return ec.fieldContext_AssignedShiftActivities_isPaid(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type AssignedShiftActivities", field.Name)
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.RequestResponse.ToSwapWith(childComplexity), true
 
-func (ec *executionContext) _AssignedShiftActivities_id(ctx context.Context, field graphql.CollectedField, obj *model.AssignedShiftActivities) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_AssignedShiftActivities_id(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "RequestResponse.type":
+		if e.complexity.RequestResponse.Type == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.RequestResponse.Type(childComplexity), true
+
+	case "RequestResponse.user":
+		if e.complexity.RequestResponse.User == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_AssignedShiftActivities_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AssignedShiftActivities",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.RequestResponse.User(childComplexity), true
 
-func (ec *executionContext) _AssignedShiftActivities_channelId(ctx context.Context, field graphql.CollectedField, obj *model.AssignedShiftActivities) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_AssignedShiftActivities_channelId(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "RequestTimeOff.attachmentUrl":
+		if e.complexity.RequestTimeOff.AttachmentURL == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.ChannelID, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOID2ᚖstring(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_AssignedShiftActivities_channelId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AssignedShiftActivities",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.RequestTimeOff.AttachmentURL(childComplexity), true
 
-func (ec *executionContext) _AssignedShiftActivities_shiftGroupId(ctx context.Context, field graphql.CollectedField, obj *model.AssignedShiftActivities) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_AssignedShiftActivities_shiftGroupId(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "RequestTimeOff.blackoutOverrideByUserId":
+		if e.complexity.RequestTimeOff.BlackoutOverrideByUserID == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.ShiftGroupID, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOID2ᚖstring(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_AssignedShiftActivities_shiftGroupId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AssignedShiftActivities",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.RequestTimeOff.BlackoutOverrideByUserID(childComplexity), true
 
-func (ec *executionContext) _AssignedShiftActivities_assignedShiftId(ctx context.Context, field graphql.CollectedField, obj *model.AssignedShiftActivities) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_AssignedShiftActivities_assignedShiftId(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "RequestTimeOff.blackoutOverrideReason":
+		if e.complexity.RequestTimeOff.BlackoutOverrideReason == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.AssignedShiftID, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.RequestTimeOff.BlackoutOverrideReason(childComplexity), true
+
+	case "RequestTimeOff.capacityOverrideByUserId":
+		if e.complexity.RequestTimeOff.CapacityOverrideByUserID == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_AssignedShiftActivities_assignedShiftId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AssignedShiftActivities",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.RequestTimeOff.CapacityOverrideByUserID(childComplexity), true
 
-func (ec *executionContext) _AssignedShiftActivities_userId(ctx context.Context, field graphql.CollectedField, obj *model.AssignedShiftActivities) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_AssignedShiftActivities_userId(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "RequestTimeOff.capacityOverrideReason":
+		if e.complexity.RequestTimeOff.CapacityOverrideReason == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.UserID, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOID2ᚖstring(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_AssignedShiftActivities_userId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AssignedShiftActivities",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.RequestTimeOff.CapacityOverrideReason(childComplexity), true
 
-func (ec *executionContext) _AssignedShiftActivities_name(ctx context.Context, field graphql.CollectedField, obj *model.AssignedShiftActivities) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_AssignedShiftActivities_name(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "RequestTimeOff.channelId":
+		if e.complexity.RequestTimeOff.ChannelID == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_AssignedShiftActivities_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AssignedShiftActivities",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.RequestTimeOff.ChannelID(childComplexity), true
 
-func (ec *executionContext) _AssignedShiftActivities_code(ctx context.Context, field graphql.CollectedField, obj *model.AssignedShiftActivities) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_AssignedShiftActivities_code(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "RequestTimeOff.comments":
+		if e.complexity.RequestTimeOff.Comments == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Code, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_AssignedShiftActivities_code(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AssignedShiftActivities",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.RequestTimeOff.Comments(childComplexity), true
 
-func (ec *executionContext) _AssignedShiftActivities_color(ctx context.Context, field graphql.CollectedField, obj *model.AssignedShiftActivities) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_AssignedShiftActivities_color(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "RequestTimeOff.createdAt":
+		if e.complexity.RequestTimeOff.CreatedAt == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Color, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_AssignedShiftActivities_color(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AssignedShiftActivities",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.RequestTimeOff.CreatedAt(childComplexity), true
 
-func (ec *executionContext) _AssignedShiftActivities_startTime(ctx context.Context, field graphql.CollectedField, obj *model.AssignedShiftActivities) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_AssignedShiftActivities_startTime(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.StartTime, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+	case "RequestTimeOff.endTime":
+		if e.complexity.RequestTimeOff.EndTime == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(time.Time)
-	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_AssignedShiftActivities_startTime(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AssignedShiftActivities",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.RequestTimeOff.EndTime(childComplexity), true
 
-func (ec *executionContext) _AssignedShiftActivities_endTime(ctx context.Context, field graphql.CollectedField, obj *model.AssignedShiftActivities) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_AssignedShiftActivities_endTime(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "RequestTimeOff.history":
+		if e.complexity.RequestTimeOff.History == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.EndTime, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.RequestTimeOff.History(childComplexity), true
+
+	case "RequestTimeOff.id":
+		if e.complexity.RequestTimeOff.ID == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(time.Time)
-	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_AssignedShiftActivities_endTime(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AssignedShiftActivities",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.RequestTimeOff.ID(childComplexity), true
 
-func (ec *executionContext) _AssignedShiftActivities_isPaid(ctx context.Context, field graphql.CollectedField, obj *model.AssignedShiftActivities) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_AssignedShiftActivities_isPaid(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.IsPaid, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+	case "RequestTimeOff.is24Hours":
+		if e.complexity.RequestTimeOff.Is24Hours == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(bool)
-	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_AssignedShiftActivities_isPaid(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AssignedShiftActivities",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.RequestTimeOff.Is24Hours(childComplexity), true
 
-func (ec *executionContext) _Mutation_createRequestTimeOff(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_createRequestTimeOff(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "RequestTimeOff.leaveType":
+		if e.complexity.RequestTimeOff.LeaveType == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().CreateRequestTimeOff(rctx, fc.Args["input"].(model.RequestTimeOffInput), fc.Args["authUserId"].(*string))
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*model.TimeOffResponse)
-	fc.Result = res
-	return ec.marshalOTimeOffResponse2ᚖrequest_time_offsᚋgraphᚋmodelᚐTimeOffResponse(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_Mutation_createRequestTimeOff(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Mutation",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "errors":
-				return ec.fieldContext_TimeOffResponse_errors(ctx, field)
-			case "request":
-				return ec.fieldContext_TimeOffResponse_request(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type TimeOffResponse", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+		return e.complexity.RequestTimeOff.LeaveType(childComplexity), true
+
+	case "RequestTimeOff.leaveTypeDetails":
+		if e.complexity.RequestTimeOff.LeaveTypeDetails == nil {
+			break
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_createRequestTimeOff_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Mutation_updateRequestTimeOff(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_updateRequestTimeOff(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.RequestTimeOff.LeaveTypeDetails(childComplexity), true
+
+	case "RequestTimeOff.reason":
+		if e.complexity.RequestTimeOff.Reason == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().UpdateRequestTimeOff(rctx, fc.Args["id"].(string), fc.Args["input"].(model.RequestTimeOffInput), fc.Args["authUserId"].(*string))
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*model.TimeOffResponse)
-	fc.Result = res
-	return ec.marshalOTimeOffResponse2ᚖrequest_time_offsᚋgraphᚋmodelᚐTimeOffResponse(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_Mutation_updateRequestTimeOff(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Mutation",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "errors":
-				return ec.fieldContext_TimeOffResponse_errors(ctx, field)
-			case "request":
-				return ec.fieldContext_TimeOffResponse_request(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type TimeOffResponse", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+		return e.complexity.RequestTimeOff.Reason(childComplexity), true
+
+	case "RequestTimeOff.requestId":
+		if e.complexity.RequestTimeOff.RequestID == nil {
+			break
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_updateRequestTimeOff_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Mutation_deleteRequestTimeOff(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_deleteRequestTimeOff(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.RequestTimeOff.RequestID(childComplexity), true
+
+	case "RequestTimeOff.requestNote":
+		if e.complexity.RequestTimeOff.RequestNote == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().DeleteRequestTimeOff(rctx, fc.Args["id"].(string), fc.Args["authUserId"].(*string))
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*model.TimeOffResponse)
-	fc.Result = res
-	return ec.marshalOTimeOffResponse2ᚖrequest_time_offsᚋgraphᚋmodelᚐTimeOffResponse(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_Mutation_deleteRequestTimeOff(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Mutation",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "errors":
-				return ec.fieldContext_TimeOffResponse_errors(ctx, field)
-			case "request":
-				return ec.fieldContext_TimeOffResponse_request(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type TimeOffResponse", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+		return e.complexity.RequestTimeOff.RequestNote(childComplexity), true
+
+	case "RequestTimeOff.responseAt":
+		if e.complexity.RequestTimeOff.ResponseAt == nil {
+			break
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_deleteRequestTimeOff_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Mutation_cancelRequestTimeOff(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_cancelRequestTimeOff(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.RequestTimeOff.ResponseAt(childComplexity), true
+
+	case "RequestTimeOff.responseByUserId":
+		if e.complexity.RequestTimeOff.ResponseByUserID == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().CancelRequestTimeOff(rctx, fc.Args["channelId"].(string), fc.Args["requestId"].(string), fc.Args["authUserId"].(*string))
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*model.TimeOffResponse)
-	fc.Result = res
-	return ec.marshalOTimeOffResponse2ᚖrequest_time_offsᚋgraphᚋmodelᚐTimeOffResponse(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_Mutation_cancelRequestTimeOff(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Mutation",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "errors":
-				return ec.fieldContext_TimeOffResponse_errors(ctx, field)
-			case "request":
-				return ec.fieldContext_TimeOffResponse_request(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type TimeOffResponse", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_cancelRequestTimeOff_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
-	return fc, nil
-}
+		return e.complexity.RequestTimeOff.ResponseByUserID(childComplexity), true
 
-func (ec *executionContext) _Mutation_approveRequestTimeOff(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_approveRequestTimeOff(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "RequestTimeOff.responseNote":
+		if e.complexity.RequestTimeOff.ResponseNote == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().ApproveRequestTimeOff(rctx, fc.Args["id"].(string), fc.Args["responseNote"].(*string), fc.Args["authUserId"].(*string))
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*model.TimeOffResponse)
-	fc.Result = res
-	return ec.marshalOTimeOffResponse2ᚖrequest_time_offsᚋgraphᚋmodelᚐTimeOffResponse(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_Mutation_approveRequestTimeOff(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Mutation",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "errors":
-				return ec.fieldContext_TimeOffResponse_errors(ctx, field)
-			case "request":
-				return ec.fieldContext_TimeOffResponse_request(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type TimeOffResponse", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+		return e.complexity.RequestTimeOff.ResponseNote(childComplexity), true
+
+	case "RequestTimeOff.startTime":
+		if e.complexity.RequestTimeOff.StartTime == nil {
+			break
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_approveRequestTimeOff_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Mutation_denyRequestTimeOff(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_denyRequestTimeOff(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.RequestTimeOff.StartTime(childComplexity), true
+
+	case "RequestTimeOff.status":
+		if e.complexity.RequestTimeOff.Status == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().DenyRequestTimeOff(rctx, fc.Args["id"].(string), fc.Args["responseNote"].(*string), fc.Args["authUserId"].(*string))
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*model.TimeOffResponse)
-	fc.Result = res
-	return ec.marshalOTimeOffResponse2ᚖrequest_time_offsᚋgraphᚋmodelᚐTimeOffResponse(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_Mutation_denyRequestTimeOff(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Mutation",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "errors":
-				return ec.fieldContext_TimeOffResponse_errors(ctx, field)
-			case "request":
-				return ec.fieldContext_TimeOffResponse_request(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type TimeOffResponse", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+		return e.complexity.RequestTimeOff.Status(childComplexity), true
+
+	case "RequestTimeOff.userId":
+		if e.complexity.RequestTimeOff.UserID == nil {
+			break
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_denyRequestTimeOff_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Query_getRequestTimeOff(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_getRequestTimeOff(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.RequestTimeOff.UserID(childComplexity), true
+
+	case "RequestTimeOffConnection.edges":
+		if e.complexity.RequestTimeOffConnection.Edges == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().GetRequestTimeOff(rctx, fc.Args["id"].(string), fc.Args["authUserId"].(*string))
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.RequestTimeOffConnection.Edges(childComplexity), true
+
+	case "RequestTimeOffConnection.pageInfo":
+		if e.complexity.RequestTimeOffConnection.PageInfo == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(*model.RequestTimeOff)
-	fc.Result = res
-	return ec.marshalNRequestTimeOff2ᚖrequest_time_offsᚋgraphᚋmodelᚐRequestTimeOff(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_Query_getRequestTimeOff(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_RequestTimeOff_id(ctx, field)
-			case "userId":
-				return ec.fieldContext_RequestTimeOff_userId(ctx, field)
-			case "channelId":
-				return ec.fieldContext_RequestTimeOff_channelId(ctx, field)
-			case "requestId":
-				return ec.fieldContext_RequestTimeOff_requestId(ctx, field)
-			case "startTime":
-				return ec.fieldContext_RequestTimeOff_startTime(ctx, field)
-			case "endTime":
-				return ec.fieldContext_RequestTimeOff_endTime(ctx, field)
-			case "is24Hours":
-				return ec.fieldContext_RequestTimeOff_is24Hours(ctx, field)
-			case "reason":
-				return ec.fieldContext_RequestTimeOff_reason(ctx, field)
-			case "requestNote":
-				return ec.fieldContext_RequestTimeOff_requestNote(ctx, field)
-			case "status":
-				return ec.fieldContext_RequestTimeOff_status(ctx, field)
-			case "responseNote":
-				return ec.fieldContext_RequestTimeOff_responseNote(ctx, field)
-			case "responseByUserId":
-				return ec.fieldContext_RequestTimeOff_responseByUserId(ctx, field)
-			case "responseAt":
-				return ec.fieldContext_RequestTimeOff_responseAt(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_RequestTimeOff_createdAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type RequestTimeOff", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+		return e.complexity.RequestTimeOffConnection.PageInfo(childComplexity), true
+
+	case "RequestTimeOffEdge.cursor":
+		if e.complexity.RequestTimeOffEdge.Cursor == nil {
+			break
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getRequestTimeOff_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Query_getRequestTimeOffs(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_getRequestTimeOffs(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.RequestTimeOffEdge.Cursor(childComplexity), true
+
+	case "RequestTimeOffEdge.node":
+		if e.complexity.RequestTimeOffEdge.Node == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().GetRequestTimeOffs(rctx, fc.Args["authUserId"].(*string))
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.RequestTimeOffEdge.Node(childComplexity), true
+
+	case "RequestTimeOffResult.capacityWarnings":
+		if e.complexity.RequestTimeOffResult.CapacityWarnings == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.([]*model.RequestTimeOff)
-	fc.Result = res
-	return ec.marshalNRequestTimeOff2ᚕᚖrequest_time_offsᚋgraphᚋmodelᚐRequestTimeOff(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_Query_getRequestTimeOffs(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_RequestTimeOff_id(ctx, field)
-			case "userId":
-				return ec.fieldContext_RequestTimeOff_userId(ctx, field)
-			case "channelId":
-				return ec.fieldContext_RequestTimeOff_channelId(ctx, field)
-			case "requestId":
-				return ec.fieldContext_RequestTimeOff_requestId(ctx, field)
-			case "startTime":
-				return ec.fieldContext_RequestTimeOff_startTime(ctx, field)
-			case "endTime":
-				return ec.fieldContext_RequestTimeOff_endTime(ctx, field)
-			case "is24Hours":
-				return ec.fieldContext_RequestTimeOff_is24Hours(ctx, field)
-			case "reason":
-				return ec.fieldContext_RequestTimeOff_reason(ctx, field)
-			case "requestNote":
-				return ec.fieldContext_RequestTimeOff_requestNote(ctx, field)
-			case "status":
-				return ec.fieldContext_RequestTimeOff_status(ctx, field)
-			case "responseNote":
-				return ec.fieldContext_RequestTimeOff_responseNote(ctx, field)
-			case "responseByUserId":
-				return ec.fieldContext_RequestTimeOff_responseByUserId(ctx, field)
-			case "responseAt":
-				return ec.fieldContext_RequestTimeOff_responseAt(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_RequestTimeOff_createdAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type RequestTimeOff", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+		return e.complexity.RequestTimeOffResult.CapacityWarnings(childComplexity), true
+
+	case "RequestTimeOffResult.errors":
+		if e.complexity.RequestTimeOffResult.Errors == nil {
+			break
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getRequestTimeOffs_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Query_getRequestTimeOffsByChannelIdRequestId(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_getRequestTimeOffsByChannelIdRequestId(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.RequestTimeOffResult.Errors(childComplexity), true
+
+	case "RequestTimeOffResult.id":
+		if e.complexity.RequestTimeOffResult.ID == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().GetRequestTimeOffsByChannelIDRequestID(rctx, fc.Args["channelId"].(string), fc.Args["requestId"].(string))
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.RequestTimeOffResult.ID(childComplexity), true
+
+	case "RequestTimeOffResult.request":
+		if e.complexity.RequestTimeOffResult.Request == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(*model.RequestTimeOff)
-	fc.Result = res
-	return ec.marshalNRequestTimeOff2ᚖrequest_time_offsᚋgraphᚋmodelᚐRequestTimeOff(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_Query_getRequestTimeOffsByChannelIdRequestId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_RequestTimeOff_id(ctx, field)
-			case "userId":
-				return ec.fieldContext_RequestTimeOff_userId(ctx, field)
-			case "channelId":
-				return ec.fieldContext_RequestTimeOff_channelId(ctx, field)
-			case "requestId":
-				return ec.fieldContext_RequestTimeOff_requestId(ctx, field)
-			case "startTime":
-				return ec.fieldContext_RequestTimeOff_startTime(ctx, field)
-			case "endTime":
-				return ec.fieldContext_RequestTimeOff_endTime(ctx, field)
-			case "is24Hours":
-				return ec.fieldContext_RequestTimeOff_is24Hours(ctx, field)
-			case "reason":
-				return ec.fieldContext_RequestTimeOff_reason(ctx, field)
-			case "requestNote":
-				return ec.fieldContext_RequestTimeOff_requestNote(ctx, field)
-			case "status":
-				return ec.fieldContext_RequestTimeOff_status(ctx, field)
-			case "responseNote":
-				return ec.fieldContext_RequestTimeOff_responseNote(ctx, field)
-			case "responseByUserId":
-				return ec.fieldContext_RequestTimeOff_responseByUserId(ctx, field)
-			case "responseAt":
-				return ec.fieldContext_RequestTimeOff_responseAt(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_RequestTimeOff_createdAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type RequestTimeOff", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+		return e.complexity.RequestTimeOffResult.Request(childComplexity), true
+
+	case "RequestTimeOffResult.shiftConflicts":
+		if e.complexity.RequestTimeOffResult.ShiftConflicts == nil {
+			break
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getRequestTimeOffsByChannelIdRequestId_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query___type(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.RequestTimeOffResult.ShiftConflicts(childComplexity), true
+
+	case "ShiftConflict.converted":
+		if e.complexity.ShiftConflict.Converted == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.introspectType(fc.Args["name"].(string))
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*introspection.Type)
-	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+		return e.complexity.ShiftConflict.Converted(childComplexity), true
+
+	case "ShiftConflict.shift":
+		if e.complexity.ShiftConflict.Shift == nil {
+			break
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query___type_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query___schema(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.ShiftConflict.Shift(childComplexity), true
+
+	case "ShiftConflictSettings.channelId":
+		if e.complexity.ShiftConflictSettings.ChannelID == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.introspectSchema()
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*introspection.Schema)
-	fc.Result = res
-	return ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_Query___schema(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "description":
-				return ec.fieldContext___Schema_description(ctx, field)
-			case "types":
-				return ec.fieldContext___Schema_types(ctx, field)
-			case "queryType":
-				return ec.fieldContext___Schema_queryType(ctx, field)
-			case "mutationType":
-				return ec.fieldContext___Schema_mutationType(ctx, field)
-			case "subscriptionType":
-				return ec.fieldContext___Schema_subscriptionType(ctx, field)
-			case "directives":
-				return ec.fieldContext___Schema_directives(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.ShiftConflictSettings.ChannelID(childComplexity), true
 
-func (ec *executionContext) _RequestResponse_channelId(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RequestResponse_channelId(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "ShiftConflictSettings.policy":
+		if e.complexity.ShiftConflictSettings.Policy == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.ChannelID, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.ShiftConflictSettings.Policy(childComplexity), true
+
+	case "ShiftConflictSettings.updatedAt":
+		if e.complexity.ShiftConflictSettings.UpdatedAt == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_RequestResponse_channelId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "RequestResponse",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.ShiftConflictSettings.UpdatedAt(childComplexity), true
 
-func (ec *executionContext) _RequestResponse_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RequestResponse_createdAt(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "ShiftConflictSettings.updatedByUserId":
+		if e.complexity.ShiftConflictSettings.UpdatedByUserID == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.CreatedAt, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*time.Time)
-	fc.Result = res
-	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_RequestResponse_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "RequestResponse",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.ShiftConflictSettings.UpdatedByUserID(childComplexity), true
 
-func (ec *executionContext) _RequestResponse_endTime(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RequestResponse_endTime(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "ShiftConflictSettingsResponse.errors":
+		if e.complexity.ShiftConflictSettingsResponse.Errors == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.EndTime, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*time.Time)
-	fc.Result = res
-	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_RequestResponse_endTime(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "RequestResponse",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.ShiftConflictSettingsResponse.Errors(childComplexity), true
 
-func (ec *executionContext) _RequestResponse_id(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RequestResponse_id(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "ShiftConflictSettingsResponse.settings":
+		if e.complexity.ShiftConflictSettingsResponse.Settings == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.ShiftConflictSettingsResponse.Settings(childComplexity), true
+
+	case "ShiftError.code":
+		if e.complexity.ShiftError.Code == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_RequestResponse_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "RequestResponse",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.ShiftError.Code(childComplexity), true
 
-func (ec *executionContext) _RequestResponse_isAllDay(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RequestResponse_isAllDay(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "ShiftError.field":
+		if e.complexity.ShiftError.Field == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.IsAllDay, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*bool)
-	fc.Result = res
-	return ec.marshalOBoolean2ᚖbool(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_RequestResponse_isAllDay(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "RequestResponse",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.ShiftError.Field(childComplexity), true
 
-func (ec *executionContext) _RequestResponse_reason(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RequestResponse_reason(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "ShiftError.message":
+		if e.complexity.ShiftError.Message == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Reason, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_RequestResponse_reason(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "RequestResponse",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.ShiftError.Message(childComplexity), true
 
-func (ec *executionContext) _RequestResponse_requestId(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RequestResponse_requestId(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.RequestID, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+	case "TimeOffAccrualPolicy.carryOverCap":
+		if e.complexity.TimeOffAccrualPolicy.CarryOverCap == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_RequestResponse_requestId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "RequestResponse",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.TimeOffAccrualPolicy.CarryOverCap(childComplexity), true
 
-func (ec *executionContext) _RequestResponse_requestNote(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RequestResponse_requestNote(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "TimeOffAccrualPolicy.channelId":
+		if e.complexity.TimeOffAccrualPolicy.ChannelID == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.RequestNote, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_RequestResponse_requestNote(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "RequestResponse",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.TimeOffAccrualPolicy.ChannelID(childComplexity), true
 
-func (ec *executionContext) _RequestResponse_responseAt(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RequestResponse_responseAt(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "TimeOffAccrualPolicy.enabled":
+		if e.complexity.TimeOffAccrualPolicy.Enabled == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.ResponseAt, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*time.Time)
-	fc.Result = res
-	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_RequestResponse_responseAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "RequestResponse",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.TimeOffAccrualPolicy.Enabled(childComplexity), true
 
-func (ec *executionContext) _RequestResponse_responseBy(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RequestResponse_responseBy(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "TimeOffAccrualPolicy.grantDay":
+		if e.complexity.TimeOffAccrualPolicy.GrantDay == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.ResponseBy, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*model.User)
-	fc.Result = res
-	return ec.marshalOUser2ᚖrequest_time_offsᚋgraphᚋmodelᚐUser(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_RequestResponse_responseBy(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "RequestResponse",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_User_id(ctx, field)
-			case "firstName":
-				return ec.fieldContext_User_firstName(ctx, field)
-			case "lastName":
-				return ec.fieldContext_User_lastName(ctx, field)
-			case "email":
-				return ec.fieldContext_User_email(ctx, field)
-			case "avatar":
-				return ec.fieldContext_User_avatar(ctx, field)
-			case "isActive":
-				return ec.fieldContext_User_isActive(ctx, field)
-			case "isStaff":
-				return ec.fieldContext_User_isStaff(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.TimeOffAccrualPolicy.GrantDay(childComplexity), true
 
-func (ec *executionContext) _RequestResponse_responseNote(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RequestResponse_responseNote(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "TimeOffAccrualPolicy.grantMonth":
+		if e.complexity.TimeOffAccrualPolicy.GrantMonth == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.ResponseNote, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_RequestResponse_responseNote(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "RequestResponse",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.TimeOffAccrualPolicy.GrantMonth(childComplexity), true
 
-func (ec *executionContext) _RequestResponse_shiftOfferedTo(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RequestResponse_shiftOfferedTo(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "TimeOffAccrualPolicy.hours":
+		if e.complexity.TimeOffAccrualPolicy.Hours == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.ShiftOfferedTo, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*model.User)
-	fc.Result = res
-	return ec.marshalOUser2ᚖrequest_time_offsᚋgraphᚋmodelᚐUser(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_RequestResponse_shiftOfferedTo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "RequestResponse",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_User_id(ctx, field)
-			case "firstName":
-				return ec.fieldContext_User_firstName(ctx, field)
-			case "lastName":
-				return ec.fieldContext_User_lastName(ctx, field)
-			case "email":
-				return ec.fieldContext_User_email(ctx, field)
-			case "avatar":
-				return ec.fieldContext_User_avatar(ctx, field)
-			case "isActive":
-				return ec.fieldContext_User_isActive(ctx, field)
-			case "isStaff":
-				return ec.fieldContext_User_isStaff(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.TimeOffAccrualPolicy.Hours(childComplexity), true
 
-func (ec *executionContext) _RequestResponse_shiftToOffer(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RequestResponse_shiftToOffer(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "TimeOffAccrualPolicy.hoursPerDay":
+		if e.complexity.TimeOffAccrualPolicy.HoursPerDay == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.ShiftToOffer, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*model.AssignedShift)
-	fc.Result = res
-	return ec.marshalOAssignedShift2ᚖrequest_time_offsᚋgraphᚋmodelᚐAssignedShift(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_RequestResponse_shiftToOffer(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "RequestResponse",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_AssignedShift_id(ctx, field)
-			case "break":
-				return ec.fieldContext_AssignedShift_break(ctx, field)
-			case "color":
-				return ec.fieldContext_AssignedShift_color(ctx, field)
-			case "startTime":
-				return ec.fieldContext_AssignedShift_startTime(ctx, field)
-			case "endTime":
-				return ec.fieldContext_AssignedShift_endTime(ctx, field)
-			case "is24Hours":
-				return ec.fieldContext_AssignedShift_is24Hours(ctx, field)
-			case "label":
-				return ec.fieldContext_AssignedShift_label(ctx, field)
-			case "note":
-				return ec.fieldContext_AssignedShift_note(ctx, field)
-			case "shiftToOffer":
-				return ec.fieldContext_AssignedShift_shiftToOffer(ctx, field)
-			case "shiftToSwap":
-				return ec.fieldContext_AssignedShift_shiftToSwap(ctx, field)
-			case "toSwapWith":
-				return ec.fieldContext_AssignedShift_toSwapWith(ctx, field)
-			case "userId":
-				return ec.fieldContext_AssignedShift_userId(ctx, field)
-			case "channelId":
-				return ec.fieldContext_AssignedShift_channelId(ctx, field)
-			case "shiftGroupId":
-				return ec.fieldContext_AssignedShift_shiftGroupId(ctx, field)
-			case "type":
-				return ec.fieldContext_AssignedShift_type(ctx, field)
-			case "isOpen":
-				return ec.fieldContext_AssignedShift_isOpen(ctx, field)
-			case "isShared":
-				return ec.fieldContext_AssignedShift_isShared(ctx, field)
-			case "ShiftActivities":
-				return ec.fieldContext_AssignedShift_ShiftActivities(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type AssignedShift", field.Name)
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.TimeOffAccrualPolicy.HoursPerDay(childComplexity), true
 
-func (ec *executionContext) _RequestResponse_shiftToSwap(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RequestResponse_shiftToSwap(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "TimeOffAccrualPolicy.includeWeekends":
+		if e.complexity.TimeOffAccrualPolicy.IncludeWeekends == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.ShiftToSwap, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*model.AssignedShift)
-	fc.Result = res
-	return ec.marshalOAssignedShift2ᚖrequest_time_offsᚋgraphᚋmodelᚐAssignedShift(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_RequestResponse_shiftToSwap(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "RequestResponse",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_AssignedShift_id(ctx, field)
-			case "break":
-				return ec.fieldContext_AssignedShift_break(ctx, field)
-			case "color":
-				return ec.fieldContext_AssignedShift_color(ctx, field)
-			case "startTime":
-				return ec.fieldContext_AssignedShift_startTime(ctx, field)
-			case "endTime":
-				return ec.fieldContext_AssignedShift_endTime(ctx, field)
-			case "is24Hours":
-				return ec.fieldContext_AssignedShift_is24Hours(ctx, field)
-			case "label":
-				return ec.fieldContext_AssignedShift_label(ctx, field)
-			case "note":
-				return ec.fieldContext_AssignedShift_note(ctx, field)
-			case "shiftToOffer":
-				return ec.fieldContext_AssignedShift_shiftToOffer(ctx, field)
-			case "shiftToSwap":
-				return ec.fieldContext_AssignedShift_shiftToSwap(ctx, field)
-			case "toSwapWith":
-				return ec.fieldContext_AssignedShift_toSwapWith(ctx, field)
-			case "userId":
-				return ec.fieldContext_AssignedShift_userId(ctx, field)
-			case "channelId":
-				return ec.fieldContext_AssignedShift_channelId(ctx, field)
-			case "shiftGroupId":
-				return ec.fieldContext_AssignedShift_shiftGroupId(ctx, field)
-			case "type":
-				return ec.fieldContext_AssignedShift_type(ctx, field)
-			case "isOpen":
-				return ec.fieldContext_AssignedShift_isOpen(ctx, field)
-			case "isShared":
-				return ec.fieldContext_AssignedShift_isShared(ctx, field)
-			case "ShiftActivities":
-				return ec.fieldContext_AssignedShift_ShiftActivities(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type AssignedShift", field.Name)
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.TimeOffAccrualPolicy.IncludeWeekends(childComplexity), true
 
-func (ec *executionContext) _RequestResponse_startTime(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RequestResponse_startTime(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "TimeOffAccrualPolicy.leaveType":
+		if e.complexity.TimeOffAccrualPolicy.LeaveType == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.StartTime, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*time.Time)
-	fc.Result = res
-	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_RequestResponse_startTime(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "RequestResponse",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.TimeOffAccrualPolicy.LeaveType(childComplexity), true
 
-func (ec *executionContext) _RequestResponse_status(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RequestResponse_status(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "TimeOffAccrualPolicy.method":
+		if e.complexity.TimeOffAccrualPolicy.Method == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Status, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*model.RequestStatus)
-	fc.Result = res
-	return ec.marshalORequestStatus2ᚖrequest_time_offsᚋgraphᚋmodelᚐRequestStatus(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_RequestResponse_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "RequestResponse",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type RequestStatus does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.TimeOffAccrualPolicy.Method(childComplexity), true
 
-func (ec *executionContext) _RequestResponse_toSwapWith(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RequestResponse_toSwapWith(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "TimeOffAccrualPolicy.periodDays":
+		if e.complexity.TimeOffAccrualPolicy.PeriodDays == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.ToSwapWith, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*model.AssignedShift)
-	fc.Result = res
-	return ec.marshalOAssignedShift2ᚖrequest_time_offsᚋgraphᚋmodelᚐAssignedShift(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_RequestResponse_toSwapWith(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "RequestResponse",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_AssignedShift_id(ctx, field)
-			case "break":
-				return ec.fieldContext_AssignedShift_break(ctx, field)
-			case "color":
-				return ec.fieldContext_AssignedShift_color(ctx, field)
-			case "startTime":
-				return ec.fieldContext_AssignedShift_startTime(ctx, field)
-			case "endTime":
-				return ec.fieldContext_AssignedShift_endTime(ctx, field)
-			case "is24Hours":
-				return ec.fieldContext_AssignedShift_is24Hours(ctx, field)
-			case "label":
-				return ec.fieldContext_AssignedShift_label(ctx, field)
-			case "note":
-				return ec.fieldContext_AssignedShift_note(ctx, field)
-			case "shiftToOffer":
-				return ec.fieldContext_AssignedShift_shiftToOffer(ctx, field)
-			case "shiftToSwap":
-				return ec.fieldContext_AssignedShift_shiftToSwap(ctx, field)
-			case "toSwapWith":
-				return ec.fieldContext_AssignedShift_toSwapWith(ctx, field)
-			case "userId":
-				return ec.fieldContext_AssignedShift_userId(ctx, field)
-			case "channelId":
-				return ec.fieldContext_AssignedShift_channelId(ctx, field)
-			case "shiftGroupId":
-				return ec.fieldContext_AssignedShift_shiftGroupId(ctx, field)
-			case "type":
-				return ec.fieldContext_AssignedShift_type(ctx, field)
-			case "isOpen":
-				return ec.fieldContext_AssignedShift_isOpen(ctx, field)
-			case "isShared":
-				return ec.fieldContext_AssignedShift_isShared(ctx, field)
-			case "ShiftActivities":
-				return ec.fieldContext_AssignedShift_ShiftActivities(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type AssignedShift", field.Name)
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.TimeOffAccrualPolicy.PeriodDays(childComplexity), true
 
-func (ec *executionContext) _RequestResponse_type(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RequestResponse_type(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "TimeOffAccrualPolicy.periodStart":
+		if e.complexity.TimeOffAccrualPolicy.PeriodStart == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*model.RequestType)
-	fc.Result = res
-	return ec.marshalORequestType2ᚖrequest_time_offsᚋgraphᚋmodelᚐRequestType(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_RequestResponse_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "RequestResponse",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type RequestType does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.TimeOffAccrualPolicy.PeriodStart(childComplexity), true
 
-func (ec *executionContext) _RequestResponse_user(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RequestResponse_user(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "TimeOffAccrualPolicy.rate":
+		if e.complexity.TimeOffAccrualPolicy.Rate == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.User, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.TimeOffAccrualPolicy.Rate(childComplexity), true
+
+	case "TimeOffAccrualPolicy.updatedAt":
+		if e.complexity.TimeOffAccrualPolicy.UpdatedAt == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(*model.User)
-	fc.Result = res
-	return ec.marshalNUser2ᚖrequest_time_offsᚋgraphᚋmodelᚐUser(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_RequestResponse_user(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "RequestResponse",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_User_id(ctx, field)
-			case "firstName":
-				return ec.fieldContext_User_firstName(ctx, field)
-			case "lastName":
-				return ec.fieldContext_User_lastName(ctx, field)
-			case "email":
-				return ec.fieldContext_User_email(ctx, field)
-			case "avatar":
-				return ec.fieldContext_User_avatar(ctx, field)
-			case "isActive":
-				return ec.fieldContext_User_isActive(ctx, field)
-			case "isStaff":
-				return ec.fieldContext_User_isStaff(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.TimeOffAccrualPolicy.UpdatedAt(childComplexity), true
 
-func (ec *executionContext) _RequestTimeOff_id(ctx context.Context, field graphql.CollectedField, obj *model.RequestTimeOff) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RequestTimeOff_id(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "TimeOffAccrualPolicy.updatedByUserId":
+		if e.complexity.TimeOffAccrualPolicy.UpdatedByUserID == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.TimeOffAccrualPolicy.UpdatedByUserID(childComplexity), true
+
+	case "TimeOffAccrualPolicyResponse.errors":
+		if e.complexity.TimeOffAccrualPolicyResponse.Errors == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_RequestTimeOff_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "RequestTimeOff",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.TimeOffAccrualPolicyResponse.Errors(childComplexity), true
 
-func (ec *executionContext) _RequestTimeOff_userId(ctx context.Context, field graphql.CollectedField, obj *model.RequestTimeOff) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RequestTimeOff_userId(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "TimeOffAccrualPolicyResponse.policy":
+		if e.complexity.TimeOffAccrualPolicyResponse.Policy == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.UserID, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.TimeOffAccrualPolicyResponse.Policy(childComplexity), true
+
+	case "TimeOffBalance.balanceHours":
+		if e.complexity.TimeOffBalance.BalanceHours == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_RequestTimeOff_userId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "RequestTimeOff",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.TimeOffBalance.BalanceHours(childComplexity), true
 
-func (ec *executionContext) _RequestTimeOff_channelId(ctx context.Context, field graphql.CollectedField, obj *model.RequestTimeOff) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RequestTimeOff_channelId(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "TimeOffBalance.channelId":
+		if e.complexity.TimeOffBalance.ChannelID == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.ChannelID, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOID2ᚖstring(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_RequestTimeOff_channelId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "RequestTimeOff",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.TimeOffBalance.ChannelID(childComplexity), true
 
-func (ec *executionContext) _RequestTimeOff_requestId(ctx context.Context, field graphql.CollectedField, obj *model.RequestTimeOff) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RequestTimeOff_requestId(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "TimeOffBalance.createdAt":
+		if e.complexity.TimeOffBalance.CreatedAt == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.RequestID, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOID2ᚖstring(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_RequestTimeOff_requestId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "RequestTimeOff",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.TimeOffBalance.CreatedAt(childComplexity), true
 
-func (ec *executionContext) _RequestTimeOff_startTime(ctx context.Context, field graphql.CollectedField, obj *model.RequestTimeOff) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RequestTimeOff_startTime(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "TimeOffBalance.history":
+		if e.complexity.TimeOffBalance.History == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.StartTime, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.TimeOffBalance.History(childComplexity), true
+
+	case "TimeOffBalance.id":
+		if e.complexity.TimeOffBalance.ID == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(time.Time)
-	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_RequestTimeOff_startTime(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "RequestTimeOff",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.TimeOffBalance.ID(childComplexity), true
 
-func (ec *executionContext) _RequestTimeOff_endTime(ctx context.Context, field graphql.CollectedField, obj *model.RequestTimeOff) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RequestTimeOff_endTime(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "TimeOffBalance.leaveType":
+		if e.complexity.TimeOffBalance.LeaveType == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.EndTime, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*time.Time)
-	fc.Result = res
-	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_RequestTimeOff_endTime(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "RequestTimeOff",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.TimeOffBalance.LeaveType(childComplexity), true
 
-func (ec *executionContext) _RequestTimeOff_is24Hours(ctx context.Context, field graphql.CollectedField, obj *model.RequestTimeOff) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RequestTimeOff_is24Hours(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "TimeOffBalance.updatedAt":
+		if e.complexity.TimeOffBalance.UpdatedAt == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Is24Hours, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*bool)
-	fc.Result = res
-	return ec.marshalOBoolean2ᚖbool(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_RequestTimeOff_is24Hours(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "RequestTimeOff",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.TimeOffBalance.UpdatedAt(childComplexity), true
 
-func (ec *executionContext) _RequestTimeOff_reason(ctx context.Context, field graphql.CollectedField, obj *model.RequestTimeOff) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RequestTimeOff_reason(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "TimeOffBalance.userId":
+		if e.complexity.TimeOffBalance.UserID == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Reason, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_RequestTimeOff_reason(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "RequestTimeOff",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.TimeOffBalance.UserID(childComplexity), true
 
-func (ec *executionContext) _RequestTimeOff_requestNote(ctx context.Context, field graphql.CollectedField, obj *model.RequestTimeOff) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RequestTimeOff_requestNote(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "TimeOffBalanceResponse.balances":
+		if e.complexity.TimeOffBalanceResponse.Balances == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.RequestNote, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_RequestTimeOff_requestNote(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "RequestTimeOff",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.TimeOffBalanceResponse.Balances(childComplexity), true
 
-func (ec *executionContext) _RequestTimeOff_status(ctx context.Context, field graphql.CollectedField, obj *model.RequestTimeOff) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RequestTimeOff_status(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "TimeOffBalanceResponse.errors":
+		if e.complexity.TimeOffBalanceResponse.Errors == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Status, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.TimeOffBalanceResponse.Errors(childComplexity), true
+
+	case "TimeOffLedgerEntry.actorUserId":
+		if e.complexity.TimeOffLedgerEntry.ActorUserID == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(model.RequestStatus)
-	fc.Result = res
-	return ec.marshalNRequestStatus2request_time_offsᚋgraphᚋmodelᚐRequestStatus(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_RequestTimeOff_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "RequestTimeOff",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type RequestStatus does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.TimeOffLedgerEntry.ActorUserID(childComplexity), true
 
-func (ec *executionContext) _RequestTimeOff_responseNote(ctx context.Context, field graphql.CollectedField, obj *model.RequestTimeOff) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RequestTimeOff_responseNote(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "TimeOffLedgerEntry.balanceAfter":
+		if e.complexity.TimeOffLedgerEntry.BalanceAfter == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.ResponseNote, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_RequestTimeOff_responseNote(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "RequestTimeOff",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.TimeOffLedgerEntry.BalanceAfter(childComplexity), true
 
-func (ec *executionContext) _RequestTimeOff_responseByUserId(ctx context.Context, field graphql.CollectedField, obj *model.RequestTimeOff) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RequestTimeOff_responseByUserId(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "TimeOffLedgerEntry.createdAt":
+		if e.complexity.TimeOffLedgerEntry.CreatedAt == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.ResponseByUserID, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOID2ᚖstring(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_RequestTimeOff_responseByUserId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "RequestTimeOff",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.TimeOffLedgerEntry.CreatedAt(childComplexity), true
 
-func (ec *executionContext) _RequestTimeOff_responseAt(ctx context.Context, field graphql.CollectedField, obj *model.RequestTimeOff) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RequestTimeOff_responseAt(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "TimeOffLedgerEntry.hours":
+		if e.complexity.TimeOffLedgerEntry.Hours == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.ResponseAt, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*time.Time)
-	fc.Result = res
-	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_RequestTimeOff_responseAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "RequestTimeOff",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.TimeOffLedgerEntry.Hours(childComplexity), true
 
-func (ec *executionContext) _RequestTimeOff_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.RequestTimeOff) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RequestTimeOff_createdAt(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "TimeOffLedgerEntry.id":
+		if e.complexity.TimeOffLedgerEntry.ID == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.CreatedAt, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.TimeOffLedgerEntry.ID(childComplexity), true
+
+	case "TimeOffLedgerEntry.kind":
+		if e.complexity.TimeOffLedgerEntry.Kind == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(time.Time)
-	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_RequestTimeOff_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "RequestTimeOff",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.TimeOffLedgerEntry.Kind(childComplexity), true
 
-func (ec *executionContext) _ShiftError_code(ctx context.Context, field graphql.CollectedField, obj *model.ShiftError) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShiftError_code(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "TimeOffLedgerEntry.note":
+		if e.complexity.TimeOffLedgerEntry.Note == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Code, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.TimeOffLedgerEntry.Note(childComplexity), true
+
+	case "TimeOffLedgerEntry.period":
+		if e.complexity.TimeOffLedgerEntry.Period == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(model.ShiftErrorCode)
-	fc.Result = res
-	return ec.marshalNShiftErrorCode2request_time_offsᚋgraphᚋmodelᚐShiftErrorCode(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_ShiftError_code(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "ShiftError",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ShiftErrorCode does not have child fields")
-		},
+		return e.complexity.TimeOffLedgerEntry.Period(childComplexity), true
+
+	case "TimeOffLedgerEntry.requestTimeOffId":
+		if e.complexity.TimeOffLedgerEntry.RequestTimeOffID == nil {
+			break
+		}
+
+		return e.complexity.TimeOffLedgerEntry.RequestTimeOffID(childComplexity), true
+
+	case "TimeOffResponse.capacityWarnings":
+		if e.complexity.TimeOffResponse.CapacityWarnings == nil {
+			break
+		}
+
+		return e.complexity.TimeOffResponse.CapacityWarnings(childComplexity), true
+
+	case "TimeOffResponse.errors":
+		if e.complexity.TimeOffResponse.Errors == nil {
+			break
+		}
+
+		return e.complexity.TimeOffResponse.Errors(childComplexity), true
+
+	case "TimeOffResponse.request":
+		if e.complexity.TimeOffResponse.Request == nil {
+			break
+		}
+
+		return e.complexity.TimeOffResponse.Request(childComplexity), true
+
+	case "TimeOffResponse.shiftConflicts":
+		if e.complexity.TimeOffResponse.ShiftConflicts == nil {
+			break
+		}
+
+		return e.complexity.TimeOffResponse.ShiftConflicts(childComplexity), true
+
+	case "User.avatar":
+		if e.complexity.User.Avatar == nil {
+			break
+		}
+
+		return e.complexity.User.Avatar(childComplexity), true
+
+	case "User.email":
+		if e.complexity.User.Email == nil {
+			break
+		}
+
+		return e.complexity.User.Email(childComplexity), true
+
+	case "User.firstName":
+		if e.complexity.User.FirstName == nil {
+			break
+		}
+
+		return e.complexity.User.FirstName(childComplexity), true
+
+	case "User.id":
+		if e.complexity.User.ID == nil {
+			break
+		}
+
+		return e.complexity.User.ID(childComplexity), true
+
+	case "User.isActive":
+		if e.complexity.User.IsActive == nil {
+			break
+		}
+
+		return e.complexity.User.IsActive(childComplexity), true
+
+	case "User.isStaff":
+		if e.complexity.User.IsStaff == nil {
+			break
+		}
+
+		return e.complexity.User.IsStaff(childComplexity), true
+
+	case "User.lastName":
+		if e.complexity.User.LastName == nil {
+			break
+		}
+
+		return e.complexity.User.LastName(childComplexity), true
+
 	}
-	return fc, nil
+	return 0, false
 }
 
-func (ec *executionContext) _ShiftError_field(ctx context.Context, field graphql.CollectedField, obj *model.ShiftError) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShiftError_field(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
+	rc := graphql.GetOperationContext(ctx)
+	ec := executionContext{rc, e}
+	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
+		ec.unmarshalInputAbsenceLimitInput,
+		ec.unmarshalInputBlackoutPeriodInput,
+		ec.unmarshalInputLeaveTypeInput,
+		ec.unmarshalInputRequestExpirySettingsInput,
+		ec.unmarshalInputRequestFilter,
+		ec.unmarshalInputRequestOrder,
+		ec.unmarshalInputRequestTimeOffInput,
+		ec.unmarshalInputRequestsInput,
+		ec.unmarshalInputShiftConflictSettingsInput,
+		ec.unmarshalInputTimeOffAccrualPolicyInput,
+	)
+	first := true
+
+	switch rc.Operation.Operation {
+	case ast.Query:
+		return func(ctx context.Context) *graphql.Response {
+			if !first {
+				return nil
+			}
+			first = false
+			ctx = graphql.WithUnmarshalerMap(ctx, inputUnmarshalMap)
+			data := ec._Query(ctx, rc.Operation.SelectionSet)
+			var buf bytes.Buffer
+			data.MarshalGQL(&buf)
+
+			return &graphql.Response{
+				Data: buf.Bytes(),
+			}
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Field, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
+	case ast.Mutation:
+		return func(ctx context.Context) *graphql.Response {
+			if !first {
+				return nil
+			}
+			first = false
+			ctx = graphql.WithUnmarshalerMap(ctx, inputUnmarshalMap)
+			data := ec._Mutation(ctx, rc.Operation.SelectionSet)
+			var buf bytes.Buffer
+			data.MarshalGQL(&buf)
+
+			return &graphql.Response{
+				Data: buf.Bytes(),
+			}
+		}
+
+	default:
+		return graphql.OneShot(graphql.ErrorResponse(ctx, "unsupported GraphQL operation"))
 	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ShiftError_field(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "ShiftError",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
+type executionContext struct {
+	*graphql.OperationContext
+	*executableSchema
 }
 
-func (ec *executionContext) _ShiftError_message(ctx context.Context, field graphql.CollectedField, obj *model.ShiftError) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShiftError_message(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Message, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
+func (ec *executionContext) introspectSchema() (*introspection.Schema, error) {
+	if ec.DisableIntrospection {
+		return nil, errors.New("introspection disabled")
 	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return introspection.WrapSchema(parsedSchema), nil
 }
 
-func (ec *executionContext) fieldContext_ShiftError_message(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "ShiftError",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+func (ec *executionContext) introspectType(name string) (*introspection.Type, error) {
+	if ec.DisableIntrospection {
+		return nil, errors.New("introspection disabled")
 	}
-	return fc, nil
+	return introspection.WrapTypeFromDef(parsedSchema, parsedSchema.Types[name]), nil
 }
 
-func (ec *executionContext) _TimeOffResponse_errors(ctx context.Context, field graphql.CollectedField, obj *model.TimeOffResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TimeOffResponse_errors(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Errors, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.([]*model.ShiftError)
-	fc.Result = res
-	return ec.marshalNShiftError2ᚕᚖrequest_time_offsᚋgraphᚋmodelᚐShiftErrorᚄ(ctx, field.Selections, res)
+var sources = []*ast.Source{
+	{Name: "../schema.graphqls", Input: `type RequestTimeOff {
+  id: ID!
+  userId: ID!
+  channelId: ID
+  requestId: ID
+  startTime: Time!
+  endTime: Time
+  is24Hours: Boolean
+  reason: String
+  """
+  Code of the leave type whose balance the time off is taken from once approved
+  """
+  leaveType: String!
+  """
+  The leave type of the channel with that code; null when the channel has none
+  """
+  leaveTypeDetails: LeaveType
+  """
+  Supporting document, required by some leave types
+  """
+  attachmentUrl: String
+  """
+  Why a manager let the time off overlap a blackout period
+  """
+  blackoutOverrideReason: String
+  blackoutOverrideByUserId: ID
+  """
+  Why a manager approved the time off over the absence limit of a shift group
+  """
+  capacityOverrideReason: String
+  capacityOverrideByUserId: ID
+  requestNote: String
+  status: RequestStatus!
+  responseNote: String
+  responseByUserId: ID
+  responseAt: Time
+  createdAt: Time!
+  """
+  Every change of the request time off, oldest first
+  """
+  history: [RequestHistoryEntry!]!
+  """
+  Comments on the request time off, oldest first
+  """
+  comments: [RequestComment!]!
+}
+
+enum RequestHistoryAction {
+  CREATED
+  UPDATED
+  STATUS_CHANGED
+}
+
+"""
+One change of a request; the history is only ever appended to
+"""
+type RequestHistoryEntry {
+  id: ID!
+  action: RequestHistoryAction!
+  """
+  Field that changed, for UPDATED and STATUS_CHANGED
+  """
+  field: String
+  oldValue: String
+  newValue: String
+  """
+  User who made the change; empty for changes of the service itself, such as expiry
+  """
+  actorUserId: ID
+  actor: User
+  createdAt: Time!
 }
 
-func (ec *executionContext) fieldContext_TimeOffResponse_errors(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "TimeOffResponse",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "code":
-				return ec.fieldContext_ShiftError_code(ctx, field)
-			case "field":
-				return ec.fieldContext_ShiftError_field(ctx, field)
-			case "message":
-				return ec.fieldContext_ShiftError_message(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ShiftError", field.Name)
-		},
-	}
-	return fc, nil
+type RequestComment {
+  id: ID!
+  userId: ID!
+  user: User
+  body: String!
+  createdAt: Time!
 }
 
-func (ec *executionContext) _TimeOffResponse_request(ctx context.Context, field graphql.CollectedField, obj *model.TimeOffResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TimeOffResponse_request(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Request, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*model.RequestResponse)
-	fc.Result = res
-	return ec.marshalORequestResponse2ᚖrequest_time_offsᚋgraphᚋmodelᚐRequestResponse(ctx, field.Selections, res)
+type RequestCommentResponse {
+  errors: [ShiftError!]!
+  comment: RequestComment
 }
 
-func (ec *executionContext) fieldContext_TimeOffResponse_request(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "TimeOffResponse",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "channelId":
-				return ec.fieldContext_RequestResponse_channelId(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_RequestResponse_createdAt(ctx, field)
-			case "endTime":
-				return ec.fieldContext_RequestResponse_endTime(ctx, field)
-			case "id":
-				return ec.fieldContext_RequestResponse_id(ctx, field)
-			case "isAllDay":
-				return ec.fieldContext_RequestResponse_isAllDay(ctx, field)
-			case "reason":
-				return ec.fieldContext_RequestResponse_reason(ctx, field)
-			case "requestId":
-				return ec.fieldContext_RequestResponse_requestId(ctx, field)
-			case "requestNote":
-				return ec.fieldContext_RequestResponse_requestNote(ctx, field)
-			case "responseAt":
-				return ec.fieldContext_RequestResponse_responseAt(ctx, field)
-			case "responseBy":
-				return ec.fieldContext_RequestResponse_responseBy(ctx, field)
-			case "responseNote":
-				return ec.fieldContext_RequestResponse_responseNote(ctx, field)
-			case "shiftOfferedTo":
-				return ec.fieldContext_RequestResponse_shiftOfferedTo(ctx, field)
-			case "shiftToOffer":
-				return ec.fieldContext_RequestResponse_shiftToOffer(ctx, field)
-			case "shiftToSwap":
-				return ec.fieldContext_RequestResponse_shiftToSwap(ctx, field)
-			case "startTime":
-				return ec.fieldContext_RequestResponse_startTime(ctx, field)
-			case "status":
-				return ec.fieldContext_RequestResponse_status(ctx, field)
-			case "toSwapWith":
-				return ec.fieldContext_RequestResponse_toSwapWith(ctx, field)
-			case "type":
-				return ec.fieldContext_RequestResponse_type(ctx, field)
-			case "user":
-				return ec.fieldContext_RequestResponse_user(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type RequestResponse", field.Name)
-		},
-	}
-	return fc, nil
+type RequestTimeOffEdge {
+  cursor: String!
+  node: RequestTimeOff!
 }
 
-func (ec *executionContext) _User_id(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_User_id(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOID2ᚖstring(ctx, field.Selections, res)
+type PageInfo {
+  hasNextPage: Boolean!
+  hasPreviousPage: Boolean!
+  startCursor: String
+  endCursor: String
 }
 
-func (ec *executionContext) fieldContext_User_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "User",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
-		},
-	}
-	return fc, nil
+type RequestTimeOffConnection {
+  edges: [RequestTimeOffEdge!]!
+  pageInfo: PageInfo!
 }
 
-func (ec *executionContext) _User_firstName(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_User_firstName(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.FirstName, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+input RequestFilter {
+  status: [RequestStatus!]
+  userId: ID
+  """
+  Only time offs that overlap the period between from and to
+  """
+  from: Time
+  to: Time
+  createdAfter: Time
+  createdBefore: Time
 }
 
-func (ec *executionContext) fieldContext_User_firstName(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "User",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
+enum RequestOrderField {
+  CREATED_AT
+  START_TIME
 }
 
-func (ec *executionContext) _User_lastName(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_User_lastName(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.LastName, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+enum OrderDirection {
+  ASC
+  DESC
 }
 
-func (ec *executionContext) fieldContext_User_lastName(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "User",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
+input RequestOrder {
+  field: RequestOrderField!
+  direction: OrderDirection!
 }
 
-func (ec *executionContext) _User_email(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_User_email(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Email, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+input RequestTimeOffInput {
+  userId: ID!
+  channelId: ID!
+  startTime: Time!
+  endTime: Time
+  is24Hours: Boolean!
+  reason: String
+  """
+  Code of a leave type of the channel, DEFAULT when it is left out
+  """
+  leaveType: String
+  attachmentUrl: String
+  """
+  Lets the time off overlap blackout periods; needs request_time_off.MANAGE_ALL
+  """
+  blackoutOverrideReason: String
+  requestNote: String
+  responseNote: String
 }
 
-func (ec *executionContext) fieldContext_User_email(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "User",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
+input RequestsInput {
+  channelId: ID
+  userId: ID
+  recipientId: ID
+  type: String
 }
 
-func (ec *executionContext) _User_avatar(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_User_avatar(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Avatar, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+type User {
+  id: ID
+  firstName: String
+  lastName: String
+  email: String
+  avatar: String
+  isActive: Boolean
+  isStaff: Boolean
 }
 
-func (ec *executionContext) fieldContext_User_avatar(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "User",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
+type AssignedShift {
+  id: ID!
+  break: String!
+  color: String!
+  startTime: Time!
+  endTime: Time!
+  is24Hours: Boolean!
+  label: String
+  note: String
+  shiftToOffer: AssignedShift
+  shiftToSwap: AssignedShift
+  toSwapWith: AssignedShift
+  userId: ID
+  channelId: ID
+  shiftGroupId: ID
+  type: String
+  isOpen: Boolean
+  isShared: Boolean
+  ShiftActivities: [AssignedShiftActivities]
 }
 
-func (ec *executionContext) _User_isActive(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_User_isActive(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.IsActive, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*bool)
-	fc.Result = res
-	return ec.marshalOBoolean2ᚖbool(ctx, field.Selections, res)
+type AssignedShiftActivities {
+  id: ID!
+  channelId: ID
+  shiftGroupId: ID
+  assignedShiftId: ID!
+  userId: ID
+  name: String
+  code: String
+  color: String
+  startTime: Time!
+  endTime: Time!
+  isPaid: Boolean!
 }
 
-func (ec *executionContext) fieldContext_User_isActive(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "User",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
-	}
-	return fc, nil
+type TimeOffResponse {
+  errors: [ShiftError!]!
+  request: RequestResponse
+  """
+  Shifts assigned to the user during the time off; set by approving it
+  """
+  shiftConflicts: [ShiftConflict!]
+  """
+  Days on which the time off would exceed the absence limit of a shift group of the user
+  """
+  capacityWarnings: [CapacityWarning!]
+}
+
+"""
+How the expiry scheduler treats the pending time offs of a channel
+"""
+type RequestExpirySettings {
+  channelId: ID!
+  enabled: Boolean!
+  """
+  Minutes after its start a pending time off expires
+  """
+  gracePeriodMinutes: Int!
+  updatedByUserId: ID
+  updatedAt: Time
 }
 
-func (ec *executionContext) _User_isStaff(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_User_isStaff(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.IsStaff, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*bool)
-	fc.Result = res
-	return ec.marshalOBoolean2ᚖbool(ctx, field.Selections, res)
+input RequestExpirySettingsInput {
+  enabled: Boolean!
+  gracePeriodMinutes: Int!
 }
 
-func (ec *executionContext) fieldContext_User_isStaff(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "User",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
-	}
-	return fc, nil
+type RequestExpirySettingsResponse {
+  errors: [ShiftError!]!
+  settings: RequestExpirySettings
 }
 
-func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Directive_name(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+"""
+A kind of leave of a channel, such as vacation or sick leave; time offs and
+balances refer to it by its code
+"""
+type LeaveType {
+  channelId: ID!
+  code: String!
+  name: String!
+  color: String
+  paid: Boolean!
+  requiresAttachment: Boolean!
+  """
+  Hours a time off of the type has to be requested before it starts
+  """
+  minimumNoticeHours: Int!
+  """
+  Most calendar days a time off of the type may span
+  """
+  maxConsecutiveDays: Int
+  """
+  Time offs of the type are approved as soon as they are requested
+  """
+  autoApprove: Boolean!
+  """
+  Archived leave types cannot be requested any more
+  """
+  archived: Boolean!
+  updatedByUserId: ID
+  updatedAt: Time
+}
+
+input LeaveTypeInput {
+  code: String!
+  name: String!
+  color: String
+  paid: Boolean!
+  requiresAttachment: Boolean
+  minimumNoticeHours: Int
+  maxConsecutiveDays: Int
+  autoApprove: Boolean
+  archived: Boolean
 }
 
-func (ec *executionContext) fieldContext___Directive_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Directive",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
+type LeaveTypeResponse {
+  errors: [ShiftError!]!
+  leaveType: LeaveType
 }
 
-func (ec *executionContext) ___Directive_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Directive_description(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+"""
+A period in which time off cannot be requested, in a whole channel or in one
+of its shift groups
+"""
+type BlackoutPeriod {
+  id: ID!
+  channelId: ID!
+  """
+  Only the members of the shift group are blocked; the whole channel when null
+  """
+  shiftGroupId: ID
+  name: String!
+  startTime: Time!
+  endTime: Time!
+  createdByUserId: ID
+  createdAt: Time!
+  updatedAt: Time
 }
 
-func (ec *executionContext) fieldContext___Directive_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Directive",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
+input BlackoutPeriodInput {
+  channelId: ID!
+  shiftGroupId: ID
+  name: String!
+  startTime: Time!
+  endTime: Time!
 }
 
-func (ec *executionContext) ___Directive_locations(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Directive_locations(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Locations, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.([]string)
-	fc.Result = res
-	return ec.marshalN__DirectiveLocation2ᚕstringᚄ(ctx, field.Selections, res)
+type BlackoutPeriodResponse {
+  errors: [ShiftError!]!
+  blackoutPeriod: BlackoutPeriod
 }
 
-func (ec *executionContext) fieldContext___Directive_locations(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Directive",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type __DirectiveLocation does not have child fields")
-		},
-	}
-	return fc, nil
+"""
+The most members of a shift group that can be off on the same day, as a count
+or as a percentage of its members; without either the shift group has no limit
+"""
+type AbsenceLimit {
+  channelId: ID!
+  shiftGroupId: ID!
+  maxAbsences: Int
+  maxAbsencePercent: Float
+  updatedByUserId: ID
+  updatedAt: Time
 }
 
-func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Directive_args(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Args, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.([]introspection.InputValue)
-	fc.Result = res
-	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+"""
+At most one of maxAbsences and maxAbsencePercent
+"""
+input AbsenceLimitInput {
+  maxAbsences: Int
+  maxAbsencePercent: Float
 }
 
-func (ec *executionContext) fieldContext___Directive_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Directive",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___InputValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___InputValue_description(ctx, field)
-			case "type":
-				return ec.fieldContext___InputValue_type(ctx, field)
-			case "defaultValue":
-				return ec.fieldContext___InputValue_defaultValue(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
-		},
-	}
-	return fc, nil
+type AbsenceLimitResponse {
+  errors: [ShiftError!]!
+  absenceLimit: AbsenceLimit
+}
+
+"""
+A day (UTC) on which approving the time off would put more members of the
+shift group off than its limit
+"""
+type CapacityWarning {
+  shiftGroupId: ID!
+  date: Time!
+  """
+  Members of the shift group already off that day, without the user of the time off
+  """
+  absences: Int!
+  limit: Int!
+}
+
+"""
+What approving a time off does with the shifts assigned to the user during it
+"""
+enum ShiftConflictPolicy {
+  """
+  The time off is not approved while the user has shifts during it
+  """
+  BLOCK
+  """
+  The time off is approved and the shifts are listed
+  """
+  WARN
+  """
+  The time off is approved and the shifts become open shifts
+  """
+  CONVERT
+}
+
+"""
+How approving the time offs of a channel treats the shifts assigned during them
+"""
+type ShiftConflictSettings {
+  channelId: ID!
+  policy: ShiftConflictPolicy!
+  updatedByUserId: ID
+  updatedAt: Time
 }
 
-func (ec *executionContext) ___Directive_isRepeatable(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Directive_isRepeatable(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.IsRepeatable, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.(bool)
-	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+input ShiftConflictSettingsInput {
+  policy: ShiftConflictPolicy!
 }
 
-func (ec *executionContext) fieldContext___Directive_isRepeatable(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Directive",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
-	}
-	return fc, nil
+type ShiftConflictSettingsResponse {
+  errors: [ShiftError!]!
+  settings: ShiftConflictSettings
 }
 
-func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___EnumValue_name(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+"""
+A shift assigned to the user during a time off being approved
+"""
+type ShiftConflict {
+  shift: AssignedShift!
+  """
+  Whether the shift was made an open shift (CONVERT)
+  """
+  converted: Boolean!
 }
 
-func (ec *executionContext) fieldContext___EnumValue_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
+"""
+Outcome of a bulk mutation for one request time off
+"""
+type RequestTimeOffResult {
+  id: ID!
+  errors: [ShiftError!]!
+  request: RequestResponse
+  shiftConflicts: [ShiftConflict!]
+  capacityWarnings: [CapacityWarning!]
 }
 
-func (ec *executionContext) ___EnumValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___EnumValue_description(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+"""
+errors are about the whole call; each request time off has its own result, in the order of the ids
+"""
+type BulkTimeOffResponse {
+  errors: [ShiftError!]!
+  results: [RequestTimeOffResult!]!
 }
 
-func (ec *executionContext) fieldContext___EnumValue_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
+type ShiftError {
+  code: ShiftErrorCode!
+  field: String
+  message: String
 }
 
-func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___EnumValue_isDeprecated(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.IsDeprecated(), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.(bool)
-	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+"""
+Stable error codes, also set as the "code" extension of GraphQL errors
+"""
+enum ShiftErrorCode {
+  UNAUTHENTICATED
+  FORBIDDEN
+  NOT_FOUND
+  VALIDATION
+  CONFLICT
+  UPSTREAM_UNAVAILABLE
+  INTERNAL
+  GRAPHQL_ERROR @deprecated(reason: "Use INTERNAL")
+  INVALID @deprecated(reason: "Use VALIDATION")
+  REQUIRED @deprecated(reason: "Use VALIDATION, UNAUTHENTICATED or FORBIDDEN")
 }
 
-func (ec *executionContext) fieldContext___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
-	}
-	return fc, nil
+type RequestResponse {
+  channelId: ID!
+  createdAt: Time
+  endTime: Time
+  id: ID!
+  isAllDay: Boolean
+  reason: String
+  requestId: ID!
+  requestNote: String
+  responseAt: Time
+  responseBy: User
+  responseNote: String
+  shiftOfferedTo: User
+  shiftToOffer: AssignedShift
+  shiftToSwap: AssignedShift
+  startTime: Time
+  status: RequestStatus
+  toSwapWith: AssignedShift
+  type: RequestType
+  user: User!
+  history: [RequestHistoryEntry!]!
+  comments: [RequestComment!]!
 }
 
-func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___EnumValue_deprecationReason(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.DeprecationReason(), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+enum RequestStatus {
+  PENDING
+  APPROVED
+  DENIED
+  CANCELLED
+  """
+  Still pending when the time off started, moved here by the expiry scheduler
+  """
+  EXPIRED
 }
 
-func (ec *executionContext) fieldContext___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
+enum RequestType {
+  OFFER
+  SWAP
+  TIMEOFF
 }
 
-func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Field_name(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+enum AccrualMethod {
+  PER_PAY_PERIOD
+  PER_HOURS_WORKED
+  ANNUAL_GRANT
 }
 
-func (ec *executionContext) fieldContext___Field_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Field",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
+enum TimeOffLedgerEntryKind {
+  ACCRUAL
+  GRANT
+  CARRY_OVER_EXPIRY
+  DEBIT
+  CREDIT
+  ADJUSTMENT
 }
 
-func (ec *executionContext) ___Field_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Field_description(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+"""
+Hours of leave a user has in a channel for a leave type
+"""
+type TimeOffBalance {
+  id: ID!
+  channelId: ID!
+  userId: ID!
+  leaveType: String!
+  balanceHours: Float!
+  createdAt: Time!
+  updatedAt: Time!
+  """
+  Every change of the balance, oldest first
+  """
+  history: [TimeOffLedgerEntry!]!
 }
 
-func (ec *executionContext) fieldContext___Field_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Field",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
+"""
+One change of a balance; the ledger is only ever appended to
+"""
+type TimeOffLedgerEntry {
+  id: ID!
+  kind: TimeOffLedgerEntryKind!
+  """
+  Hours added to (positive) or taken from (negative) the balance
+  """
+  hours: Float!
+  balanceAfter: Float!
+  requestTimeOffId: ID
+  """
+  Pay period, hours worked or grant year the entry is for
+  """
+  period: String
+  note: String
+  """
+  User who made the change; empty for accruals and grants of the service
+  """
+  actorUserId: ID
+  createdAt: Time!
 }
 
-func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Field_args(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Args, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.([]introspection.InputValue)
-	fc.Result = res
-	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+"""
+How a leave type accrues in a channel and how many hours a day of it takes
+"""
+type TimeOffAccrualPolicy {
+  channelId: ID!
+  leaveType: String!
+  method: AccrualMethod!
+  """
+  Hours accrued each pay period (PER_PAY_PERIOD) or granted each year (ANNUAL_GRANT)
+  """
+  hours: Float!
+  """
+  Hours accrued per hour worked (PER_HOURS_WORKED)
+  """
+  rate: Float!
+  """
+  Length of a pay period in days and start of the first one (PER_PAY_PERIOD)
+  """
+  periodDays: Int!
+  periodStart: Time
+  """
+  Month and day of the annual grant (ANNUAL_GRANT)
+  """
+  grantMonth: Int!
+  grantDay: Int!
+  """
+  Most hours kept when the annual grant is made; no cap when empty
+  """
+  carryOverCap: Float
+  """
+  Working hours of a day of leave
+  """
+  hoursPerDay: Float!
+  """
+  Whether Saturdays and Sundays are working days
+  """
+  includeWeekends: Boolean!
+  enabled: Boolean!
+  updatedByUserId: ID
+  updatedAt: Time
+}
+
+input TimeOffAccrualPolicyInput {
+  leaveType: String!
+  method: AccrualMethod!
+  hours: Float
+  rate: Float
+  periodDays: Int
+  periodStart: Time
+  grantMonth: Int
+  grantDay: Int
+  carryOverCap: Float
+  hoursPerDay: Float
+  includeWeekends: Boolean
+  enabled: Boolean!
+}
+
+type TimeOffAccrualPolicyResponse {
+  errors: [ShiftError!]!
+  policy: TimeOffAccrualPolicy
 }
 
-func (ec *executionContext) fieldContext___Field_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Field",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___InputValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___InputValue_description(ctx, field)
-			case "type":
-				return ec.fieldContext___InputValue_type(ctx, field)
-			case "defaultValue":
-				return ec.fieldContext___InputValue_defaultValue(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
-		},
-	}
-	return fc, nil
+type TimeOffBalanceResponse {
+  errors: [ShiftError!]!
+  balances: [TimeOffBalance!]
 }
 
-func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Field_type(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.(*introspection.Type)
-	fc.Result = res
-	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+scalar Time
+
+type Query {
+  getRequestTimeOff(id: ID!, authUserId: ID @deprecated(reason: "The user is taken from the session; only honored for trusted services")): RequestTimeOff!
+  getRequestTimeOffs(
+    """
+    Only the time offs of this channel; all channels when it is left out
+    """
+    channelId: ID
+    authUserId: ID @deprecated(reason: "The user is taken from the session; only honored for trusted services")
+  ): [RequestTimeOff]!
+  """
+  Time offs of the channel, a page at a time; newest first unless orderBy says otherwise
+  """
+  getRequestTimeOffsConnection(
+    channelId: ID!
+    filter: RequestFilter
+    orderBy: RequestOrder
+    first: Int
+    after: String
+    authUserId: ID @deprecated(reason: "The user is taken from the session; only honored for trusted services")
+  ): RequestTimeOffConnection!
+  getRequestTimeOffsByChannelIdRequestId(
+    channelId: ID!
+    requestId: ID!
+  ): RequestTimeOff!
+  """
+  Expiry settings of the channel; the defaults of the service when it has none
+  """
+  getRequestExpirySettings(
+    channelId: ID!
+    authUserId: ID @deprecated(reason: "The user is taken from the session; only honored for trusted services")
+  ): RequestExpirySettings!
+  """
+  Balances of the user with their history; the user's own ones need request_time_off.READ, the others request_time_off.READ_ALL
+  """
+  timeOffBalance(
+    userId: ID!
+    channelId: ID
+    leaveType: String
+    authUserId: ID @deprecated(reason: "The user is taken from the session; only honored for trusted services")
+  ): [TimeOffBalance!]!
+  getTimeOffAccrualPolicies(
+    channelId: ID!
+    authUserId: ID @deprecated(reason: "The user is taken from the session; only honored for trusted services")
+  ): [TimeOffAccrualPolicy!]!
+  """
+  Shift conflict settings of the channel; the default of the service when it has none
+  """
+  getShiftConflictSettings(
+    channelId: ID!
+    authUserId: ID @deprecated(reason: "The user is taken from the session; only honored for trusted services")
+  ): ShiftConflictSettings!
+  """
+  Leave types of the channel, ordered by name
+  """
+  getLeaveTypes(
+    channelId: ID!
+    includeArchived: Boolean
+    authUserId: ID @deprecated(reason: "The user is taken from the session; only honored for trusted services")
+  ): [LeaveType!]!
+  """
+  Blackout periods of the channel that overlap the period between from and to, earliest first
+  """
+  getBlackoutPeriods(
+    channelId: ID!
+    shiftGroupId: ID
+    from: Time
+    to: Time
+    authUserId: ID @deprecated(reason: "The user is taken from the session; only honored for trusted services")
+  ): [BlackoutPeriod!]!
+  """
+  Absence limits of the shift groups of the channel
+  """
+  getAbsenceLimits(
+    channelId: ID!
+    authUserId: ID @deprecated(reason: "The user is taken from the session; only honored for trusted services")
+  ): [AbsenceLimit!]!
+  """
+  Approved time offs of the users in the channel that overlap the period
+  """
+  getApprovedTimeOffs(
+    channelId: ID!
+    userIds: [ID!]!
+    startTime: Time!
+    endTime: Time!
+    authUserId: ID @deprecated(reason: "The user is taken from the session; only honored for trusted services")
+  ): [RequestTimeOff!]!
 }
 
-func (ec *executionContext) fieldContext___Field_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Field",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
-	}
-	return fc, nil
+type Mutation {
+  createRequestTimeOff(
+    input: RequestTimeOffInput!
+    authUserId: ID @deprecated(reason: "The user is taken from the session; only honored for trusted services")
+  ): TimeOffResponse
+  updateRequestTimeOff(
+    id: ID!
+    input: RequestTimeOffInput!
+    authUserId: ID @deprecated(reason: "The user is taken from the session; only honored for trusted services")
+  ): TimeOffResponse
+  deleteRequestTimeOff(id: ID!, authUserId: ID @deprecated(reason: "The user is taken from the session; only honored for trusted services")): TimeOffResponse
+  cancelRequestTimeOff(
+    channelId: ID!
+    requestId: ID!
+    authUserId: ID @deprecated(reason: "The user is taken from the session; only honored for trusted services")
+  ): TimeOffResponse
+  approveRequestTimeOff(
+    id: ID!
+    responseNote: String
+    """
+    Approves the time off over the absence limits; needs request_time_off.MANAGE_ALL
+    """
+    capacityOverrideReason: String
+    authUserId: ID @deprecated(reason: "The user is taken from the session; only honored for trusted services")
+  ): TimeOffResponse
+  denyRequestTimeOff(
+    id: ID!
+    responseNote: String
+    authUserId: ID @deprecated(reason: "The user is taken from the session; only honored for trusted services")
+  ): TimeOffResponse
+  """
+  Approves each request time off on its own, at most 100
+  """
+  approveRequestTimeOffs(
+    ids: [ID!]!
+    responseNote: String
+    authUserId: ID @deprecated(reason: "The user is taken from the session; only honored for trusted services")
+  ): BulkTimeOffResponse!
+  """
+  Denies each request time off on its own, at most 100
+  """
+  denyRequestTimeOffs(
+    ids: [ID!]!
+    responseNote: String
+    authUserId: ID @deprecated(reason: "The user is taken from the session; only honored for trusted services")
+  ): BulkTimeOffResponse!
+  """
+  Adds a comment to the request time off; its requester and managers can comment
+  """
+  addRequestComment(
+    id: ID!
+    body: String!
+    authUserId: ID @deprecated(reason: "The user is taken from the session; only honored for trusted services")
+  ): RequestCommentResponse!
+  setRequestExpirySettings(
+    channelId: ID!
+    input: RequestExpirySettingsInput!
+    authUserId: ID @deprecated(reason: "The user is taken from the session; only honored for trusted services")
+  ): RequestExpirySettingsResponse!
+  """
+  Sets how the leave type accrues in the channel
+  """
+  setTimeOffAccrualPolicy(
+    channelId: ID!
+    input: TimeOffAccrualPolicyInput!
+    authUserId: ID @deprecated(reason: "The user is taken from the session; only honored for trusted services")
+  ): TimeOffAccrualPolicyResponse!
+  """
+  Adds hours to (positive) or takes hours from (negative) the balance, such as an opening balance
+  """
+  adjustTimeOffBalance(
+    channelId: ID!
+    userId: ID!
+    leaveType: String!
+    hours: Float!
+    note: String
+    authUserId: ID @deprecated(reason: "The user is taken from the session; only honored for trusted services")
+  ): TimeOffBalanceResponse!
+  """
+  Accrues the hours worked until workedUntil for the PER_HOURS_WORKED policies of the channel; each workedUntil counts once
+  """
+  recordHoursWorked(
+    channelId: ID!
+    userId: ID!
+    hours: Float!
+    workedUntil: Time!
+    authUserId: ID @deprecated(reason: "The user is taken from the session; only honored for trusted services")
+  ): TimeOffBalanceResponse!
+  setShiftConflictSettings(
+    channelId: ID!
+    input: ShiftConflictSettingsInput!
+    authUserId: ID @deprecated(reason: "The user is taken from the session; only honored for trusted services")
+  ): ShiftConflictSettingsResponse!
+  """
+  Creates or changes the leave type with the code in the channel
+  """
+  setLeaveType(
+    channelId: ID!
+    input: LeaveTypeInput!
+    authUserId: ID @deprecated(reason: "The user is taken from the session; only honored for trusted services")
+  ): LeaveTypeResponse!
+  createBlackoutPeriod(
+    input: BlackoutPeriodInput!
+    authUserId: ID @deprecated(reason: "The user is taken from the session; only honored for trusted services")
+  ): BlackoutPeriodResponse!
+  updateBlackoutPeriod(
+    id: ID!
+    input: BlackoutPeriodInput!
+    authUserId: ID @deprecated(reason: "The user is taken from the session; only honored for trusted services")
+  ): BlackoutPeriodResponse!
+  """
+  Deletes the blackout period and returns it
+  """
+  deleteBlackoutPeriod(
+    id: ID!
+    authUserId: ID @deprecated(reason: "The user is taken from the session; only honored for trusted services")
+  ): BlackoutPeriodResponse!
+  setAbsenceLimit(
+    channelId: ID!
+    shiftGroupId: ID!
+    input: AbsenceLimitInput!
+    authUserId: ID @deprecated(reason: "The user is taken from the session; only honored for trusted services")
+  ): AbsenceLimitResponse!
+}
+`, BuiltIn: false},
 }
+var parsedSchema = gqlparser.MustLoadSchema(sources...)
 
-func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Field_isDeprecated(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+// endregion ************************** generated!.gotpl **************************
+
+// region    ***************************** args.gotpl *****************************
+
+func (ec *executionContext) field_Mutation_addRequestComment_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.IsDeprecated(), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
 	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+	args["id"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["body"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("body"))
+		arg1, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
 		}
-		return graphql.Null
 	}
-	res := resTmp.(bool)
-	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
-}
-
-func (ec *executionContext) fieldContext___Field_isDeprecated(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Field",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
+	args["body"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["authUserId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authUserId"))
+		arg2, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
 	}
-	return fc, nil
+	args["authUserId"] = arg2
+	return args, nil
 }
 
-func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Field_deprecationReason(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+func (ec *executionContext) field_Mutation_adjustTimeOffBalance_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["channelId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("channelId"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.DeprecationReason(), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
 	}
-	if resTmp == nil {
-		return graphql.Null
+	args["channelId"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["userId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("userId"))
+		arg1, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
 	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
-}
-
-func (ec *executionContext) fieldContext___Field_deprecationReason(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Field",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+	args["userId"] = arg1
+	var arg2 string
+	if tmp, ok := rawArgs["leaveType"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("leaveType"))
+		arg2, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
 	}
-	return fc, nil
-}
-
-func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___InputValue_name(ctx, field)
-	if err != nil {
-		return graphql.Null
+	args["leaveType"] = arg2
+	var arg3 float64
+	if tmp, ok := rawArgs["hours"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("hours"))
+		arg3, err = ec.unmarshalNFloat2float64(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
 	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	args["hours"] = arg3
+	var arg4 *string
+	if tmp, ok := rawArgs["note"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("note"))
+		arg4, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
 	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+	args["note"] = arg4
+	var arg5 *string
+	if tmp, ok := rawArgs["authUserId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authUserId"))
+		arg5, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
 		}
-		return graphql.Null
 	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	args["authUserId"] = arg5
+	return args, nil
 }
 
-func (ec *executionContext) fieldContext___InputValue_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+func (ec *executionContext) field_Mutation_approveRequestTimeOff_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
 	}
-	return fc, nil
-}
-
-func (ec *executionContext) ___InputValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___InputValue_description(ctx, field)
-	if err != nil {
-		return graphql.Null
+	args["id"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["responseNote"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("responseNote"))
+		arg1, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
 	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	args["responseNote"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["capacityOverrideReason"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("capacityOverrideReason"))
+		arg2, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
 	}
-	if resTmp == nil {
-		return graphql.Null
+	args["capacityOverrideReason"] = arg2
+	var arg3 *string
+	if tmp, ok := rawArgs["authUserId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authUserId"))
+		arg3, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
 	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	args["authUserId"] = arg3
+	return args, nil
 }
 
-func (ec *executionContext) fieldContext___InputValue_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+func (ec *executionContext) field_Mutation_approveRequestTimeOffs_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 []string
+	if tmp, ok := rawArgs["ids"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("ids"))
+		arg0, err = ec.unmarshalNID2ᚕstringᚄ(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
 	}
-	return fc, nil
+	args["ids"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["responseNote"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("responseNote"))
+		arg1, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["responseNote"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["authUserId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authUserId"))
+		arg2, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["authUserId"] = arg2
+	return args, nil
 }
 
-func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___InputValue_type(ctx, field)
-	if err != nil {
-		return graphql.Null
+func (ec *executionContext) field_Mutation_cancelRequestTimeOff_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["channelId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("channelId"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
 	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	args["channelId"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["requestId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("requestId"))
+		arg1, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
 	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+	args["requestId"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["authUserId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authUserId"))
+		arg2, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
 		}
-		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
-	fc.Result = res
-	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	args["authUserId"] = arg2
+	return args, nil
 }
 
-func (ec *executionContext) fieldContext___InputValue_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
+func (ec *executionContext) field_Mutation_createBlackoutPeriod_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 model.BlackoutPeriodInput
+	if tmp, ok := rawArgs["input"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+		arg0, err = ec.unmarshalNBlackoutPeriodInput2request_time_offsᚋgraphᚋmodelᚐBlackoutPeriodInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
 	}
-	return fc, nil
+	args["input"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["authUserId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authUserId"))
+		arg1, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["authUserId"] = arg1
+	return args, nil
 }
 
-func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___InputValue_defaultValue(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+func (ec *executionContext) field_Mutation_createRequestTimeOff_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 model.RequestTimeOffInput
+	if tmp, ok := rawArgs["input"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+		arg0, err = ec.unmarshalNRequestTimeOffInput2request_time_offsᚋgraphᚋmodelᚐRequestTimeOffInput(ctx, tmp)
+		if err != nil {
+			return nil, err
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.DefaultValue, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
 	}
-	if resTmp == nil {
-		return graphql.Null
+	args["input"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["authUserId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authUserId"))
+		arg1, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
 	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	args["authUserId"] = arg1
+	return args, nil
 }
 
-func (ec *executionContext) fieldContext___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+func (ec *executionContext) field_Mutation_deleteBlackoutPeriod_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
 	}
-	return fc, nil
+	args["id"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["authUserId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authUserId"))
+		arg1, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["authUserId"] = arg1
+	return args, nil
 }
 
-func (ec *executionContext) ___Schema_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Schema_description(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+func (ec *executionContext) field_Mutation_deleteRequestTimeOff_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
 	}
-	if resTmp == nil {
-		return graphql.Null
+	args["id"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["authUserId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authUserId"))
+		arg1, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
 	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	args["authUserId"] = arg1
+	return args, nil
 }
 
-func (ec *executionContext) fieldContext___Schema_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Schema",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+func (ec *executionContext) field_Mutation_denyRequestTimeOff_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
 	}
-	return fc, nil
+	args["id"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["responseNote"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("responseNote"))
+		arg1, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["responseNote"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["authUserId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authUserId"))
+		arg2, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["authUserId"] = arg2
+	return args, nil
 }
 
-func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Schema_types(ctx, field)
-	if err != nil {
-		return graphql.Null
+func (ec *executionContext) field_Mutation_denyRequestTimeOffs_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 []string
+	if tmp, ok := rawArgs["ids"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("ids"))
+		arg0, err = ec.unmarshalNID2ᚕstringᚄ(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
 	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	args["ids"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["responseNote"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("responseNote"))
+		arg1, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Types(), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
 	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+	args["responseNote"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["authUserId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authUserId"))
+		arg2, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
 		}
-		return graphql.Null
 	}
-	res := resTmp.([]introspection.Type)
-	fc.Result = res
-	return ec.marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+	args["authUserId"] = arg2
+	return args, nil
 }
 
-func (ec *executionContext) fieldContext___Schema_types(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Schema",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
+func (ec *executionContext) field_Mutation_recordHoursWorked_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["channelId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("channelId"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
 	}
-	return fc, nil
+	args["channelId"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["userId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("userId"))
+		arg1, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["userId"] = arg1
+	var arg2 float64
+	if tmp, ok := rawArgs["hours"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("hours"))
+		arg2, err = ec.unmarshalNFloat2float64(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["hours"] = arg2
+	var arg3 time.Time
+	if tmp, ok := rawArgs["workedUntil"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("workedUntil"))
+		arg3, err = ec.unmarshalNTime2timeᚐTime(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["workedUntil"] = arg3
+	var arg4 *string
+	if tmp, ok := rawArgs["authUserId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authUserId"))
+		arg4, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["authUserId"] = arg4
+	return args, nil
 }
 
-func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Schema_queryType(ctx, field)
-	if err != nil {
-		return graphql.Null
+func (ec *executionContext) field_Mutation_setAbsenceLimit_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["channelId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("channelId"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
 	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	args["channelId"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["shiftGroupId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("shiftGroupId"))
+		arg1, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.QueryType(), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
 	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+	args["shiftGroupId"] = arg1
+	var arg2 model.AbsenceLimitInput
+	if tmp, ok := rawArgs["input"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+		arg2, err = ec.unmarshalNAbsenceLimitInput2request_time_offsᚋgraphᚋmodelᚐAbsenceLimitInput(ctx, tmp)
+		if err != nil {
+			return nil, err
 		}
-		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
-	fc.Result = res
-	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	args["input"] = arg2
+	var arg3 *string
+	if tmp, ok := rawArgs["authUserId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authUserId"))
+		arg3, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["authUserId"] = arg3
+	return args, nil
 }
 
-func (ec *executionContext) fieldContext___Schema_queryType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Schema",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+func (ec *executionContext) field_Mutation_setLeaveType_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["channelId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("channelId"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["channelId"] = arg0
+	var arg1 model.LeaveTypeInput
+	if tmp, ok := rawArgs["input"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+		arg1, err = ec.unmarshalNLeaveTypeInput2request_time_offsᚋgraphᚋmodelᚐLeaveTypeInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["input"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["authUserId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authUserId"))
+		arg2, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["authUserId"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_setRequestExpirySettings_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["channelId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("channelId"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["channelId"] = arg0
+	var arg1 model.RequestExpirySettingsInput
+	if tmp, ok := rawArgs["input"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+		arg1, err = ec.unmarshalNRequestExpirySettingsInput2request_time_offsᚋgraphᚋmodelᚐRequestExpirySettingsInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["input"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["authUserId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authUserId"))
+		arg2, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["authUserId"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_setShiftConflictSettings_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["channelId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("channelId"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["channelId"] = arg0
+	var arg1 model.ShiftConflictSettingsInput
+	if tmp, ok := rawArgs["input"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+		arg1, err = ec.unmarshalNShiftConflictSettingsInput2request_time_offsᚋgraphᚋmodelᚐShiftConflictSettingsInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["input"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["authUserId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authUserId"))
+		arg2, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["authUserId"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_setTimeOffAccrualPolicy_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["channelId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("channelId"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["channelId"] = arg0
+	var arg1 model.TimeOffAccrualPolicyInput
+	if tmp, ok := rawArgs["input"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+		arg1, err = ec.unmarshalNTimeOffAccrualPolicyInput2request_time_offsᚋgraphᚋmodelᚐTimeOffAccrualPolicyInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["input"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["authUserId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authUserId"))
+		arg2, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["authUserId"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_updateBlackoutPeriod_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	var arg1 model.BlackoutPeriodInput
+	if tmp, ok := rawArgs["input"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+		arg1, err = ec.unmarshalNBlackoutPeriodInput2request_time_offsᚋgraphᚋmodelᚐBlackoutPeriodInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["input"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["authUserId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authUserId"))
+		arg2, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["authUserId"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_updateRequestTimeOff_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	var arg1 model.RequestTimeOffInput
+	if tmp, ok := rawArgs["input"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+		arg1, err = ec.unmarshalNRequestTimeOffInput2request_time_offsᚋgraphᚋmodelᚐRequestTimeOffInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["input"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["authUserId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authUserId"))
+		arg2, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["authUserId"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["name"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["name"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_getAbsenceLimits_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["channelId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("channelId"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["channelId"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["authUserId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authUserId"))
+		arg1, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["authUserId"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_getApprovedTimeOffs_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["channelId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("channelId"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["channelId"] = arg0
+	var arg1 []string
+	if tmp, ok := rawArgs["userIds"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("userIds"))
+		arg1, err = ec.unmarshalNID2ᚕstringᚄ(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["userIds"] = arg1
+	var arg2 time.Time
+	if tmp, ok := rawArgs["startTime"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("startTime"))
+		arg2, err = ec.unmarshalNTime2timeᚐTime(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["startTime"] = arg2
+	var arg3 time.Time
+	if tmp, ok := rawArgs["endTime"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("endTime"))
+		arg3, err = ec.unmarshalNTime2timeᚐTime(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["endTime"] = arg3
+	var arg4 *string
+	if tmp, ok := rawArgs["authUserId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authUserId"))
+		arg4, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["authUserId"] = arg4
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_getBlackoutPeriods_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["channelId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("channelId"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["channelId"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["shiftGroupId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("shiftGroupId"))
+		arg1, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["shiftGroupId"] = arg1
+	var arg2 *time.Time
+	if tmp, ok := rawArgs["from"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("from"))
+		arg2, err = ec.unmarshalOTime2ᚖtimeᚐTime(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["from"] = arg2
+	var arg3 *time.Time
+	if tmp, ok := rawArgs["to"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("to"))
+		arg3, err = ec.unmarshalOTime2ᚖtimeᚐTime(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["to"] = arg3
+	var arg4 *string
+	if tmp, ok := rawArgs["authUserId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authUserId"))
+		arg4, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["authUserId"] = arg4
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_getLeaveTypes_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["channelId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("channelId"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["channelId"] = arg0
+	var arg1 *bool
+	if tmp, ok := rawArgs["includeArchived"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("includeArchived"))
+		arg1, err = ec.unmarshalOBoolean2ᚖbool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["includeArchived"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["authUserId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authUserId"))
+		arg2, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["authUserId"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_getRequestExpirySettings_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["channelId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("channelId"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["channelId"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["authUserId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authUserId"))
+		arg1, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["authUserId"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_getRequestTimeOff_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["authUserId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authUserId"))
+		arg1, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["authUserId"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_getRequestTimeOffsByChannelIdRequestId_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["channelId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("channelId"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["channelId"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["requestId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("requestId"))
+		arg1, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["requestId"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_getRequestTimeOffsConnection_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["channelId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("channelId"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["channelId"] = arg0
+	var arg1 *model.RequestFilter
+	if tmp, ok := rawArgs["filter"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("filter"))
+		arg1, err = ec.unmarshalORequestFilter2ᚖrequest_time_offsᚋgraphᚋmodelᚐRequestFilter(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["filter"] = arg1
+	var arg2 *model.RequestOrder
+	if tmp, ok := rawArgs["orderBy"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("orderBy"))
+		arg2, err = ec.unmarshalORequestOrder2ᚖrequest_time_offsᚋgraphᚋmodelᚐRequestOrder(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["orderBy"] = arg2
+	var arg3 *int
+	if tmp, ok := rawArgs["first"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("first"))
+		arg3, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["first"] = arg3
+	var arg4 *string
+	if tmp, ok := rawArgs["after"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("after"))
+		arg4, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["after"] = arg4
+	var arg5 *string
+	if tmp, ok := rawArgs["authUserId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authUserId"))
+		arg5, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["authUserId"] = arg5
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_getRequestTimeOffs_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *string
+	if tmp, ok := rawArgs["channelId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("channelId"))
+		arg0, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["channelId"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["authUserId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authUserId"))
+		arg1, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["authUserId"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_getShiftConflictSettings_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["channelId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("channelId"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["channelId"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["authUserId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authUserId"))
+		arg1, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["authUserId"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_getTimeOffAccrualPolicies_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["channelId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("channelId"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["channelId"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["authUserId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authUserId"))
+		arg1, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["authUserId"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_timeOffBalance_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["userId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("userId"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["userId"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["channelId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("channelId"))
+		arg1, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["channelId"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["leaveType"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("leaveType"))
+		arg2, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["leaveType"] = arg2
+	var arg3 *string
+	if tmp, ok := rawArgs["authUserId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authUserId"))
+		arg3, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["authUserId"] = arg3
+	return args, nil
+}
+
+func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 bool
+	if tmp, ok := rawArgs["includeDeprecated"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("includeDeprecated"))
+		arg0, err = ec.unmarshalOBoolean2bool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 bool
+	if tmp, ok := rawArgs["includeDeprecated"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("includeDeprecated"))
+		arg0, err = ec.unmarshalOBoolean2bool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+// endregion ***************************** args.gotpl *****************************
+
+// region    ************************** directives.gotpl **************************
+
+// endregion ************************** directives.gotpl **************************
+
+// region    **************************** field.gotpl *****************************
+
+func (ec *executionContext) _AbsenceLimit_channelId(ctx context.Context, field graphql.CollectedField, obj *model.AbsenceLimit) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AbsenceLimit_channelId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ChannelID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AbsenceLimit_channelId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AbsenceLimit",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AbsenceLimit_shiftGroupId(ctx context.Context, field graphql.CollectedField, obj *model.AbsenceLimit) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AbsenceLimit_shiftGroupId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ShiftGroupID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AbsenceLimit_shiftGroupId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AbsenceLimit",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AbsenceLimit_maxAbsences(ctx context.Context, field graphql.CollectedField, obj *model.AbsenceLimit) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AbsenceLimit_maxAbsences(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.MaxAbsences, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	fc.Result = res
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AbsenceLimit_maxAbsences(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AbsenceLimit",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AbsenceLimit_maxAbsencePercent(ctx context.Context, field graphql.CollectedField, obj *model.AbsenceLimit) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AbsenceLimit_maxAbsencePercent(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.MaxAbsencePercent, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*float64)
+	fc.Result = res
+	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AbsenceLimit_maxAbsencePercent(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AbsenceLimit",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AbsenceLimit_updatedByUserId(ctx context.Context, field graphql.CollectedField, obj *model.AbsenceLimit) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AbsenceLimit_updatedByUserId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.UpdatedByUserID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOID2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AbsenceLimit_updatedByUserId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AbsenceLimit",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AbsenceLimit_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.AbsenceLimit) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AbsenceLimit_updatedAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.UpdatedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AbsenceLimit_updatedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AbsenceLimit",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AbsenceLimitResponse_errors(ctx context.Context, field graphql.CollectedField, obj *model.AbsenceLimitResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AbsenceLimitResponse_errors(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Errors, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.ShiftError)
+	fc.Result = res
+	return ec.marshalNShiftError2ᚕᚖrequest_time_offsᚋgraphᚋmodelᚐShiftErrorᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AbsenceLimitResponse_errors(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AbsenceLimitResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "code":
+				return ec.fieldContext_ShiftError_code(ctx, field)
+			case "field":
+				return ec.fieldContext_ShiftError_field(ctx, field)
+			case "message":
+				return ec.fieldContext_ShiftError_message(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ShiftError", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AbsenceLimitResponse_absenceLimit(ctx context.Context, field graphql.CollectedField, obj *model.AbsenceLimitResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AbsenceLimitResponse_absenceLimit(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.AbsenceLimit, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.AbsenceLimit)
+	fc.Result = res
+	return ec.marshalOAbsenceLimit2ᚖrequest_time_offsᚋgraphᚋmodelᚐAbsenceLimit(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AbsenceLimitResponse_absenceLimit(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AbsenceLimitResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "channelId":
+				return ec.fieldContext_AbsenceLimit_channelId(ctx, field)
+			case "shiftGroupId":
+				return ec.fieldContext_AbsenceLimit_shiftGroupId(ctx, field)
+			case "maxAbsences":
+				return ec.fieldContext_AbsenceLimit_maxAbsences(ctx, field)
+			case "maxAbsencePercent":
+				return ec.fieldContext_AbsenceLimit_maxAbsencePercent(ctx, field)
+			case "updatedByUserId":
+				return ec.fieldContext_AbsenceLimit_updatedByUserId(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_AbsenceLimit_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AbsenceLimit", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AssignedShift_id(ctx context.Context, field graphql.CollectedField, obj *model.AssignedShift) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AssignedShift_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AssignedShift_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AssignedShift",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AssignedShift_break(ctx context.Context, field graphql.CollectedField, obj *model.AssignedShift) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AssignedShift_break(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Break, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AssignedShift_break(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AssignedShift",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AssignedShift_color(ctx context.Context, field graphql.CollectedField, obj *model.AssignedShift) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AssignedShift_color(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Color, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AssignedShift_color(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AssignedShift",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AssignedShift_startTime(ctx context.Context, field graphql.CollectedField, obj *model.AssignedShift) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AssignedShift_startTime(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.StartTime, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AssignedShift_startTime(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AssignedShift",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AssignedShift_endTime(ctx context.Context, field graphql.CollectedField, obj *model.AssignedShift) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AssignedShift_endTime(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.EndTime, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AssignedShift_endTime(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AssignedShift",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AssignedShift_is24Hours(ctx context.Context, field graphql.CollectedField, obj *model.AssignedShift) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AssignedShift_is24Hours(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Is24Hours, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AssignedShift_is24Hours(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AssignedShift",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AssignedShift_label(ctx context.Context, field graphql.CollectedField, obj *model.AssignedShift) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AssignedShift_label(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Label, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AssignedShift_label(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AssignedShift",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AssignedShift_note(ctx context.Context, field graphql.CollectedField, obj *model.AssignedShift) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AssignedShift_note(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Note, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AssignedShift_note(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AssignedShift",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AssignedShift_shiftToOffer(ctx context.Context, field graphql.CollectedField, obj *model.AssignedShift) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AssignedShift_shiftToOffer(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ShiftToOffer, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.AssignedShift)
+	fc.Result = res
+	return ec.marshalOAssignedShift2ᚖrequest_time_offsᚋgraphᚋmodelᚐAssignedShift(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AssignedShift_shiftToOffer(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AssignedShift",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AssignedShift_id(ctx, field)
+			case "break":
+				return ec.fieldContext_AssignedShift_break(ctx, field)
+			case "color":
+				return ec.fieldContext_AssignedShift_color(ctx, field)
+			case "startTime":
+				return ec.fieldContext_AssignedShift_startTime(ctx, field)
+			case "endTime":
+				return ec.fieldContext_AssignedShift_endTime(ctx, field)
+			case "is24Hours":
+				return ec.fieldContext_AssignedShift_is24Hours(ctx, field)
+			case "label":
+				return ec.fieldContext_AssignedShift_label(ctx, field)
+			case "note":
+				return ec.fieldContext_AssignedShift_note(ctx, field)
+			case "shiftToOffer":
+				return ec.fieldContext_AssignedShift_shiftToOffer(ctx, field)
+			case "shiftToSwap":
+				return ec.fieldContext_AssignedShift_shiftToSwap(ctx, field)
+			case "toSwapWith":
+				return ec.fieldContext_AssignedShift_toSwapWith(ctx, field)
+			case "userId":
+				return ec.fieldContext_AssignedShift_userId(ctx, field)
+			case "channelId":
+				return ec.fieldContext_AssignedShift_channelId(ctx, field)
+			case "shiftGroupId":
+				return ec.fieldContext_AssignedShift_shiftGroupId(ctx, field)
+			case "type":
+				return ec.fieldContext_AssignedShift_type(ctx, field)
+			case "isOpen":
+				return ec.fieldContext_AssignedShift_isOpen(ctx, field)
+			case "isShared":
+				return ec.fieldContext_AssignedShift_isShared(ctx, field)
+			case "ShiftActivities":
+				return ec.fieldContext_AssignedShift_ShiftActivities(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AssignedShift", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AssignedShift_shiftToSwap(ctx context.Context, field graphql.CollectedField, obj *model.AssignedShift) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AssignedShift_shiftToSwap(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ShiftToSwap, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.AssignedShift)
+	fc.Result = res
+	return ec.marshalOAssignedShift2ᚖrequest_time_offsᚋgraphᚋmodelᚐAssignedShift(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AssignedShift_shiftToSwap(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AssignedShift",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AssignedShift_id(ctx, field)
+			case "break":
+				return ec.fieldContext_AssignedShift_break(ctx, field)
+			case "color":
+				return ec.fieldContext_AssignedShift_color(ctx, field)
+			case "startTime":
+				return ec.fieldContext_AssignedShift_startTime(ctx, field)
+			case "endTime":
+				return ec.fieldContext_AssignedShift_endTime(ctx, field)
+			case "is24Hours":
+				return ec.fieldContext_AssignedShift_is24Hours(ctx, field)
+			case "label":
+				return ec.fieldContext_AssignedShift_label(ctx, field)
+			case "note":
+				return ec.fieldContext_AssignedShift_note(ctx, field)
+			case "shiftToOffer":
+				return ec.fieldContext_AssignedShift_shiftToOffer(ctx, field)
+			case "shiftToSwap":
+				return ec.fieldContext_AssignedShift_shiftToSwap(ctx, field)
+			case "toSwapWith":
+				return ec.fieldContext_AssignedShift_toSwapWith(ctx, field)
+			case "userId":
+				return ec.fieldContext_AssignedShift_userId(ctx, field)
+			case "channelId":
+				return ec.fieldContext_AssignedShift_channelId(ctx, field)
+			case "shiftGroupId":
+				return ec.fieldContext_AssignedShift_shiftGroupId(ctx, field)
+			case "type":
+				return ec.fieldContext_AssignedShift_type(ctx, field)
+			case "isOpen":
+				return ec.fieldContext_AssignedShift_isOpen(ctx, field)
+			case "isShared":
+				return ec.fieldContext_AssignedShift_isShared(ctx, field)
+			case "ShiftActivities":
+				return ec.fieldContext_AssignedShift_ShiftActivities(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AssignedShift", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AssignedShift_toSwapWith(ctx context.Context, field graphql.CollectedField, obj *model.AssignedShift) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AssignedShift_toSwapWith(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ToSwapWith, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.AssignedShift)
+	fc.Result = res
+	return ec.marshalOAssignedShift2ᚖrequest_time_offsᚋgraphᚋmodelᚐAssignedShift(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AssignedShift_toSwapWith(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AssignedShift",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AssignedShift_id(ctx, field)
+			case "break":
+				return ec.fieldContext_AssignedShift_break(ctx, field)
+			case "color":
+				return ec.fieldContext_AssignedShift_color(ctx, field)
+			case "startTime":
+				return ec.fieldContext_AssignedShift_startTime(ctx, field)
+			case "endTime":
+				return ec.fieldContext_AssignedShift_endTime(ctx, field)
+			case "is24Hours":
+				return ec.fieldContext_AssignedShift_is24Hours(ctx, field)
+			case "label":
+				return ec.fieldContext_AssignedShift_label(ctx, field)
+			case "note":
+				return ec.fieldContext_AssignedShift_note(ctx, field)
+			case "shiftToOffer":
+				return ec.fieldContext_AssignedShift_shiftToOffer(ctx, field)
+			case "shiftToSwap":
+				return ec.fieldContext_AssignedShift_shiftToSwap(ctx, field)
+			case "toSwapWith":
+				return ec.fieldContext_AssignedShift_toSwapWith(ctx, field)
+			case "userId":
+				return ec.fieldContext_AssignedShift_userId(ctx, field)
+			case "channelId":
+				return ec.fieldContext_AssignedShift_channelId(ctx, field)
+			case "shiftGroupId":
+				return ec.fieldContext_AssignedShift_shiftGroupId(ctx, field)
+			case "type":
+				return ec.fieldContext_AssignedShift_type(ctx, field)
+			case "isOpen":
+				return ec.fieldContext_AssignedShift_isOpen(ctx, field)
+			case "isShared":
+				return ec.fieldContext_AssignedShift_isShared(ctx, field)
+			case "ShiftActivities":
+				return ec.fieldContext_AssignedShift_ShiftActivities(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AssignedShift", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AssignedShift_userId(ctx context.Context, field graphql.CollectedField, obj *model.AssignedShift) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AssignedShift_userId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.UserID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOID2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AssignedShift_userId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AssignedShift",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AssignedShift_channelId(ctx context.Context, field graphql.CollectedField, obj *model.AssignedShift) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AssignedShift_channelId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ChannelID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOID2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AssignedShift_channelId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AssignedShift",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AssignedShift_shiftGroupId(ctx context.Context, field graphql.CollectedField, obj *model.AssignedShift) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AssignedShift_shiftGroupId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ShiftGroupID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOID2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AssignedShift_shiftGroupId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AssignedShift",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AssignedShift_type(ctx context.Context, field graphql.CollectedField, obj *model.AssignedShift) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AssignedShift_type(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AssignedShift_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AssignedShift",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AssignedShift_isOpen(ctx context.Context, field graphql.CollectedField, obj *model.AssignedShift) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AssignedShift_isOpen(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsOpen, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*bool)
+	fc.Result = res
+	return ec.marshalOBoolean2ᚖbool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AssignedShift_isOpen(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AssignedShift",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AssignedShift_isShared(ctx context.Context, field graphql.CollectedField, obj *model.AssignedShift) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AssignedShift_isShared(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsShared, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*bool)
+	fc.Result = res
+	return ec.marshalOBoolean2ᚖbool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AssignedShift_isShared(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AssignedShift",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AssignedShift_ShiftActivities(ctx context.Context, field graphql.CollectedField, obj *model.AssignedShift) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AssignedShift_ShiftActivities(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ShiftActivities, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.AssignedShiftActivities)
+	fc.Result = res
+	return ec.marshalOAssignedShiftActivities2ᚕᚖrequest_time_offsᚋgraphᚋmodelᚐAssignedShiftActivities(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AssignedShift_ShiftActivities(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AssignedShift",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AssignedShiftActivities_id(ctx, field)
+			case "channelId":
+				return ec.fieldContext_AssignedShiftActivities_channelId(ctx, field)
+			case "shiftGroupId":
+				return ec.fieldContext_AssignedShiftActivities_shiftGroupId(ctx, field)
+			case "assignedShiftId":
+				return ec.fieldContext_AssignedShiftActivities_assignedShiftId(ctx, field)
+			case "userId":
+				return ec.fieldContext_AssignedShiftActivities_userId(ctx, field)
+			case "name":
+				return ec.fieldContext_AssignedShiftActivities_name(ctx, field)
+			case "code":
+				return ec.fieldContext_AssignedShiftActivities_code(ctx, field)
+			case "color":
+				return ec.fieldContext_AssignedShiftActivities_color(ctx, field)
+			case "startTime":
+				return ec.fieldContext_AssignedShiftActivities_startTime(ctx, field)
+			case "endTime":
+				return ec.fieldContext_AssignedShiftActivities_endTime(ctx, field)
+			case "isPaid":
+				return ec.fieldContext_AssignedShiftActivities_isPaid(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AssignedShiftActivities", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AssignedShiftActivities_id(ctx context.Context, field graphql.CollectedField, obj *model.AssignedShiftActivities) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AssignedShiftActivities_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AssignedShiftActivities_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AssignedShiftActivities",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AssignedShiftActivities_channelId(ctx context.Context, field graphql.CollectedField, obj *model.AssignedShiftActivities) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AssignedShiftActivities_channelId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ChannelID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOID2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AssignedShiftActivities_channelId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AssignedShiftActivities",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AssignedShiftActivities_shiftGroupId(ctx context.Context, field graphql.CollectedField, obj *model.AssignedShiftActivities) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AssignedShiftActivities_shiftGroupId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ShiftGroupID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOID2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AssignedShiftActivities_shiftGroupId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AssignedShiftActivities",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AssignedShiftActivities_assignedShiftId(ctx context.Context, field graphql.CollectedField, obj *model.AssignedShiftActivities) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AssignedShiftActivities_assignedShiftId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.AssignedShiftID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AssignedShiftActivities_assignedShiftId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AssignedShiftActivities",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AssignedShiftActivities_userId(ctx context.Context, field graphql.CollectedField, obj *model.AssignedShiftActivities) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AssignedShiftActivities_userId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.UserID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOID2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AssignedShiftActivities_userId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AssignedShiftActivities",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AssignedShiftActivities_name(ctx context.Context, field graphql.CollectedField, obj *model.AssignedShiftActivities) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AssignedShiftActivities_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AssignedShiftActivities_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AssignedShiftActivities",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AssignedShiftActivities_code(ctx context.Context, field graphql.CollectedField, obj *model.AssignedShiftActivities) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AssignedShiftActivities_code(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Code, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AssignedShiftActivities_code(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AssignedShiftActivities",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AssignedShiftActivities_color(ctx context.Context, field graphql.CollectedField, obj *model.AssignedShiftActivities) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AssignedShiftActivities_color(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Color, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AssignedShiftActivities_color(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AssignedShiftActivities",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AssignedShiftActivities_startTime(ctx context.Context, field graphql.CollectedField, obj *model.AssignedShiftActivities) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AssignedShiftActivities_startTime(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.StartTime, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AssignedShiftActivities_startTime(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AssignedShiftActivities",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AssignedShiftActivities_endTime(ctx context.Context, field graphql.CollectedField, obj *model.AssignedShiftActivities) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AssignedShiftActivities_endTime(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.EndTime, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AssignedShiftActivities_endTime(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AssignedShiftActivities",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AssignedShiftActivities_isPaid(ctx context.Context, field graphql.CollectedField, obj *model.AssignedShiftActivities) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AssignedShiftActivities_isPaid(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsPaid, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AssignedShiftActivities_isPaid(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AssignedShiftActivities",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _BlackoutPeriod_id(ctx context.Context, field graphql.CollectedField, obj *model.BlackoutPeriod) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_BlackoutPeriod_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_BlackoutPeriod_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BlackoutPeriod",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _BlackoutPeriod_channelId(ctx context.Context, field graphql.CollectedField, obj *model.BlackoutPeriod) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_BlackoutPeriod_channelId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ChannelID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_BlackoutPeriod_channelId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BlackoutPeriod",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _BlackoutPeriod_shiftGroupId(ctx context.Context, field graphql.CollectedField, obj *model.BlackoutPeriod) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_BlackoutPeriod_shiftGroupId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ShiftGroupID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOID2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_BlackoutPeriod_shiftGroupId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BlackoutPeriod",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _BlackoutPeriod_name(ctx context.Context, field graphql.CollectedField, obj *model.BlackoutPeriod) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_BlackoutPeriod_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_BlackoutPeriod_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BlackoutPeriod",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _BlackoutPeriod_startTime(ctx context.Context, field graphql.CollectedField, obj *model.BlackoutPeriod) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_BlackoutPeriod_startTime(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.StartTime, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_BlackoutPeriod_startTime(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BlackoutPeriod",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _BlackoutPeriod_endTime(ctx context.Context, field graphql.CollectedField, obj *model.BlackoutPeriod) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_BlackoutPeriod_endTime(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.EndTime, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_BlackoutPeriod_endTime(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BlackoutPeriod",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _BlackoutPeriod_createdByUserId(ctx context.Context, field graphql.CollectedField, obj *model.BlackoutPeriod) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_BlackoutPeriod_createdByUserId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CreatedByUserID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOID2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_BlackoutPeriod_createdByUserId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BlackoutPeriod",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _BlackoutPeriod_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.BlackoutPeriod) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_BlackoutPeriod_createdAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CreatedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_BlackoutPeriod_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BlackoutPeriod",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _BlackoutPeriod_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.BlackoutPeriod) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_BlackoutPeriod_updatedAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.UpdatedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_BlackoutPeriod_updatedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BlackoutPeriod",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _BlackoutPeriodResponse_errors(ctx context.Context, field graphql.CollectedField, obj *model.BlackoutPeriodResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_BlackoutPeriodResponse_errors(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Errors, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.ShiftError)
+	fc.Result = res
+	return ec.marshalNShiftError2ᚕᚖrequest_time_offsᚋgraphᚋmodelᚐShiftErrorᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_BlackoutPeriodResponse_errors(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BlackoutPeriodResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "code":
+				return ec.fieldContext_ShiftError_code(ctx, field)
+			case "field":
+				return ec.fieldContext_ShiftError_field(ctx, field)
+			case "message":
+				return ec.fieldContext_ShiftError_message(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ShiftError", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _BlackoutPeriodResponse_blackoutPeriod(ctx context.Context, field graphql.CollectedField, obj *model.BlackoutPeriodResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_BlackoutPeriodResponse_blackoutPeriod(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.BlackoutPeriod, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.BlackoutPeriod)
+	fc.Result = res
+	return ec.marshalOBlackoutPeriod2ᚖrequest_time_offsᚋgraphᚋmodelᚐBlackoutPeriod(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_BlackoutPeriodResponse_blackoutPeriod(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BlackoutPeriodResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_BlackoutPeriod_id(ctx, field)
+			case "channelId":
+				return ec.fieldContext_BlackoutPeriod_channelId(ctx, field)
+			case "shiftGroupId":
+				return ec.fieldContext_BlackoutPeriod_shiftGroupId(ctx, field)
+			case "name":
+				return ec.fieldContext_BlackoutPeriod_name(ctx, field)
+			case "startTime":
+				return ec.fieldContext_BlackoutPeriod_startTime(ctx, field)
+			case "endTime":
+				return ec.fieldContext_BlackoutPeriod_endTime(ctx, field)
+			case "createdByUserId":
+				return ec.fieldContext_BlackoutPeriod_createdByUserId(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_BlackoutPeriod_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_BlackoutPeriod_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type BlackoutPeriod", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _BulkTimeOffResponse_errors(ctx context.Context, field graphql.CollectedField, obj *model.BulkTimeOffResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_BulkTimeOffResponse_errors(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Errors, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.ShiftError)
+	fc.Result = res
+	return ec.marshalNShiftError2ᚕᚖrequest_time_offsᚋgraphᚋmodelᚐShiftErrorᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_BulkTimeOffResponse_errors(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BulkTimeOffResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "code":
+				return ec.fieldContext_ShiftError_code(ctx, field)
+			case "field":
+				return ec.fieldContext_ShiftError_field(ctx, field)
+			case "message":
+				return ec.fieldContext_ShiftError_message(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ShiftError", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _BulkTimeOffResponse_results(ctx context.Context, field graphql.CollectedField, obj *model.BulkTimeOffResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_BulkTimeOffResponse_results(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Results, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.RequestTimeOffResult)
+	fc.Result = res
+	return ec.marshalNRequestTimeOffResult2ᚕᚖrequest_time_offsᚋgraphᚋmodelᚐRequestTimeOffResultᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_BulkTimeOffResponse_results(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BulkTimeOffResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_RequestTimeOffResult_id(ctx, field)
+			case "errors":
+				return ec.fieldContext_RequestTimeOffResult_errors(ctx, field)
+			case "request":
+				return ec.fieldContext_RequestTimeOffResult_request(ctx, field)
+			case "shiftConflicts":
+				return ec.fieldContext_RequestTimeOffResult_shiftConflicts(ctx, field)
+			case "capacityWarnings":
+				return ec.fieldContext_RequestTimeOffResult_capacityWarnings(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RequestTimeOffResult", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CapacityWarning_shiftGroupId(ctx context.Context, field graphql.CollectedField, obj *model.CapacityWarning) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CapacityWarning_shiftGroupId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ShiftGroupID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CapacityWarning_shiftGroupId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CapacityWarning",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CapacityWarning_date(ctx context.Context, field graphql.CollectedField, obj *model.CapacityWarning) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CapacityWarning_date(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Date, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CapacityWarning_date(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CapacityWarning",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CapacityWarning_absences(ctx context.Context, field graphql.CollectedField, obj *model.CapacityWarning) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CapacityWarning_absences(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Absences, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CapacityWarning_absences(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CapacityWarning",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CapacityWarning_limit(ctx context.Context, field graphql.CollectedField, obj *model.CapacityWarning) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CapacityWarning_limit(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Limit, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CapacityWarning_limit(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CapacityWarning",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LeaveType_channelId(ctx context.Context, field graphql.CollectedField, obj *model.LeaveType) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LeaveType_channelId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ChannelID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_LeaveType_channelId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LeaveType",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LeaveType_code(ctx context.Context, field graphql.CollectedField, obj *model.LeaveType) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LeaveType_code(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Code, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_LeaveType_code(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LeaveType",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LeaveType_name(ctx context.Context, field graphql.CollectedField, obj *model.LeaveType) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LeaveType_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_LeaveType_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LeaveType",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LeaveType_color(ctx context.Context, field graphql.CollectedField, obj *model.LeaveType) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LeaveType_color(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Color, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_LeaveType_color(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LeaveType",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LeaveType_paid(ctx context.Context, field graphql.CollectedField, obj *model.LeaveType) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LeaveType_paid(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Paid, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_LeaveType_paid(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LeaveType",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LeaveType_requiresAttachment(ctx context.Context, field graphql.CollectedField, obj *model.LeaveType) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LeaveType_requiresAttachment(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RequiresAttachment, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_LeaveType_requiresAttachment(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LeaveType",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LeaveType_minimumNoticeHours(ctx context.Context, field graphql.CollectedField, obj *model.LeaveType) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LeaveType_minimumNoticeHours(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.MinimumNoticeHours, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_LeaveType_minimumNoticeHours(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LeaveType",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LeaveType_maxConsecutiveDays(ctx context.Context, field graphql.CollectedField, obj *model.LeaveType) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LeaveType_maxConsecutiveDays(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.MaxConsecutiveDays, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	fc.Result = res
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_LeaveType_maxConsecutiveDays(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LeaveType",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LeaveType_autoApprove(ctx context.Context, field graphql.CollectedField, obj *model.LeaveType) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LeaveType_autoApprove(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.AutoApprove, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_LeaveType_autoApprove(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LeaveType",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LeaveType_archived(ctx context.Context, field graphql.CollectedField, obj *model.LeaveType) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LeaveType_archived(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Archived, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_LeaveType_archived(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LeaveType",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LeaveType_updatedByUserId(ctx context.Context, field graphql.CollectedField, obj *model.LeaveType) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LeaveType_updatedByUserId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.UpdatedByUserID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOID2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_LeaveType_updatedByUserId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LeaveType",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LeaveType_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.LeaveType) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LeaveType_updatedAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.UpdatedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_LeaveType_updatedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LeaveType",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LeaveTypeResponse_errors(ctx context.Context, field graphql.CollectedField, obj *model.LeaveTypeResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LeaveTypeResponse_errors(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Errors, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.ShiftError)
+	fc.Result = res
+	return ec.marshalNShiftError2ᚕᚖrequest_time_offsᚋgraphᚋmodelᚐShiftErrorᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_LeaveTypeResponse_errors(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LeaveTypeResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "code":
+				return ec.fieldContext_ShiftError_code(ctx, field)
+			case "field":
+				return ec.fieldContext_ShiftError_field(ctx, field)
+			case "message":
+				return ec.fieldContext_ShiftError_message(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ShiftError", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LeaveTypeResponse_leaveType(ctx context.Context, field graphql.CollectedField, obj *model.LeaveTypeResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LeaveTypeResponse_leaveType(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LeaveType, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.LeaveType)
+	fc.Result = res
+	return ec.marshalOLeaveType2ᚖrequest_time_offsᚋgraphᚋmodelᚐLeaveType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_LeaveTypeResponse_leaveType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LeaveTypeResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "channelId":
+				return ec.fieldContext_LeaveType_channelId(ctx, field)
+			case "code":
+				return ec.fieldContext_LeaveType_code(ctx, field)
+			case "name":
+				return ec.fieldContext_LeaveType_name(ctx, field)
+			case "color":
+				return ec.fieldContext_LeaveType_color(ctx, field)
+			case "paid":
+				return ec.fieldContext_LeaveType_paid(ctx, field)
+			case "requiresAttachment":
+				return ec.fieldContext_LeaveType_requiresAttachment(ctx, field)
+			case "minimumNoticeHours":
+				return ec.fieldContext_LeaveType_minimumNoticeHours(ctx, field)
+			case "maxConsecutiveDays":
+				return ec.fieldContext_LeaveType_maxConsecutiveDays(ctx, field)
+			case "autoApprove":
+				return ec.fieldContext_LeaveType_autoApprove(ctx, field)
+			case "archived":
+				return ec.fieldContext_LeaveType_archived(ctx, field)
+			case "updatedByUserId":
+				return ec.fieldContext_LeaveType_updatedByUserId(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_LeaveType_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LeaveType", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_createRequestTimeOff(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_createRequestTimeOff(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().CreateRequestTimeOff(rctx, fc.Args["input"].(model.RequestTimeOffInput), fc.Args["authUserId"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.TimeOffResponse)
+	fc.Result = res
+	return ec.marshalOTimeOffResponse2ᚖrequest_time_offsᚋgraphᚋmodelᚐTimeOffResponse(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_createRequestTimeOff(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "errors":
+				return ec.fieldContext_TimeOffResponse_errors(ctx, field)
+			case "request":
+				return ec.fieldContext_TimeOffResponse_request(ctx, field)
+			case "shiftConflicts":
+				return ec.fieldContext_TimeOffResponse_shiftConflicts(ctx, field)
+			case "capacityWarnings":
+				return ec.fieldContext_TimeOffResponse_capacityWarnings(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TimeOffResponse", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_createRequestTimeOff_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_updateRequestTimeOff(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_updateRequestTimeOff(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().UpdateRequestTimeOff(rctx, fc.Args["id"].(string), fc.Args["input"].(model.RequestTimeOffInput), fc.Args["authUserId"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.TimeOffResponse)
+	fc.Result = res
+	return ec.marshalOTimeOffResponse2ᚖrequest_time_offsᚋgraphᚋmodelᚐTimeOffResponse(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_updateRequestTimeOff(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "errors":
+				return ec.fieldContext_TimeOffResponse_errors(ctx, field)
+			case "request":
+				return ec.fieldContext_TimeOffResponse_request(ctx, field)
+			case "shiftConflicts":
+				return ec.fieldContext_TimeOffResponse_shiftConflicts(ctx, field)
+			case "capacityWarnings":
+				return ec.fieldContext_TimeOffResponse_capacityWarnings(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TimeOffResponse", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_updateRequestTimeOff_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_deleteRequestTimeOff(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_deleteRequestTimeOff(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().DeleteRequestTimeOff(rctx, fc.Args["id"].(string), fc.Args["authUserId"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.TimeOffResponse)
+	fc.Result = res
+	return ec.marshalOTimeOffResponse2ᚖrequest_time_offsᚋgraphᚋmodelᚐTimeOffResponse(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_deleteRequestTimeOff(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "errors":
+				return ec.fieldContext_TimeOffResponse_errors(ctx, field)
+			case "request":
+				return ec.fieldContext_TimeOffResponse_request(ctx, field)
+			case "shiftConflicts":
+				return ec.fieldContext_TimeOffResponse_shiftConflicts(ctx, field)
+			case "capacityWarnings":
+				return ec.fieldContext_TimeOffResponse_capacityWarnings(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TimeOffResponse", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_deleteRequestTimeOff_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_cancelRequestTimeOff(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_cancelRequestTimeOff(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().CancelRequestTimeOff(rctx, fc.Args["channelId"].(string), fc.Args["requestId"].(string), fc.Args["authUserId"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.TimeOffResponse)
+	fc.Result = res
+	return ec.marshalOTimeOffResponse2ᚖrequest_time_offsᚋgraphᚋmodelᚐTimeOffResponse(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_cancelRequestTimeOff(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "errors":
+				return ec.fieldContext_TimeOffResponse_errors(ctx, field)
+			case "request":
+				return ec.fieldContext_TimeOffResponse_request(ctx, field)
+			case "shiftConflicts":
+				return ec.fieldContext_TimeOffResponse_shiftConflicts(ctx, field)
+			case "capacityWarnings":
+				return ec.fieldContext_TimeOffResponse_capacityWarnings(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TimeOffResponse", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_cancelRequestTimeOff_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_approveRequestTimeOff(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_approveRequestTimeOff(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().ApproveRequestTimeOff(rctx, fc.Args["id"].(string), fc.Args["responseNote"].(*string), fc.Args["capacityOverrideReason"].(*string), fc.Args["authUserId"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.TimeOffResponse)
+	fc.Result = res
+	return ec.marshalOTimeOffResponse2ᚖrequest_time_offsᚋgraphᚋmodelᚐTimeOffResponse(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_approveRequestTimeOff(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "errors":
+				return ec.fieldContext_TimeOffResponse_errors(ctx, field)
+			case "request":
+				return ec.fieldContext_TimeOffResponse_request(ctx, field)
+			case "shiftConflicts":
+				return ec.fieldContext_TimeOffResponse_shiftConflicts(ctx, field)
+			case "capacityWarnings":
+				return ec.fieldContext_TimeOffResponse_capacityWarnings(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TimeOffResponse", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_approveRequestTimeOff_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_denyRequestTimeOff(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_denyRequestTimeOff(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().DenyRequestTimeOff(rctx, fc.Args["id"].(string), fc.Args["responseNote"].(*string), fc.Args["authUserId"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.TimeOffResponse)
+	fc.Result = res
+	return ec.marshalOTimeOffResponse2ᚖrequest_time_offsᚋgraphᚋmodelᚐTimeOffResponse(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_denyRequestTimeOff(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "errors":
+				return ec.fieldContext_TimeOffResponse_errors(ctx, field)
+			case "request":
+				return ec.fieldContext_TimeOffResponse_request(ctx, field)
+			case "shiftConflicts":
+				return ec.fieldContext_TimeOffResponse_shiftConflicts(ctx, field)
+			case "capacityWarnings":
+				return ec.fieldContext_TimeOffResponse_capacityWarnings(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TimeOffResponse", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_denyRequestTimeOff_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_approveRequestTimeOffs(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_approveRequestTimeOffs(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().ApproveRequestTimeOffs(rctx, fc.Args["ids"].([]string), fc.Args["responseNote"].(*string), fc.Args["authUserId"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.BulkTimeOffResponse)
+	fc.Result = res
+	return ec.marshalNBulkTimeOffResponse2ᚖrequest_time_offsᚋgraphᚋmodelᚐBulkTimeOffResponse(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_approveRequestTimeOffs(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "errors":
+				return ec.fieldContext_BulkTimeOffResponse_errors(ctx, field)
+			case "results":
+				return ec.fieldContext_BulkTimeOffResponse_results(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type BulkTimeOffResponse", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_approveRequestTimeOffs_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_denyRequestTimeOffs(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_denyRequestTimeOffs(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().DenyRequestTimeOffs(rctx, fc.Args["ids"].([]string), fc.Args["responseNote"].(*string), fc.Args["authUserId"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.BulkTimeOffResponse)
+	fc.Result = res
+	return ec.marshalNBulkTimeOffResponse2ᚖrequest_time_offsᚋgraphᚋmodelᚐBulkTimeOffResponse(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_denyRequestTimeOffs(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "errors":
+				return ec.fieldContext_BulkTimeOffResponse_errors(ctx, field)
+			case "results":
+				return ec.fieldContext_BulkTimeOffResponse_results(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type BulkTimeOffResponse", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_denyRequestTimeOffs_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_addRequestComment(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_addRequestComment(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().AddRequestComment(rctx, fc.Args["id"].(string), fc.Args["body"].(string), fc.Args["authUserId"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.RequestCommentResponse)
+	fc.Result = res
+	return ec.marshalNRequestCommentResponse2ᚖrequest_time_offsᚋgraphᚋmodelᚐRequestCommentResponse(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_addRequestComment(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "errors":
+				return ec.fieldContext_RequestCommentResponse_errors(ctx, field)
+			case "comment":
+				return ec.fieldContext_RequestCommentResponse_comment(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RequestCommentResponse", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_addRequestComment_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_setRequestExpirySettings(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_setRequestExpirySettings(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().SetRequestExpirySettings(rctx, fc.Args["channelId"].(string), fc.Args["input"].(model.RequestExpirySettingsInput), fc.Args["authUserId"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.RequestExpirySettingsResponse)
+	fc.Result = res
+	return ec.marshalNRequestExpirySettingsResponse2ᚖrequest_time_offsᚋgraphᚋmodelᚐRequestExpirySettingsResponse(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_setRequestExpirySettings(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "errors":
+				return ec.fieldContext_RequestExpirySettingsResponse_errors(ctx, field)
+			case "settings":
+				return ec.fieldContext_RequestExpirySettingsResponse_settings(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RequestExpirySettingsResponse", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_setRequestExpirySettings_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_setTimeOffAccrualPolicy(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_setTimeOffAccrualPolicy(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().SetTimeOffAccrualPolicy(rctx, fc.Args["channelId"].(string), fc.Args["input"].(model.TimeOffAccrualPolicyInput), fc.Args["authUserId"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.TimeOffAccrualPolicyResponse)
+	fc.Result = res
+	return ec.marshalNTimeOffAccrualPolicyResponse2ᚖrequest_time_offsᚋgraphᚋmodelᚐTimeOffAccrualPolicyResponse(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_setTimeOffAccrualPolicy(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "errors":
+				return ec.fieldContext_TimeOffAccrualPolicyResponse_errors(ctx, field)
+			case "policy":
+				return ec.fieldContext_TimeOffAccrualPolicyResponse_policy(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TimeOffAccrualPolicyResponse", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_setTimeOffAccrualPolicy_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_adjustTimeOffBalance(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_adjustTimeOffBalance(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().AdjustTimeOffBalance(rctx, fc.Args["channelId"].(string), fc.Args["userId"].(string), fc.Args["leaveType"].(string), fc.Args["hours"].(float64), fc.Args["note"].(*string), fc.Args["authUserId"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.TimeOffBalanceResponse)
+	fc.Result = res
+	return ec.marshalNTimeOffBalanceResponse2ᚖrequest_time_offsᚋgraphᚋmodelᚐTimeOffBalanceResponse(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_adjustTimeOffBalance(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "errors":
+				return ec.fieldContext_TimeOffBalanceResponse_errors(ctx, field)
+			case "balances":
+				return ec.fieldContext_TimeOffBalanceResponse_balances(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TimeOffBalanceResponse", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_adjustTimeOffBalance_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_recordHoursWorked(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_recordHoursWorked(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().RecordHoursWorked(rctx, fc.Args["channelId"].(string), fc.Args["userId"].(string), fc.Args["hours"].(float64), fc.Args["workedUntil"].(time.Time), fc.Args["authUserId"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.TimeOffBalanceResponse)
+	fc.Result = res
+	return ec.marshalNTimeOffBalanceResponse2ᚖrequest_time_offsᚋgraphᚋmodelᚐTimeOffBalanceResponse(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_recordHoursWorked(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "errors":
+				return ec.fieldContext_TimeOffBalanceResponse_errors(ctx, field)
+			case "balances":
+				return ec.fieldContext_TimeOffBalanceResponse_balances(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TimeOffBalanceResponse", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_recordHoursWorked_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_setShiftConflictSettings(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_setShiftConflictSettings(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().SetShiftConflictSettings(rctx, fc.Args["channelId"].(string), fc.Args["input"].(model.ShiftConflictSettingsInput), fc.Args["authUserId"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.ShiftConflictSettingsResponse)
+	fc.Result = res
+	return ec.marshalNShiftConflictSettingsResponse2ᚖrequest_time_offsᚋgraphᚋmodelᚐShiftConflictSettingsResponse(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_setShiftConflictSettings(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "errors":
+				return ec.fieldContext_ShiftConflictSettingsResponse_errors(ctx, field)
+			case "settings":
+				return ec.fieldContext_ShiftConflictSettingsResponse_settings(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ShiftConflictSettingsResponse", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_setShiftConflictSettings_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_setLeaveType(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_setLeaveType(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().SetLeaveType(rctx, fc.Args["channelId"].(string), fc.Args["input"].(model.LeaveTypeInput), fc.Args["authUserId"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.LeaveTypeResponse)
+	fc.Result = res
+	return ec.marshalNLeaveTypeResponse2ᚖrequest_time_offsᚋgraphᚋmodelᚐLeaveTypeResponse(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_setLeaveType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "errors":
+				return ec.fieldContext_LeaveTypeResponse_errors(ctx, field)
+			case "leaveType":
+				return ec.fieldContext_LeaveTypeResponse_leaveType(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LeaveTypeResponse", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_setLeaveType_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_createBlackoutPeriod(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_createBlackoutPeriod(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().CreateBlackoutPeriod(rctx, fc.Args["input"].(model.BlackoutPeriodInput), fc.Args["authUserId"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.BlackoutPeriodResponse)
+	fc.Result = res
+	return ec.marshalNBlackoutPeriodResponse2ᚖrequest_time_offsᚋgraphᚋmodelᚐBlackoutPeriodResponse(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_createBlackoutPeriod(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "errors":
+				return ec.fieldContext_BlackoutPeriodResponse_errors(ctx, field)
+			case "blackoutPeriod":
+				return ec.fieldContext_BlackoutPeriodResponse_blackoutPeriod(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type BlackoutPeriodResponse", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_createBlackoutPeriod_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_updateBlackoutPeriod(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_updateBlackoutPeriod(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().UpdateBlackoutPeriod(rctx, fc.Args["id"].(string), fc.Args["input"].(model.BlackoutPeriodInput), fc.Args["authUserId"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.BlackoutPeriodResponse)
+	fc.Result = res
+	return ec.marshalNBlackoutPeriodResponse2ᚖrequest_time_offsᚋgraphᚋmodelᚐBlackoutPeriodResponse(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_updateBlackoutPeriod(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "errors":
+				return ec.fieldContext_BlackoutPeriodResponse_errors(ctx, field)
+			case "blackoutPeriod":
+				return ec.fieldContext_BlackoutPeriodResponse_blackoutPeriod(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type BlackoutPeriodResponse", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_updateBlackoutPeriod_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_deleteBlackoutPeriod(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_deleteBlackoutPeriod(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().DeleteBlackoutPeriod(rctx, fc.Args["id"].(string), fc.Args["authUserId"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.BlackoutPeriodResponse)
+	fc.Result = res
+	return ec.marshalNBlackoutPeriodResponse2ᚖrequest_time_offsᚋgraphᚋmodelᚐBlackoutPeriodResponse(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_deleteBlackoutPeriod(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "errors":
+				return ec.fieldContext_BlackoutPeriodResponse_errors(ctx, field)
+			case "blackoutPeriod":
+				return ec.fieldContext_BlackoutPeriodResponse_blackoutPeriod(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type BlackoutPeriodResponse", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_deleteBlackoutPeriod_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_setAbsenceLimit(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_setAbsenceLimit(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().SetAbsenceLimit(rctx, fc.Args["channelId"].(string), fc.Args["shiftGroupId"].(string), fc.Args["input"].(model.AbsenceLimitInput), fc.Args["authUserId"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.AbsenceLimitResponse)
+	fc.Result = res
+	return ec.marshalNAbsenceLimitResponse2ᚖrequest_time_offsᚋgraphᚋmodelᚐAbsenceLimitResponse(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_setAbsenceLimit(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "errors":
+				return ec.fieldContext_AbsenceLimitResponse_errors(ctx, field)
+			case "absenceLimit":
+				return ec.fieldContext_AbsenceLimitResponse_absenceLimit(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AbsenceLimitResponse", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_setAbsenceLimit_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PageInfo_hasNextPage(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PageInfo_hasNextPage(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.HasNextPage, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PageInfo_hasNextPage(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PageInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PageInfo_hasPreviousPage(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PageInfo_hasPreviousPage(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.HasPreviousPage, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PageInfo_hasPreviousPage(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PageInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PageInfo_startCursor(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PageInfo_startCursor(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.StartCursor, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PageInfo_startCursor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PageInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PageInfo_endCursor(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PageInfo_endCursor(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.EndCursor, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PageInfo_endCursor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PageInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getRequestTimeOff(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_getRequestTimeOff(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().GetRequestTimeOff(rctx, fc.Args["id"].(string), fc.Args["authUserId"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.RequestTimeOff)
+	fc.Result = res
+	return ec.marshalNRequestTimeOff2ᚖrequest_time_offsᚋgraphᚋmodelᚐRequestTimeOff(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_getRequestTimeOff(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_RequestTimeOff_id(ctx, field)
+			case "userId":
+				return ec.fieldContext_RequestTimeOff_userId(ctx, field)
+			case "channelId":
+				return ec.fieldContext_RequestTimeOff_channelId(ctx, field)
+			case "requestId":
+				return ec.fieldContext_RequestTimeOff_requestId(ctx, field)
+			case "startTime":
+				return ec.fieldContext_RequestTimeOff_startTime(ctx, field)
+			case "endTime":
+				return ec.fieldContext_RequestTimeOff_endTime(ctx, field)
+			case "is24Hours":
+				return ec.fieldContext_RequestTimeOff_is24Hours(ctx, field)
+			case "reason":
+				return ec.fieldContext_RequestTimeOff_reason(ctx, field)
+			case "leaveType":
+				return ec.fieldContext_RequestTimeOff_leaveType(ctx, field)
+			case "leaveTypeDetails":
+				return ec.fieldContext_RequestTimeOff_leaveTypeDetails(ctx, field)
+			case "attachmentUrl":
+				return ec.fieldContext_RequestTimeOff_attachmentUrl(ctx, field)
+			case "blackoutOverrideReason":
+				return ec.fieldContext_RequestTimeOff_blackoutOverrideReason(ctx, field)
+			case "blackoutOverrideByUserId":
+				return ec.fieldContext_RequestTimeOff_blackoutOverrideByUserId(ctx, field)
+			case "capacityOverrideReason":
+				return ec.fieldContext_RequestTimeOff_capacityOverrideReason(ctx, field)
+			case "capacityOverrideByUserId":
+				return ec.fieldContext_RequestTimeOff_capacityOverrideByUserId(ctx, field)
+			case "requestNote":
+				return ec.fieldContext_RequestTimeOff_requestNote(ctx, field)
+			case "status":
+				return ec.fieldContext_RequestTimeOff_status(ctx, field)
+			case "responseNote":
+				return ec.fieldContext_RequestTimeOff_responseNote(ctx, field)
+			case "responseByUserId":
+				return ec.fieldContext_RequestTimeOff_responseByUserId(ctx, field)
+			case "responseAt":
+				return ec.fieldContext_RequestTimeOff_responseAt(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_RequestTimeOff_createdAt(ctx, field)
+			case "history":
+				return ec.fieldContext_RequestTimeOff_history(ctx, field)
+			case "comments":
+				return ec.fieldContext_RequestTimeOff_comments(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RequestTimeOff", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getRequestTimeOff_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getRequestTimeOffs(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_getRequestTimeOffs(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().GetRequestTimeOffs(rctx, fc.Args["channelId"].(*string), fc.Args["authUserId"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.RequestTimeOff)
+	fc.Result = res
+	return ec.marshalNRequestTimeOff2ᚕᚖrequest_time_offsᚋgraphᚋmodelᚐRequestTimeOff(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_getRequestTimeOffs(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_RequestTimeOff_id(ctx, field)
+			case "userId":
+				return ec.fieldContext_RequestTimeOff_userId(ctx, field)
+			case "channelId":
+				return ec.fieldContext_RequestTimeOff_channelId(ctx, field)
+			case "requestId":
+				return ec.fieldContext_RequestTimeOff_requestId(ctx, field)
+			case "startTime":
+				return ec.fieldContext_RequestTimeOff_startTime(ctx, field)
+			case "endTime":
+				return ec.fieldContext_RequestTimeOff_endTime(ctx, field)
+			case "is24Hours":
+				return ec.fieldContext_RequestTimeOff_is24Hours(ctx, field)
+			case "reason":
+				return ec.fieldContext_RequestTimeOff_reason(ctx, field)
+			case "leaveType":
+				return ec.fieldContext_RequestTimeOff_leaveType(ctx, field)
+			case "leaveTypeDetails":
+				return ec.fieldContext_RequestTimeOff_leaveTypeDetails(ctx, field)
+			case "attachmentUrl":
+				return ec.fieldContext_RequestTimeOff_attachmentUrl(ctx, field)
+			case "blackoutOverrideReason":
+				return ec.fieldContext_RequestTimeOff_blackoutOverrideReason(ctx, field)
+			case "blackoutOverrideByUserId":
+				return ec.fieldContext_RequestTimeOff_blackoutOverrideByUserId(ctx, field)
+			case "capacityOverrideReason":
+				return ec.fieldContext_RequestTimeOff_capacityOverrideReason(ctx, field)
+			case "capacityOverrideByUserId":
+				return ec.fieldContext_RequestTimeOff_capacityOverrideByUserId(ctx, field)
+			case "requestNote":
+				return ec.fieldContext_RequestTimeOff_requestNote(ctx, field)
+			case "status":
+				return ec.fieldContext_RequestTimeOff_status(ctx, field)
+			case "responseNote":
+				return ec.fieldContext_RequestTimeOff_responseNote(ctx, field)
+			case "responseByUserId":
+				return ec.fieldContext_RequestTimeOff_responseByUserId(ctx, field)
+			case "responseAt":
+				return ec.fieldContext_RequestTimeOff_responseAt(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_RequestTimeOff_createdAt(ctx, field)
+			case "history":
+				return ec.fieldContext_RequestTimeOff_history(ctx, field)
+			case "comments":
+				return ec.fieldContext_RequestTimeOff_comments(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RequestTimeOff", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getRequestTimeOffs_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getRequestTimeOffsConnection(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_getRequestTimeOffsConnection(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().GetRequestTimeOffsConnection(rctx, fc.Args["channelId"].(string), fc.Args["filter"].(*model.RequestFilter), fc.Args["orderBy"].(*model.RequestOrder), fc.Args["first"].(*int), fc.Args["after"].(*string), fc.Args["authUserId"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.RequestTimeOffConnection)
+	fc.Result = res
+	return ec.marshalNRequestTimeOffConnection2ᚖrequest_time_offsᚋgraphᚋmodelᚐRequestTimeOffConnection(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_getRequestTimeOffsConnection(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "edges":
+				return ec.fieldContext_RequestTimeOffConnection_edges(ctx, field)
+			case "pageInfo":
+				return ec.fieldContext_RequestTimeOffConnection_pageInfo(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RequestTimeOffConnection", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getRequestTimeOffsConnection_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getRequestTimeOffsByChannelIdRequestId(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_getRequestTimeOffsByChannelIdRequestId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().GetRequestTimeOffsByChannelIDRequestID(rctx, fc.Args["channelId"].(string), fc.Args["requestId"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.RequestTimeOff)
+	fc.Result = res
+	return ec.marshalNRequestTimeOff2ᚖrequest_time_offsᚋgraphᚋmodelᚐRequestTimeOff(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_getRequestTimeOffsByChannelIdRequestId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_RequestTimeOff_id(ctx, field)
+			case "userId":
+				return ec.fieldContext_RequestTimeOff_userId(ctx, field)
+			case "channelId":
+				return ec.fieldContext_RequestTimeOff_channelId(ctx, field)
+			case "requestId":
+				return ec.fieldContext_RequestTimeOff_requestId(ctx, field)
+			case "startTime":
+				return ec.fieldContext_RequestTimeOff_startTime(ctx, field)
+			case "endTime":
+				return ec.fieldContext_RequestTimeOff_endTime(ctx, field)
+			case "is24Hours":
+				return ec.fieldContext_RequestTimeOff_is24Hours(ctx, field)
+			case "reason":
+				return ec.fieldContext_RequestTimeOff_reason(ctx, field)
+			case "leaveType":
+				return ec.fieldContext_RequestTimeOff_leaveType(ctx, field)
+			case "leaveTypeDetails":
+				return ec.fieldContext_RequestTimeOff_leaveTypeDetails(ctx, field)
+			case "attachmentUrl":
+				return ec.fieldContext_RequestTimeOff_attachmentUrl(ctx, field)
+			case "blackoutOverrideReason":
+				return ec.fieldContext_RequestTimeOff_blackoutOverrideReason(ctx, field)
+			case "blackoutOverrideByUserId":
+				return ec.fieldContext_RequestTimeOff_blackoutOverrideByUserId(ctx, field)
+			case "capacityOverrideReason":
+				return ec.fieldContext_RequestTimeOff_capacityOverrideReason(ctx, field)
+			case "capacityOverrideByUserId":
+				return ec.fieldContext_RequestTimeOff_capacityOverrideByUserId(ctx, field)
+			case "requestNote":
+				return ec.fieldContext_RequestTimeOff_requestNote(ctx, field)
+			case "status":
+				return ec.fieldContext_RequestTimeOff_status(ctx, field)
+			case "responseNote":
+				return ec.fieldContext_RequestTimeOff_responseNote(ctx, field)
+			case "responseByUserId":
+				return ec.fieldContext_RequestTimeOff_responseByUserId(ctx, field)
+			case "responseAt":
+				return ec.fieldContext_RequestTimeOff_responseAt(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_RequestTimeOff_createdAt(ctx, field)
+			case "history":
+				return ec.fieldContext_RequestTimeOff_history(ctx, field)
+			case "comments":
+				return ec.fieldContext_RequestTimeOff_comments(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RequestTimeOff", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getRequestTimeOffsByChannelIdRequestId_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getRequestExpirySettings(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_getRequestExpirySettings(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().GetRequestExpirySettings(rctx, fc.Args["channelId"].(string), fc.Args["authUserId"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.RequestExpirySettings)
+	fc.Result = res
+	return ec.marshalNRequestExpirySettings2ᚖrequest_time_offsᚋgraphᚋmodelᚐRequestExpirySettings(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_getRequestExpirySettings(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "channelId":
+				return ec.fieldContext_RequestExpirySettings_channelId(ctx, field)
+			case "enabled":
+				return ec.fieldContext_RequestExpirySettings_enabled(ctx, field)
+			case "gracePeriodMinutes":
+				return ec.fieldContext_RequestExpirySettings_gracePeriodMinutes(ctx, field)
+			case "updatedByUserId":
+				return ec.fieldContext_RequestExpirySettings_updatedByUserId(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_RequestExpirySettings_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RequestExpirySettings", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getRequestExpirySettings_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_timeOffBalance(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_timeOffBalance(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().TimeOffBalance(rctx, fc.Args["userId"].(string), fc.Args["channelId"].(*string), fc.Args["leaveType"].(*string), fc.Args["authUserId"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.TimeOffBalance)
+	fc.Result = res
+	return ec.marshalNTimeOffBalance2ᚕᚖrequest_time_offsᚋgraphᚋmodelᚐTimeOffBalanceᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_timeOffBalance(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_TimeOffBalance_id(ctx, field)
+			case "channelId":
+				return ec.fieldContext_TimeOffBalance_channelId(ctx, field)
+			case "userId":
+				return ec.fieldContext_TimeOffBalance_userId(ctx, field)
+			case "leaveType":
+				return ec.fieldContext_TimeOffBalance_leaveType(ctx, field)
+			case "balanceHours":
+				return ec.fieldContext_TimeOffBalance_balanceHours(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_TimeOffBalance_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_TimeOffBalance_updatedAt(ctx, field)
+			case "history":
+				return ec.fieldContext_TimeOffBalance_history(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TimeOffBalance", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_timeOffBalance_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getTimeOffAccrualPolicies(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_getTimeOffAccrualPolicies(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().GetTimeOffAccrualPolicies(rctx, fc.Args["channelId"].(string), fc.Args["authUserId"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.TimeOffAccrualPolicy)
+	fc.Result = res
+	return ec.marshalNTimeOffAccrualPolicy2ᚕᚖrequest_time_offsᚋgraphᚋmodelᚐTimeOffAccrualPolicyᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_getTimeOffAccrualPolicies(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "channelId":
+				return ec.fieldContext_TimeOffAccrualPolicy_channelId(ctx, field)
+			case "leaveType":
+				return ec.fieldContext_TimeOffAccrualPolicy_leaveType(ctx, field)
+			case "method":
+				return ec.fieldContext_TimeOffAccrualPolicy_method(ctx, field)
+			case "hours":
+				return ec.fieldContext_TimeOffAccrualPolicy_hours(ctx, field)
+			case "rate":
+				return ec.fieldContext_TimeOffAccrualPolicy_rate(ctx, field)
+			case "periodDays":
+				return ec.fieldContext_TimeOffAccrualPolicy_periodDays(ctx, field)
+			case "periodStart":
+				return ec.fieldContext_TimeOffAccrualPolicy_periodStart(ctx, field)
+			case "grantMonth":
+				return ec.fieldContext_TimeOffAccrualPolicy_grantMonth(ctx, field)
+			case "grantDay":
+				return ec.fieldContext_TimeOffAccrualPolicy_grantDay(ctx, field)
+			case "carryOverCap":
+				return ec.fieldContext_TimeOffAccrualPolicy_carryOverCap(ctx, field)
+			case "hoursPerDay":
+				return ec.fieldContext_TimeOffAccrualPolicy_hoursPerDay(ctx, field)
+			case "includeWeekends":
+				return ec.fieldContext_TimeOffAccrualPolicy_includeWeekends(ctx, field)
+			case "enabled":
+				return ec.fieldContext_TimeOffAccrualPolicy_enabled(ctx, field)
+			case "updatedByUserId":
+				return ec.fieldContext_TimeOffAccrualPolicy_updatedByUserId(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_TimeOffAccrualPolicy_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TimeOffAccrualPolicy", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getTimeOffAccrualPolicies_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getShiftConflictSettings(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_getShiftConflictSettings(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().GetShiftConflictSettings(rctx, fc.Args["channelId"].(string), fc.Args["authUserId"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.ShiftConflictSettings)
+	fc.Result = res
+	return ec.marshalNShiftConflictSettings2ᚖrequest_time_offsᚋgraphᚋmodelᚐShiftConflictSettings(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_getShiftConflictSettings(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "channelId":
+				return ec.fieldContext_ShiftConflictSettings_channelId(ctx, field)
+			case "policy":
+				return ec.fieldContext_ShiftConflictSettings_policy(ctx, field)
+			case "updatedByUserId":
+				return ec.fieldContext_ShiftConflictSettings_updatedByUserId(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_ShiftConflictSettings_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ShiftConflictSettings", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getShiftConflictSettings_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getLeaveTypes(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_getLeaveTypes(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().GetLeaveTypes(rctx, fc.Args["channelId"].(string), fc.Args["includeArchived"].(*bool), fc.Args["authUserId"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.LeaveType)
+	fc.Result = res
+	return ec.marshalNLeaveType2ᚕᚖrequest_time_offsᚋgraphᚋmodelᚐLeaveTypeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_getLeaveTypes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "channelId":
+				return ec.fieldContext_LeaveType_channelId(ctx, field)
+			case "code":
+				return ec.fieldContext_LeaveType_code(ctx, field)
+			case "name":
+				return ec.fieldContext_LeaveType_name(ctx, field)
+			case "color":
+				return ec.fieldContext_LeaveType_color(ctx, field)
+			case "paid":
+				return ec.fieldContext_LeaveType_paid(ctx, field)
+			case "requiresAttachment":
+				return ec.fieldContext_LeaveType_requiresAttachment(ctx, field)
+			case "minimumNoticeHours":
+				return ec.fieldContext_LeaveType_minimumNoticeHours(ctx, field)
+			case "maxConsecutiveDays":
+				return ec.fieldContext_LeaveType_maxConsecutiveDays(ctx, field)
+			case "autoApprove":
+				return ec.fieldContext_LeaveType_autoApprove(ctx, field)
+			case "archived":
+				return ec.fieldContext_LeaveType_archived(ctx, field)
+			case "updatedByUserId":
+				return ec.fieldContext_LeaveType_updatedByUserId(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_LeaveType_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LeaveType", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getLeaveTypes_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getBlackoutPeriods(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_getBlackoutPeriods(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().GetBlackoutPeriods(rctx, fc.Args["channelId"].(string), fc.Args["shiftGroupId"].(*string), fc.Args["from"].(*time.Time), fc.Args["to"].(*time.Time), fc.Args["authUserId"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.BlackoutPeriod)
+	fc.Result = res
+	return ec.marshalNBlackoutPeriod2ᚕᚖrequest_time_offsᚋgraphᚋmodelᚐBlackoutPeriodᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_getBlackoutPeriods(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_BlackoutPeriod_id(ctx, field)
+			case "channelId":
+				return ec.fieldContext_BlackoutPeriod_channelId(ctx, field)
+			case "shiftGroupId":
+				return ec.fieldContext_BlackoutPeriod_shiftGroupId(ctx, field)
+			case "name":
+				return ec.fieldContext_BlackoutPeriod_name(ctx, field)
+			case "startTime":
+				return ec.fieldContext_BlackoutPeriod_startTime(ctx, field)
+			case "endTime":
+				return ec.fieldContext_BlackoutPeriod_endTime(ctx, field)
+			case "createdByUserId":
+				return ec.fieldContext_BlackoutPeriod_createdByUserId(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_BlackoutPeriod_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_BlackoutPeriod_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type BlackoutPeriod", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getBlackoutPeriods_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getAbsenceLimits(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_getAbsenceLimits(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().GetAbsenceLimits(rctx, fc.Args["channelId"].(string), fc.Args["authUserId"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.AbsenceLimit)
+	fc.Result = res
+	return ec.marshalNAbsenceLimit2ᚕᚖrequest_time_offsᚋgraphᚋmodelᚐAbsenceLimitᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_getAbsenceLimits(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "channelId":
+				return ec.fieldContext_AbsenceLimit_channelId(ctx, field)
+			case "shiftGroupId":
+				return ec.fieldContext_AbsenceLimit_shiftGroupId(ctx, field)
+			case "maxAbsences":
+				return ec.fieldContext_AbsenceLimit_maxAbsences(ctx, field)
+			case "maxAbsencePercent":
+				return ec.fieldContext_AbsenceLimit_maxAbsencePercent(ctx, field)
+			case "updatedByUserId":
+				return ec.fieldContext_AbsenceLimit_updatedByUserId(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_AbsenceLimit_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AbsenceLimit", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getAbsenceLimits_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getApprovedTimeOffs(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_getApprovedTimeOffs(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().GetApprovedTimeOffs(rctx, fc.Args["channelId"].(string), fc.Args["userIds"].([]string), fc.Args["startTime"].(time.Time), fc.Args["endTime"].(time.Time), fc.Args["authUserId"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.RequestTimeOff)
+	fc.Result = res
+	return ec.marshalNRequestTimeOff2ᚕᚖrequest_time_offsᚋgraphᚋmodelᚐRequestTimeOffᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_getApprovedTimeOffs(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_RequestTimeOff_id(ctx, field)
+			case "userId":
+				return ec.fieldContext_RequestTimeOff_userId(ctx, field)
+			case "channelId":
+				return ec.fieldContext_RequestTimeOff_channelId(ctx, field)
+			case "requestId":
+				return ec.fieldContext_RequestTimeOff_requestId(ctx, field)
+			case "startTime":
+				return ec.fieldContext_RequestTimeOff_startTime(ctx, field)
+			case "endTime":
+				return ec.fieldContext_RequestTimeOff_endTime(ctx, field)
+			case "is24Hours":
+				return ec.fieldContext_RequestTimeOff_is24Hours(ctx, field)
+			case "reason":
+				return ec.fieldContext_RequestTimeOff_reason(ctx, field)
+			case "leaveType":
+				return ec.fieldContext_RequestTimeOff_leaveType(ctx, field)
+			case "leaveTypeDetails":
+				return ec.fieldContext_RequestTimeOff_leaveTypeDetails(ctx, field)
+			case "attachmentUrl":
+				return ec.fieldContext_RequestTimeOff_attachmentUrl(ctx, field)
+			case "blackoutOverrideReason":
+				return ec.fieldContext_RequestTimeOff_blackoutOverrideReason(ctx, field)
+			case "blackoutOverrideByUserId":
+				return ec.fieldContext_RequestTimeOff_blackoutOverrideByUserId(ctx, field)
+			case "capacityOverrideReason":
+				return ec.fieldContext_RequestTimeOff_capacityOverrideReason(ctx, field)
+			case "capacityOverrideByUserId":
+				return ec.fieldContext_RequestTimeOff_capacityOverrideByUserId(ctx, field)
+			case "requestNote":
+				return ec.fieldContext_RequestTimeOff_requestNote(ctx, field)
+			case "status":
+				return ec.fieldContext_RequestTimeOff_status(ctx, field)
+			case "responseNote":
+				return ec.fieldContext_RequestTimeOff_responseNote(ctx, field)
+			case "responseByUserId":
+				return ec.fieldContext_RequestTimeOff_responseByUserId(ctx, field)
+			case "responseAt":
+				return ec.fieldContext_RequestTimeOff_responseAt(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_RequestTimeOff_createdAt(ctx, field)
+			case "history":
+				return ec.fieldContext_RequestTimeOff_history(ctx, field)
+			case "comments":
+				return ec.fieldContext_RequestTimeOff_comments(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RequestTimeOff", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getApprovedTimeOffs_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query___type(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.introspectType(fc.Args["name"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query___type_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query___schema(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.introspectSchema()
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Schema)
+	fc.Result = res
+	return ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query___schema(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "description":
+				return ec.fieldContext___Schema_description(ctx, field)
+			case "types":
+				return ec.fieldContext___Schema_types(ctx, field)
+			case "queryType":
+				return ec.fieldContext___Schema_queryType(ctx, field)
+			case "mutationType":
+				return ec.fieldContext___Schema_mutationType(ctx, field)
+			case "subscriptionType":
+				return ec.fieldContext___Schema_subscriptionType(ctx, field)
+			case "directives":
+				return ec.fieldContext___Schema_directives(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestComment_id(ctx context.Context, field graphql.CollectedField, obj *model.RequestComment) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestComment_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestComment_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestComment",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestComment_userId(ctx context.Context, field graphql.CollectedField, obj *model.RequestComment) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestComment_userId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.UserID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestComment_userId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestComment",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestComment_user(ctx context.Context, field graphql.CollectedField, obj *model.RequestComment) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestComment_user(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.RequestComment().User(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.User)
+	fc.Result = res
+	return ec.marshalOUser2ᚖrequest_time_offsᚋgraphᚋmodelᚐUser(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestComment_user(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestComment",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_User_id(ctx, field)
+			case "firstName":
+				return ec.fieldContext_User_firstName(ctx, field)
+			case "lastName":
+				return ec.fieldContext_User_lastName(ctx, field)
+			case "email":
+				return ec.fieldContext_User_email(ctx, field)
+			case "avatar":
+				return ec.fieldContext_User_avatar(ctx, field)
+			case "isActive":
+				return ec.fieldContext_User_isActive(ctx, field)
+			case "isStaff":
+				return ec.fieldContext_User_isStaff(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestComment_body(ctx context.Context, field graphql.CollectedField, obj *model.RequestComment) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestComment_body(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Body, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestComment_body(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestComment",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestComment_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.RequestComment) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestComment_createdAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CreatedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestComment_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestComment",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestCommentResponse_errors(ctx context.Context, field graphql.CollectedField, obj *model.RequestCommentResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestCommentResponse_errors(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Errors, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.ShiftError)
+	fc.Result = res
+	return ec.marshalNShiftError2ᚕᚖrequest_time_offsᚋgraphᚋmodelᚐShiftErrorᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestCommentResponse_errors(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestCommentResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "code":
+				return ec.fieldContext_ShiftError_code(ctx, field)
+			case "field":
+				return ec.fieldContext_ShiftError_field(ctx, field)
+			case "message":
+				return ec.fieldContext_ShiftError_message(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ShiftError", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestCommentResponse_comment(ctx context.Context, field graphql.CollectedField, obj *model.RequestCommentResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestCommentResponse_comment(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Comment, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.RequestComment)
+	fc.Result = res
+	return ec.marshalORequestComment2ᚖrequest_time_offsᚋgraphᚋmodelᚐRequestComment(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestCommentResponse_comment(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestCommentResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_RequestComment_id(ctx, field)
+			case "userId":
+				return ec.fieldContext_RequestComment_userId(ctx, field)
+			case "user":
+				return ec.fieldContext_RequestComment_user(ctx, field)
+			case "body":
+				return ec.fieldContext_RequestComment_body(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_RequestComment_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RequestComment", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestExpirySettings_channelId(ctx context.Context, field graphql.CollectedField, obj *model.RequestExpirySettings) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestExpirySettings_channelId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ChannelID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestExpirySettings_channelId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestExpirySettings",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestExpirySettings_enabled(ctx context.Context, field graphql.CollectedField, obj *model.RequestExpirySettings) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestExpirySettings_enabled(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Enabled, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestExpirySettings_enabled(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestExpirySettings",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestExpirySettings_gracePeriodMinutes(ctx context.Context, field graphql.CollectedField, obj *model.RequestExpirySettings) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestExpirySettings_gracePeriodMinutes(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.GracePeriodMinutes, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestExpirySettings_gracePeriodMinutes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestExpirySettings",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestExpirySettings_updatedByUserId(ctx context.Context, field graphql.CollectedField, obj *model.RequestExpirySettings) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestExpirySettings_updatedByUserId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.UpdatedByUserID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOID2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestExpirySettings_updatedByUserId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestExpirySettings",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestExpirySettings_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.RequestExpirySettings) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestExpirySettings_updatedAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.UpdatedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestExpirySettings_updatedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestExpirySettings",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestExpirySettingsResponse_errors(ctx context.Context, field graphql.CollectedField, obj *model.RequestExpirySettingsResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestExpirySettingsResponse_errors(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Errors, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.ShiftError)
+	fc.Result = res
+	return ec.marshalNShiftError2ᚕᚖrequest_time_offsᚋgraphᚋmodelᚐShiftErrorᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestExpirySettingsResponse_errors(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestExpirySettingsResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "code":
+				return ec.fieldContext_ShiftError_code(ctx, field)
+			case "field":
+				return ec.fieldContext_ShiftError_field(ctx, field)
+			case "message":
+				return ec.fieldContext_ShiftError_message(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ShiftError", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestExpirySettingsResponse_settings(ctx context.Context, field graphql.CollectedField, obj *model.RequestExpirySettingsResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestExpirySettingsResponse_settings(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Settings, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.RequestExpirySettings)
+	fc.Result = res
+	return ec.marshalORequestExpirySettings2ᚖrequest_time_offsᚋgraphᚋmodelᚐRequestExpirySettings(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestExpirySettingsResponse_settings(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestExpirySettingsResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "channelId":
+				return ec.fieldContext_RequestExpirySettings_channelId(ctx, field)
+			case "enabled":
+				return ec.fieldContext_RequestExpirySettings_enabled(ctx, field)
+			case "gracePeriodMinutes":
+				return ec.fieldContext_RequestExpirySettings_gracePeriodMinutes(ctx, field)
+			case "updatedByUserId":
+				return ec.fieldContext_RequestExpirySettings_updatedByUserId(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_RequestExpirySettings_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RequestExpirySettings", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestHistoryEntry_id(ctx context.Context, field graphql.CollectedField, obj *model.RequestHistoryEntry) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestHistoryEntry_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestHistoryEntry_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestHistoryEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestHistoryEntry_action(ctx context.Context, field graphql.CollectedField, obj *model.RequestHistoryEntry) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestHistoryEntry_action(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Action, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.RequestHistoryAction)
+	fc.Result = res
+	return ec.marshalNRequestHistoryAction2request_time_offsᚋgraphᚋmodelᚐRequestHistoryAction(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestHistoryEntry_action(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestHistoryEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type RequestHistoryAction does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestHistoryEntry_field(ctx context.Context, field graphql.CollectedField, obj *model.RequestHistoryEntry) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestHistoryEntry_field(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Field, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestHistoryEntry_field(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestHistoryEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestHistoryEntry_oldValue(ctx context.Context, field graphql.CollectedField, obj *model.RequestHistoryEntry) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestHistoryEntry_oldValue(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.OldValue, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestHistoryEntry_oldValue(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestHistoryEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestHistoryEntry_newValue(ctx context.Context, field graphql.CollectedField, obj *model.RequestHistoryEntry) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestHistoryEntry_newValue(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.NewValue, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestHistoryEntry_newValue(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestHistoryEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestHistoryEntry_actorUserId(ctx context.Context, field graphql.CollectedField, obj *model.RequestHistoryEntry) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestHistoryEntry_actorUserId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ActorUserID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOID2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestHistoryEntry_actorUserId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestHistoryEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestHistoryEntry_actor(ctx context.Context, field graphql.CollectedField, obj *model.RequestHistoryEntry) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestHistoryEntry_actor(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.RequestHistoryEntry().Actor(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.User)
+	fc.Result = res
+	return ec.marshalOUser2ᚖrequest_time_offsᚋgraphᚋmodelᚐUser(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestHistoryEntry_actor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestHistoryEntry",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_User_id(ctx, field)
+			case "firstName":
+				return ec.fieldContext_User_firstName(ctx, field)
+			case "lastName":
+				return ec.fieldContext_User_lastName(ctx, field)
+			case "email":
+				return ec.fieldContext_User_email(ctx, field)
+			case "avatar":
+				return ec.fieldContext_User_avatar(ctx, field)
+			case "isActive":
+				return ec.fieldContext_User_isActive(ctx, field)
+			case "isStaff":
+				return ec.fieldContext_User_isStaff(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestHistoryEntry_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.RequestHistoryEntry) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestHistoryEntry_createdAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CreatedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestHistoryEntry_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestHistoryEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestResponse_channelId(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestResponse_channelId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ChannelID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestResponse_channelId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestResponse_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestResponse_createdAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CreatedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestResponse_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestResponse_endTime(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestResponse_endTime(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.EndTime, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestResponse_endTime(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestResponse_id(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestResponse_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestResponse_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestResponse_isAllDay(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestResponse_isAllDay(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsAllDay, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*bool)
+	fc.Result = res
+	return ec.marshalOBoolean2ᚖbool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestResponse_isAllDay(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestResponse_reason(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestResponse_reason(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Reason, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestResponse_reason(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestResponse_requestId(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestResponse_requestId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RequestID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestResponse_requestId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestResponse_requestNote(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestResponse_requestNote(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RequestNote, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestResponse_requestNote(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestResponse_responseAt(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestResponse_responseAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ResponseAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestResponse_responseAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestResponse_responseBy(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestResponse_responseBy(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.RequestResponse().ResponseBy(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.User)
+	fc.Result = res
+	return ec.marshalOUser2ᚖrequest_time_offsᚋgraphᚋmodelᚐUser(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestResponse_responseBy(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestResponse",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_User_id(ctx, field)
+			case "firstName":
+				return ec.fieldContext_User_firstName(ctx, field)
+			case "lastName":
+				return ec.fieldContext_User_lastName(ctx, field)
+			case "email":
+				return ec.fieldContext_User_email(ctx, field)
+			case "avatar":
+				return ec.fieldContext_User_avatar(ctx, field)
+			case "isActive":
+				return ec.fieldContext_User_isActive(ctx, field)
+			case "isStaff":
+				return ec.fieldContext_User_isStaff(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestResponse_responseNote(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestResponse_responseNote(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ResponseNote, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestResponse_responseNote(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestResponse_shiftOfferedTo(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestResponse_shiftOfferedTo(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ShiftOfferedTo, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.User)
+	fc.Result = res
+	return ec.marshalOUser2ᚖrequest_time_offsᚋgraphᚋmodelᚐUser(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestResponse_shiftOfferedTo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_User_id(ctx, field)
+			case "firstName":
+				return ec.fieldContext_User_firstName(ctx, field)
+			case "lastName":
+				return ec.fieldContext_User_lastName(ctx, field)
+			case "email":
+				return ec.fieldContext_User_email(ctx, field)
+			case "avatar":
+				return ec.fieldContext_User_avatar(ctx, field)
+			case "isActive":
+				return ec.fieldContext_User_isActive(ctx, field)
+			case "isStaff":
+				return ec.fieldContext_User_isStaff(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestResponse_shiftToOffer(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestResponse_shiftToOffer(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ShiftToOffer, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.AssignedShift)
+	fc.Result = res
+	return ec.marshalOAssignedShift2ᚖrequest_time_offsᚋgraphᚋmodelᚐAssignedShift(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestResponse_shiftToOffer(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AssignedShift_id(ctx, field)
+			case "break":
+				return ec.fieldContext_AssignedShift_break(ctx, field)
+			case "color":
+				return ec.fieldContext_AssignedShift_color(ctx, field)
+			case "startTime":
+				return ec.fieldContext_AssignedShift_startTime(ctx, field)
+			case "endTime":
+				return ec.fieldContext_AssignedShift_endTime(ctx, field)
+			case "is24Hours":
+				return ec.fieldContext_AssignedShift_is24Hours(ctx, field)
+			case "label":
+				return ec.fieldContext_AssignedShift_label(ctx, field)
+			case "note":
+				return ec.fieldContext_AssignedShift_note(ctx, field)
+			case "shiftToOffer":
+				return ec.fieldContext_AssignedShift_shiftToOffer(ctx, field)
+			case "shiftToSwap":
+				return ec.fieldContext_AssignedShift_shiftToSwap(ctx, field)
+			case "toSwapWith":
+				return ec.fieldContext_AssignedShift_toSwapWith(ctx, field)
+			case "userId":
+				return ec.fieldContext_AssignedShift_userId(ctx, field)
+			case "channelId":
+				return ec.fieldContext_AssignedShift_channelId(ctx, field)
+			case "shiftGroupId":
+				return ec.fieldContext_AssignedShift_shiftGroupId(ctx, field)
+			case "type":
+				return ec.fieldContext_AssignedShift_type(ctx, field)
+			case "isOpen":
+				return ec.fieldContext_AssignedShift_isOpen(ctx, field)
+			case "isShared":
+				return ec.fieldContext_AssignedShift_isShared(ctx, field)
+			case "ShiftActivities":
+				return ec.fieldContext_AssignedShift_ShiftActivities(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AssignedShift", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestResponse_shiftToSwap(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestResponse_shiftToSwap(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ShiftToSwap, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.AssignedShift)
+	fc.Result = res
+	return ec.marshalOAssignedShift2ᚖrequest_time_offsᚋgraphᚋmodelᚐAssignedShift(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestResponse_shiftToSwap(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AssignedShift_id(ctx, field)
+			case "break":
+				return ec.fieldContext_AssignedShift_break(ctx, field)
+			case "color":
+				return ec.fieldContext_AssignedShift_color(ctx, field)
+			case "startTime":
+				return ec.fieldContext_AssignedShift_startTime(ctx, field)
+			case "endTime":
+				return ec.fieldContext_AssignedShift_endTime(ctx, field)
+			case "is24Hours":
+				return ec.fieldContext_AssignedShift_is24Hours(ctx, field)
+			case "label":
+				return ec.fieldContext_AssignedShift_label(ctx, field)
+			case "note":
+				return ec.fieldContext_AssignedShift_note(ctx, field)
+			case "shiftToOffer":
+				return ec.fieldContext_AssignedShift_shiftToOffer(ctx, field)
+			case "shiftToSwap":
+				return ec.fieldContext_AssignedShift_shiftToSwap(ctx, field)
+			case "toSwapWith":
+				return ec.fieldContext_AssignedShift_toSwapWith(ctx, field)
+			case "userId":
+				return ec.fieldContext_AssignedShift_userId(ctx, field)
+			case "channelId":
+				return ec.fieldContext_AssignedShift_channelId(ctx, field)
+			case "shiftGroupId":
+				return ec.fieldContext_AssignedShift_shiftGroupId(ctx, field)
+			case "type":
+				return ec.fieldContext_AssignedShift_type(ctx, field)
+			case "isOpen":
+				return ec.fieldContext_AssignedShift_isOpen(ctx, field)
+			case "isShared":
+				return ec.fieldContext_AssignedShift_isShared(ctx, field)
+			case "ShiftActivities":
+				return ec.fieldContext_AssignedShift_ShiftActivities(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AssignedShift", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestResponse_startTime(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestResponse_startTime(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.StartTime, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestResponse_startTime(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestResponse_status(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestResponse_status(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Status, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.RequestStatus)
+	fc.Result = res
+	return ec.marshalORequestStatus2ᚖrequest_time_offsᚋgraphᚋmodelᚐRequestStatus(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestResponse_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type RequestStatus does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestResponse_toSwapWith(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestResponse_toSwapWith(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ToSwapWith, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.AssignedShift)
+	fc.Result = res
+	return ec.marshalOAssignedShift2ᚖrequest_time_offsᚋgraphᚋmodelᚐAssignedShift(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestResponse_toSwapWith(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AssignedShift_id(ctx, field)
+			case "break":
+				return ec.fieldContext_AssignedShift_break(ctx, field)
+			case "color":
+				return ec.fieldContext_AssignedShift_color(ctx, field)
+			case "startTime":
+				return ec.fieldContext_AssignedShift_startTime(ctx, field)
+			case "endTime":
+				return ec.fieldContext_AssignedShift_endTime(ctx, field)
+			case "is24Hours":
+				return ec.fieldContext_AssignedShift_is24Hours(ctx, field)
+			case "label":
+				return ec.fieldContext_AssignedShift_label(ctx, field)
+			case "note":
+				return ec.fieldContext_AssignedShift_note(ctx, field)
+			case "shiftToOffer":
+				return ec.fieldContext_AssignedShift_shiftToOffer(ctx, field)
+			case "shiftToSwap":
+				return ec.fieldContext_AssignedShift_shiftToSwap(ctx, field)
+			case "toSwapWith":
+				return ec.fieldContext_AssignedShift_toSwapWith(ctx, field)
+			case "userId":
+				return ec.fieldContext_AssignedShift_userId(ctx, field)
+			case "channelId":
+				return ec.fieldContext_AssignedShift_channelId(ctx, field)
+			case "shiftGroupId":
+				return ec.fieldContext_AssignedShift_shiftGroupId(ctx, field)
+			case "type":
+				return ec.fieldContext_AssignedShift_type(ctx, field)
+			case "isOpen":
+				return ec.fieldContext_AssignedShift_isOpen(ctx, field)
+			case "isShared":
+				return ec.fieldContext_AssignedShift_isShared(ctx, field)
+			case "ShiftActivities":
+				return ec.fieldContext_AssignedShift_ShiftActivities(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AssignedShift", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestResponse_type(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestResponse_type(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.RequestType)
+	fc.Result = res
+	return ec.marshalORequestType2ᚖrequest_time_offsᚋgraphᚋmodelᚐRequestType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestResponse_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type RequestType does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestResponse_user(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestResponse_user(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.User, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.User)
+	fc.Result = res
+	return ec.marshalNUser2ᚖrequest_time_offsᚋgraphᚋmodelᚐUser(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestResponse_user(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_User_id(ctx, field)
+			case "firstName":
+				return ec.fieldContext_User_firstName(ctx, field)
+			case "lastName":
+				return ec.fieldContext_User_lastName(ctx, field)
+			case "email":
+				return ec.fieldContext_User_email(ctx, field)
+			case "avatar":
+				return ec.fieldContext_User_avatar(ctx, field)
+			case "isActive":
+				return ec.fieldContext_User_isActive(ctx, field)
+			case "isStaff":
+				return ec.fieldContext_User_isStaff(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestResponse_history(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestResponse_history(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.RequestResponse().History(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.RequestHistoryEntry)
+	fc.Result = res
+	return ec.marshalNRequestHistoryEntry2ᚕᚖrequest_time_offsᚋgraphᚋmodelᚐRequestHistoryEntryᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestResponse_history(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestResponse",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_RequestHistoryEntry_id(ctx, field)
+			case "action":
+				return ec.fieldContext_RequestHistoryEntry_action(ctx, field)
+			case "field":
+				return ec.fieldContext_RequestHistoryEntry_field(ctx, field)
+			case "oldValue":
+				return ec.fieldContext_RequestHistoryEntry_oldValue(ctx, field)
+			case "newValue":
+				return ec.fieldContext_RequestHistoryEntry_newValue(ctx, field)
+			case "actorUserId":
+				return ec.fieldContext_RequestHistoryEntry_actorUserId(ctx, field)
+			case "actor":
+				return ec.fieldContext_RequestHistoryEntry_actor(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_RequestHistoryEntry_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RequestHistoryEntry", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestResponse_comments(ctx context.Context, field graphql.CollectedField, obj *model.RequestResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestResponse_comments(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.RequestResponse().Comments(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.RequestComment)
+	fc.Result = res
+	return ec.marshalNRequestComment2ᚕᚖrequest_time_offsᚋgraphᚋmodelᚐRequestCommentᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestResponse_comments(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestResponse",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_RequestComment_id(ctx, field)
+			case "userId":
+				return ec.fieldContext_RequestComment_userId(ctx, field)
+			case "user":
+				return ec.fieldContext_RequestComment_user(ctx, field)
+			case "body":
+				return ec.fieldContext_RequestComment_body(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_RequestComment_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RequestComment", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestTimeOff_id(ctx context.Context, field graphql.CollectedField, obj *model.RequestTimeOff) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestTimeOff_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestTimeOff_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestTimeOff",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestTimeOff_userId(ctx context.Context, field graphql.CollectedField, obj *model.RequestTimeOff) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestTimeOff_userId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.UserID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestTimeOff_userId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestTimeOff",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestTimeOff_channelId(ctx context.Context, field graphql.CollectedField, obj *model.RequestTimeOff) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestTimeOff_channelId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ChannelID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOID2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestTimeOff_channelId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestTimeOff",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestTimeOff_requestId(ctx context.Context, field graphql.CollectedField, obj *model.RequestTimeOff) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestTimeOff_requestId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RequestID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOID2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestTimeOff_requestId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestTimeOff",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestTimeOff_startTime(ctx context.Context, field graphql.CollectedField, obj *model.RequestTimeOff) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestTimeOff_startTime(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.StartTime, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestTimeOff_startTime(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestTimeOff",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestTimeOff_endTime(ctx context.Context, field graphql.CollectedField, obj *model.RequestTimeOff) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestTimeOff_endTime(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.EndTime, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestTimeOff_endTime(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestTimeOff",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestTimeOff_is24Hours(ctx context.Context, field graphql.CollectedField, obj *model.RequestTimeOff) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestTimeOff_is24Hours(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Is24Hours, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*bool)
+	fc.Result = res
+	return ec.marshalOBoolean2ᚖbool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestTimeOff_is24Hours(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestTimeOff",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestTimeOff_reason(ctx context.Context, field graphql.CollectedField, obj *model.RequestTimeOff) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestTimeOff_reason(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Reason, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestTimeOff_reason(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestTimeOff",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestTimeOff_leaveType(ctx context.Context, field graphql.CollectedField, obj *model.RequestTimeOff) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestTimeOff_leaveType(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LeaveType, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestTimeOff_leaveType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestTimeOff",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestTimeOff_leaveTypeDetails(ctx context.Context, field graphql.CollectedField, obj *model.RequestTimeOff) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestTimeOff_leaveTypeDetails(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.RequestTimeOff().LeaveTypeDetails(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.LeaveType)
+	fc.Result = res
+	return ec.marshalOLeaveType2ᚖrequest_time_offsᚋgraphᚋmodelᚐLeaveType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestTimeOff_leaveTypeDetails(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestTimeOff",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "channelId":
+				return ec.fieldContext_LeaveType_channelId(ctx, field)
+			case "code":
+				return ec.fieldContext_LeaveType_code(ctx, field)
+			case "name":
+				return ec.fieldContext_LeaveType_name(ctx, field)
+			case "color":
+				return ec.fieldContext_LeaveType_color(ctx, field)
+			case "paid":
+				return ec.fieldContext_LeaveType_paid(ctx, field)
+			case "requiresAttachment":
+				return ec.fieldContext_LeaveType_requiresAttachment(ctx, field)
+			case "minimumNoticeHours":
+				return ec.fieldContext_LeaveType_minimumNoticeHours(ctx, field)
+			case "maxConsecutiveDays":
+				return ec.fieldContext_LeaveType_maxConsecutiveDays(ctx, field)
+			case "autoApprove":
+				return ec.fieldContext_LeaveType_autoApprove(ctx, field)
+			case "archived":
+				return ec.fieldContext_LeaveType_archived(ctx, field)
+			case "updatedByUserId":
+				return ec.fieldContext_LeaveType_updatedByUserId(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_LeaveType_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LeaveType", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestTimeOff_attachmentUrl(ctx context.Context, field graphql.CollectedField, obj *model.RequestTimeOff) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestTimeOff_attachmentUrl(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.AttachmentURL, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestTimeOff_attachmentUrl(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestTimeOff",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestTimeOff_blackoutOverrideReason(ctx context.Context, field graphql.CollectedField, obj *model.RequestTimeOff) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestTimeOff_blackoutOverrideReason(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.BlackoutOverrideReason, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestTimeOff_blackoutOverrideReason(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestTimeOff",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestTimeOff_blackoutOverrideByUserId(ctx context.Context, field graphql.CollectedField, obj *model.RequestTimeOff) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestTimeOff_blackoutOverrideByUserId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.BlackoutOverrideByUserID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOID2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestTimeOff_blackoutOverrideByUserId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestTimeOff",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestTimeOff_capacityOverrideReason(ctx context.Context, field graphql.CollectedField, obj *model.RequestTimeOff) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestTimeOff_capacityOverrideReason(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CapacityOverrideReason, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestTimeOff_capacityOverrideReason(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestTimeOff",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestTimeOff_capacityOverrideByUserId(ctx context.Context, field graphql.CollectedField, obj *model.RequestTimeOff) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestTimeOff_capacityOverrideByUserId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CapacityOverrideByUserID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOID2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestTimeOff_capacityOverrideByUserId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestTimeOff",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestTimeOff_requestNote(ctx context.Context, field graphql.CollectedField, obj *model.RequestTimeOff) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestTimeOff_requestNote(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RequestNote, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestTimeOff_requestNote(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestTimeOff",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestTimeOff_status(ctx context.Context, field graphql.CollectedField, obj *model.RequestTimeOff) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestTimeOff_status(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Status, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.RequestStatus)
+	fc.Result = res
+	return ec.marshalNRequestStatus2request_time_offsᚋgraphᚋmodelᚐRequestStatus(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestTimeOff_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestTimeOff",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type RequestStatus does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestTimeOff_responseNote(ctx context.Context, field graphql.CollectedField, obj *model.RequestTimeOff) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestTimeOff_responseNote(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ResponseNote, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestTimeOff_responseNote(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestTimeOff",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestTimeOff_responseByUserId(ctx context.Context, field graphql.CollectedField, obj *model.RequestTimeOff) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestTimeOff_responseByUserId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ResponseByUserID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOID2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestTimeOff_responseByUserId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestTimeOff",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestTimeOff_responseAt(ctx context.Context, field graphql.CollectedField, obj *model.RequestTimeOff) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestTimeOff_responseAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ResponseAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestTimeOff_responseAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestTimeOff",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestTimeOff_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.RequestTimeOff) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestTimeOff_createdAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CreatedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestTimeOff_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestTimeOff",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestTimeOff_history(ctx context.Context, field graphql.CollectedField, obj *model.RequestTimeOff) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestTimeOff_history(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.RequestTimeOff().History(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.RequestHistoryEntry)
+	fc.Result = res
+	return ec.marshalNRequestHistoryEntry2ᚕᚖrequest_time_offsᚋgraphᚋmodelᚐRequestHistoryEntryᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestTimeOff_history(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestTimeOff",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_RequestHistoryEntry_id(ctx, field)
+			case "action":
+				return ec.fieldContext_RequestHistoryEntry_action(ctx, field)
+			case "field":
+				return ec.fieldContext_RequestHistoryEntry_field(ctx, field)
+			case "oldValue":
+				return ec.fieldContext_RequestHistoryEntry_oldValue(ctx, field)
+			case "newValue":
+				return ec.fieldContext_RequestHistoryEntry_newValue(ctx, field)
+			case "actorUserId":
+				return ec.fieldContext_RequestHistoryEntry_actorUserId(ctx, field)
+			case "actor":
+				return ec.fieldContext_RequestHistoryEntry_actor(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_RequestHistoryEntry_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RequestHistoryEntry", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestTimeOff_comments(ctx context.Context, field graphql.CollectedField, obj *model.RequestTimeOff) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestTimeOff_comments(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.RequestTimeOff().Comments(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.RequestComment)
+	fc.Result = res
+	return ec.marshalNRequestComment2ᚕᚖrequest_time_offsᚋgraphᚋmodelᚐRequestCommentᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestTimeOff_comments(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestTimeOff",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_RequestComment_id(ctx, field)
+			case "userId":
+				return ec.fieldContext_RequestComment_userId(ctx, field)
+			case "user":
+				return ec.fieldContext_RequestComment_user(ctx, field)
+			case "body":
+				return ec.fieldContext_RequestComment_body(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_RequestComment_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RequestComment", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestTimeOffConnection_edges(ctx context.Context, field graphql.CollectedField, obj *model.RequestTimeOffConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestTimeOffConnection_edges(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Edges, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.RequestTimeOffEdge)
+	fc.Result = res
+	return ec.marshalNRequestTimeOffEdge2ᚕᚖrequest_time_offsᚋgraphᚋmodelᚐRequestTimeOffEdgeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestTimeOffConnection_edges(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestTimeOffConnection",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "cursor":
+				return ec.fieldContext_RequestTimeOffEdge_cursor(ctx, field)
+			case "node":
+				return ec.fieldContext_RequestTimeOffEdge_node(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RequestTimeOffEdge", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestTimeOffConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.RequestTimeOffConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestTimeOffConnection_pageInfo(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PageInfo, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.PageInfo)
+	fc.Result = res
+	return ec.marshalNPageInfo2ᚖrequest_time_offsᚋgraphᚋmodelᚐPageInfo(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestTimeOffConnection_pageInfo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestTimeOffConnection",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "hasNextPage":
+				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
+			case "hasPreviousPage":
+				return ec.fieldContext_PageInfo_hasPreviousPage(ctx, field)
+			case "startCursor":
+				return ec.fieldContext_PageInfo_startCursor(ctx, field)
+			case "endCursor":
+				return ec.fieldContext_PageInfo_endCursor(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestTimeOffEdge_cursor(ctx context.Context, field graphql.CollectedField, obj *model.RequestTimeOffEdge) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestTimeOffEdge_cursor(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Cursor, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestTimeOffEdge_cursor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestTimeOffEdge",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestTimeOffEdge_node(ctx context.Context, field graphql.CollectedField, obj *model.RequestTimeOffEdge) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestTimeOffEdge_node(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Node, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.RequestTimeOff)
+	fc.Result = res
+	return ec.marshalNRequestTimeOff2ᚖrequest_time_offsᚋgraphᚋmodelᚐRequestTimeOff(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestTimeOffEdge_node(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestTimeOffEdge",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_RequestTimeOff_id(ctx, field)
+			case "userId":
+				return ec.fieldContext_RequestTimeOff_userId(ctx, field)
+			case "channelId":
+				return ec.fieldContext_RequestTimeOff_channelId(ctx, field)
+			case "requestId":
+				return ec.fieldContext_RequestTimeOff_requestId(ctx, field)
+			case "startTime":
+				return ec.fieldContext_RequestTimeOff_startTime(ctx, field)
+			case "endTime":
+				return ec.fieldContext_RequestTimeOff_endTime(ctx, field)
+			case "is24Hours":
+				return ec.fieldContext_RequestTimeOff_is24Hours(ctx, field)
+			case "reason":
+				return ec.fieldContext_RequestTimeOff_reason(ctx, field)
+			case "leaveType":
+				return ec.fieldContext_RequestTimeOff_leaveType(ctx, field)
+			case "leaveTypeDetails":
+				return ec.fieldContext_RequestTimeOff_leaveTypeDetails(ctx, field)
+			case "attachmentUrl":
+				return ec.fieldContext_RequestTimeOff_attachmentUrl(ctx, field)
+			case "blackoutOverrideReason":
+				return ec.fieldContext_RequestTimeOff_blackoutOverrideReason(ctx, field)
+			case "blackoutOverrideByUserId":
+				return ec.fieldContext_RequestTimeOff_blackoutOverrideByUserId(ctx, field)
+			case "capacityOverrideReason":
+				return ec.fieldContext_RequestTimeOff_capacityOverrideReason(ctx, field)
+			case "capacityOverrideByUserId":
+				return ec.fieldContext_RequestTimeOff_capacityOverrideByUserId(ctx, field)
+			case "requestNote":
+				return ec.fieldContext_RequestTimeOff_requestNote(ctx, field)
+			case "status":
+				return ec.fieldContext_RequestTimeOff_status(ctx, field)
+			case "responseNote":
+				return ec.fieldContext_RequestTimeOff_responseNote(ctx, field)
+			case "responseByUserId":
+				return ec.fieldContext_RequestTimeOff_responseByUserId(ctx, field)
+			case "responseAt":
+				return ec.fieldContext_RequestTimeOff_responseAt(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_RequestTimeOff_createdAt(ctx, field)
+			case "history":
+				return ec.fieldContext_RequestTimeOff_history(ctx, field)
+			case "comments":
+				return ec.fieldContext_RequestTimeOff_comments(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RequestTimeOff", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestTimeOffResult_id(ctx context.Context, field graphql.CollectedField, obj *model.RequestTimeOffResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestTimeOffResult_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestTimeOffResult_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestTimeOffResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestTimeOffResult_errors(ctx context.Context, field graphql.CollectedField, obj *model.RequestTimeOffResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestTimeOffResult_errors(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Errors, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.ShiftError)
+	fc.Result = res
+	return ec.marshalNShiftError2ᚕᚖrequest_time_offsᚋgraphᚋmodelᚐShiftErrorᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestTimeOffResult_errors(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestTimeOffResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "code":
+				return ec.fieldContext_ShiftError_code(ctx, field)
+			case "field":
+				return ec.fieldContext_ShiftError_field(ctx, field)
+			case "message":
+				return ec.fieldContext_ShiftError_message(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ShiftError", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestTimeOffResult_request(ctx context.Context, field graphql.CollectedField, obj *model.RequestTimeOffResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestTimeOffResult_request(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Request, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.RequestResponse)
+	fc.Result = res
+	return ec.marshalORequestResponse2ᚖrequest_time_offsᚋgraphᚋmodelᚐRequestResponse(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestTimeOffResult_request(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestTimeOffResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "channelId":
+				return ec.fieldContext_RequestResponse_channelId(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_RequestResponse_createdAt(ctx, field)
+			case "endTime":
+				return ec.fieldContext_RequestResponse_endTime(ctx, field)
+			case "id":
+				return ec.fieldContext_RequestResponse_id(ctx, field)
+			case "isAllDay":
+				return ec.fieldContext_RequestResponse_isAllDay(ctx, field)
+			case "reason":
+				return ec.fieldContext_RequestResponse_reason(ctx, field)
+			case "requestId":
+				return ec.fieldContext_RequestResponse_requestId(ctx, field)
+			case "requestNote":
+				return ec.fieldContext_RequestResponse_requestNote(ctx, field)
+			case "responseAt":
+				return ec.fieldContext_RequestResponse_responseAt(ctx, field)
+			case "responseBy":
+				return ec.fieldContext_RequestResponse_responseBy(ctx, field)
+			case "responseNote":
+				return ec.fieldContext_RequestResponse_responseNote(ctx, field)
+			case "shiftOfferedTo":
+				return ec.fieldContext_RequestResponse_shiftOfferedTo(ctx, field)
+			case "shiftToOffer":
+				return ec.fieldContext_RequestResponse_shiftToOffer(ctx, field)
+			case "shiftToSwap":
+				return ec.fieldContext_RequestResponse_shiftToSwap(ctx, field)
+			case "startTime":
+				return ec.fieldContext_RequestResponse_startTime(ctx, field)
+			case "status":
+				return ec.fieldContext_RequestResponse_status(ctx, field)
+			case "toSwapWith":
+				return ec.fieldContext_RequestResponse_toSwapWith(ctx, field)
+			case "type":
+				return ec.fieldContext_RequestResponse_type(ctx, field)
+			case "user":
+				return ec.fieldContext_RequestResponse_user(ctx, field)
+			case "history":
+				return ec.fieldContext_RequestResponse_history(ctx, field)
+			case "comments":
+				return ec.fieldContext_RequestResponse_comments(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RequestResponse", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestTimeOffResult_shiftConflicts(ctx context.Context, field graphql.CollectedField, obj *model.RequestTimeOffResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestTimeOffResult_shiftConflicts(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ShiftConflicts, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.ShiftConflict)
+	fc.Result = res
+	return ec.marshalOShiftConflict2ᚕᚖrequest_time_offsᚋgraphᚋmodelᚐShiftConflictᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestTimeOffResult_shiftConflicts(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestTimeOffResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "shift":
+				return ec.fieldContext_ShiftConflict_shift(ctx, field)
+			case "converted":
+				return ec.fieldContext_ShiftConflict_converted(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ShiftConflict", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RequestTimeOffResult_capacityWarnings(ctx context.Context, field graphql.CollectedField, obj *model.RequestTimeOffResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RequestTimeOffResult_capacityWarnings(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CapacityWarnings, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.CapacityWarning)
+	fc.Result = res
+	return ec.marshalOCapacityWarning2ᚕᚖrequest_time_offsᚋgraphᚋmodelᚐCapacityWarningᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RequestTimeOffResult_capacityWarnings(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestTimeOffResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "shiftGroupId":
+				return ec.fieldContext_CapacityWarning_shiftGroupId(ctx, field)
+			case "date":
+				return ec.fieldContext_CapacityWarning_date(ctx, field)
+			case "absences":
+				return ec.fieldContext_CapacityWarning_absences(ctx, field)
+			case "limit":
+				return ec.fieldContext_CapacityWarning_limit(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CapacityWarning", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShiftConflict_shift(ctx context.Context, field graphql.CollectedField, obj *model.ShiftConflict) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShiftConflict_shift(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Shift, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.AssignedShift)
+	fc.Result = res
+	return ec.marshalNAssignedShift2ᚖrequest_time_offsᚋgraphᚋmodelᚐAssignedShift(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShiftConflict_shift(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShiftConflict",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AssignedShift_id(ctx, field)
+			case "break":
+				return ec.fieldContext_AssignedShift_break(ctx, field)
+			case "color":
+				return ec.fieldContext_AssignedShift_color(ctx, field)
+			case "startTime":
+				return ec.fieldContext_AssignedShift_startTime(ctx, field)
+			case "endTime":
+				return ec.fieldContext_AssignedShift_endTime(ctx, field)
+			case "is24Hours":
+				return ec.fieldContext_AssignedShift_is24Hours(ctx, field)
+			case "label":
+				return ec.fieldContext_AssignedShift_label(ctx, field)
+			case "note":
+				return ec.fieldContext_AssignedShift_note(ctx, field)
+			case "shiftToOffer":
+				return ec.fieldContext_AssignedShift_shiftToOffer(ctx, field)
+			case "shiftToSwap":
+				return ec.fieldContext_AssignedShift_shiftToSwap(ctx, field)
+			case "toSwapWith":
+				return ec.fieldContext_AssignedShift_toSwapWith(ctx, field)
+			case "userId":
+				return ec.fieldContext_AssignedShift_userId(ctx, field)
+			case "channelId":
+				return ec.fieldContext_AssignedShift_channelId(ctx, field)
+			case "shiftGroupId":
+				return ec.fieldContext_AssignedShift_shiftGroupId(ctx, field)
+			case "type":
+				return ec.fieldContext_AssignedShift_type(ctx, field)
+			case "isOpen":
+				return ec.fieldContext_AssignedShift_isOpen(ctx, field)
+			case "isShared":
+				return ec.fieldContext_AssignedShift_isShared(ctx, field)
+			case "ShiftActivities":
+				return ec.fieldContext_AssignedShift_ShiftActivities(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AssignedShift", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShiftConflict_converted(ctx context.Context, field graphql.CollectedField, obj *model.ShiftConflict) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShiftConflict_converted(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Converted, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShiftConflict_converted(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShiftConflict",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShiftConflictSettings_channelId(ctx context.Context, field graphql.CollectedField, obj *model.ShiftConflictSettings) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShiftConflictSettings_channelId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ChannelID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShiftConflictSettings_channelId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShiftConflictSettings",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShiftConflictSettings_policy(ctx context.Context, field graphql.CollectedField, obj *model.ShiftConflictSettings) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShiftConflictSettings_policy(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Policy, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.ShiftConflictPolicy)
+	fc.Result = res
+	return ec.marshalNShiftConflictPolicy2request_time_offsᚋgraphᚋmodelᚐShiftConflictPolicy(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShiftConflictSettings_policy(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShiftConflictSettings",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ShiftConflictPolicy does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShiftConflictSettings_updatedByUserId(ctx context.Context, field graphql.CollectedField, obj *model.ShiftConflictSettings) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShiftConflictSettings_updatedByUserId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.UpdatedByUserID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOID2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShiftConflictSettings_updatedByUserId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShiftConflictSettings",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShiftConflictSettings_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.ShiftConflictSettings) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShiftConflictSettings_updatedAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.UpdatedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShiftConflictSettings_updatedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShiftConflictSettings",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShiftConflictSettingsResponse_errors(ctx context.Context, field graphql.CollectedField, obj *model.ShiftConflictSettingsResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShiftConflictSettingsResponse_errors(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Errors, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.ShiftError)
+	fc.Result = res
+	return ec.marshalNShiftError2ᚕᚖrequest_time_offsᚋgraphᚋmodelᚐShiftErrorᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShiftConflictSettingsResponse_errors(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShiftConflictSettingsResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "code":
+				return ec.fieldContext_ShiftError_code(ctx, field)
+			case "field":
+				return ec.fieldContext_ShiftError_field(ctx, field)
+			case "message":
+				return ec.fieldContext_ShiftError_message(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ShiftError", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShiftConflictSettingsResponse_settings(ctx context.Context, field graphql.CollectedField, obj *model.ShiftConflictSettingsResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShiftConflictSettingsResponse_settings(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Settings, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.ShiftConflictSettings)
+	fc.Result = res
+	return ec.marshalOShiftConflictSettings2ᚖrequest_time_offsᚋgraphᚋmodelᚐShiftConflictSettings(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShiftConflictSettingsResponse_settings(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShiftConflictSettingsResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "channelId":
+				return ec.fieldContext_ShiftConflictSettings_channelId(ctx, field)
+			case "policy":
+				return ec.fieldContext_ShiftConflictSettings_policy(ctx, field)
+			case "updatedByUserId":
+				return ec.fieldContext_ShiftConflictSettings_updatedByUserId(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_ShiftConflictSettings_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ShiftConflictSettings", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShiftError_code(ctx context.Context, field graphql.CollectedField, obj *model.ShiftError) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShiftError_code(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Code, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.ShiftErrorCode)
+	fc.Result = res
+	return ec.marshalNShiftErrorCode2request_time_offsᚋgraphᚋmodelᚐShiftErrorCode(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShiftError_code(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShiftError",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ShiftErrorCode does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShiftError_field(ctx context.Context, field graphql.CollectedField, obj *model.ShiftError) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShiftError_field(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Field, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShiftError_field(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShiftError",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShiftError_message(ctx context.Context, field graphql.CollectedField, obj *model.ShiftError) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShiftError_message(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShiftError_message(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShiftError",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TimeOffAccrualPolicy_channelId(ctx context.Context, field graphql.CollectedField, obj *model.TimeOffAccrualPolicy) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TimeOffAccrualPolicy_channelId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ChannelID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TimeOffAccrualPolicy_channelId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TimeOffAccrualPolicy",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TimeOffAccrualPolicy_leaveType(ctx context.Context, field graphql.CollectedField, obj *model.TimeOffAccrualPolicy) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TimeOffAccrualPolicy_leaveType(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LeaveType, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TimeOffAccrualPolicy_leaveType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TimeOffAccrualPolicy",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TimeOffAccrualPolicy_method(ctx context.Context, field graphql.CollectedField, obj *model.TimeOffAccrualPolicy) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TimeOffAccrualPolicy_method(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Method, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.AccrualMethod)
+	fc.Result = res
+	return ec.marshalNAccrualMethod2request_time_offsᚋgraphᚋmodelᚐAccrualMethod(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TimeOffAccrualPolicy_method(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TimeOffAccrualPolicy",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type AccrualMethod does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TimeOffAccrualPolicy_hours(ctx context.Context, field graphql.CollectedField, obj *model.TimeOffAccrualPolicy) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TimeOffAccrualPolicy_hours(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Hours, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TimeOffAccrualPolicy_hours(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TimeOffAccrualPolicy",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TimeOffAccrualPolicy_rate(ctx context.Context, field graphql.CollectedField, obj *model.TimeOffAccrualPolicy) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TimeOffAccrualPolicy_rate(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Rate, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TimeOffAccrualPolicy_rate(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TimeOffAccrualPolicy",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TimeOffAccrualPolicy_periodDays(ctx context.Context, field graphql.CollectedField, obj *model.TimeOffAccrualPolicy) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TimeOffAccrualPolicy_periodDays(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PeriodDays, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TimeOffAccrualPolicy_periodDays(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TimeOffAccrualPolicy",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TimeOffAccrualPolicy_periodStart(ctx context.Context, field graphql.CollectedField, obj *model.TimeOffAccrualPolicy) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TimeOffAccrualPolicy_periodStart(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PeriodStart, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TimeOffAccrualPolicy_periodStart(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TimeOffAccrualPolicy",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TimeOffAccrualPolicy_grantMonth(ctx context.Context, field graphql.CollectedField, obj *model.TimeOffAccrualPolicy) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TimeOffAccrualPolicy_grantMonth(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.GrantMonth, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TimeOffAccrualPolicy_grantMonth(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TimeOffAccrualPolicy",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TimeOffAccrualPolicy_grantDay(ctx context.Context, field graphql.CollectedField, obj *model.TimeOffAccrualPolicy) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TimeOffAccrualPolicy_grantDay(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.GrantDay, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TimeOffAccrualPolicy_grantDay(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TimeOffAccrualPolicy",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TimeOffAccrualPolicy_carryOverCap(ctx context.Context, field graphql.CollectedField, obj *model.TimeOffAccrualPolicy) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TimeOffAccrualPolicy_carryOverCap(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CarryOverCap, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*float64)
+	fc.Result = res
+	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TimeOffAccrualPolicy_carryOverCap(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TimeOffAccrualPolicy",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TimeOffAccrualPolicy_hoursPerDay(ctx context.Context, field graphql.CollectedField, obj *model.TimeOffAccrualPolicy) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TimeOffAccrualPolicy_hoursPerDay(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.HoursPerDay, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TimeOffAccrualPolicy_hoursPerDay(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TimeOffAccrualPolicy",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TimeOffAccrualPolicy_includeWeekends(ctx context.Context, field graphql.CollectedField, obj *model.TimeOffAccrualPolicy) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TimeOffAccrualPolicy_includeWeekends(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IncludeWeekends, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TimeOffAccrualPolicy_includeWeekends(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TimeOffAccrualPolicy",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TimeOffAccrualPolicy_enabled(ctx context.Context, field graphql.CollectedField, obj *model.TimeOffAccrualPolicy) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TimeOffAccrualPolicy_enabled(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Enabled, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TimeOffAccrualPolicy_enabled(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TimeOffAccrualPolicy",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TimeOffAccrualPolicy_updatedByUserId(ctx context.Context, field graphql.CollectedField, obj *model.TimeOffAccrualPolicy) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TimeOffAccrualPolicy_updatedByUserId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.UpdatedByUserID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOID2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TimeOffAccrualPolicy_updatedByUserId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TimeOffAccrualPolicy",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TimeOffAccrualPolicy_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.TimeOffAccrualPolicy) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TimeOffAccrualPolicy_updatedAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.UpdatedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TimeOffAccrualPolicy_updatedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TimeOffAccrualPolicy",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TimeOffAccrualPolicyResponse_errors(ctx context.Context, field graphql.CollectedField, obj *model.TimeOffAccrualPolicyResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TimeOffAccrualPolicyResponse_errors(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Errors, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.ShiftError)
+	fc.Result = res
+	return ec.marshalNShiftError2ᚕᚖrequest_time_offsᚋgraphᚋmodelᚐShiftErrorᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TimeOffAccrualPolicyResponse_errors(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TimeOffAccrualPolicyResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "code":
+				return ec.fieldContext_ShiftError_code(ctx, field)
+			case "field":
+				return ec.fieldContext_ShiftError_field(ctx, field)
+			case "message":
+				return ec.fieldContext_ShiftError_message(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ShiftError", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TimeOffAccrualPolicyResponse_policy(ctx context.Context, field graphql.CollectedField, obj *model.TimeOffAccrualPolicyResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TimeOffAccrualPolicyResponse_policy(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Policy, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.TimeOffAccrualPolicy)
+	fc.Result = res
+	return ec.marshalOTimeOffAccrualPolicy2ᚖrequest_time_offsᚋgraphᚋmodelᚐTimeOffAccrualPolicy(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TimeOffAccrualPolicyResponse_policy(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TimeOffAccrualPolicyResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "channelId":
+				return ec.fieldContext_TimeOffAccrualPolicy_channelId(ctx, field)
+			case "leaveType":
+				return ec.fieldContext_TimeOffAccrualPolicy_leaveType(ctx, field)
+			case "method":
+				return ec.fieldContext_TimeOffAccrualPolicy_method(ctx, field)
+			case "hours":
+				return ec.fieldContext_TimeOffAccrualPolicy_hours(ctx, field)
+			case "rate":
+				return ec.fieldContext_TimeOffAccrualPolicy_rate(ctx, field)
+			case "periodDays":
+				return ec.fieldContext_TimeOffAccrualPolicy_periodDays(ctx, field)
+			case "periodStart":
+				return ec.fieldContext_TimeOffAccrualPolicy_periodStart(ctx, field)
+			case "grantMonth":
+				return ec.fieldContext_TimeOffAccrualPolicy_grantMonth(ctx, field)
+			case "grantDay":
+				return ec.fieldContext_TimeOffAccrualPolicy_grantDay(ctx, field)
+			case "carryOverCap":
+				return ec.fieldContext_TimeOffAccrualPolicy_carryOverCap(ctx, field)
+			case "hoursPerDay":
+				return ec.fieldContext_TimeOffAccrualPolicy_hoursPerDay(ctx, field)
+			case "includeWeekends":
+				return ec.fieldContext_TimeOffAccrualPolicy_includeWeekends(ctx, field)
+			case "enabled":
+				return ec.fieldContext_TimeOffAccrualPolicy_enabled(ctx, field)
+			case "updatedByUserId":
+				return ec.fieldContext_TimeOffAccrualPolicy_updatedByUserId(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_TimeOffAccrualPolicy_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TimeOffAccrualPolicy", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TimeOffBalance_id(ctx context.Context, field graphql.CollectedField, obj *model.TimeOffBalance) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TimeOffBalance_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TimeOffBalance_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TimeOffBalance",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TimeOffBalance_channelId(ctx context.Context, field graphql.CollectedField, obj *model.TimeOffBalance) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TimeOffBalance_channelId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ChannelID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TimeOffBalance_channelId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TimeOffBalance",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TimeOffBalance_userId(ctx context.Context, field graphql.CollectedField, obj *model.TimeOffBalance) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TimeOffBalance_userId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.UserID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TimeOffBalance_userId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TimeOffBalance",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TimeOffBalance_leaveType(ctx context.Context, field graphql.CollectedField, obj *model.TimeOffBalance) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TimeOffBalance_leaveType(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LeaveType, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TimeOffBalance_leaveType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TimeOffBalance",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TimeOffBalance_balanceHours(ctx context.Context, field graphql.CollectedField, obj *model.TimeOffBalance) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TimeOffBalance_balanceHours(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.BalanceHours, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TimeOffBalance_balanceHours(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TimeOffBalance",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TimeOffBalance_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.TimeOffBalance) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TimeOffBalance_createdAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CreatedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TimeOffBalance_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TimeOffBalance",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TimeOffBalance_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.TimeOffBalance) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TimeOffBalance_updatedAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.UpdatedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TimeOffBalance_updatedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TimeOffBalance",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TimeOffBalance_history(ctx context.Context, field graphql.CollectedField, obj *model.TimeOffBalance) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TimeOffBalance_history(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.TimeOffBalance().History(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.TimeOffLedgerEntry)
+	fc.Result = res
+	return ec.marshalNTimeOffLedgerEntry2ᚕᚖrequest_time_offsᚋgraphᚋmodelᚐTimeOffLedgerEntryᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TimeOffBalance_history(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TimeOffBalance",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_TimeOffLedgerEntry_id(ctx, field)
+			case "kind":
+				return ec.fieldContext_TimeOffLedgerEntry_kind(ctx, field)
+			case "hours":
+				return ec.fieldContext_TimeOffLedgerEntry_hours(ctx, field)
+			case "balanceAfter":
+				return ec.fieldContext_TimeOffLedgerEntry_balanceAfter(ctx, field)
+			case "requestTimeOffId":
+				return ec.fieldContext_TimeOffLedgerEntry_requestTimeOffId(ctx, field)
+			case "period":
+				return ec.fieldContext_TimeOffLedgerEntry_period(ctx, field)
+			case "note":
+				return ec.fieldContext_TimeOffLedgerEntry_note(ctx, field)
+			case "actorUserId":
+				return ec.fieldContext_TimeOffLedgerEntry_actorUserId(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_TimeOffLedgerEntry_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TimeOffLedgerEntry", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TimeOffBalanceResponse_errors(ctx context.Context, field graphql.CollectedField, obj *model.TimeOffBalanceResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TimeOffBalanceResponse_errors(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Errors, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.ShiftError)
+	fc.Result = res
+	return ec.marshalNShiftError2ᚕᚖrequest_time_offsᚋgraphᚋmodelᚐShiftErrorᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TimeOffBalanceResponse_errors(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TimeOffBalanceResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "code":
+				return ec.fieldContext_ShiftError_code(ctx, field)
+			case "field":
+				return ec.fieldContext_ShiftError_field(ctx, field)
+			case "message":
+				return ec.fieldContext_ShiftError_message(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ShiftError", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TimeOffBalanceResponse_balances(ctx context.Context, field graphql.CollectedField, obj *model.TimeOffBalanceResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TimeOffBalanceResponse_balances(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Balances, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.TimeOffBalance)
+	fc.Result = res
+	return ec.marshalOTimeOffBalance2ᚕᚖrequest_time_offsᚋgraphᚋmodelᚐTimeOffBalanceᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TimeOffBalanceResponse_balances(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TimeOffBalanceResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_TimeOffBalance_id(ctx, field)
+			case "channelId":
+				return ec.fieldContext_TimeOffBalance_channelId(ctx, field)
+			case "userId":
+				return ec.fieldContext_TimeOffBalance_userId(ctx, field)
+			case "leaveType":
+				return ec.fieldContext_TimeOffBalance_leaveType(ctx, field)
+			case "balanceHours":
+				return ec.fieldContext_TimeOffBalance_balanceHours(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_TimeOffBalance_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_TimeOffBalance_updatedAt(ctx, field)
+			case "history":
+				return ec.fieldContext_TimeOffBalance_history(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TimeOffBalance", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TimeOffLedgerEntry_id(ctx context.Context, field graphql.CollectedField, obj *model.TimeOffLedgerEntry) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TimeOffLedgerEntry_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TimeOffLedgerEntry_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TimeOffLedgerEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TimeOffLedgerEntry_kind(ctx context.Context, field graphql.CollectedField, obj *model.TimeOffLedgerEntry) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TimeOffLedgerEntry_kind(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Kind, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.TimeOffLedgerEntryKind)
+	fc.Result = res
+	return ec.marshalNTimeOffLedgerEntryKind2request_time_offsᚋgraphᚋmodelᚐTimeOffLedgerEntryKind(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TimeOffLedgerEntry_kind(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TimeOffLedgerEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type TimeOffLedgerEntryKind does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TimeOffLedgerEntry_hours(ctx context.Context, field graphql.CollectedField, obj *model.TimeOffLedgerEntry) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TimeOffLedgerEntry_hours(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Hours, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TimeOffLedgerEntry_hours(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TimeOffLedgerEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TimeOffLedgerEntry_balanceAfter(ctx context.Context, field graphql.CollectedField, obj *model.TimeOffLedgerEntry) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TimeOffLedgerEntry_balanceAfter(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.BalanceAfter, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TimeOffLedgerEntry_balanceAfter(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TimeOffLedgerEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TimeOffLedgerEntry_requestTimeOffId(ctx context.Context, field graphql.CollectedField, obj *model.TimeOffLedgerEntry) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TimeOffLedgerEntry_requestTimeOffId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RequestTimeOffID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOID2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TimeOffLedgerEntry_requestTimeOffId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TimeOffLedgerEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TimeOffLedgerEntry_period(ctx context.Context, field graphql.CollectedField, obj *model.TimeOffLedgerEntry) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TimeOffLedgerEntry_period(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Period, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TimeOffLedgerEntry_period(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TimeOffLedgerEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TimeOffLedgerEntry_note(ctx context.Context, field graphql.CollectedField, obj *model.TimeOffLedgerEntry) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TimeOffLedgerEntry_note(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Note, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TimeOffLedgerEntry_note(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TimeOffLedgerEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TimeOffLedgerEntry_actorUserId(ctx context.Context, field graphql.CollectedField, obj *model.TimeOffLedgerEntry) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TimeOffLedgerEntry_actorUserId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ActorUserID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOID2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TimeOffLedgerEntry_actorUserId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TimeOffLedgerEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TimeOffLedgerEntry_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.TimeOffLedgerEntry) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TimeOffLedgerEntry_createdAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CreatedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TimeOffLedgerEntry_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TimeOffLedgerEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TimeOffResponse_errors(ctx context.Context, field graphql.CollectedField, obj *model.TimeOffResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TimeOffResponse_errors(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Errors, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.ShiftError)
+	fc.Result = res
+	return ec.marshalNShiftError2ᚕᚖrequest_time_offsᚋgraphᚋmodelᚐShiftErrorᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TimeOffResponse_errors(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TimeOffResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "code":
+				return ec.fieldContext_ShiftError_code(ctx, field)
+			case "field":
+				return ec.fieldContext_ShiftError_field(ctx, field)
+			case "message":
+				return ec.fieldContext_ShiftError_message(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ShiftError", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TimeOffResponse_request(ctx context.Context, field graphql.CollectedField, obj *model.TimeOffResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TimeOffResponse_request(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Request, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.RequestResponse)
+	fc.Result = res
+	return ec.marshalORequestResponse2ᚖrequest_time_offsᚋgraphᚋmodelᚐRequestResponse(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TimeOffResponse_request(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TimeOffResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "channelId":
+				return ec.fieldContext_RequestResponse_channelId(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_RequestResponse_createdAt(ctx, field)
+			case "endTime":
+				return ec.fieldContext_RequestResponse_endTime(ctx, field)
+			case "id":
+				return ec.fieldContext_RequestResponse_id(ctx, field)
+			case "isAllDay":
+				return ec.fieldContext_RequestResponse_isAllDay(ctx, field)
+			case "reason":
+				return ec.fieldContext_RequestResponse_reason(ctx, field)
+			case "requestId":
+				return ec.fieldContext_RequestResponse_requestId(ctx, field)
+			case "requestNote":
+				return ec.fieldContext_RequestResponse_requestNote(ctx, field)
+			case "responseAt":
+				return ec.fieldContext_RequestResponse_responseAt(ctx, field)
+			case "responseBy":
+				return ec.fieldContext_RequestResponse_responseBy(ctx, field)
+			case "responseNote":
+				return ec.fieldContext_RequestResponse_responseNote(ctx, field)
+			case "shiftOfferedTo":
+				return ec.fieldContext_RequestResponse_shiftOfferedTo(ctx, field)
+			case "shiftToOffer":
+				return ec.fieldContext_RequestResponse_shiftToOffer(ctx, field)
+			case "shiftToSwap":
+				return ec.fieldContext_RequestResponse_shiftToSwap(ctx, field)
+			case "startTime":
+				return ec.fieldContext_RequestResponse_startTime(ctx, field)
+			case "status":
+				return ec.fieldContext_RequestResponse_status(ctx, field)
+			case "toSwapWith":
+				return ec.fieldContext_RequestResponse_toSwapWith(ctx, field)
+			case "type":
+				return ec.fieldContext_RequestResponse_type(ctx, field)
+			case "user":
+				return ec.fieldContext_RequestResponse_user(ctx, field)
+			case "history":
+				return ec.fieldContext_RequestResponse_history(ctx, field)
+			case "comments":
+				return ec.fieldContext_RequestResponse_comments(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RequestResponse", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TimeOffResponse_shiftConflicts(ctx context.Context, field graphql.CollectedField, obj *model.TimeOffResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TimeOffResponse_shiftConflicts(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ShiftConflicts, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.ShiftConflict)
+	fc.Result = res
+	return ec.marshalOShiftConflict2ᚕᚖrequest_time_offsᚋgraphᚋmodelᚐShiftConflictᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TimeOffResponse_shiftConflicts(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TimeOffResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "shift":
+				return ec.fieldContext_ShiftConflict_shift(ctx, field)
+			case "converted":
+				return ec.fieldContext_ShiftConflict_converted(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ShiftConflict", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TimeOffResponse_capacityWarnings(ctx context.Context, field graphql.CollectedField, obj *model.TimeOffResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TimeOffResponse_capacityWarnings(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CapacityWarnings, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.CapacityWarning)
+	fc.Result = res
+	return ec.marshalOCapacityWarning2ᚕᚖrequest_time_offsᚋgraphᚋmodelᚐCapacityWarningᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TimeOffResponse_capacityWarnings(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TimeOffResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "shiftGroupId":
+				return ec.fieldContext_CapacityWarning_shiftGroupId(ctx, field)
+			case "date":
+				return ec.fieldContext_CapacityWarning_date(ctx, field)
+			case "absences":
+				return ec.fieldContext_CapacityWarning_absences(ctx, field)
+			case "limit":
+				return ec.fieldContext_CapacityWarning_limit(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CapacityWarning", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _User_id(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_User_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOID2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_User_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _User_firstName(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_User_firstName(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.FirstName, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_User_firstName(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _User_lastName(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_User_lastName(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LastName, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_User_lastName(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _User_email(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_User_email(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Email, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_User_email(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _User_avatar(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_User_avatar(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Avatar, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_User_avatar(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _User_isActive(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_User_isActive(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsActive, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*bool)
+	fc.Result = res
+	return ec.marshalOBoolean2ᚖbool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_User_isActive(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _User_isStaff(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_User_isStaff(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsStaff, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*bool)
+	fc.Result = res
+	return ec.marshalOBoolean2ᚖbool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_User_isStaff(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Directive_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Directive_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Directive_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Directive_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_locations(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Directive_locations(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Locations, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalN__DirectiveLocation2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Directive_locations(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type __DirectiveLocation does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Directive_args(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Args, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.InputValue)
+	fc.Result = res
+	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Directive_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___InputValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___InputValue_description(ctx, field)
+			case "type":
+				return ec.fieldContext___InputValue_type(ctx, field)
+			case "defaultValue":
+				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_isRepeatable(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Directive_isRepeatable(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsRepeatable, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Directive_isRepeatable(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___EnumValue_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___EnumValue_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___EnumValue_isDeprecated(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsDeprecated(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___EnumValue_deprecationReason(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DeprecationReason(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Field_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Field_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Field_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Field_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Field_args(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Args, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.InputValue)
+	fc.Result = res
+	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Field_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___InputValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___InputValue_description(ctx, field)
+			case "type":
+				return ec.fieldContext___InputValue_type(ctx, field)
+			case "defaultValue":
+				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Field_type(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Field_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Field_isDeprecated(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsDeprecated(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Field_isDeprecated(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Field_deprecationReason(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DeprecationReason(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Field_deprecationReason(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___InputValue_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___InputValue_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___InputValue_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___InputValue_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___InputValue_type(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___InputValue_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___InputValue_defaultValue(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DefaultValue, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Schema_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Schema_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Schema_types(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Types(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Schema_types(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Schema_queryType(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.QueryType(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Schema_queryType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Schema_mutationType(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.MutationType(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Schema_mutationType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Schema_subscriptionType(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SubscriptionType(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_directives(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Schema_directives(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Directives(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Directive)
+	fc.Result = res
+	return ec.marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Schema_directives(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___Directive_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Directive_description(ctx, field)
+			case "locations":
+				return ec.fieldContext___Directive_locations(ctx, field)
+			case "args":
+				return ec.fieldContext___Directive_args(ctx, field)
+			case "isRepeatable":
+				return ec.fieldContext___Directive_isRepeatable(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Directive", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_kind(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Kind(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalN__TypeKind2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_kind(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type __TypeKind does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_fields(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Fields(fc.Args["includeDeprecated"].(bool)), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Field)
+	fc.Result = res
+	return ec.marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐFieldᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_fields(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___Field_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Field_description(ctx, field)
+			case "args":
+				return ec.fieldContext___Field_args(ctx, field)
+			case "type":
+				return ec.fieldContext___Field_type(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___Field_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___Field_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Field", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Type_fields_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_interfaces(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Interfaces(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_interfaces(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_possibleTypes(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PossibleTypes(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_possibleTypes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_enumValues(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.EnumValues(fc.Args["includeDeprecated"].(bool)), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.EnumValue)
+	fc.Result = res
+	return ec.marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_enumValues(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___EnumValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___EnumValue_description(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___EnumValue_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___EnumValue_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __EnumValue", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Type_enumValues_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_inputFields(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.InputFields(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.InputValue)
+	fc.Result = res
+	return ec.marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_inputFields(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___InputValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___InputValue_description(ctx, field)
+			case "type":
+				return ec.fieldContext___InputValue_type(ctx, field)
+			case "defaultValue":
+				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_ofType(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.OfType(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_ofType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "kind":
 				return ec.fieldContext___Type_kind(ctx, field)
@@ -6584,993 +17916,3158 @@ func (ec *executionContext) fieldContext___Schema_queryType(ctx context.Context,
 			case "specifiedByURL":
 				return ec.fieldContext___Type_specifiedByURL(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
-	}
-	return fc, nil
-}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_specifiedByURL(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_specifiedByURL(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SpecifiedByURL(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_specifiedByURL(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+// endregion **************************** field.gotpl *****************************
+
+// region    **************************** input.gotpl *****************************
+
+func (ec *executionContext) unmarshalInputAbsenceLimitInput(ctx context.Context, obj interface{}) (model.AbsenceLimitInput, error) {
+	var it model.AbsenceLimitInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"maxAbsences", "maxAbsencePercent"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "maxAbsences":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("maxAbsences"))
+			it.MaxAbsences, err = ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "maxAbsencePercent":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("maxAbsencePercent"))
+			it.MaxAbsencePercent, err = ec.unmarshalOFloat2ᚖfloat64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputBlackoutPeriodInput(ctx context.Context, obj interface{}) (model.BlackoutPeriodInput, error) {
+	var it model.BlackoutPeriodInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"channelId", "shiftGroupId", "name", "startTime", "endTime"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "channelId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("channelId"))
+			it.ChannelID, err = ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "shiftGroupId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("shiftGroupId"))
+			it.ShiftGroupID, err = ec.unmarshalOID2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "name":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			it.Name, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "startTime":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("startTime"))
+			it.StartTime, err = ec.unmarshalNTime2timeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "endTime":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("endTime"))
+			it.EndTime, err = ec.unmarshalNTime2timeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputLeaveTypeInput(ctx context.Context, obj interface{}) (model.LeaveTypeInput, error) {
+	var it model.LeaveTypeInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"code", "name", "color", "paid", "requiresAttachment", "minimumNoticeHours", "maxConsecutiveDays", "autoApprove", "archived"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "code":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("code"))
+			it.Code, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "name":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			it.Name, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "color":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("color"))
+			it.Color, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "paid":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("paid"))
+			it.Paid, err = ec.unmarshalNBoolean2bool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "requiresAttachment":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("requiresAttachment"))
+			it.RequiresAttachment, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "minimumNoticeHours":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("minimumNoticeHours"))
+			it.MinimumNoticeHours, err = ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "maxConsecutiveDays":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("maxConsecutiveDays"))
+			it.MaxConsecutiveDays, err = ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "autoApprove":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("autoApprove"))
+			it.AutoApprove, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "archived":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("archived"))
+			it.Archived, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputRequestExpirySettingsInput(ctx context.Context, obj interface{}) (model.RequestExpirySettingsInput, error) {
+	var it model.RequestExpirySettingsInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"enabled", "gracePeriodMinutes"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "enabled":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("enabled"))
+			it.Enabled, err = ec.unmarshalNBoolean2bool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "gracePeriodMinutes":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("gracePeriodMinutes"))
+			it.GracePeriodMinutes, err = ec.unmarshalNInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputRequestFilter(ctx context.Context, obj interface{}) (model.RequestFilter, error) {
+	var it model.RequestFilter
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"status", "userId", "from", "to", "createdAfter", "createdBefore"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "status":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("status"))
+			it.Status, err = ec.unmarshalORequestStatus2ᚕrequest_time_offsᚋgraphᚋmodelᚐRequestStatusᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "userId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("userId"))
+			it.UserID, err = ec.unmarshalOID2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "from":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("from"))
+			it.From, err = ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "to":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("to"))
+			it.To, err = ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "createdAfter":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("createdAfter"))
+			it.CreatedAfter, err = ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "createdBefore":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("createdBefore"))
+			it.CreatedBefore, err = ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputRequestOrder(ctx context.Context, obj interface{}) (model.RequestOrder, error) {
+	var it model.RequestOrder
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"field", "direction"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "field":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("field"))
+			it.Field, err = ec.unmarshalNRequestOrderField2request_time_offsᚋgraphᚋmodelᚐRequestOrderField(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "direction":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("direction"))
+			it.Direction, err = ec.unmarshalNOrderDirection2request_time_offsᚋgraphᚋmodelᚐOrderDirection(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputRequestTimeOffInput(ctx context.Context, obj interface{}) (model.RequestTimeOffInput, error) {
+	var it model.RequestTimeOffInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"userId", "channelId", "startTime", "endTime", "is24Hours", "reason", "leaveType", "attachmentUrl", "blackoutOverrideReason", "requestNote", "responseNote"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "userId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("userId"))
+			it.UserID, err = ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "channelId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("channelId"))
+			it.ChannelID, err = ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "startTime":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("startTime"))
+			it.StartTime, err = ec.unmarshalNTime2timeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "endTime":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("endTime"))
+			it.EndTime, err = ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "is24Hours":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("is24Hours"))
+			it.Is24Hours, err = ec.unmarshalNBoolean2bool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "reason":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("reason"))
+			it.Reason, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "leaveType":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("leaveType"))
+			it.LeaveType, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "attachmentUrl":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("attachmentUrl"))
+			it.AttachmentURL, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "blackoutOverrideReason":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("blackoutOverrideReason"))
+			it.BlackoutOverrideReason, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "requestNote":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("requestNote"))
+			it.RequestNote, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "responseNote":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("responseNote"))
+			it.ResponseNote, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputRequestsInput(ctx context.Context, obj interface{}) (model.RequestsInput, error) {
+	var it model.RequestsInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"channelId", "userId", "recipientId", "type"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "channelId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("channelId"))
+			it.ChannelID, err = ec.unmarshalOID2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "userId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("userId"))
+			it.UserID, err = ec.unmarshalOID2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "recipientId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("recipientId"))
+			it.RecipientID, err = ec.unmarshalOID2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "type":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("type"))
+			it.Type, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputShiftConflictSettingsInput(ctx context.Context, obj interface{}) (model.ShiftConflictSettingsInput, error) {
+	var it model.ShiftConflictSettingsInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"policy"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "policy":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("policy"))
+			it.Policy, err = ec.unmarshalNShiftConflictPolicy2request_time_offsᚋgraphᚋmodelᚐShiftConflictPolicy(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputTimeOffAccrualPolicyInput(ctx context.Context, obj interface{}) (model.TimeOffAccrualPolicyInput, error) {
+	var it model.TimeOffAccrualPolicyInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"leaveType", "method", "hours", "rate", "periodDays", "periodStart", "grantMonth", "grantDay", "carryOverCap", "hoursPerDay", "includeWeekends", "enabled"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "leaveType":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("leaveType"))
+			it.LeaveType, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "method":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("method"))
+			it.Method, err = ec.unmarshalNAccrualMethod2request_time_offsᚋgraphᚋmodelᚐAccrualMethod(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "hours":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("hours"))
+			it.Hours, err = ec.unmarshalOFloat2ᚖfloat64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "rate":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("rate"))
+			it.Rate, err = ec.unmarshalOFloat2ᚖfloat64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "periodDays":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("periodDays"))
+			it.PeriodDays, err = ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "periodStart":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("periodStart"))
+			it.PeriodStart, err = ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "grantMonth":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("grantMonth"))
+			it.GrantMonth, err = ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "grantDay":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("grantDay"))
+			it.GrantDay, err = ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "carryOverCap":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("carryOverCap"))
+			it.CarryOverCap, err = ec.unmarshalOFloat2ᚖfloat64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "hoursPerDay":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("hoursPerDay"))
+			it.HoursPerDay, err = ec.unmarshalOFloat2ᚖfloat64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "includeWeekends":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("includeWeekends"))
+			it.IncludeWeekends, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "enabled":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("enabled"))
+			it.Enabled, err = ec.unmarshalNBoolean2bool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+// endregion **************************** input.gotpl *****************************
+
+// region    ************************** interface.gotpl ***************************
+
+// endregion ************************** interface.gotpl ***************************
+
+// region    **************************** object.gotpl ****************************
+
+var absenceLimitImplementors = []string{"AbsenceLimit"}
+
+func (ec *executionContext) _AbsenceLimit(ctx context.Context, sel ast.SelectionSet, obj *model.AbsenceLimit) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, absenceLimitImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AbsenceLimit")
+		case "channelId":
+
+			out.Values[i] = ec._AbsenceLimit_channelId(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "shiftGroupId":
+
+			out.Values[i] = ec._AbsenceLimit_shiftGroupId(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "maxAbsences":
+
+			out.Values[i] = ec._AbsenceLimit_maxAbsences(ctx, field, obj)
+
+		case "maxAbsencePercent":
+
+			out.Values[i] = ec._AbsenceLimit_maxAbsencePercent(ctx, field, obj)
+
+		case "updatedByUserId":
+
+			out.Values[i] = ec._AbsenceLimit_updatedByUserId(ctx, field, obj)
+
+		case "updatedAt":
+
+			out.Values[i] = ec._AbsenceLimit_updatedAt(ctx, field, obj)
+
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var absenceLimitResponseImplementors = []string{"AbsenceLimitResponse"}
+
+func (ec *executionContext) _AbsenceLimitResponse(ctx context.Context, sel ast.SelectionSet, obj *model.AbsenceLimitResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, absenceLimitResponseImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AbsenceLimitResponse")
+		case "errors":
+
+			out.Values[i] = ec._AbsenceLimitResponse_errors(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "absenceLimit":
+
+			out.Values[i] = ec._AbsenceLimitResponse_absenceLimit(ctx, field, obj)
+
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var assignedShiftImplementors = []string{"AssignedShift"}
+
+func (ec *executionContext) _AssignedShift(ctx context.Context, sel ast.SelectionSet, obj *model.AssignedShift) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, assignedShiftImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AssignedShift")
+		case "id":
+
+			out.Values[i] = ec._AssignedShift_id(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "break":
+
+			out.Values[i] = ec._AssignedShift_break(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "color":
+
+			out.Values[i] = ec._AssignedShift_color(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "startTime":
+
+			out.Values[i] = ec._AssignedShift_startTime(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "endTime":
+
+			out.Values[i] = ec._AssignedShift_endTime(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "is24Hours":
+
+			out.Values[i] = ec._AssignedShift_is24Hours(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "label":
+
+			out.Values[i] = ec._AssignedShift_label(ctx, field, obj)
+
+		case "note":
+
+			out.Values[i] = ec._AssignedShift_note(ctx, field, obj)
+
+		case "shiftToOffer":
+
+			out.Values[i] = ec._AssignedShift_shiftToOffer(ctx, field, obj)
+
+		case "shiftToSwap":
+
+			out.Values[i] = ec._AssignedShift_shiftToSwap(ctx, field, obj)
+
+		case "toSwapWith":
+
+			out.Values[i] = ec._AssignedShift_toSwapWith(ctx, field, obj)
+
+		case "userId":
+
+			out.Values[i] = ec._AssignedShift_userId(ctx, field, obj)
+
+		case "channelId":
+
+			out.Values[i] = ec._AssignedShift_channelId(ctx, field, obj)
+
+		case "shiftGroupId":
+
+			out.Values[i] = ec._AssignedShift_shiftGroupId(ctx, field, obj)
+
+		case "type":
+
+			out.Values[i] = ec._AssignedShift_type(ctx, field, obj)
+
+		case "isOpen":
+
+			out.Values[i] = ec._AssignedShift_isOpen(ctx, field, obj)
+
+		case "isShared":
+
+			out.Values[i] = ec._AssignedShift_isShared(ctx, field, obj)
+
+		case "ShiftActivities":
+
+			out.Values[i] = ec._AssignedShift_ShiftActivities(ctx, field, obj)
+
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var assignedShiftActivitiesImplementors = []string{"AssignedShiftActivities"}
+
+func (ec *executionContext) _AssignedShiftActivities(ctx context.Context, sel ast.SelectionSet, obj *model.AssignedShiftActivities) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, assignedShiftActivitiesImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AssignedShiftActivities")
+		case "id":
+
+			out.Values[i] = ec._AssignedShiftActivities_id(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "channelId":
+
+			out.Values[i] = ec._AssignedShiftActivities_channelId(ctx, field, obj)
+
+		case "shiftGroupId":
+
+			out.Values[i] = ec._AssignedShiftActivities_shiftGroupId(ctx, field, obj)
+
+		case "assignedShiftId":
+
+			out.Values[i] = ec._AssignedShiftActivities_assignedShiftId(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "userId":
+
+			out.Values[i] = ec._AssignedShiftActivities_userId(ctx, field, obj)
+
+		case "name":
+
+			out.Values[i] = ec._AssignedShiftActivities_name(ctx, field, obj)
+
+		case "code":
+
+			out.Values[i] = ec._AssignedShiftActivities_code(ctx, field, obj)
+
+		case "color":
+
+			out.Values[i] = ec._AssignedShiftActivities_color(ctx, field, obj)
+
+		case "startTime":
+
+			out.Values[i] = ec._AssignedShiftActivities_startTime(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "endTime":
+
+			out.Values[i] = ec._AssignedShiftActivities_endTime(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "isPaid":
+
+			out.Values[i] = ec._AssignedShiftActivities_isPaid(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var blackoutPeriodImplementors = []string{"BlackoutPeriod"}
+
+func (ec *executionContext) _BlackoutPeriod(ctx context.Context, sel ast.SelectionSet, obj *model.BlackoutPeriod) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, blackoutPeriodImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("BlackoutPeriod")
+		case "id":
+
+			out.Values[i] = ec._BlackoutPeriod_id(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "channelId":
+
+			out.Values[i] = ec._BlackoutPeriod_channelId(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "shiftGroupId":
+
+			out.Values[i] = ec._BlackoutPeriod_shiftGroupId(ctx, field, obj)
+
+		case "name":
+
+			out.Values[i] = ec._BlackoutPeriod_name(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "startTime":
+
+			out.Values[i] = ec._BlackoutPeriod_startTime(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "endTime":
+
+			out.Values[i] = ec._BlackoutPeriod_endTime(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "createdByUserId":
+
+			out.Values[i] = ec._BlackoutPeriod_createdByUserId(ctx, field, obj)
+
+		case "createdAt":
+
+			out.Values[i] = ec._BlackoutPeriod_createdAt(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "updatedAt":
+
+			out.Values[i] = ec._BlackoutPeriod_updatedAt(ctx, field, obj)
+
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var blackoutPeriodResponseImplementors = []string{"BlackoutPeriodResponse"}
+
+func (ec *executionContext) _BlackoutPeriodResponse(ctx context.Context, sel ast.SelectionSet, obj *model.BlackoutPeriodResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, blackoutPeriodResponseImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("BlackoutPeriodResponse")
+		case "errors":
+
+			out.Values[i] = ec._BlackoutPeriodResponse_errors(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "blackoutPeriod":
+
+			out.Values[i] = ec._BlackoutPeriodResponse_blackoutPeriod(ctx, field, obj)
+
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var bulkTimeOffResponseImplementors = []string{"BulkTimeOffResponse"}
+
+func (ec *executionContext) _BulkTimeOffResponse(ctx context.Context, sel ast.SelectionSet, obj *model.BulkTimeOffResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, bulkTimeOffResponseImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("BulkTimeOffResponse")
+		case "errors":
+
+			out.Values[i] = ec._BulkTimeOffResponse_errors(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "results":
+
+			out.Values[i] = ec._BulkTimeOffResponse_results(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var capacityWarningImplementors = []string{"CapacityWarning"}
+
+func (ec *executionContext) _CapacityWarning(ctx context.Context, sel ast.SelectionSet, obj *model.CapacityWarning) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, capacityWarningImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CapacityWarning")
+		case "shiftGroupId":
+
+			out.Values[i] = ec._CapacityWarning_shiftGroupId(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "date":
+
+			out.Values[i] = ec._CapacityWarning_date(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "absences":
+
+			out.Values[i] = ec._CapacityWarning_absences(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "limit":
+
+			out.Values[i] = ec._CapacityWarning_limit(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var leaveTypeImplementors = []string{"LeaveType"}
+
+func (ec *executionContext) _LeaveType(ctx context.Context, sel ast.SelectionSet, obj *model.LeaveType) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, leaveTypeImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("LeaveType")
+		case "channelId":
+
+			out.Values[i] = ec._LeaveType_channelId(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "code":
+
+			out.Values[i] = ec._LeaveType_code(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "name":
+
+			out.Values[i] = ec._LeaveType_name(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "color":
+
+			out.Values[i] = ec._LeaveType_color(ctx, field, obj)
+
+		case "paid":
+
+			out.Values[i] = ec._LeaveType_paid(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "requiresAttachment":
+
+			out.Values[i] = ec._LeaveType_requiresAttachment(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "minimumNoticeHours":
+
+			out.Values[i] = ec._LeaveType_minimumNoticeHours(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "maxConsecutiveDays":
+
+			out.Values[i] = ec._LeaveType_maxConsecutiveDays(ctx, field, obj)
+
+		case "autoApprove":
+
+			out.Values[i] = ec._LeaveType_autoApprove(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "archived":
+
+			out.Values[i] = ec._LeaveType_archived(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "updatedByUserId":
+
+			out.Values[i] = ec._LeaveType_updatedByUserId(ctx, field, obj)
+
+		case "updatedAt":
+
+			out.Values[i] = ec._LeaveType_updatedAt(ctx, field, obj)
+
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var leaveTypeResponseImplementors = []string{"LeaveTypeResponse"}
+
+func (ec *executionContext) _LeaveTypeResponse(ctx context.Context, sel ast.SelectionSet, obj *model.LeaveTypeResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, leaveTypeResponseImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("LeaveTypeResponse")
+		case "errors":
+
+			out.Values[i] = ec._LeaveTypeResponse_errors(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "leaveType":
+
+			out.Values[i] = ec._LeaveTypeResponse_leaveType(ctx, field, obj)
+
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var mutationImplementors = []string{"Mutation"}
+
+func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, mutationImplementors)
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Mutation",
+	})
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
+			Object: field.Name,
+			Field:  field,
+		})
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Mutation")
+		case "createRequestTimeOff":
+
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_createRequestTimeOff(ctx, field)
+			})
+
+		case "updateRequestTimeOff":
+
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_updateRequestTimeOff(ctx, field)
+			})
+
+		case "deleteRequestTimeOff":
+
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_deleteRequestTimeOff(ctx, field)
+			})
+
+		case "cancelRequestTimeOff":
+
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_cancelRequestTimeOff(ctx, field)
+			})
+
+		case "approveRequestTimeOff":
+
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_approveRequestTimeOff(ctx, field)
+			})
+
+		case "denyRequestTimeOff":
+
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_denyRequestTimeOff(ctx, field)
+			})
+
+		case "approveRequestTimeOffs":
+
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_approveRequestTimeOffs(ctx, field)
+			})
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "denyRequestTimeOffs":
+
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_denyRequestTimeOffs(ctx, field)
+			})
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "addRequestComment":
+
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_addRequestComment(ctx, field)
+			})
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "setRequestExpirySettings":
+
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_setRequestExpirySettings(ctx, field)
+			})
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "setTimeOffAccrualPolicy":
+
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_setTimeOffAccrualPolicy(ctx, field)
+			})
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "adjustTimeOffBalance":
+
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_adjustTimeOffBalance(ctx, field)
+			})
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "recordHoursWorked":
+
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_recordHoursWorked(ctx, field)
+			})
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "setShiftConflictSettings":
+
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_setShiftConflictSettings(ctx, field)
+			})
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "setLeaveType":
+
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_setLeaveType(ctx, field)
+			})
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "createBlackoutPeriod":
+
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_createBlackoutPeriod(ctx, field)
+			})
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "updateBlackoutPeriod":
+
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_updateBlackoutPeriod(ctx, field)
+			})
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "deleteBlackoutPeriod":
+
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_deleteBlackoutPeriod(ctx, field)
+			})
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "setAbsenceLimit":
+
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_setAbsenceLimit(ctx, field)
+			})
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var pageInfoImplementors = []string{"PageInfo"}
+
+func (ec *executionContext) _PageInfo(ctx context.Context, sel ast.SelectionSet, obj *model.PageInfo) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, pageInfoImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PageInfo")
+		case "hasNextPage":
+
+			out.Values[i] = ec._PageInfo_hasNextPage(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "hasPreviousPage":
+
+			out.Values[i] = ec._PageInfo_hasPreviousPage(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "startCursor":
+
+			out.Values[i] = ec._PageInfo_startCursor(ctx, field, obj)
+
+		case "endCursor":
+
+			out.Values[i] = ec._PageInfo_endCursor(ctx, field, obj)
+
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var queryImplementors = []string{"Query"}
+
+func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, queryImplementors)
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Query",
+	})
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
+			Object: field.Name,
+			Field:  field,
+		})
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Query")
+		case "getRequestTimeOff":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getRequestTimeOff(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return rrm(innerCtx)
+			})
+		case "getRequestTimeOffs":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getRequestTimeOffs(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return rrm(innerCtx)
+			})
+		case "getRequestTimeOffsConnection":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getRequestTimeOffsConnection(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return rrm(innerCtx)
+			})
+		case "getRequestTimeOffsByChannelIdRequestId":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getRequestTimeOffsByChannelIdRequestId(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return rrm(innerCtx)
+			})
+		case "getRequestExpirySettings":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getRequestExpirySettings(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return rrm(innerCtx)
+			})
+		case "timeOffBalance":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_timeOffBalance(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return rrm(innerCtx)
+			})
+		case "getTimeOffAccrualPolicies":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getTimeOffAccrualPolicies(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return rrm(innerCtx)
+			})
+		case "getShiftConflictSettings":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getShiftConflictSettings(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return rrm(innerCtx)
+			})
+		case "getLeaveTypes":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getLeaveTypes(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return rrm(innerCtx)
+			})
+		case "getBlackoutPeriods":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getBlackoutPeriods(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return rrm(innerCtx)
+			})
+		case "getAbsenceLimits":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getAbsenceLimits(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return rrm(innerCtx)
+			})
+		case "getApprovedTimeOffs":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getApprovedTimeOffs(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return rrm(innerCtx)
+			})
+		case "__type":
+
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Query___type(ctx, field)
+			})
+
+		case "__schema":
+
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Query___schema(ctx, field)
+			})
+
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var requestCommentImplementors = []string{"RequestComment"}
+
+func (ec *executionContext) _RequestComment(ctx context.Context, sel ast.SelectionSet, obj *model.RequestComment) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, requestCommentImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RequestComment")
+		case "id":
+
+			out.Values[i] = ec._RequestComment_id(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "userId":
+
+			out.Values[i] = ec._RequestComment_userId(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "user":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._RequestComment_user(ctx, field, obj)
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "body":
+
+			out.Values[i] = ec._RequestComment_body(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "createdAt":
+
+			out.Values[i] = ec._RequestComment_createdAt(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var requestCommentResponseImplementors = []string{"RequestCommentResponse"}
+
+func (ec *executionContext) _RequestCommentResponse(ctx context.Context, sel ast.SelectionSet, obj *model.RequestCommentResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, requestCommentResponseImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RequestCommentResponse")
+		case "errors":
+
+			out.Values[i] = ec._RequestCommentResponse_errors(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "comment":
+
+			out.Values[i] = ec._RequestCommentResponse_comment(ctx, field, obj)
+
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var requestExpirySettingsImplementors = []string{"RequestExpirySettings"}
+
+func (ec *executionContext) _RequestExpirySettings(ctx context.Context, sel ast.SelectionSet, obj *model.RequestExpirySettings) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, requestExpirySettingsImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RequestExpirySettings")
+		case "channelId":
+
+			out.Values[i] = ec._RequestExpirySettings_channelId(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "enabled":
+
+			out.Values[i] = ec._RequestExpirySettings_enabled(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "gracePeriodMinutes":
+
+			out.Values[i] = ec._RequestExpirySettings_gracePeriodMinutes(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "updatedByUserId":
+
+			out.Values[i] = ec._RequestExpirySettings_updatedByUserId(ctx, field, obj)
+
+		case "updatedAt":
+
+			out.Values[i] = ec._RequestExpirySettings_updatedAt(ctx, field, obj)
+
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var requestExpirySettingsResponseImplementors = []string{"RequestExpirySettingsResponse"}
+
+func (ec *executionContext) _RequestExpirySettingsResponse(ctx context.Context, sel ast.SelectionSet, obj *model.RequestExpirySettingsResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, requestExpirySettingsResponseImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RequestExpirySettingsResponse")
+		case "errors":
+
+			out.Values[i] = ec._RequestExpirySettingsResponse_errors(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "settings":
+
+			out.Values[i] = ec._RequestExpirySettingsResponse_settings(ctx, field, obj)
+
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var requestHistoryEntryImplementors = []string{"RequestHistoryEntry"}
+
+func (ec *executionContext) _RequestHistoryEntry(ctx context.Context, sel ast.SelectionSet, obj *model.RequestHistoryEntry) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, requestHistoryEntryImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RequestHistoryEntry")
+		case "id":
+
+			out.Values[i] = ec._RequestHistoryEntry_id(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "action":
+
+			out.Values[i] = ec._RequestHistoryEntry_action(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "field":
+
+			out.Values[i] = ec._RequestHistoryEntry_field(ctx, field, obj)
+
+		case "oldValue":
+
+			out.Values[i] = ec._RequestHistoryEntry_oldValue(ctx, field, obj)
+
+		case "newValue":
+
+			out.Values[i] = ec._RequestHistoryEntry_newValue(ctx, field, obj)
+
+		case "actorUserId":
+
+			out.Values[i] = ec._RequestHistoryEntry_actorUserId(ctx, field, obj)
+
+		case "actor":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._RequestHistoryEntry_actor(ctx, field, obj)
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "createdAt":
+
+			out.Values[i] = ec._RequestHistoryEntry_createdAt(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var requestResponseImplementors = []string{"RequestResponse"}
+
+func (ec *executionContext) _RequestResponse(ctx context.Context, sel ast.SelectionSet, obj *model.RequestResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, requestResponseImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RequestResponse")
+		case "channelId":
+
+			out.Values[i] = ec._RequestResponse_channelId(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "createdAt":
+
+			out.Values[i] = ec._RequestResponse_createdAt(ctx, field, obj)
+
+		case "endTime":
+
+			out.Values[i] = ec._RequestResponse_endTime(ctx, field, obj)
+
+		case "id":
+
+			out.Values[i] = ec._RequestResponse_id(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "isAllDay":
+
+			out.Values[i] = ec._RequestResponse_isAllDay(ctx, field, obj)
+
+		case "reason":
+
+			out.Values[i] = ec._RequestResponse_reason(ctx, field, obj)
+
+		case "requestId":
+
+			out.Values[i] = ec._RequestResponse_requestId(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "requestNote":
+
+			out.Values[i] = ec._RequestResponse_requestNote(ctx, field, obj)
+
+		case "responseAt":
+
+			out.Values[i] = ec._RequestResponse_responseAt(ctx, field, obj)
+
+		case "responseBy":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._RequestResponse_responseBy(ctx, field, obj)
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "responseNote":
+
+			out.Values[i] = ec._RequestResponse_responseNote(ctx, field, obj)
+
+		case "shiftOfferedTo":
+
+			out.Values[i] = ec._RequestResponse_shiftOfferedTo(ctx, field, obj)
+
+		case "shiftToOffer":
+
+			out.Values[i] = ec._RequestResponse_shiftToOffer(ctx, field, obj)
+
+		case "shiftToSwap":
+
+			out.Values[i] = ec._RequestResponse_shiftToSwap(ctx, field, obj)
+
+		case "startTime":
+
+			out.Values[i] = ec._RequestResponse_startTime(ctx, field, obj)
+
+		case "status":
+
+			out.Values[i] = ec._RequestResponse_status(ctx, field, obj)
+
+		case "toSwapWith":
+
+			out.Values[i] = ec._RequestResponse_toSwapWith(ctx, field, obj)
+
+		case "type":
+
+			out.Values[i] = ec._RequestResponse_type(ctx, field, obj)
+
+		case "user":
+
+			out.Values[i] = ec._RequestResponse_user(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "history":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._RequestResponse_history(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "comments":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._RequestResponse_comments(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
 
-func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Schema_mutationType(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.MutationType(), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
 	}
-	if resTmp == nil {
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
-	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return out
 }
 
-func (ec *executionContext) fieldContext___Schema_mutationType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Schema",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+var requestTimeOffImplementors = []string{"RequestTimeOff"}
+
+func (ec *executionContext) _RequestTimeOff(ctx context.Context, sel ast.SelectionSet, obj *model.RequestTimeOff) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, requestTimeOffImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RequestTimeOff")
+		case "id":
+
+			out.Values[i] = ec._RequestTimeOff_id(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
-	}
-	return fc, nil
-}
+		case "userId":
 
-func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Schema_subscriptionType(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.SubscriptionType(), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*introspection.Type)
-	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
-}
+			out.Values[i] = ec._RequestTimeOff_userId(ctx, field, obj)
 
-func (ec *executionContext) fieldContext___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Schema",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
-	}
-	return fc, nil
-}
+		case "channelId":
 
-func (ec *executionContext) ___Schema_directives(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Schema_directives(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Directives(), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.([]introspection.Directive)
-	fc.Result = res
-	return ec.marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ(ctx, field.Selections, res)
-}
+			out.Values[i] = ec._RequestTimeOff_channelId(ctx, field, obj)
+
+		case "requestId":
+
+			out.Values[i] = ec._RequestTimeOff_requestId(ctx, field, obj)
+
+		case "startTime":
+
+			out.Values[i] = ec._RequestTimeOff_startTime(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "endTime":
+
+			out.Values[i] = ec._RequestTimeOff_endTime(ctx, field, obj)
+
+		case "is24Hours":
+
+			out.Values[i] = ec._RequestTimeOff_is24Hours(ctx, field, obj)
+
+		case "reason":
+
+			out.Values[i] = ec._RequestTimeOff_reason(ctx, field, obj)
+
+		case "leaveType":
+
+			out.Values[i] = ec._RequestTimeOff_leaveType(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "leaveTypeDetails":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._RequestTimeOff_leaveTypeDetails(ctx, field, obj)
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "attachmentUrl":
+
+			out.Values[i] = ec._RequestTimeOff_attachmentUrl(ctx, field, obj)
+
+		case "blackoutOverrideReason":
+
+			out.Values[i] = ec._RequestTimeOff_blackoutOverrideReason(ctx, field, obj)
+
+		case "blackoutOverrideByUserId":
+
+			out.Values[i] = ec._RequestTimeOff_blackoutOverrideByUserId(ctx, field, obj)
+
+		case "capacityOverrideReason":
+
+			out.Values[i] = ec._RequestTimeOff_capacityOverrideReason(ctx, field, obj)
+
+		case "capacityOverrideByUserId":
+
+			out.Values[i] = ec._RequestTimeOff_capacityOverrideByUserId(ctx, field, obj)
+
+		case "requestNote":
+
+			out.Values[i] = ec._RequestTimeOff_requestNote(ctx, field, obj)
+
+		case "status":
+
+			out.Values[i] = ec._RequestTimeOff_status(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "responseNote":
+
+			out.Values[i] = ec._RequestTimeOff_responseNote(ctx, field, obj)
+
+		case "responseByUserId":
+
+			out.Values[i] = ec._RequestTimeOff_responseByUserId(ctx, field, obj)
+
+		case "responseAt":
+
+			out.Values[i] = ec._RequestTimeOff_responseAt(ctx, field, obj)
+
+		case "createdAt":
+
+			out.Values[i] = ec._RequestTimeOff_createdAt(ctx, field, obj)
 
-func (ec *executionContext) fieldContext___Schema_directives(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Schema",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___Directive_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Directive_description(ctx, field)
-			case "locations":
-				return ec.fieldContext___Directive_locations(ctx, field)
-			case "args":
-				return ec.fieldContext___Directive_args(ctx, field)
-			case "isRepeatable":
-				return ec.fieldContext___Directive_isRepeatable(ctx, field)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Directive", field.Name)
-		},
-	}
-	return fc, nil
-}
+		case "history":
+			field := field
 
-func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_kind(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Kind(), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalN__TypeKind2string(ctx, field.Selections, res)
-}
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._RequestTimeOff_history(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
 
-func (ec *executionContext) fieldContext___Type_kind(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type __TypeKind does not have child fields")
-		},
-	}
-	return fc, nil
-}
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
 
-func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_name(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Name(), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
-}
+			})
+		case "comments":
+			field := field
 
-func (ec *executionContext) fieldContext___Type_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._RequestTimeOff_comments(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
 
-func (ec *executionContext) ___Type_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_description(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
 	}
-	if resTmp == nil {
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return out
 }
 
-func (ec *executionContext) fieldContext___Type_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+var requestTimeOffConnectionImplementors = []string{"RequestTimeOffConnection"}
 
-func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_fields(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Fields(fc.Args["includeDeprecated"].(bool)), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.([]introspection.Field)
-	fc.Result = res
-	return ec.marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐFieldᚄ(ctx, field.Selections, res)
-}
+func (ec *executionContext) _RequestTimeOffConnection(ctx context.Context, sel ast.SelectionSet, obj *model.RequestTimeOffConnection) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, requestTimeOffConnectionImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RequestTimeOffConnection")
+		case "edges":
 
-func (ec *executionContext) fieldContext___Type_fields(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___Field_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Field_description(ctx, field)
-			case "args":
-				return ec.fieldContext___Field_args(ctx, field)
-			case "type":
-				return ec.fieldContext___Field_type(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___Field_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___Field_deprecationReason(ctx, field)
+			out.Values[i] = ec._RequestTimeOffConnection_edges(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Field", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Type_fields_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
-	return fc, nil
-}
+		case "pageInfo":
 
-func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_interfaces(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+			out.Values[i] = ec._RequestTimeOffConnection_pageInfo(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Interfaces(), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
 	}
-	if resTmp == nil {
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Type)
-	fc.Result = res
-	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+	return out
 }
 
-func (ec *executionContext) fieldContext___Type_interfaces(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+var requestTimeOffEdgeImplementors = []string{"RequestTimeOffEdge"}
+
+func (ec *executionContext) _RequestTimeOffEdge(ctx context.Context, sel ast.SelectionSet, obj *model.RequestTimeOffEdge) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, requestTimeOffEdgeImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RequestTimeOffEdge")
+		case "cursor":
+
+			out.Values[i] = ec._RequestTimeOffEdge_cursor(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
-	}
-	return fc, nil
-}
+		case "node":
 
-func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_possibleTypes(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+			out.Values[i] = ec._RequestTimeOffEdge_node(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.PossibleTypes(), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
 	}
-	if resTmp == nil {
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Type)
-	fc.Result = res
-	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+	return out
 }
 
-func (ec *executionContext) fieldContext___Type_possibleTypes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+var requestTimeOffResultImplementors = []string{"RequestTimeOffResult"}
+
+func (ec *executionContext) _RequestTimeOffResult(ctx context.Context, sel ast.SelectionSet, obj *model.RequestTimeOffResult) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, requestTimeOffResultImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RequestTimeOffResult")
+		case "id":
+
+			out.Values[i] = ec._RequestTimeOffResult_id(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
-	}
-	return fc, nil
-}
+		case "errors":
 
-func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_enumValues(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+			out.Values[i] = ec._RequestTimeOffResult_errors(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "request":
+
+			out.Values[i] = ec._RequestTimeOffResult_request(ctx, field, obj)
+
+		case "shiftConflicts":
+
+			out.Values[i] = ec._RequestTimeOffResult_shiftConflicts(ctx, field, obj)
+
+		case "capacityWarnings":
+
+			out.Values[i] = ec._RequestTimeOffResult_capacityWarnings(ctx, field, obj)
+
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.EnumValues(fc.Args["includeDeprecated"].(bool)), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
 	}
-	if resTmp == nil {
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.EnumValue)
-	fc.Result = res
-	return ec.marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ(ctx, field.Selections, res)
+	return out
 }
 
-func (ec *executionContext) fieldContext___Type_enumValues(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___EnumValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___EnumValue_description(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___EnumValue_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___EnumValue_deprecationReason(ctx, field)
+var shiftConflictImplementors = []string{"ShiftConflict"}
+
+func (ec *executionContext) _ShiftConflict(ctx context.Context, sel ast.SelectionSet, obj *model.ShiftConflict) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, shiftConflictImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ShiftConflict")
+		case "shift":
+
+			out.Values[i] = ec._ShiftConflict_shift(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __EnumValue", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+		case "converted":
+
+			out.Values[i] = ec._ShiftConflict_converted(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Type_enumValues_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
 	}
-	return fc, nil
-}
-
-func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_inputFields(ctx, field)
-	if err != nil {
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	return out
+}
+
+var shiftConflictSettingsImplementors = []string{"ShiftConflictSettings"}
+
+func (ec *executionContext) _ShiftConflictSettings(ctx context.Context, sel ast.SelectionSet, obj *model.ShiftConflictSettings) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, shiftConflictSettingsImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ShiftConflictSettings")
+		case "channelId":
+
+			out.Values[i] = ec._ShiftConflictSettings_channelId(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "policy":
+
+			out.Values[i] = ec._ShiftConflictSettings_policy(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "updatedByUserId":
+
+			out.Values[i] = ec._ShiftConflictSettings_updatedByUserId(ctx, field, obj)
+
+		case "updatedAt":
+
+			out.Values[i] = ec._ShiftConflictSettings_updatedAt(ctx, field, obj)
+
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.InputFields(), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
 	}
-	if resTmp == nil {
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.InputValue)
-	fc.Result = res
-	return ec.marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+	return out
 }
 
-func (ec *executionContext) fieldContext___Type_inputFields(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___InputValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___InputValue_description(ctx, field)
-			case "type":
-				return ec.fieldContext___InputValue_type(ctx, field)
-			case "defaultValue":
-				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+var shiftConflictSettingsResponseImplementors = []string{"ShiftConflictSettingsResponse"}
+
+func (ec *executionContext) _ShiftConflictSettingsResponse(ctx context.Context, sel ast.SelectionSet, obj *model.ShiftConflictSettingsResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, shiftConflictSettingsResponseImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ShiftConflictSettingsResponse")
+		case "errors":
+
+			out.Values[i] = ec._ShiftConflictSettingsResponse_errors(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
-		},
-	}
-	return fc, nil
-}
+		case "settings":
 
-func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_ofType(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+			out.Values[i] = ec._ShiftConflictSettingsResponse_settings(ctx, field, obj)
+
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.OfType(), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
 	}
-	if resTmp == nil {
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
-	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return out
 }
 
-func (ec *executionContext) fieldContext___Type_ofType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+var shiftErrorImplementors = []string{"ShiftError"}
+
+func (ec *executionContext) _ShiftError(ctx context.Context, sel ast.SelectionSet, obj *model.ShiftError) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, shiftErrorImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ShiftError")
+		case "code":
+
+			out.Values[i] = ec._ShiftError_code(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
-	}
-	return fc, nil
-}
+		case "field":
 
-func (ec *executionContext) ___Type_specifiedByURL(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_specifiedByURL(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+			out.Values[i] = ec._ShiftError_field(ctx, field, obj)
+
+		case "message":
+
+			out.Values[i] = ec._ShiftError_message(ctx, field, obj)
+
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.SpecifiedByURL(), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
 	}
-	if resTmp == nil {
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return out
 }
 
-func (ec *executionContext) fieldContext___Type_specifiedByURL(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+var timeOffAccrualPolicyImplementors = []string{"TimeOffAccrualPolicy"}
+
+func (ec *executionContext) _TimeOffAccrualPolicy(ctx context.Context, sel ast.SelectionSet, obj *model.TimeOffAccrualPolicy) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, timeOffAccrualPolicyImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("TimeOffAccrualPolicy")
+		case "channelId":
+
+			out.Values[i] = ec._TimeOffAccrualPolicy_channelId(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "leaveType":
+
+			out.Values[i] = ec._TimeOffAccrualPolicy_leaveType(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "method":
+
+			out.Values[i] = ec._TimeOffAccrualPolicy_method(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "hours":
+
+			out.Values[i] = ec._TimeOffAccrualPolicy_hours(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "rate":
+
+			out.Values[i] = ec._TimeOffAccrualPolicy_rate(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "periodDays":
+
+			out.Values[i] = ec._TimeOffAccrualPolicy_periodDays(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "periodStart":
+
+			out.Values[i] = ec._TimeOffAccrualPolicy_periodStart(ctx, field, obj)
+
+		case "grantMonth":
+
+			out.Values[i] = ec._TimeOffAccrualPolicy_grantMonth(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "grantDay":
+
+			out.Values[i] = ec._TimeOffAccrualPolicy_grantDay(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "carryOverCap":
+
+			out.Values[i] = ec._TimeOffAccrualPolicy_carryOverCap(ctx, field, obj)
+
+		case "hoursPerDay":
+
+			out.Values[i] = ec._TimeOffAccrualPolicy_hoursPerDay(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "includeWeekends":
+
+			out.Values[i] = ec._TimeOffAccrualPolicy_includeWeekends(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "enabled":
+
+			out.Values[i] = ec._TimeOffAccrualPolicy_enabled(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "updatedByUserId":
+
+			out.Values[i] = ec._TimeOffAccrualPolicy_updatedByUserId(ctx, field, obj)
+
+		case "updatedAt":
+
+			out.Values[i] = ec._TimeOffAccrualPolicy_updatedAt(ctx, field, obj)
+
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
 	}
-	return fc, nil
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
 }
 
-// endregion **************************** field.gotpl *****************************
+var timeOffAccrualPolicyResponseImplementors = []string{"TimeOffAccrualPolicyResponse"}
+
+func (ec *executionContext) _TimeOffAccrualPolicyResponse(ctx context.Context, sel ast.SelectionSet, obj *model.TimeOffAccrualPolicyResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, timeOffAccrualPolicyResponseImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("TimeOffAccrualPolicyResponse")
+		case "errors":
+
+			out.Values[i] = ec._TimeOffAccrualPolicyResponse_errors(ctx, field, obj)
 
-// region    **************************** input.gotpl *****************************
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "policy":
 
-func (ec *executionContext) unmarshalInputRequestTimeOffInput(ctx context.Context, obj interface{}) (model.RequestTimeOffInput, error) {
-	var it model.RequestTimeOffInput
-	asMap := map[string]interface{}{}
-	for k, v := range obj.(map[string]interface{}) {
-		asMap[k] = v
-	}
+			out.Values[i] = ec._TimeOffAccrualPolicyResponse_policy(ctx, field, obj)
 
-	fieldsInOrder := [...]string{"userId", "channelId", "startTime", "endTime", "is24Hours", "reason", "requestNote", "responseNote", "responseByUserId", "responseAt"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-		switch k {
-		case "userId":
-			var err error
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("userId"))
-			it.UserID, err = ec.unmarshalNID2string(ctx, v)
-			if err != nil {
-				return it, err
+var timeOffBalanceImplementors = []string{"TimeOffBalance"}
+
+func (ec *executionContext) _TimeOffBalance(ctx context.Context, sel ast.SelectionSet, obj *model.TimeOffBalance) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, timeOffBalanceImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("TimeOffBalance")
+		case "id":
+
+			out.Values[i] = ec._TimeOffBalance_id(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
 			}
 		case "channelId":
-			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("channelId"))
-			it.ChannelID, err = ec.unmarshalNID2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "startTime":
-			var err error
+			out.Values[i] = ec._TimeOffBalance_channelId(ctx, field, obj)
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("startTime"))
-			it.StartTime, err = ec.unmarshalNTime2timeᚐTime(ctx, v)
-			if err != nil {
-				return it, err
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
 			}
-		case "endTime":
-			var err error
+		case "userId":
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("endTime"))
-			it.EndTime, err = ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "is24Hours":
-			var err error
+			out.Values[i] = ec._TimeOffBalance_userId(ctx, field, obj)
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("is24Hours"))
-			it.Is24Hours, err = ec.unmarshalNBoolean2bool(ctx, v)
-			if err != nil {
-				return it, err
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
 			}
-		case "reason":
-			var err error
+		case "leaveType":
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("reason"))
-			it.Reason, err = ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
+			out.Values[i] = ec._TimeOffBalance_leaveType(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
 			}
-		case "requestNote":
-			var err error
+		case "balanceHours":
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("requestNote"))
-			it.RequestNote, err = ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
+			out.Values[i] = ec._TimeOffBalance_balanceHours(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
 			}
-		case "responseNote":
-			var err error
+		case "createdAt":
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("responseNote"))
-			it.ResponseNote, err = ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
+			out.Values[i] = ec._TimeOffBalance_createdAt(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
 			}
-		case "responseByUserId":
-			var err error
+		case "updatedAt":
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("responseByUserId"))
-			it.ResponseByUserID, err = ec.unmarshalOID2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
+			out.Values[i] = ec._TimeOffBalance_updatedAt(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
 			}
-		case "responseAt":
-			var err error
+		case "history":
+			field := field
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("responseAt"))
-			it.ResponseAt, err = ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
-			if err != nil {
-				return it, err
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._TimeOffBalance_history(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
 			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
-
-	return it, nil
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
 }
 
-func (ec *executionContext) unmarshalInputRequestsInput(ctx context.Context, obj interface{}) (model.RequestsInput, error) {
-	var it model.RequestsInput
-	asMap := map[string]interface{}{}
-	for k, v := range obj.(map[string]interface{}) {
-		asMap[k] = v
-	}
+var timeOffBalanceResponseImplementors = []string{"TimeOffBalanceResponse"}
 
-	fieldsInOrder := [...]string{"channelId", "userId", "recipientId", "type"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "channelId":
-			var err error
+func (ec *executionContext) _TimeOffBalanceResponse(ctx context.Context, sel ast.SelectionSet, obj *model.TimeOffBalanceResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, timeOffBalanceResponseImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("TimeOffBalanceResponse")
+		case "errors":
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("channelId"))
-			it.ChannelID, err = ec.unmarshalOID2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "userId":
-			var err error
+			out.Values[i] = ec._TimeOffBalanceResponse_errors(ctx, field, obj)
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("userId"))
-			it.UserID, err = ec.unmarshalOID2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
-		case "recipientId":
-			var err error
+		case "balances":
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("recipientId"))
-			it.RecipientID, err = ec.unmarshalOID2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "type":
-			var err error
+			out.Values[i] = ec._TimeOffBalanceResponse_balances(ctx, field, obj)
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("type"))
-			it.Type, err = ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
-
-	return it, nil
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
 }
 
-// endregion **************************** input.gotpl *****************************
-
-// region    ************************** interface.gotpl ***************************
-
-// endregion ************************** interface.gotpl ***************************
-
-// region    **************************** object.gotpl ****************************
-
-var assignedShiftImplementors = []string{"AssignedShift"}
+var timeOffLedgerEntryImplementors = []string{"TimeOffLedgerEntry"}
 
-func (ec *executionContext) _AssignedShift(ctx context.Context, sel ast.SelectionSet, obj *model.AssignedShift) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, assignedShiftImplementors)
+func (ec *executionContext) _TimeOffLedgerEntry(ctx context.Context, sel ast.SelectionSet, obj *model.TimeOffLedgerEntry) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, timeOffLedgerEntryImplementors)
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("AssignedShift")
+			out.Values[i] = graphql.MarshalString("TimeOffLedgerEntry")
 		case "id":
 
-			out.Values[i] = ec._AssignedShift_id(ctx, field, obj)
+			out.Values[i] = ec._TimeOffLedgerEntry_id(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "break":
+		case "kind":
 
-			out.Values[i] = ec._AssignedShift_break(ctx, field, obj)
+			out.Values[i] = ec._TimeOffLedgerEntry_kind(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "hours":
+
+			out.Values[i] = ec._TimeOffLedgerEntry_hours(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "balanceAfter":
+
+			out.Values[i] = ec._TimeOffLedgerEntry_balanceAfter(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "requestTimeOffId":
+
+			out.Values[i] = ec._TimeOffLedgerEntry_requestTimeOffId(ctx, field, obj)
+
+		case "period":
+
+			out.Values[i] = ec._TimeOffLedgerEntry_period(ctx, field, obj)
+
+		case "note":
+
+			out.Values[i] = ec._TimeOffLedgerEntry_note(ctx, field, obj)
+
+		case "actorUserId":
+
+			out.Values[i] = ec._TimeOffLedgerEntry_actorUserId(ctx, field, obj)
+
+		case "createdAt":
+
+			out.Values[i] = ec._TimeOffLedgerEntry_createdAt(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var timeOffResponseImplementors = []string{"TimeOffResponse"}
+
+func (ec *executionContext) _TimeOffResponse(ctx context.Context, sel ast.SelectionSet, obj *model.TimeOffResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, timeOffResponseImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("TimeOffResponse")
+		case "errors":
+
+			out.Values[i] = ec._TimeOffResponse_errors(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "color":
+		case "request":
 
-			out.Values[i] = ec._AssignedShift_color(ctx, field, obj)
+			out.Values[i] = ec._TimeOffResponse_request(ctx, field, obj)
 
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "startTime":
+		case "shiftConflicts":
 
-			out.Values[i] = ec._AssignedShift_startTime(ctx, field, obj)
+			out.Values[i] = ec._TimeOffResponse_shiftConflicts(ctx, field, obj)
 
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "endTime":
+		case "capacityWarnings":
 
-			out.Values[i] = ec._AssignedShift_endTime(ctx, field, obj)
+			out.Values[i] = ec._TimeOffResponse_capacityWarnings(ctx, field, obj)
 
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "is24Hours":
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
 
-			out.Values[i] = ec._AssignedShift_is24Hours(ctx, field, obj)
+var userImplementors = []string{"User"}
 
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "label":
+func (ec *executionContext) _User(ctx context.Context, sel ast.SelectionSet, obj *model.User) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, userImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("User")
+		case "id":
 
-			out.Values[i] = ec._AssignedShift_label(ctx, field, obj)
+			out.Values[i] = ec._User_id(ctx, field, obj)
 
-		case "note":
+		case "firstName":
 
-			out.Values[i] = ec._AssignedShift_note(ctx, field, obj)
+			out.Values[i] = ec._User_firstName(ctx, field, obj)
 
-		case "shiftToOffer":
+		case "lastName":
 
-			out.Values[i] = ec._AssignedShift_shiftToOffer(ctx, field, obj)
+			out.Values[i] = ec._User_lastName(ctx, field, obj)
 
-		case "shiftToSwap":
+		case "email":
 
-			out.Values[i] = ec._AssignedShift_shiftToSwap(ctx, field, obj)
+			out.Values[i] = ec._User_email(ctx, field, obj)
 
-		case "toSwapWith":
+		case "avatar":
 
-			out.Values[i] = ec._AssignedShift_toSwapWith(ctx, field, obj)
+			out.Values[i] = ec._User_avatar(ctx, field, obj)
 
-		case "userId":
+		case "isActive":
 
-			out.Values[i] = ec._AssignedShift_userId(ctx, field, obj)
+			out.Values[i] = ec._User_isActive(ctx, field, obj)
 
-		case "channelId":
+		case "isStaff":
 
-			out.Values[i] = ec._AssignedShift_channelId(ctx, field, obj)
+			out.Values[i] = ec._User_isStaff(ctx, field, obj)
 
-		case "shiftGroupId":
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
 
-			out.Values[i] = ec._AssignedShift_shiftGroupId(ctx, field, obj)
+var __DirectiveImplementors = []string{"__Directive"}
 
-		case "type":
+func (ec *executionContext) ___Directive(ctx context.Context, sel ast.SelectionSet, obj *introspection.Directive) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __DirectiveImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__Directive")
+		case "name":
 
-			out.Values[i] = ec._AssignedShift_type(ctx, field, obj)
+			out.Values[i] = ec.___Directive_name(ctx, field, obj)
 
-		case "isOpen":
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "description":
 
-			out.Values[i] = ec._AssignedShift_isOpen(ctx, field, obj)
+			out.Values[i] = ec.___Directive_description(ctx, field, obj)
 
-		case "isShared":
+		case "locations":
 
-			out.Values[i] = ec._AssignedShift_isShared(ctx, field, obj)
+			out.Values[i] = ec.___Directive_locations(ctx, field, obj)
 
-		case "ShiftActivities":
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "args":
 
-			out.Values[i] = ec._AssignedShift_ShiftActivities(ctx, field, obj)
+			out.Values[i] = ec.___Directive_args(ctx, field, obj)
 
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "isRepeatable":
+
+			out.Values[i] = ec.___Directive_isRepeatable(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -7582,75 +21079,95 @@ func (ec *executionContext) _AssignedShift(ctx context.Context, sel ast.Selectio
 	return out
 }
 
-var assignedShiftActivitiesImplementors = []string{"AssignedShiftActivities"}
+var __EnumValueImplementors = []string{"__EnumValue"}
 
-func (ec *executionContext) _AssignedShiftActivities(ctx context.Context, sel ast.SelectionSet, obj *model.AssignedShiftActivities) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, assignedShiftActivitiesImplementors)
+func (ec *executionContext) ___EnumValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.EnumValue) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __EnumValueImplementors)
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("AssignedShiftActivities")
-		case "id":
+			out.Values[i] = graphql.MarshalString("__EnumValue")
+		case "name":
 
-			out.Values[i] = ec._AssignedShiftActivities_id(ctx, field, obj)
+			out.Values[i] = ec.___EnumValue_name(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "channelId":
-
-			out.Values[i] = ec._AssignedShiftActivities_channelId(ctx, field, obj)
-
-		case "shiftGroupId":
+		case "description":
 
-			out.Values[i] = ec._AssignedShiftActivities_shiftGroupId(ctx, field, obj)
+			out.Values[i] = ec.___EnumValue_description(ctx, field, obj)
 
-		case "assignedShiftId":
+		case "isDeprecated":
 
-			out.Values[i] = ec._AssignedShiftActivities_assignedShiftId(ctx, field, obj)
+			out.Values[i] = ec.___EnumValue_isDeprecated(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "userId":
+		case "deprecationReason":
 
-			out.Values[i] = ec._AssignedShiftActivities_userId(ctx, field, obj)
+			out.Values[i] = ec.___EnumValue_deprecationReason(ctx, field, obj)
 
-		case "name":
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
 
-			out.Values[i] = ec._AssignedShiftActivities_name(ctx, field, obj)
+var __FieldImplementors = []string{"__Field"}
 
-		case "code":
+func (ec *executionContext) ___Field(ctx context.Context, sel ast.SelectionSet, obj *introspection.Field) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __FieldImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__Field")
+		case "name":
 
-			out.Values[i] = ec._AssignedShiftActivities_code(ctx, field, obj)
+			out.Values[i] = ec.___Field_name(ctx, field, obj)
 
-		case "color":
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "description":
 
-			out.Values[i] = ec._AssignedShiftActivities_color(ctx, field, obj)
+			out.Values[i] = ec.___Field_description(ctx, field, obj)
 
-		case "startTime":
+		case "args":
 
-			out.Values[i] = ec._AssignedShiftActivities_startTime(ctx, field, obj)
+			out.Values[i] = ec.___Field_args(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "endTime":
+		case "type":
 
-			out.Values[i] = ec._AssignedShiftActivities_endTime(ctx, field, obj)
+			out.Values[i] = ec.___Field_type(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "isPaid":
+		case "isDeprecated":
 
-			out.Values[i] = ec._AssignedShiftActivities_isPaid(ctx, field, obj)
+			out.Values[i] = ec.___Field_isDeprecated(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
+		case "deprecationReason":
+
+			out.Values[i] = ec.___Field_deprecationReason(ctx, field, obj)
+
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -7662,60 +21179,37 @@ func (ec *executionContext) _AssignedShiftActivities(ctx context.Context, sel as
 	return out
 }
 
-var mutationImplementors = []string{"Mutation"}
-
-func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, mutationImplementors)
-	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
-		Object: "Mutation",
-	})
+var __InputValueImplementors = []string{"__InputValue"}
 
+func (ec *executionContext) ___InputValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.InputValue) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __InputValueImplementors)
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
-		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
-			Object: field.Name,
-			Field:  field,
-		})
-
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Mutation")
-		case "createRequestTimeOff":
-
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_createRequestTimeOff(ctx, field)
-			})
-
-		case "updateRequestTimeOff":
-
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_updateRequestTimeOff(ctx, field)
-			})
-
-		case "deleteRequestTimeOff":
-
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_deleteRequestTimeOff(ctx, field)
-			})
+			out.Values[i] = graphql.MarshalString("__InputValue")
+		case "name":
 
-		case "cancelRequestTimeOff":
+			out.Values[i] = ec.___InputValue_name(ctx, field, obj)
 
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_cancelRequestTimeOff(ctx, field)
-			})
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "description":
 
-		case "approveRequestTimeOff":
+			out.Values[i] = ec.___InputValue_description(ctx, field, obj)
 
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_approveRequestTimeOff(ctx, field)
-			})
+		case "type":
 
-		case "denyRequestTimeOff":
+			out.Values[i] = ec.___InputValue_type(ctx, field, obj)
 
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_denyRequestTimeOff(ctx, field)
-			})
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "defaultValue":
+
+			out.Values[i] = ec.___InputValue_defaultValue(ctx, field, obj)
 
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
@@ -7728,106 +21222,49 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 	return out
 }
 
-var queryImplementors = []string{"Query"}
-
-func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, queryImplementors)
-	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
-		Object: "Query",
-	})
+var __SchemaImplementors = []string{"__Schema"}
 
+func (ec *executionContext) ___Schema(ctx context.Context, sel ast.SelectionSet, obj *introspection.Schema) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __SchemaImplementors)
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
-		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
-			Object: field.Name,
-			Field:  field,
-		})
-
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Query")
-		case "getRequestTimeOff":
-			field := field
-
-			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_getRequestTimeOff(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
-				return res
-			}
+			out.Values[i] = graphql.MarshalString("__Schema")
+		case "description":
 
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
-			}
+			out.Values[i] = ec.___Schema_description(ctx, field, obj)
 
-			out.Concurrently(i, func() graphql.Marshaler {
-				return rrm(innerCtx)
-			})
-		case "getRequestTimeOffs":
-			field := field
+		case "types":
 
-			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_getRequestTimeOffs(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
-				return res
-			}
+			out.Values[i] = ec.___Schema_types(ctx, field, obj)
 
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
+		case "queryType":
 
-			out.Concurrently(i, func() graphql.Marshaler {
-				return rrm(innerCtx)
-			})
-		case "getRequestTimeOffsByChannelIdRequestId":
-			field := field
+			out.Values[i] = ec.___Schema_queryType(ctx, field, obj)
 
-			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_getRequestTimeOffsByChannelIdRequestId(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
-				return res
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
+		case "mutationType":
 
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
-			}
+			out.Values[i] = ec.___Schema_mutationType(ctx, field, obj)
 
-			out.Concurrently(i, func() graphql.Marshaler {
-				return rrm(innerCtx)
-			})
-		case "__type":
+		case "subscriptionType":
 
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Query___type(ctx, field)
-			})
+			out.Values[i] = ec.___Schema_subscriptionType(ctx, field, obj)
 
-		case "__schema":
+		case "directives":
 
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Query___schema(ctx, field)
-			})
+			out.Values[i] = ec.___Schema_directives(ctx, field, obj)
 
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -7839,687 +21276,1078 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 	return out
 }
 
-var requestResponseImplementors = []string{"RequestResponse"}
+var __TypeImplementors = []string{"__Type"}
 
-func (ec *executionContext) _RequestResponse(ctx context.Context, sel ast.SelectionSet, obj *model.RequestResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, requestResponseImplementors)
+func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, obj *introspection.Type) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __TypeImplementors)
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("RequestResponse")
-		case "channelId":
-
-			out.Values[i] = ec._RequestResponse_channelId(ctx, field, obj)
-
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "createdAt":
-
-			out.Values[i] = ec._RequestResponse_createdAt(ctx, field, obj)
-
-		case "endTime":
-
-			out.Values[i] = ec._RequestResponse_endTime(ctx, field, obj)
-
-		case "id":
+			out.Values[i] = graphql.MarshalString("__Type")
+		case "kind":
 
-			out.Values[i] = ec._RequestResponse_id(ctx, field, obj)
+			out.Values[i] = ec.___Type_kind(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "isAllDay":
-
-			out.Values[i] = ec._RequestResponse_isAllDay(ctx, field, obj)
-
-		case "reason":
+		case "name":
 
-			out.Values[i] = ec._RequestResponse_reason(ctx, field, obj)
+			out.Values[i] = ec.___Type_name(ctx, field, obj)
 
-		case "requestId":
+		case "description":
 
-			out.Values[i] = ec._RequestResponse_requestId(ctx, field, obj)
+			out.Values[i] = ec.___Type_description(ctx, field, obj)
 
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "requestNote":
+		case "fields":
 
-			out.Values[i] = ec._RequestResponse_requestNote(ctx, field, obj)
+			out.Values[i] = ec.___Type_fields(ctx, field, obj)
 
-		case "responseAt":
+		case "interfaces":
 
-			out.Values[i] = ec._RequestResponse_responseAt(ctx, field, obj)
+			out.Values[i] = ec.___Type_interfaces(ctx, field, obj)
 
-		case "responseBy":
+		case "possibleTypes":
 
-			out.Values[i] = ec._RequestResponse_responseBy(ctx, field, obj)
+			out.Values[i] = ec.___Type_possibleTypes(ctx, field, obj)
 
-		case "responseNote":
+		case "enumValues":
 
-			out.Values[i] = ec._RequestResponse_responseNote(ctx, field, obj)
+			out.Values[i] = ec.___Type_enumValues(ctx, field, obj)
 
-		case "shiftOfferedTo":
+		case "inputFields":
 
-			out.Values[i] = ec._RequestResponse_shiftOfferedTo(ctx, field, obj)
+			out.Values[i] = ec.___Type_inputFields(ctx, field, obj)
 
-		case "shiftToOffer":
+		case "ofType":
 
-			out.Values[i] = ec._RequestResponse_shiftToOffer(ctx, field, obj)
+			out.Values[i] = ec.___Type_ofType(ctx, field, obj)
 
-		case "shiftToSwap":
+		case "specifiedByURL":
 
-			out.Values[i] = ec._RequestResponse_shiftToSwap(ctx, field, obj)
+			out.Values[i] = ec.___Type_specifiedByURL(ctx, field, obj)
 
-		case "startTime":
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
 
-			out.Values[i] = ec._RequestResponse_startTime(ctx, field, obj)
+// endregion **************************** object.gotpl ****************************
 
-		case "status":
+// region    ***************************** type.gotpl *****************************
 
-			out.Values[i] = ec._RequestResponse_status(ctx, field, obj)
+func (ec *executionContext) marshalNAbsenceLimit2ᚕᚖrequest_time_offsᚋgraphᚋmodelᚐAbsenceLimitᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.AbsenceLimit) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNAbsenceLimit2ᚖrequest_time_offsᚋgraphᚋmodelᚐAbsenceLimit(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-		case "toSwapWith":
+	}
+	wg.Wait()
 
-			out.Values[i] = ec._RequestResponse_toSwapWith(ctx, field, obj)
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
 
-		case "type":
+	return ret
+}
 
-			out.Values[i] = ec._RequestResponse_type(ctx, field, obj)
+func (ec *executionContext) marshalNAbsenceLimit2ᚖrequest_time_offsᚋgraphᚋmodelᚐAbsenceLimit(ctx context.Context, sel ast.SelectionSet, v *model.AbsenceLimit) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._AbsenceLimit(ctx, sel, v)
+}
 
-		case "user":
+func (ec *executionContext) unmarshalNAbsenceLimitInput2request_time_offsᚋgraphᚋmodelᚐAbsenceLimitInput(ctx context.Context, v interface{}) (model.AbsenceLimitInput, error) {
+	res, err := ec.unmarshalInputAbsenceLimitInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-			out.Values[i] = ec._RequestResponse_user(ctx, field, obj)
+func (ec *executionContext) marshalNAbsenceLimitResponse2request_time_offsᚋgraphᚋmodelᚐAbsenceLimitResponse(ctx context.Context, sel ast.SelectionSet, v model.AbsenceLimitResponse) graphql.Marshaler {
+	return ec._AbsenceLimitResponse(ctx, sel, &v)
+}
 
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
+func (ec *executionContext) marshalNAbsenceLimitResponse2ᚖrequest_time_offsᚋgraphᚋmodelᚐAbsenceLimitResponse(ctx context.Context, sel ast.SelectionSet, v *model.AbsenceLimitResponse) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
 		return graphql.Null
 	}
-	return out
+	return ec._AbsenceLimitResponse(ctx, sel, v)
 }
 
-var requestTimeOffImplementors = []string{"RequestTimeOff"}
-
-func (ec *executionContext) _RequestTimeOff(ctx context.Context, sel ast.SelectionSet, obj *model.RequestTimeOff) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, requestTimeOffImplementors)
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("RequestTimeOff")
-		case "id":
-
-			out.Values[i] = ec._RequestTimeOff_id(ctx, field, obj)
+func (ec *executionContext) unmarshalNAccrualMethod2request_time_offsᚋgraphᚋmodelᚐAccrualMethod(ctx context.Context, v interface{}) (model.AccrualMethod, error) {
+	var res model.AccrualMethod
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "userId":
+func (ec *executionContext) marshalNAccrualMethod2request_time_offsᚋgraphᚋmodelᚐAccrualMethod(ctx context.Context, sel ast.SelectionSet, v model.AccrualMethod) graphql.Marshaler {
+	return v
+}
 
-			out.Values[i] = ec._RequestTimeOff_userId(ctx, field, obj)
+func (ec *executionContext) marshalNAssignedShift2ᚖrequest_time_offsᚋgraphᚋmodelᚐAssignedShift(ctx context.Context, sel ast.SelectionSet, v *model.AssignedShift) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._AssignedShift(ctx, sel, v)
+}
 
-			if out.Values[i] == graphql.Null {
-				invalids++
+func (ec *executionContext) marshalNBlackoutPeriod2ᚕᚖrequest_time_offsᚋgraphᚋmodelᚐBlackoutPeriodᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.BlackoutPeriod) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		case "channelId":
-
-			out.Values[i] = ec._RequestTimeOff_channelId(ctx, field, obj)
-
-		case "requestId":
+			ret[i] = ec.marshalNBlackoutPeriod2ᚖrequest_time_offsᚋgraphᚋmodelᚐBlackoutPeriod(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-			out.Values[i] = ec._RequestTimeOff_requestId(ctx, field, obj)
+	}
+	wg.Wait()
 
-		case "startTime":
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
 
-			out.Values[i] = ec._RequestTimeOff_startTime(ctx, field, obj)
+	return ret
+}
 
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "endTime":
+func (ec *executionContext) marshalNBlackoutPeriod2ᚖrequest_time_offsᚋgraphᚋmodelᚐBlackoutPeriod(ctx context.Context, sel ast.SelectionSet, v *model.BlackoutPeriod) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._BlackoutPeriod(ctx, sel, v)
+}
 
-			out.Values[i] = ec._RequestTimeOff_endTime(ctx, field, obj)
+func (ec *executionContext) unmarshalNBlackoutPeriodInput2request_time_offsᚋgraphᚋmodelᚐBlackoutPeriodInput(ctx context.Context, v interface{}) (model.BlackoutPeriodInput, error) {
+	res, err := ec.unmarshalInputBlackoutPeriodInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-		case "is24Hours":
+func (ec *executionContext) marshalNBlackoutPeriodResponse2request_time_offsᚋgraphᚋmodelᚐBlackoutPeriodResponse(ctx context.Context, sel ast.SelectionSet, v model.BlackoutPeriodResponse) graphql.Marshaler {
+	return ec._BlackoutPeriodResponse(ctx, sel, &v)
+}
 
-			out.Values[i] = ec._RequestTimeOff_is24Hours(ctx, field, obj)
+func (ec *executionContext) marshalNBlackoutPeriodResponse2ᚖrequest_time_offsᚋgraphᚋmodelᚐBlackoutPeriodResponse(ctx context.Context, sel ast.SelectionSet, v *model.BlackoutPeriodResponse) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._BlackoutPeriodResponse(ctx, sel, v)
+}
 
-		case "reason":
+func (ec *executionContext) unmarshalNBoolean2bool(ctx context.Context, v interface{}) (bool, error) {
+	res, err := graphql.UnmarshalBoolean(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-			out.Values[i] = ec._RequestTimeOff_reason(ctx, field, obj)
+func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
+	res := graphql.MarshalBoolean(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
 
-		case "requestNote":
+func (ec *executionContext) marshalNBulkTimeOffResponse2request_time_offsᚋgraphᚋmodelᚐBulkTimeOffResponse(ctx context.Context, sel ast.SelectionSet, v model.BulkTimeOffResponse) graphql.Marshaler {
+	return ec._BulkTimeOffResponse(ctx, sel, &v)
+}
 
-			out.Values[i] = ec._RequestTimeOff_requestNote(ctx, field, obj)
+func (ec *executionContext) marshalNBulkTimeOffResponse2ᚖrequest_time_offsᚋgraphᚋmodelᚐBulkTimeOffResponse(ctx context.Context, sel ast.SelectionSet, v *model.BulkTimeOffResponse) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._BulkTimeOffResponse(ctx, sel, v)
+}
 
-		case "status":
+func (ec *executionContext) marshalNCapacityWarning2ᚖrequest_time_offsᚋgraphᚋmodelᚐCapacityWarning(ctx context.Context, sel ast.SelectionSet, v *model.CapacityWarning) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._CapacityWarning(ctx, sel, v)
+}
 
-			out.Values[i] = ec._RequestTimeOff_status(ctx, field, obj)
+func (ec *executionContext) unmarshalNFloat2float64(ctx context.Context, v interface{}) (float64, error) {
+	res, err := graphql.UnmarshalFloatContext(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "responseNote":
+func (ec *executionContext) marshalNFloat2float64(ctx context.Context, sel ast.SelectionSet, v float64) graphql.Marshaler {
+	res := graphql.MarshalFloatContext(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return graphql.WrapContextMarshaler(ctx, res)
+}
 
-			out.Values[i] = ec._RequestTimeOff_responseNote(ctx, field, obj)
+func (ec *executionContext) unmarshalNID2string(ctx context.Context, v interface{}) (string, error) {
+	res, err := graphql.UnmarshalID(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-		case "responseByUserId":
+func (ec *executionContext) marshalNID2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+	res := graphql.MarshalID(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
 
-			out.Values[i] = ec._RequestTimeOff_responseByUserId(ctx, field, obj)
+func (ec *executionContext) unmarshalNID2ᚕstringᚄ(ctx context.Context, v interface{}) ([]string, error) {
+	var vSlice []interface{}
+	if v != nil {
+		vSlice = graphql.CoerceList(v)
+	}
+	var err error
+	res := make([]string, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNID2string(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
 
-		case "responseAt":
+func (ec *executionContext) marshalNID2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNID2string(ctx, sel, v[i])
+	}
 
-			out.Values[i] = ec._RequestTimeOff_responseAt(ctx, field, obj)
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
 
-		case "createdAt":
+	return ret
+}
 
-			out.Values[i] = ec._RequestTimeOff_createdAt(ctx, field, obj)
+func (ec *executionContext) unmarshalNInt2int(ctx context.Context, v interface{}) (int, error) {
+	res, err := graphql.UnmarshalInt(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNInt2int(ctx context.Context, sel ast.SelectionSet, v int) graphql.Marshaler {
+	res := graphql.MarshalInt(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
-	}
-	return out
+	return res
 }
 
-var shiftErrorImplementors = []string{"ShiftError"}
-
-func (ec *executionContext) _ShiftError(ctx context.Context, sel ast.SelectionSet, obj *model.ShiftError) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, shiftErrorImplementors)
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("ShiftError")
-		case "code":
-
-			out.Values[i] = ec._ShiftError_code(ctx, field, obj)
-
-			if out.Values[i] == graphql.Null {
-				invalids++
+func (ec *executionContext) marshalNLeaveType2ᚕᚖrequest_time_offsᚋgraphᚋmodelᚐLeaveTypeᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.LeaveType) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		case "field":
+			ret[i] = ec.marshalNLeaveType2ᚖrequest_time_offsᚋgraphᚋmodelᚐLeaveType(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-			out.Values[i] = ec._ShiftError_field(ctx, field, obj)
+	}
+	wg.Wait()
 
-		case "message":
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
 
-			out.Values[i] = ec._ShiftError_message(ctx, field, obj)
+	return ret
+}
 
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNLeaveType2ᚖrequest_time_offsᚋgraphᚋmodelᚐLeaveType(ctx context.Context, sel ast.SelectionSet, v *model.LeaveType) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
 		return graphql.Null
 	}
-	return out
+	return ec._LeaveType(ctx, sel, v)
 }
 
-var timeOffResponseImplementors = []string{"TimeOffResponse"}
-
-func (ec *executionContext) _TimeOffResponse(ctx context.Context, sel ast.SelectionSet, obj *model.TimeOffResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, timeOffResponseImplementors)
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("TimeOffResponse")
-		case "errors":
-
-			out.Values[i] = ec._TimeOffResponse_errors(ctx, field, obj)
-
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "request":
+func (ec *executionContext) unmarshalNLeaveTypeInput2request_time_offsᚋgraphᚋmodelᚐLeaveTypeInput(ctx context.Context, v interface{}) (model.LeaveTypeInput, error) {
+	res, err := ec.unmarshalInputLeaveTypeInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-			out.Values[i] = ec._TimeOffResponse_request(ctx, field, obj)
+func (ec *executionContext) marshalNLeaveTypeResponse2request_time_offsᚋgraphᚋmodelᚐLeaveTypeResponse(ctx context.Context, sel ast.SelectionSet, v model.LeaveTypeResponse) graphql.Marshaler {
+	return ec._LeaveTypeResponse(ctx, sel, &v)
+}
 
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNLeaveTypeResponse2ᚖrequest_time_offsᚋgraphᚋmodelᚐLeaveTypeResponse(ctx context.Context, sel ast.SelectionSet, v *model.LeaveTypeResponse) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
 		return graphql.Null
 	}
-	return out
+	return ec._LeaveTypeResponse(ctx, sel, v)
 }
 
-var userImplementors = []string{"User"}
-
-func (ec *executionContext) _User(ctx context.Context, sel ast.SelectionSet, obj *model.User) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, userImplementors)
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("User")
-		case "id":
-
-			out.Values[i] = ec._User_id(ctx, field, obj)
-
-		case "firstName":
-
-			out.Values[i] = ec._User_firstName(ctx, field, obj)
-
-		case "lastName":
-
-			out.Values[i] = ec._User_lastName(ctx, field, obj)
-
-		case "email":
-
-			out.Values[i] = ec._User_email(ctx, field, obj)
-
-		case "avatar":
-
-			out.Values[i] = ec._User_avatar(ctx, field, obj)
-
-		case "isActive":
-
-			out.Values[i] = ec._User_isActive(ctx, field, obj)
-
-		case "isStaff":
+func (ec *executionContext) unmarshalNOrderDirection2request_time_offsᚋgraphᚋmodelᚐOrderDirection(ctx context.Context, v interface{}) (model.OrderDirection, error) {
+	var res model.OrderDirection
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-			out.Values[i] = ec._User_isStaff(ctx, field, obj)
+func (ec *executionContext) marshalNOrderDirection2request_time_offsᚋgraphᚋmodelᚐOrderDirection(ctx context.Context, sel ast.SelectionSet, v model.OrderDirection) graphql.Marshaler {
+	return v
+}
 
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNPageInfo2ᚖrequest_time_offsᚋgraphᚋmodelᚐPageInfo(ctx context.Context, sel ast.SelectionSet, v *model.PageInfo) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
 		return graphql.Null
 	}
-	return out
+	return ec._PageInfo(ctx, sel, v)
 }
 
-var __DirectiveImplementors = []string{"__Directive"}
-
-func (ec *executionContext) ___Directive(ctx context.Context, sel ast.SelectionSet, obj *introspection.Directive) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __DirectiveImplementors)
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Directive")
-		case "name":
-
-			out.Values[i] = ec.___Directive_name(ctx, field, obj)
-
-			if out.Values[i] == graphql.Null {
-				invalids++
+func (ec *executionContext) marshalNRequestComment2ᚕᚖrequest_time_offsᚋgraphᚋmodelᚐRequestCommentᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.RequestComment) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		case "description":
-
-			out.Values[i] = ec.___Directive_description(ctx, field, obj)
-
-		case "locations":
+			ret[i] = ec.marshalNRequestComment2ᚖrequest_time_offsᚋgraphᚋmodelᚐRequestComment(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-			out.Values[i] = ec.___Directive_locations(ctx, field, obj)
+	}
+	wg.Wait()
 
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "args":
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
 
-			out.Values[i] = ec.___Directive_args(ctx, field, obj)
+	return ret
+}
 
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "isRepeatable":
+func (ec *executionContext) marshalNRequestComment2ᚖrequest_time_offsᚋgraphᚋmodelᚐRequestComment(ctx context.Context, sel ast.SelectionSet, v *model.RequestComment) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._RequestComment(ctx, sel, v)
+}
 
-			out.Values[i] = ec.___Directive_isRepeatable(ctx, field, obj)
+func (ec *executionContext) marshalNRequestCommentResponse2request_time_offsᚋgraphᚋmodelᚐRequestCommentResponse(ctx context.Context, sel ast.SelectionSet, v model.RequestCommentResponse) graphql.Marshaler {
+	return ec._RequestCommentResponse(ctx, sel, &v)
+}
 
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNRequestCommentResponse2ᚖrequest_time_offsᚋgraphᚋmodelᚐRequestCommentResponse(ctx context.Context, sel ast.SelectionSet, v *model.RequestCommentResponse) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
 		return graphql.Null
 	}
-	return out
+	return ec._RequestCommentResponse(ctx, sel, v)
 }
 
-var __EnumValueImplementors = []string{"__EnumValue"}
+func (ec *executionContext) marshalNRequestExpirySettings2request_time_offsᚋgraphᚋmodelᚐRequestExpirySettings(ctx context.Context, sel ast.SelectionSet, v model.RequestExpirySettings) graphql.Marshaler {
+	return ec._RequestExpirySettings(ctx, sel, &v)
+}
 
-func (ec *executionContext) ___EnumValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.EnumValue) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __EnumValueImplementors)
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__EnumValue")
-		case "name":
+func (ec *executionContext) marshalNRequestExpirySettings2ᚖrequest_time_offsᚋgraphᚋmodelᚐRequestExpirySettings(ctx context.Context, sel ast.SelectionSet, v *model.RequestExpirySettings) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._RequestExpirySettings(ctx, sel, v)
+}
 
-			out.Values[i] = ec.___EnumValue_name(ctx, field, obj)
+func (ec *executionContext) unmarshalNRequestExpirySettingsInput2request_time_offsᚋgraphᚋmodelᚐRequestExpirySettingsInput(ctx context.Context, v interface{}) (model.RequestExpirySettingsInput, error) {
+	res, err := ec.unmarshalInputRequestExpirySettingsInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "description":
+func (ec *executionContext) marshalNRequestExpirySettingsResponse2request_time_offsᚋgraphᚋmodelᚐRequestExpirySettingsResponse(ctx context.Context, sel ast.SelectionSet, v model.RequestExpirySettingsResponse) graphql.Marshaler {
+	return ec._RequestExpirySettingsResponse(ctx, sel, &v)
+}
 
-			out.Values[i] = ec.___EnumValue_description(ctx, field, obj)
+func (ec *executionContext) marshalNRequestExpirySettingsResponse2ᚖrequest_time_offsᚋgraphᚋmodelᚐRequestExpirySettingsResponse(ctx context.Context, sel ast.SelectionSet, v *model.RequestExpirySettingsResponse) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._RequestExpirySettingsResponse(ctx, sel, v)
+}
 
-		case "isDeprecated":
+func (ec *executionContext) unmarshalNRequestHistoryAction2request_time_offsᚋgraphᚋmodelᚐRequestHistoryAction(ctx context.Context, v interface{}) (model.RequestHistoryAction, error) {
+	var res model.RequestHistoryAction
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-			out.Values[i] = ec.___EnumValue_isDeprecated(ctx, field, obj)
+func (ec *executionContext) marshalNRequestHistoryAction2request_time_offsᚋgraphᚋmodelᚐRequestHistoryAction(ctx context.Context, sel ast.SelectionSet, v model.RequestHistoryAction) graphql.Marshaler {
+	return v
+}
 
-			if out.Values[i] == graphql.Null {
-				invalids++
+func (ec *executionContext) marshalNRequestHistoryEntry2ᚕᚖrequest_time_offsᚋgraphᚋmodelᚐRequestHistoryEntryᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.RequestHistoryEntry) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		case "deprecationReason":
+			ret[i] = ec.marshalNRequestHistoryEntry2ᚖrequest_time_offsᚋgraphᚋmodelᚐRequestHistoryEntry(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-			out.Values[i] = ec.___EnumValue_deprecationReason(ctx, field, obj)
+	}
+	wg.Wait()
 
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
 		}
 	}
-	out.Dispatch()
-	if invalids > 0 {
+
+	return ret
+}
+
+func (ec *executionContext) marshalNRequestHistoryEntry2ᚖrequest_time_offsᚋgraphᚋmodelᚐRequestHistoryEntry(ctx context.Context, sel ast.SelectionSet, v *model.RequestHistoryEntry) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
 		return graphql.Null
 	}
-	return out
+	return ec._RequestHistoryEntry(ctx, sel, v)
 }
 
-var __FieldImplementors = []string{"__Field"}
-
-func (ec *executionContext) ___Field(ctx context.Context, sel ast.SelectionSet, obj *introspection.Field) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __FieldImplementors)
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Field")
-		case "name":
-
-			out.Values[i] = ec.___Field_name(ctx, field, obj)
+func (ec *executionContext) unmarshalNRequestOrderField2request_time_offsᚋgraphᚋmodelᚐRequestOrderField(ctx context.Context, v interface{}) (model.RequestOrderField, error) {
+	var res model.RequestOrderField
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "description":
+func (ec *executionContext) marshalNRequestOrderField2request_time_offsᚋgraphᚋmodelᚐRequestOrderField(ctx context.Context, sel ast.SelectionSet, v model.RequestOrderField) graphql.Marshaler {
+	return v
+}
 
-			out.Values[i] = ec.___Field_description(ctx, field, obj)
+func (ec *executionContext) unmarshalNRequestStatus2request_time_offsᚋgraphᚋmodelᚐRequestStatus(ctx context.Context, v interface{}) (model.RequestStatus, error) {
+	var res model.RequestStatus
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-		case "args":
+func (ec *executionContext) marshalNRequestStatus2request_time_offsᚋgraphᚋmodelᚐRequestStatus(ctx context.Context, sel ast.SelectionSet, v model.RequestStatus) graphql.Marshaler {
+	return v
+}
 
-			out.Values[i] = ec.___Field_args(ctx, field, obj)
+func (ec *executionContext) marshalNRequestTimeOff2request_time_offsᚋgraphᚋmodelᚐRequestTimeOff(ctx context.Context, sel ast.SelectionSet, v model.RequestTimeOff) graphql.Marshaler {
+	return ec._RequestTimeOff(ctx, sel, &v)
+}
 
-			if out.Values[i] == graphql.Null {
-				invalids++
+func (ec *executionContext) marshalNRequestTimeOff2ᚕᚖrequest_time_offsᚋgraphᚋmodelᚐRequestTimeOff(ctx context.Context, sel ast.SelectionSet, v []*model.RequestTimeOff) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		case "type":
-
-			out.Values[i] = ec.___Field_type(ctx, field, obj)
+			ret[i] = ec.marshalORequestTimeOff2ᚖrequest_time_offsᚋgraphᚋmodelᚐRequestTimeOff(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "isDeprecated":
+	}
+	wg.Wait()
 
-			out.Values[i] = ec.___Field_isDeprecated(ctx, field, obj)
+	return ret
+}
 
-			if out.Values[i] == graphql.Null {
-				invalids++
+func (ec *executionContext) marshalNRequestTimeOff2ᚕᚖrequest_time_offsᚋgraphᚋmodelᚐRequestTimeOffᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.RequestTimeOff) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		case "deprecationReason":
+			ret[i] = ec.marshalNRequestTimeOff2ᚖrequest_time_offsᚋgraphᚋmodelᚐRequestTimeOff(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-			out.Values[i] = ec.___Field_deprecationReason(ctx, field, obj)
+	}
+	wg.Wait()
 
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
 		}
 	}
-	out.Dispatch()
-	if invalids > 0 {
+
+	return ret
+}
+
+func (ec *executionContext) marshalNRequestTimeOff2ᚖrequest_time_offsᚋgraphᚋmodelᚐRequestTimeOff(ctx context.Context, sel ast.SelectionSet, v *model.RequestTimeOff) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
 		return graphql.Null
 	}
-	return out
+	return ec._RequestTimeOff(ctx, sel, v)
 }
 
-var __InputValueImplementors = []string{"__InputValue"}
-
-func (ec *executionContext) ___InputValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.InputValue) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __InputValueImplementors)
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__InputValue")
-		case "name":
+func (ec *executionContext) marshalNRequestTimeOffConnection2request_time_offsᚋgraphᚋmodelᚐRequestTimeOffConnection(ctx context.Context, sel ast.SelectionSet, v model.RequestTimeOffConnection) graphql.Marshaler {
+	return ec._RequestTimeOffConnection(ctx, sel, &v)
+}
 
-			out.Values[i] = ec.___InputValue_name(ctx, field, obj)
+func (ec *executionContext) marshalNRequestTimeOffConnection2ᚖrequest_time_offsᚋgraphᚋmodelᚐRequestTimeOffConnection(ctx context.Context, sel ast.SelectionSet, v *model.RequestTimeOffConnection) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._RequestTimeOffConnection(ctx, sel, v)
+}
 
-			if out.Values[i] == graphql.Null {
-				invalids++
+func (ec *executionContext) marshalNRequestTimeOffEdge2ᚕᚖrequest_time_offsᚋgraphᚋmodelᚐRequestTimeOffEdgeᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.RequestTimeOffEdge) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		case "description":
+			ret[i] = ec.marshalNRequestTimeOffEdge2ᚖrequest_time_offsᚋgraphᚋmodelᚐRequestTimeOffEdge(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-			out.Values[i] = ec.___InputValue_description(ctx, field, obj)
+	}
+	wg.Wait()
 
-		case "type":
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
 
-			out.Values[i] = ec.___InputValue_type(ctx, field, obj)
+	return ret
+}
 
-			if out.Values[i] == graphql.Null {
-				invalids++
+func (ec *executionContext) marshalNRequestTimeOffEdge2ᚖrequest_time_offsᚋgraphᚋmodelᚐRequestTimeOffEdge(ctx context.Context, sel ast.SelectionSet, v *model.RequestTimeOffEdge) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._RequestTimeOffEdge(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNRequestTimeOffInput2request_time_offsᚋgraphᚋmodelᚐRequestTimeOffInput(ctx context.Context, v interface{}) (model.RequestTimeOffInput, error) {
+	res, err := ec.unmarshalInputRequestTimeOffInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNRequestTimeOffResult2ᚕᚖrequest_time_offsᚋgraphᚋmodelᚐRequestTimeOffResultᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.RequestTimeOffResult) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		case "defaultValue":
+			ret[i] = ec.marshalNRequestTimeOffResult2ᚖrequest_time_offsᚋgraphᚋmodelᚐRequestTimeOffResult(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-			out.Values[i] = ec.___InputValue_defaultValue(ctx, field, obj)
+	}
+	wg.Wait()
 
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
 		}
 	}
-	out.Dispatch()
-	if invalids > 0 {
+
+	return ret
+}
+
+func (ec *executionContext) marshalNRequestTimeOffResult2ᚖrequest_time_offsᚋgraphᚋmodelᚐRequestTimeOffResult(ctx context.Context, sel ast.SelectionSet, v *model.RequestTimeOffResult) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
 		return graphql.Null
 	}
-	return out
+	return ec._RequestTimeOffResult(ctx, sel, v)
 }
 
-var __SchemaImplementors = []string{"__Schema"}
-
-func (ec *executionContext) ___Schema(ctx context.Context, sel ast.SelectionSet, obj *introspection.Schema) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __SchemaImplementors)
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Schema")
-		case "description":
-
-			out.Values[i] = ec.___Schema_description(ctx, field, obj)
-
-		case "types":
-
-			out.Values[i] = ec.___Schema_types(ctx, field, obj)
-
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "queryType":
-
-			out.Values[i] = ec.___Schema_queryType(ctx, field, obj)
-
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "mutationType":
-
-			out.Values[i] = ec.___Schema_mutationType(ctx, field, obj)
-
-		case "subscriptionType":
+func (ec *executionContext) marshalNShiftConflict2ᚖrequest_time_offsᚋgraphᚋmodelᚐShiftConflict(ctx context.Context, sel ast.SelectionSet, v *model.ShiftConflict) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._ShiftConflict(ctx, sel, v)
+}
 
-			out.Values[i] = ec.___Schema_subscriptionType(ctx, field, obj)
+func (ec *executionContext) unmarshalNShiftConflictPolicy2request_time_offsᚋgraphᚋmodelᚐShiftConflictPolicy(ctx context.Context, v interface{}) (model.ShiftConflictPolicy, error) {
+	var res model.ShiftConflictPolicy
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-		case "directives":
+func (ec *executionContext) marshalNShiftConflictPolicy2request_time_offsᚋgraphᚋmodelᚐShiftConflictPolicy(ctx context.Context, sel ast.SelectionSet, v model.ShiftConflictPolicy) graphql.Marshaler {
+	return v
+}
 
-			out.Values[i] = ec.___Schema_directives(ctx, field, obj)
+func (ec *executionContext) marshalNShiftConflictSettings2request_time_offsᚋgraphᚋmodelᚐShiftConflictSettings(ctx context.Context, sel ast.SelectionSet, v model.ShiftConflictSettings) graphql.Marshaler {
+	return ec._ShiftConflictSettings(ctx, sel, &v)
+}
 
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNShiftConflictSettings2ᚖrequest_time_offsᚋgraphᚋmodelᚐShiftConflictSettings(ctx context.Context, sel ast.SelectionSet, v *model.ShiftConflictSettings) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
 		return graphql.Null
 	}
-	return out
+	return ec._ShiftConflictSettings(ctx, sel, v)
 }
 
-var __TypeImplementors = []string{"__Type"}
+func (ec *executionContext) unmarshalNShiftConflictSettingsInput2request_time_offsᚋgraphᚋmodelᚐShiftConflictSettingsInput(ctx context.Context, v interface{}) (model.ShiftConflictSettingsInput, error) {
+	res, err := ec.unmarshalInputShiftConflictSettingsInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, obj *introspection.Type) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __TypeImplementors)
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Type")
-		case "kind":
+func (ec *executionContext) marshalNShiftConflictSettingsResponse2request_time_offsᚋgraphᚋmodelᚐShiftConflictSettingsResponse(ctx context.Context, sel ast.SelectionSet, v model.ShiftConflictSettingsResponse) graphql.Marshaler {
+	return ec._ShiftConflictSettingsResponse(ctx, sel, &v)
+}
 
-			out.Values[i] = ec.___Type_kind(ctx, field, obj)
+func (ec *executionContext) marshalNShiftConflictSettingsResponse2ᚖrequest_time_offsᚋgraphᚋmodelᚐShiftConflictSettingsResponse(ctx context.Context, sel ast.SelectionSet, v *model.ShiftConflictSettingsResponse) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._ShiftConflictSettingsResponse(ctx, sel, v)
+}
 
-			if out.Values[i] == graphql.Null {
-				invalids++
+func (ec *executionContext) marshalNShiftError2ᚕᚖrequest_time_offsᚋgraphᚋmodelᚐShiftErrorᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.ShiftError) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		case "name":
-
-			out.Values[i] = ec.___Type_name(ctx, field, obj)
-
-		case "description":
-
-			out.Values[i] = ec.___Type_description(ctx, field, obj)
-
-		case "fields":
-
-			out.Values[i] = ec.___Type_fields(ctx, field, obj)
-
-		case "interfaces":
-
-			out.Values[i] = ec.___Type_interfaces(ctx, field, obj)
-
-		case "possibleTypes":
+			ret[i] = ec.marshalNShiftError2ᚖrequest_time_offsᚋgraphᚋmodelᚐShiftError(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-			out.Values[i] = ec.___Type_possibleTypes(ctx, field, obj)
+	}
+	wg.Wait()
 
-		case "enumValues":
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
 
-			out.Values[i] = ec.___Type_enumValues(ctx, field, obj)
+	return ret
+}
 
-		case "inputFields":
+func (ec *executionContext) marshalNShiftError2ᚖrequest_time_offsᚋgraphᚋmodelᚐShiftError(ctx context.Context, sel ast.SelectionSet, v *model.ShiftError) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._ShiftError(ctx, sel, v)
+}
 
-			out.Values[i] = ec.___Type_inputFields(ctx, field, obj)
+func (ec *executionContext) unmarshalNShiftErrorCode2request_time_offsᚋgraphᚋmodelᚐShiftErrorCode(ctx context.Context, v interface{}) (model.ShiftErrorCode, error) {
+	var res model.ShiftErrorCode
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-		case "ofType":
+func (ec *executionContext) marshalNShiftErrorCode2request_time_offsᚋgraphᚋmodelᚐShiftErrorCode(ctx context.Context, sel ast.SelectionSet, v model.ShiftErrorCode) graphql.Marshaler {
+	return v
+}
 
-			out.Values[i] = ec.___Type_ofType(ctx, field, obj)
+func (ec *executionContext) unmarshalNString2string(ctx context.Context, v interface{}) (string, error) {
+	res, err := graphql.UnmarshalString(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-		case "specifiedByURL":
+func (ec *executionContext) marshalNString2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+	res := graphql.MarshalString(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
 
-			out.Values[i] = ec.___Type_specifiedByURL(ctx, field, obj)
+func (ec *executionContext) unmarshalNTime2timeᚐTime(ctx context.Context, v interface{}) (time.Time, error) {
+	res, err := graphql.UnmarshalTime(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNTime2timeᚐTime(ctx context.Context, sel ast.SelectionSet, v time.Time) graphql.Marshaler {
+	res := graphql.MarshalTime(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
-	}
-	return out
+	return res
 }
 
-// endregion **************************** object.gotpl ****************************
-
-// region    ***************************** type.gotpl *****************************
+func (ec *executionContext) marshalNTimeOffAccrualPolicy2ᚕᚖrequest_time_offsᚋgraphᚋmodelᚐTimeOffAccrualPolicyᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.TimeOffAccrualPolicy) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNTimeOffAccrualPolicy2ᚖrequest_time_offsᚋgraphᚋmodelᚐTimeOffAccrualPolicy(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-func (ec *executionContext) unmarshalNBoolean2bool(ctx context.Context, v interface{}) (bool, error) {
-	res, err := graphql.UnmarshalBoolean(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
+	}
+	wg.Wait()
 
-func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
-	res := graphql.MarshalBoolean(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
 		}
 	}
-	return res
-}
 
-func (ec *executionContext) unmarshalNID2string(ctx context.Context, v interface{}) (string, error) {
-	res, err := graphql.UnmarshalID(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+	return ret
 }
 
-func (ec *executionContext) marshalNID2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
-	res := graphql.MarshalID(v)
-	if res == graphql.Null {
+func (ec *executionContext) marshalNTimeOffAccrualPolicy2ᚖrequest_time_offsᚋgraphᚋmodelᚐTimeOffAccrualPolicy(ctx context.Context, sel ast.SelectionSet, v *model.TimeOffAccrualPolicy) graphql.Marshaler {
+	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
+		return graphql.Null
 	}
-	return res
+	return ec._TimeOffAccrualPolicy(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNRequestStatus2request_time_offsᚋgraphᚋmodelᚐRequestStatus(ctx context.Context, v interface{}) (model.RequestStatus, error) {
-	var res model.RequestStatus
-	err := res.UnmarshalGQL(v)
+func (ec *executionContext) unmarshalNTimeOffAccrualPolicyInput2request_time_offsᚋgraphᚋmodelᚐTimeOffAccrualPolicyInput(ctx context.Context, v interface{}) (model.TimeOffAccrualPolicyInput, error) {
+	res, err := ec.unmarshalInputTimeOffAccrualPolicyInput(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNRequestStatus2request_time_offsᚋgraphᚋmodelᚐRequestStatus(ctx context.Context, sel ast.SelectionSet, v model.RequestStatus) graphql.Marshaler {
-	return v
+func (ec *executionContext) marshalNTimeOffAccrualPolicyResponse2request_time_offsᚋgraphᚋmodelᚐTimeOffAccrualPolicyResponse(ctx context.Context, sel ast.SelectionSet, v model.TimeOffAccrualPolicyResponse) graphql.Marshaler {
+	return ec._TimeOffAccrualPolicyResponse(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNRequestTimeOff2request_time_offsᚋgraphᚋmodelᚐRequestTimeOff(ctx context.Context, sel ast.SelectionSet, v model.RequestTimeOff) graphql.Marshaler {
-	return ec._RequestTimeOff(ctx, sel, &v)
+func (ec *executionContext) marshalNTimeOffAccrualPolicyResponse2ᚖrequest_time_offsᚋgraphᚋmodelᚐTimeOffAccrualPolicyResponse(ctx context.Context, sel ast.SelectionSet, v *model.TimeOffAccrualPolicyResponse) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._TimeOffAccrualPolicyResponse(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNRequestTimeOff2ᚕᚖrequest_time_offsᚋgraphᚋmodelᚐRequestTimeOff(ctx context.Context, sel ast.SelectionSet, v []*model.RequestTimeOff) graphql.Marshaler {
+func (ec *executionContext) marshalNTimeOffBalance2ᚕᚖrequest_time_offsᚋgraphᚋmodelᚐTimeOffBalanceᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.TimeOffBalance) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -8543,7 +22371,7 @@ func (ec *executionContext) marshalNRequestTimeOff2ᚕᚖrequest_time_offsᚋgra
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalORequestTimeOff2ᚖrequest_time_offsᚋgraphᚋmodelᚐRequestTimeOff(ctx, sel, v[i])
+			ret[i] = ec.marshalNTimeOffBalance2ᚖrequest_time_offsᚋgraphᚋmodelᚐTimeOffBalance(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -8554,25 +22382,40 @@ func (ec *executionContext) marshalNRequestTimeOff2ᚕᚖrequest_time_offsᚋgra
 	}
 	wg.Wait()
 
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
 	return ret
 }
 
-func (ec *executionContext) marshalNRequestTimeOff2ᚖrequest_time_offsᚋgraphᚋmodelᚐRequestTimeOff(ctx context.Context, sel ast.SelectionSet, v *model.RequestTimeOff) graphql.Marshaler {
+func (ec *executionContext) marshalNTimeOffBalance2ᚖrequest_time_offsᚋgraphᚋmodelᚐTimeOffBalance(ctx context.Context, sel ast.SelectionSet, v *model.TimeOffBalance) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._RequestTimeOff(ctx, sel, v)
+	return ec._TimeOffBalance(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNRequestTimeOffInput2request_time_offsᚋgraphᚋmodelᚐRequestTimeOffInput(ctx context.Context, v interface{}) (model.RequestTimeOffInput, error) {
-	res, err := ec.unmarshalInputRequestTimeOffInput(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
+func (ec *executionContext) marshalNTimeOffBalanceResponse2request_time_offsᚋgraphᚋmodelᚐTimeOffBalanceResponse(ctx context.Context, sel ast.SelectionSet, v model.TimeOffBalanceResponse) graphql.Marshaler {
+	return ec._TimeOffBalanceResponse(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNShiftError2ᚕᚖrequest_time_offsᚋgraphᚋmodelᚐShiftErrorᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.ShiftError) graphql.Marshaler {
+func (ec *executionContext) marshalNTimeOffBalanceResponse2ᚖrequest_time_offsᚋgraphᚋmodelᚐTimeOffBalanceResponse(ctx context.Context, sel ast.SelectionSet, v *model.TimeOffBalanceResponse) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._TimeOffBalanceResponse(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNTimeOffLedgerEntry2ᚕᚖrequest_time_offsᚋgraphᚋmodelᚐTimeOffLedgerEntryᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.TimeOffLedgerEntry) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -8596,7 +22439,7 @@ func (ec *executionContext) marshalNShiftError2ᚕᚖrequest_time_offsᚋgraph
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNShiftError2ᚖrequest_time_offsᚋgraphᚋmodelᚐShiftError(ctx, sel, v[i])
+			ret[i] = ec.marshalNTimeOffLedgerEntry2ᚖrequest_time_offsᚋgraphᚋmodelᚐTimeOffLedgerEntry(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -8616,56 +22459,26 @@ func (ec *executionContext) marshalNShiftError2ᚕᚖrequest_time_offsᚋgraph
 	return ret
 }
 
-func (ec *executionContext) marshalNShiftError2ᚖrequest_time_offsᚋgraphᚋmodelᚐShiftError(ctx context.Context, sel ast.SelectionSet, v *model.ShiftError) graphql.Marshaler {
+func (ec *executionContext) marshalNTimeOffLedgerEntry2ᚖrequest_time_offsᚋgraphᚋmodelᚐTimeOffLedgerEntry(ctx context.Context, sel ast.SelectionSet, v *model.TimeOffLedgerEntry) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._ShiftError(ctx, sel, v)
+	return ec._TimeOffLedgerEntry(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNShiftErrorCode2request_time_offsᚋgraphᚋmodelᚐShiftErrorCode(ctx context.Context, v interface{}) (model.ShiftErrorCode, error) {
-	var res model.ShiftErrorCode
+func (ec *executionContext) unmarshalNTimeOffLedgerEntryKind2request_time_offsᚋgraphᚋmodelᚐTimeOffLedgerEntryKind(ctx context.Context, v interface{}) (model.TimeOffLedgerEntryKind, error) {
+	var res model.TimeOffLedgerEntryKind
 	err := res.UnmarshalGQL(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNShiftErrorCode2request_time_offsᚋgraphᚋmodelᚐShiftErrorCode(ctx context.Context, sel ast.SelectionSet, v model.ShiftErrorCode) graphql.Marshaler {
+func (ec *executionContext) marshalNTimeOffLedgerEntryKind2request_time_offsᚋgraphᚋmodelᚐTimeOffLedgerEntryKind(ctx context.Context, sel ast.SelectionSet, v model.TimeOffLedgerEntryKind) graphql.Marshaler {
 	return v
 }
 
-func (ec *executionContext) unmarshalNString2string(ctx context.Context, v interface{}) (string, error) {
-	res, err := graphql.UnmarshalString(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalNString2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
-	res := graphql.MarshalString(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
-		}
-	}
-	return res
-}
-
-func (ec *executionContext) unmarshalNTime2timeᚐTime(ctx context.Context, v interface{}) (time.Time, error) {
-	res, err := graphql.UnmarshalTime(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalNTime2timeᚐTime(ctx context.Context, sel ast.SelectionSet, v time.Time) graphql.Marshaler {
-	res := graphql.MarshalTime(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
-		}
-	}
-	return res
-}
-
 func (ec *executionContext) marshalNUser2ᚖrequest_time_offsᚋgraphᚋmodelᚐUser(ctx context.Context, sel ast.SelectionSet, v *model.User) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
@@ -8860,7 +22673,174 @@ func (ec *executionContext) marshalN__Type2githubᚗcomᚋ99designsᚋgqlgenᚋg
 	return ec.___Type(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.Type) graphql.Marshaler {
+func (ec *executionContext) marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.Type) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalN__Type2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx context.Context, sel ast.SelectionSet, v *introspection.Type) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec.___Type(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalN__TypeKind2string(ctx context.Context, v interface{}) (string, error) {
+	res, err := graphql.UnmarshalString(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalN__TypeKind2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+	res := graphql.MarshalString(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
+
+func (ec *executionContext) marshalOAbsenceLimit2ᚖrequest_time_offsᚋgraphᚋmodelᚐAbsenceLimit(ctx context.Context, sel ast.SelectionSet, v *model.AbsenceLimit) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._AbsenceLimit(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalOAssignedShift2ᚖrequest_time_offsᚋgraphᚋmodelᚐAssignedShift(ctx context.Context, sel ast.SelectionSet, v *model.AssignedShift) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._AssignedShift(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalOAssignedShiftActivities2ᚕᚖrequest_time_offsᚋgraphᚋmodelᚐAssignedShiftActivities(ctx context.Context, sel ast.SelectionSet, v []*model.AssignedShiftActivities) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalOAssignedShiftActivities2ᚖrequest_time_offsᚋgraphᚋmodelᚐAssignedShiftActivities(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	return ret
+}
+
+func (ec *executionContext) marshalOAssignedShiftActivities2ᚖrequest_time_offsᚋgraphᚋmodelᚐAssignedShiftActivities(ctx context.Context, sel ast.SelectionSet, v *model.AssignedShiftActivities) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._AssignedShiftActivities(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalOBlackoutPeriod2ᚖrequest_time_offsᚋgraphᚋmodelᚐBlackoutPeriod(ctx context.Context, sel ast.SelectionSet, v *model.BlackoutPeriod) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._BlackoutPeriod(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalOBoolean2bool(ctx context.Context, v interface{}) (bool, error) {
+	res, err := graphql.UnmarshalBoolean(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
+	res := graphql.MarshalBoolean(v)
+	return res
+}
+
+func (ec *executionContext) unmarshalOBoolean2ᚖbool(ctx context.Context, v interface{}) (*bool, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := graphql.UnmarshalBoolean(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOBoolean2ᚖbool(ctx context.Context, sel ast.SelectionSet, v *bool) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	res := graphql.MarshalBoolean(*v)
+	return res
+}
+
+func (ec *executionContext) marshalOCapacityWarning2ᚕᚖrequest_time_offsᚋgraphᚋmodelᚐCapacityWarningᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.CapacityWarning) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -8884,7 +22864,7 @@ func (ec *executionContext) marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgen
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalN__Type2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, sel, v[i])
+			ret[i] = ec.marshalNCapacityWarning2ᚖrequest_time_offsᚋgraphᚋmodelᚐCapacityWarning(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -8904,39 +22884,119 @@ func (ec *executionContext) marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgen
 	return ret
 }
 
-func (ec *executionContext) marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx context.Context, sel ast.SelectionSet, v *introspection.Type) graphql.Marshaler {
+func (ec *executionContext) unmarshalOFloat2ᚖfloat64(ctx context.Context, v interface{}) (*float64, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := graphql.UnmarshalFloatContext(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOFloat2ᚖfloat64(ctx context.Context, sel ast.SelectionSet, v *float64) graphql.Marshaler {
 	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
-		}
 		return graphql.Null
 	}
-	return ec.___Type(ctx, sel, v)
+	res := graphql.MarshalFloatContext(*v)
+	return graphql.WrapContextMarshaler(ctx, res)
 }
 
-func (ec *executionContext) unmarshalN__TypeKind2string(ctx context.Context, v interface{}) (string, error) {
-	res, err := graphql.UnmarshalString(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+func (ec *executionContext) unmarshalOID2ᚖstring(ctx context.Context, v interface{}) (*string, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := graphql.UnmarshalID(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalN__TypeKind2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
-	res := graphql.MarshalString(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
-		}
+func (ec *executionContext) marshalOID2ᚖstring(ctx context.Context, sel ast.SelectionSet, v *string) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
 	}
+	res := graphql.MarshalID(*v)
 	return res
 }
 
-func (ec *executionContext) marshalOAssignedShift2ᚖrequest_time_offsᚋgraphᚋmodelᚐAssignedShift(ctx context.Context, sel ast.SelectionSet, v *model.AssignedShift) graphql.Marshaler {
+func (ec *executionContext) unmarshalOInt2ᚖint(ctx context.Context, v interface{}) (*int, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := graphql.UnmarshalInt(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOInt2ᚖint(ctx context.Context, sel ast.SelectionSet, v *int) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
-	return ec._AssignedShift(ctx, sel, v)
+	res := graphql.MarshalInt(*v)
+	return res
 }
 
-func (ec *executionContext) marshalOAssignedShiftActivities2ᚕᚖrequest_time_offsᚋgraphᚋmodelᚐAssignedShiftActivities(ctx context.Context, sel ast.SelectionSet, v []*model.AssignedShiftActivities) graphql.Marshaler {
+func (ec *executionContext) marshalOLeaveType2ᚖrequest_time_offsᚋgraphᚋmodelᚐLeaveType(ctx context.Context, sel ast.SelectionSet, v *model.LeaveType) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._LeaveType(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalORequestComment2ᚖrequest_time_offsᚋgraphᚋmodelᚐRequestComment(ctx context.Context, sel ast.SelectionSet, v *model.RequestComment) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._RequestComment(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalORequestExpirySettings2ᚖrequest_time_offsᚋgraphᚋmodelᚐRequestExpirySettings(ctx context.Context, sel ast.SelectionSet, v *model.RequestExpirySettings) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._RequestExpirySettings(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalORequestFilter2ᚖrequest_time_offsᚋgraphᚋmodelᚐRequestFilter(ctx context.Context, v interface{}) (*model.RequestFilter, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputRequestFilter(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalORequestOrder2ᚖrequest_time_offsᚋgraphᚋmodelᚐRequestOrder(ctx context.Context, v interface{}) (*model.RequestOrder, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputRequestOrder(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalORequestResponse2ᚖrequest_time_offsᚋgraphᚋmodelᚐRequestResponse(ctx context.Context, sel ast.SelectionSet, v *model.RequestResponse) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._RequestResponse(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalORequestStatus2ᚕrequest_time_offsᚋgraphᚋmodelᚐRequestStatusᚄ(ctx context.Context, v interface{}) ([]model.RequestStatus, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []interface{}
+	if v != nil {
+		vSlice = graphql.CoerceList(v)
+	}
+	var err error
+	res := make([]model.RequestStatus, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNRequestStatus2request_time_offsᚋgraphᚋmodelᚐRequestStatus(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalORequestStatus2ᚕrequest_time_offsᚋgraphᚋmodelᚐRequestStatusᚄ(ctx context.Context, sel ast.SelectionSet, v []model.RequestStatus) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
@@ -8963,7 +23023,7 @@ func (ec *executionContext) marshalOAssignedShiftActivities2ᚕᚖrequest_time_o
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalOAssignedShiftActivities2ᚖrequest_time_offsᚋgraphᚋmodelᚐAssignedShiftActivities(ctx, sel, v[i])
+			ret[i] = ec.marshalNRequestStatus2request_time_offsᚋgraphᚋmodelᚐRequestStatus(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -8974,102 +23034,106 @@ func (ec *executionContext) marshalOAssignedShiftActivities2ᚕᚖrequest_time_o
 	}
 	wg.Wait()
 
-	return ret
-}
-
-func (ec *executionContext) marshalOAssignedShiftActivities2ᚖrequest_time_offsᚋgraphᚋmodelᚐAssignedShiftActivities(ctx context.Context, sel ast.SelectionSet, v *model.AssignedShiftActivities) graphql.Marshaler {
-	if v == nil {
-		return graphql.Null
-	}
-	return ec._AssignedShiftActivities(ctx, sel, v)
-}
-
-func (ec *executionContext) unmarshalOBoolean2bool(ctx context.Context, v interface{}) (bool, error) {
-	res, err := graphql.UnmarshalBoolean(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalOBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
-	res := graphql.MarshalBoolean(v)
-	return res
-}
-
-func (ec *executionContext) unmarshalOBoolean2ᚖbool(ctx context.Context, v interface{}) (*bool, error) {
-	if v == nil {
-		return nil, nil
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
 	}
-	res, err := graphql.UnmarshalBoolean(v)
-	return &res, graphql.ErrorOnPath(ctx, err)
-}
 
-func (ec *executionContext) marshalOBoolean2ᚖbool(ctx context.Context, sel ast.SelectionSet, v *bool) graphql.Marshaler {
-	if v == nil {
-		return graphql.Null
-	}
-	res := graphql.MarshalBoolean(*v)
-	return res
+	return ret
 }
 
-func (ec *executionContext) unmarshalOID2ᚖstring(ctx context.Context, v interface{}) (*string, error) {
+func (ec *executionContext) unmarshalORequestStatus2ᚖrequest_time_offsᚋgraphᚋmodelᚐRequestStatus(ctx context.Context, v interface{}) (*model.RequestStatus, error) {
 	if v == nil {
 		return nil, nil
 	}
-	res, err := graphql.UnmarshalID(v)
-	return &res, graphql.ErrorOnPath(ctx, err)
+	var res = new(model.RequestStatus)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalOID2ᚖstring(ctx context.Context, sel ast.SelectionSet, v *string) graphql.Marshaler {
+func (ec *executionContext) marshalORequestStatus2ᚖrequest_time_offsᚋgraphᚋmodelᚐRequestStatus(ctx context.Context, sel ast.SelectionSet, v *model.RequestStatus) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
-	res := graphql.MarshalID(*v)
-	return res
+	return v
 }
 
-func (ec *executionContext) marshalORequestResponse2ᚖrequest_time_offsᚋgraphᚋmodelᚐRequestResponse(ctx context.Context, sel ast.SelectionSet, v *model.RequestResponse) graphql.Marshaler {
+func (ec *executionContext) marshalORequestTimeOff2ᚖrequest_time_offsᚋgraphᚋmodelᚐRequestTimeOff(ctx context.Context, sel ast.SelectionSet, v *model.RequestTimeOff) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
-	return ec._RequestResponse(ctx, sel, v)
+	return ec._RequestTimeOff(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalORequestStatus2ᚖrequest_time_offsᚋgraphᚋmodelᚐRequestStatus(ctx context.Context, v interface{}) (*model.RequestStatus, error) {
+func (ec *executionContext) unmarshalORequestType2ᚖrequest_time_offsᚋgraphᚋmodelᚐRequestType(ctx context.Context, v interface{}) (*model.RequestType, error) {
 	if v == nil {
 		return nil, nil
 	}
-	var res = new(model.RequestStatus)
+	var res = new(model.RequestType)
 	err := res.UnmarshalGQL(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalORequestStatus2ᚖrequest_time_offsᚋgraphᚋmodelᚐRequestStatus(ctx context.Context, sel ast.SelectionSet, v *model.RequestStatus) graphql.Marshaler {
+func (ec *executionContext) marshalORequestType2ᚖrequest_time_offsᚋgraphᚋmodelᚐRequestType(ctx context.Context, sel ast.SelectionSet, v *model.RequestType) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
 	return v
 }
 
-func (ec *executionContext) marshalORequestTimeOff2ᚖrequest_time_offsᚋgraphᚋmodelᚐRequestTimeOff(ctx context.Context, sel ast.SelectionSet, v *model.RequestTimeOff) graphql.Marshaler {
+func (ec *executionContext) marshalOShiftConflict2ᚕᚖrequest_time_offsᚋgraphᚋmodelᚐShiftConflictᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.ShiftConflict) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
-	return ec._RequestTimeOff(ctx, sel, v)
-}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNShiftConflict2ᚖrequest_time_offsᚋgraphᚋmodelᚐShiftConflict(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-func (ec *executionContext) unmarshalORequestType2ᚖrequest_time_offsᚋgraphᚋmodelᚐRequestType(ctx context.Context, v interface{}) (*model.RequestType, error) {
-	if v == nil {
-		return nil, nil
 	}
-	var res = new(model.RequestType)
-	err := res.UnmarshalGQL(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
 }
 
-func (ec *executionContext) marshalORequestType2ᚖrequest_time_offsᚋgraphᚋmodelᚐRequestType(ctx context.Context, sel ast.SelectionSet, v *model.RequestType) graphql.Marshaler {
+func (ec *executionContext) marshalOShiftConflictSettings2ᚖrequest_time_offsᚋgraphᚋmodelᚐShiftConflictSettings(ctx context.Context, sel ast.SelectionSet, v *model.ShiftConflictSettings) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
-	return v
+	return ec._ShiftConflictSettings(ctx, sel, v)
 }
 
 func (ec *executionContext) unmarshalOString2ᚖstring(ctx context.Context, v interface{}) (*string, error) {
@@ -9104,6 +23168,60 @@ func (ec *executionContext) marshalOTime2ᚖtimeᚐTime(ctx context.Context, sel
 	return res
 }
 
+func (ec *executionContext) marshalOTimeOffAccrualPolicy2ᚖrequest_time_offsᚋgraphᚋmodelᚐTimeOffAccrualPolicy(ctx context.Context, sel ast.SelectionSet, v *model.TimeOffAccrualPolicy) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._TimeOffAccrualPolicy(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalOTimeOffBalance2ᚕᚖrequest_time_offsᚋgraphᚋmodelᚐTimeOffBalanceᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.TimeOffBalance) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNTimeOffBalance2ᚖrequest_time_offsᚋgraphᚋmodelᚐTimeOffBalance(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
 func (ec *executionContext) marshalOTimeOffResponse2ᚖrequest_time_offsᚋgraphᚋmodelᚐTimeOffResponse(ctx context.Context, sel ast.SelectionSet, v *model.TimeOffResponse) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
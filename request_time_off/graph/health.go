@@ -0,0 +1,139 @@
+package graph
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Dependency is another service this one calls, checked by the readiness probe
+type Dependency struct {
+	Name      string
+	URL       string
+	DaprAppID string
+}
+
+// HealthChecker serves the liveness (/healthz) and readiness (/readyz) probes
+type HealthChecker struct {
+	database     *Database
+	credentials  CredentialsProvider
+	dependencies []Dependency
+	client       *http.Client
+	shuttingDown atomic.Bool
+}
+
+const healthCheckTimeout = 2 * time.Second
+
+// notConfigured is the result of a dependency without a URL, such as in a
+// local setup; it is reported but does not make the service unready
+const notConfigured = "not configured"
+
+func NewHealthChecker(database *Database, credentials CredentialsProvider, dependencies []Dependency) *HealthChecker {
+	return &HealthChecker{
+		database:     database,
+		credentials:  credentials,
+		dependencies: dependencies,
+		client:       &http.Client{Timeout: healthCheckTimeout},
+	}
+}
+
+// ShuttingDown makes the readiness probe fail so no new traffic is routed
+// to the pod while in-flight requests are drained
+func (h *HealthChecker) ShuttingDown() {
+	h.shuttingDown.Store(true)
+}
+
+// Healthz reports that the process is alive
+func (h *HealthChecker) Healthz(writer http.ResponseWriter, request *http.Request) {
+	writeHealth(writer, http.StatusOK, map[string]string{"status": "ok"})
+}
+
+// Readyz reports whether the service can handle requests: the database
+// answers, the credentials are valid and the dependent services are reachable
+func (h *HealthChecker) Readyz(writer http.ResponseWriter, request *http.Request) {
+	ctx, cancel := context.WithTimeout(request.Context(), healthCheckTimeout)
+	defer cancel()
+
+	checks := map[string]string{}
+	var checksMutex sync.Mutex
+	var wg sync.WaitGroup
+
+	check := func(name string, f func() error) {
+		defer wg.Done()
+
+		result := "ok"
+		if err := f(); err != nil {
+			result = err.Error()
+		}
+
+		checksMutex.Lock()
+		checks[name] = result
+		checksMutex.Unlock()
+	}
+
+	wg.Add(2 + len(h.dependencies))
+	go check("database", func() error { return h.database.Ping(ctx) })
+	go check("credentials", h.credentials.Status)
+	for _, dependency := range h.dependencies {
+		dependency := dependency
+		if dependency.URL == "" {
+			checksMutex.Lock()
+			checks[dependency.Name] = notConfigured
+			checksMutex.Unlock()
+			wg.Done()
+			continue
+		}
+		go check(dependency.Name, func() error { return h.reach(ctx, dependency) })
+	}
+	wg.Wait()
+
+	status := http.StatusOK
+	for _, result := range checks {
+		if result != "ok" && result != notConfigured {
+			status = http.StatusServiceUnavailable
+		}
+	}
+	if h.shuttingDown.Load() {
+		checks["shutdown"] = "in progress"
+		status = http.StatusServiceUnavailable
+	}
+
+	writeHealth(writer, status, map[string]interface{}{
+		"status": http.StatusText(status),
+		"checks": checks,
+	})
+}
+
+// reach only checks that the dependency answers; any response below 500
+// (including a GraphQL "missing query" error) counts as reachable
+func (h *HealthChecker) reach(ctx context.Context, dependency Dependency) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, dependency.URL, nil)
+	if err != nil {
+		return err
+	}
+	if dependency.DaprAppID != "" {
+		request.Header.Add("dapr-app-id", dependency.DaprAppID)
+	}
+
+	response, err := h.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode >= http.StatusInternalServerError {
+		return fmt.Errorf("unexpected status %d", response.StatusCode)
+	}
+
+	return nil
+}
+
+func writeHealth(writer http.ResponseWriter, status int, body interface{}) {
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(status)
+	_ = json.NewEncoder(writer).Encode(body)
+}
@@ -0,0 +1,188 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"request_time_offs/graph/model"
+	"request_time_offs/util"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"gorm.io/gorm"
+)
+
+// maxCommentLength is the most characters a comment may have
+const maxCommentLength = 2000
+
+// newHistoryEntry is an entry of the history of the request time off; a nil
+// actor is the service itself
+func newHistoryEntry(recordID string, action model.RequestHistoryAction, field string, oldValue *string, newValue *string, actorUserID *string) *model.RequestHistoryEntry {
+	entry := &model.RequestHistoryEntry{
+		RecordID:    recordID,
+		Action:      action,
+		OldValue:    oldValue,
+		NewValue:    newValue,
+		ActorUserID: actorUserID,
+		CreatedAt:   time.Now().UTC(),
+	}
+	if field != "" {
+		entry.Field = &field
+	}
+
+	return entry
+}
+
+// newStatusEntry records the move of the request time off from one status to another
+func newStatusEntry(recordID string, from model.RequestStatus, to model.RequestStatus, actorUserID *string) *model.RequestHistoryEntry {
+	oldValue, newValue := from.String(), to.String()
+	return newHistoryEntry(recordID, model.RequestHistoryActionStatusChanged, "status", &oldValue, &newValue, actorUserID)
+}
+
+// recordHistory appends the entries in the transaction of the change they describe
+func recordHistory(tx *gorm.DB, entries ...*model.RequestHistoryEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	return tx.Create(entries).Error
+}
+
+// requestTimeOffEdits are the history entries of updating requestTimeOff with
+// changes; like gorm, the empty fields of changes are left as they are
+func requestTimeOffEdits(requestTimeOff *model.RequestTimeOff, changes model.RequestTimeOff, actorUserID *string) []*model.RequestHistoryEntry {
+	fields := []struct {
+		name     string
+		oldValue *string
+		newValue *string
+	}{
+		{"userId", &requestTimeOff.UserID, nonEmpty(changes.UserID)},
+		{"channelId", requestTimeOff.ChannelID, changes.ChannelID},
+		{"startTime", formatTime(&requestTimeOff.StartTime), formatTime(nonZero(changes.StartTime))},
+		{"endTime", formatTime(requestTimeOff.EndTime), formatTime(changes.EndTime)},
+		{"is24Hours", formatBool(requestTimeOff.Is24Hours), formatBool(changes.Is24Hours)},
+		{"reason", requestTimeOff.Reason, changes.Reason},
+		{"leaveType", &requestTimeOff.LeaveType, nonEmpty(changes.LeaveType)},
+		{"attachmentUrl", requestTimeOff.AttachmentURL, changes.AttachmentURL},
+		{"blackoutOverrideReason", requestTimeOff.BlackoutOverrideReason, changes.BlackoutOverrideReason},
+		{"requestNote", requestTimeOff.RequestNote, changes.RequestNote},
+		{"responseNote", requestTimeOff.ResponseNote, changes.ResponseNote},
+		{"responseByUserId", requestTimeOff.ResponseByUserID, changes.ResponseByUserID},
+		{"responseAt", formatTime(requestTimeOff.ResponseAt), formatTime(changes.ResponseAt)},
+	}
+
+	var entries []*model.RequestHistoryEntry
+	for _, field := range fields {
+		if field.newValue == nil || (field.oldValue != nil && *field.oldValue == *field.newValue) {
+			continue
+		}
+
+		entries = append(entries, newHistoryEntry(requestTimeOff.ID, model.RequestHistoryActionUpdated, field.name, field.oldValue, field.newValue, actorUserID))
+	}
+
+	if changes.Status != "" && changes.Status != requestTimeOff.Status {
+		entries = append(entries, newStatusEntry(requestTimeOff.ID, requestTimeOff.Status, changes.Status, actorUserID))
+	}
+
+	return entries
+}
+
+// getRequestHistory fetches the history of the request time offs, oldest first
+func getRequestHistory(ctx context.Context, ids []string) (map[string][]*model.RequestHistoryEntry, error) {
+	var entries []*model.RequestHistoryEntry
+	err := GetOpenConnection().WithContext(ctx).Where("record_id IN ?", ids).Order("created_at, id").Find(&entries).Error
+	if err != nil {
+		return nil, err
+	}
+
+	history := make(map[string][]*model.RequestHistoryEntry, len(ids))
+	for _, entry := range entries {
+		history[entry.RecordID] = append(history[entry.RecordID], entry)
+	}
+
+	return history, nil
+}
+
+// getRequestComments fetches the comments on the request time offs, oldest first
+func getRequestComments(ctx context.Context, ids []string) (map[string][]*model.RequestComment, error) {
+	var comments []*model.RequestComment
+	err := GetOpenConnection().WithContext(ctx).Where("record_id IN ?", ids).Order("created_at, id").Find(&comments).Error
+	if err != nil {
+		return nil, err
+	}
+
+	byRecord := make(map[string][]*model.RequestComment, len(ids))
+	for _, comment := range comments {
+		byRecord[comment.RecordID] = append(byRecord[comment.RecordID], comment)
+	}
+
+	return byRecord, nil
+}
+
+// addRequestTimeOffComment adds the comment of userID to the request time
+// off; with requesterOnly the user has to be its requester
+func (r *Resolver) addRequestTimeOffComment(ctx context.Context, id string, userID string, body string, requesterOnly bool) (*model.RequestTimeOff, *model.RequestComment, error) {
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return nil, nil, util.NewError(util.ErrorCodeValidation, "body is required")
+	}
+	if utf8.RuneCountInString(body) > maxCommentLength {
+		return nil, nil, util.NewError(util.ErrorCodeValidation, fmt.Sprintf("body must be at most %d characters", maxCommentLength))
+	}
+
+	var requestTimeOff model.RequestTimeOff
+	if err := r.DB.WithContext(ctx).Where("id = ?", id).First(&requestTimeOff).Error; err != nil {
+		return nil, nil, err
+	}
+
+	if requesterOnly && requestTimeOff.UserID != userID {
+		return nil, nil, util.NewError(util.ErrorCodeForbidden, "Permission denied: request_time_off.READ_ALL")
+	}
+
+	comment := &model.RequestComment{
+		RecordID:  requestTimeOff.ID,
+		UserID:    userID,
+		Body:      body,
+		CreatedAt: time.Now().UTC(),
+	}
+	if err := r.DB.WithContext(ctx).Create(comment).Error; err != nil {
+		return nil, nil, err
+	}
+
+	return &requestTimeOff, comment, nil
+}
+
+func nonEmpty(value string) *string {
+	if value == "" {
+		return nil
+	}
+
+	return &value
+}
+
+func formatTime(value *time.Time) *string {
+	if value == nil {
+		return nil
+	}
+
+	formatted := value.UTC().Format(time.RFC3339)
+	return &formatted
+}
+
+func nonZero(value time.Time) *time.Time {
+	if value.IsZero() {
+		return nil
+	}
+
+	return &value
+}
+
+func formatBool(value *bool) *string {
+	if value == nil {
+		return nil
+	}
+
+	formatted := strconv.FormatBool(*value)
+	return &formatted
+}
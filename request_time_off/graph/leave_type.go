@@ -0,0 +1,225 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"request_time_offs/events"
+	"request_time_offs/graph/model"
+	"request_time_offs/util"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/getsentry/sentry-go"
+	"gorm.io/gorm"
+)
+
+// Catalog keeps the leave types of the channels. A channel without leave
+// types takes time offs of any leave type; once it has some, a time off has
+// to be of one of them and follows its policy.
+type Catalog struct {
+	db *gorm.DB
+}
+
+func NewCatalog(db *gorm.DB) *Catalog {
+	return &Catalog{db: db}
+}
+
+// LeaveTypes are the leave types of the channel by name, without the
+// archived ones unless includeArchived
+func (c *Catalog) LeaveTypes(ctx context.Context, channelID string, includeArchived bool) ([]*model.LeaveType, error) {
+	query := c.db.WithContext(ctx).Where("channel_id = ?", channelID)
+	if !includeArchived {
+		query = query.Where("NOT archived")
+	}
+
+	leaveTypes := []*model.LeaveType{}
+	if err := query.Order("name, code").Find(&leaveTypes).Error; err != nil {
+		return nil, err
+	}
+
+	return leaveTypes, nil
+}
+
+// SetLeaveType stores the leave type with the code of the input in the
+// channel, creating it when the channel has none with that code
+func (c *Catalog) SetLeaveType(ctx context.Context, channelID string, input model.LeaveTypeInput, updatedByUserID string) (*model.LeaveType, error) {
+	updatedAt := time.Now().UTC()
+	leaveType := &model.LeaveType{
+		ChannelID:          channelID,
+		Code:               strings.TrimSpace(input.Code),
+		Name:               strings.TrimSpace(input.Name),
+		Color:              input.Color,
+		Paid:               input.Paid,
+		RequiresAttachment: input.RequiresAttachment != nil && *input.RequiresAttachment,
+		MinimumNoticeHours: intValue(input.MinimumNoticeHours, 0),
+		MaxConsecutiveDays: input.MaxConsecutiveDays,
+		AutoApprove:        input.AutoApprove != nil && *input.AutoApprove,
+		Archived:           input.Archived != nil && *input.Archived,
+		UpdatedByUserID:    &updatedByUserID,
+		UpdatedAt:          &updatedAt,
+	}
+
+	if err := validateLeaveTypeInput(leaveType); err != nil {
+		return nil, err
+	}
+
+	if err := c.db.WithContext(ctx).Save(leaveType).Error; err != nil {
+		return nil, err
+	}
+
+	return leaveType, nil
+}
+
+func validateLeaveTypeInput(leaveType *model.LeaveType) error {
+	if leaveType.Code == "" || utf8.RuneCountInString(leaveType.Code) > 64 {
+		return util.NewError(util.ErrorCodeValidation, "code is required and must be at most 64 characters")
+	}
+	if leaveType.Name == "" || utf8.RuneCountInString(leaveType.Name) > 128 {
+		return util.NewError(util.ErrorCodeValidation, "name is required and must be at most 128 characters")
+	}
+	if leaveType.MinimumNoticeHours < 0 {
+		return util.NewError(util.ErrorCodeValidation, "minimumNoticeHours must be at least 0")
+	}
+	if leaveType.MaxConsecutiveDays != nil && *leaveType.MaxConsecutiveDays < 1 {
+		return util.NewError(util.ErrorCodeValidation, "maxConsecutiveDays must be at least 1")
+	}
+
+	return nil
+}
+
+// findLeaveType returns the leave type with the code in the channel, nil
+// when it has none
+func findLeaveType(tx *gorm.DB, channelID string, code string) (*model.LeaveType, error) {
+	var leaveTypes []*model.LeaveType
+	err := tx.Where("channel_id = ? AND code = ?", channelID, code).Limit(1).Find(&leaveTypes).Error
+	if err != nil {
+		return nil, err
+	}
+
+	if len(leaveTypes) == 0 {
+		return nil, nil
+	}
+
+	return leaveTypes[0], nil
+}
+
+// validateLeaveType checks the time off against the policy of its leave type
+// and returns the leave type, nil when the channel has no leave types. The
+// minimum notice is only checked with checkNotice, as a time off that is
+// edited after the deadline keeps the notice it was requested with.
+func validateLeaveType(tx *gorm.DB, requestTimeOff *model.RequestTimeOff, checkNotice bool) (*model.LeaveType, error) {
+	if requestTimeOff.ChannelID == nil {
+		return nil, nil
+	}
+
+	code := leaveTypeOf(requestTimeOff)
+	leaveType, err := findLeaveType(tx, *requestTimeOff.ChannelID, code)
+	if err != nil {
+		return nil, err
+	}
+
+	if leaveType == nil {
+		var count int64
+		if err := tx.Model(&model.LeaveType{}).Where("channel_id = ?", *requestTimeOff.ChannelID).Count(&count).Error; err != nil {
+			return nil, err
+		}
+		if count > 0 {
+			return nil, util.NewError(util.ErrorCodeValidation, fmt.Sprintf("%s is not a leave type of the channel", code))
+		}
+
+		return nil, nil
+	}
+
+	if leaveType.Archived {
+		return nil, util.NewError(util.ErrorCodeValidation, fmt.Sprintf("Leave type %s is archived", code))
+	}
+	if leaveType.RequiresAttachment && (requestTimeOff.AttachmentURL == nil || strings.TrimSpace(*requestTimeOff.AttachmentURL) == "") {
+		return nil, util.NewError(util.ErrorCodeValidation, fmt.Sprintf("Leave type %s requires an attachmentUrl", code))
+	}
+	if checkNotice && leaveType.MinimumNoticeHours > 0 &&
+		requestTimeOff.StartTime.Before(time.Now().Add(time.Duration(leaveType.MinimumNoticeHours)*time.Hour)) {
+		return nil, util.NewError(util.ErrorCodeValidation, fmt.Sprintf("Leave type %s has to be requested %d hours before it starts", code, leaveType.MinimumNoticeHours))
+	}
+	if leaveType.MaxConsecutiveDays != nil && consecutiveDays(requestTimeOff) > *leaveType.MaxConsecutiveDays {
+		return nil, util.NewError(util.ErrorCodeValidation, fmt.Sprintf("Leave type %s allows at most %d consecutive days", code, *leaveType.MaxConsecutiveDays))
+	}
+
+	return leaveType, nil
+}
+
+// autoApprove approves the new time off when its leave type is auto-approved,
+// under the shift conflict policy of the channel, and returns it with the
+// shifts assigned during it. A time off that cannot be approved, such as one
+// blocked by shifts or over an absence limit, is left pending.
+func (r *Resolver) autoApprove(ctx context.Context, requestTimeOff *model.RequestTimeOff, leaveType *model.LeaveType, actorUserID string) (*model.RequestTimeOff, []*model.ShiftConflict) {
+	if leaveType == nil || !leaveType.AutoApprove {
+		return requestTimeOff, nil
+	}
+
+	responseNote := fmt.Sprintf("Approved automatically (%s)", leaveType.Name)
+	approved, conflicts, _, err := r.approveRequestTimeOff(ctx, requestTimeOff.ID, actorUserID, &responseNote, nil)
+	if err != nil {
+		if util.ShiftErrorCodeOf(err) != model.ShiftErrorCodeConflict {
+			sentry.CaptureException(err)
+			log.Printf("unable to approve request time off %s automatically: %v", requestTimeOff.ID, err)
+		}
+
+		return requestTimeOff, conflicts
+	}
+
+	r.publish(ctx, events.NewRequestTimeOffEvent(events.RequestTimeOffApproved, *approved, &actorUserID))
+	return approved, conflicts
+}
+
+// consecutiveDays are the calendar days (UTC) the time off spans. A time off
+// without an end lasts the day it starts, and one ending at midnight does not count the
+// day it ends on.
+func consecutiveDays(requestTimeOff *model.RequestTimeOff) int {
+	start, end := timeOffPeriod(requestTimeOff)
+	start = start.UTC()
+	if !end.After(start) {
+		return 1
+	}
+
+	last := end.UTC().Add(-time.Nanosecond)
+	firstDay := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+	lastDay := time.Date(last.Year(), last.Month(), last.Day(), 0, 0, 0, 0, time.UTC)
+
+	return int(lastDay.Sub(firstDay).Hours()/24) + 1
+}
+
+// leaveTypeKey is the key of the leave type with the code in the channel for
+// the LeaveTypes loader
+func leaveTypeKey(channelID string, code string) string {
+	return channelID + "/" + code
+}
+
+// getLeaveTypes fetches the leave types by their leaveTypeKey
+func getLeaveTypes(ctx context.Context, keys []string) (map[string]*model.LeaveType, error) {
+	pairs := make([][]interface{}, 0, len(keys))
+	for _, key := range keys {
+		// codes may contain "/", channel ids do not
+		channelID, code, ok := strings.Cut(key, "/")
+		if ok {
+			pairs = append(pairs, []interface{}{channelID, code})
+		}
+	}
+
+	leaveTypes := make(map[string]*model.LeaveType, len(keys))
+	if len(pairs) == 0 {
+		return leaveTypes, nil
+	}
+
+	var found []*model.LeaveType
+	if err := GetOpenConnection().WithContext(ctx).Where("(channel_id, code) IN ?", pairs).Find(&found).Error; err != nil {
+		return nil, err
+	}
+
+	for _, leaveType := range found {
+		leaveTypes[leaveTypeKey(leaveType.ChannelID, leaveType.Code)] = leaveType
+	}
+
+	return leaveTypes, nil
+}
@@ -0,0 +1,46 @@
+package graph
+
+import (
+	"request_time_offs/graph/model"
+	"testing"
+	"time"
+)
+
+func TestConsecutiveDays(t *testing.T) {
+	at := func(days int, hours int) *time.Time {
+		value := monday.AddDate(0, 0, days).Add(time.Duration(hours) * time.Hour)
+		return &value
+	}
+
+	tests := []struct {
+		name  string
+		start *time.Time
+		end   *time.Time
+		want  int
+	}{
+		{name: "without an end", start: at(0, 9), want: 1},
+		{name: "end before start", start: at(1, 0), end: at(0, 0), want: 1},
+		{name: "part of a day", start: at(0, 9), end: at(0, 17), want: 1},
+		{name: "whole day", start: at(0, 0), end: at(1, 0), want: 1},
+		{name: "over midnight", start: at(0, 20), end: at(1, 4), want: 2},
+		{name: "week ending at midnight", start: at(0, 0), end: at(7, 0), want: 7},
+		{name: "week ending after midnight", start: at(0, 0), end: at(7, 1), want: 8},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			requestTimeOff := &model.RequestTimeOff{StartTime: *test.start, EndTime: test.end}
+			if got := consecutiveDays(requestTimeOff); got != test.want {
+				t.Errorf("got %d days, want %d", got, test.want)
+			}
+		})
+	}
+
+	// the days are counted in UTC
+	offset := time.FixedZone("UTC+2", 2*60*60)
+	start := time.Date(2024, 3, 4, 1, 0, 0, 0, offset)
+	end := time.Date(2024, 3, 4, 3, 0, 0, 0, offset)
+	if got := consecutiveDays(&model.RequestTimeOff{StartTime: start, EndTime: &end}); got != 2 {
+		t.Errorf("got %d days in UTC, want 2", got)
+	}
+}
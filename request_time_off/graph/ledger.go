@@ -0,0 +1,758 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"os"
+	"request_time_offs/graph/model"
+	"request_time_offs/util"
+	"strconv"
+	"time"
+
+	"github.com/getsentry/sentry-go"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	defaultAccrualInterval = time.Hour
+
+	// defaultHoursPerDay is the working hours of a day of leave of a leave
+	// type without a policy
+	defaultHoursPerDay = 8
+
+	// accrualLockID is the advisory lock held while accruing a policy, so that
+	// only one replica at a time accrues
+	accrualLockID = 8150727143
+)
+
+// Ledger keeps the time off balances of the users, per channel and leave
+// type. Every change of a balance is an entry of the ledger: accruals and
+// grants of the accrual policies, debits of approved time offs, credits of
+// cancelled ones and adjustments of managers.
+type Ledger struct {
+	db *gorm.DB
+}
+
+func NewLedger(db *gorm.DB) *Ledger {
+	return &Ledger{db: db}
+}
+
+// Balances are the balances of the user, optionally only the ones of the
+// channel and the leave type
+func (l *Ledger) Balances(ctx context.Context, userID string, channelID *string, leaveType *string) ([]*model.TimeOffBalance, error) {
+	query := l.db.WithContext(ctx).Where("user_id = ?", userID)
+	if channelID != nil && *channelID != "" {
+		query = query.Where("channel_id = ?", *channelID)
+	}
+	if leaveType != nil && *leaveType != "" {
+		query = query.Where("leave_type = ?", *leaveType)
+	}
+
+	balances := []*model.TimeOffBalance{}
+	if err := query.Order("channel_id, leave_type").Find(&balances).Error; err != nil {
+		return nil, err
+	}
+
+	return balances, nil
+}
+
+// Policies are the accrual policies of the channel
+func (l *Ledger) Policies(ctx context.Context, channelID string) ([]*model.TimeOffAccrualPolicy, error) {
+	policies := []*model.TimeOffAccrualPolicy{}
+	err := l.db.WithContext(ctx).Where("channel_id = ?", channelID).Order("leave_type").Find(&policies).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return policies, nil
+}
+
+// SetPolicy stores the accrual policy of the leave type in the channel
+func (l *Ledger) SetPolicy(ctx context.Context, channelID string, input model.TimeOffAccrualPolicyInput, updatedByUserID string) (*model.TimeOffAccrualPolicy, error) {
+	updatedAt := time.Now().UTC()
+	policy := &model.TimeOffAccrualPolicy{
+		ChannelID:       channelID,
+		LeaveType:       input.LeaveType,
+		Method:          input.Method,
+		Hours:           floatValue(input.Hours, 0),
+		Rate:            floatValue(input.Rate, 0),
+		PeriodDays:      intValue(input.PeriodDays, 14),
+		PeriodStart:     input.PeriodStart,
+		GrantMonth:      intValue(input.GrantMonth, 1),
+		GrantDay:        intValue(input.GrantDay, 1),
+		CarryOverCap:    input.CarryOverCap,
+		HoursPerDay:     floatValue(input.HoursPerDay, defaultHoursPerDay),
+		IncludeWeekends: input.IncludeWeekends != nil && *input.IncludeWeekends,
+		Enabled:         input.Enabled,
+		UpdatedByUserID: &updatedByUserID,
+		UpdatedAt:       &updatedAt,
+		CreatedAt:       updatedAt,
+	}
+
+	if err := validatePolicy(policy); err != nil {
+		return nil, err
+	}
+
+	// every member accrues from the start, not only the ones with a balance already
+	userIDs, err := channelMemberIDs(ctx, channelID, updatedByUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = l.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// the creation time of a policy is kept when it changes
+		err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "channel_id"}, {Name: "leave_type"}},
+			DoUpdates: clause.AssignmentColumns([]string{"method", "hours", "rate", "period_days", "period_start", "grant_month", "grant_day", "carry_over_cap", "hours_per_day", "include_weekends", "enabled", "updated_by_user_id", "updated_at"}),
+		}).Create(policy).Error
+		if err != nil {
+			return err
+		}
+
+		if err := tx.Where("channel_id = ? AND leave_type = ?", channelID, input.LeaveType).First(policy).Error; err != nil {
+			return err
+		}
+
+		return openBalances(tx, channelID, input.LeaveType, userIDs)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+// channelMemberIDs are the users in any shift group of the channel, looked up
+// as authUserID
+func channelMemberIDs(ctx context.Context, channelID string, authUserID string) ([]string, error) {
+	shiftGroupIDs, err := util.GetShiftGroupIDs(ctx, channelID, authUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := map[string]bool{}
+	var userIDs []string
+	for _, shiftGroupID := range shiftGroupIDs {
+		memberIDs, err := util.GetShiftGroupMemberUserIDs(ctx, channelID, shiftGroupID, authUserID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, userID := range memberIDs {
+			if !seen[userID] {
+				seen[userID] = true
+				userIDs = append(userIDs, userID)
+			}
+		}
+	}
+
+	return userIDs, nil
+}
+
+// openBalances opens an empty balance of the leave type for each of the users
+// without one
+func openBalances(tx *gorm.DB, channelID string, leaveType string, userIDs []string) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	now := time.Now().UTC()
+	balances := make([]*model.TimeOffBalance, 0, len(userIDs))
+	for _, userID := range userIDs {
+		balances = append(balances, &model.TimeOffBalance{
+			ChannelID: channelID,
+			UserID:    userID,
+			LeaveType: leaveType,
+			CreatedAt: now,
+			UpdatedAt: now,
+		})
+	}
+
+	return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&balances).Error
+}
+
+func validatePolicy(policy *model.TimeOffAccrualPolicy) error {
+	if policy.LeaveType == "" {
+		return util.NewError(util.ErrorCodeValidation, "leaveType is required")
+	}
+	if !policy.Method.IsValid() {
+		return util.NewError(util.ErrorCodeValidation, fmt.Sprintf("%s is not a valid accrual method", policy.Method))
+	}
+	if policy.Hours < 0 || policy.Rate < 0 || (policy.CarryOverCap != nil && *policy.CarryOverCap < 0) {
+		return util.NewError(util.ErrorCodeValidation, "hours, rate and carryOverCap must be at least 0")
+	}
+	if policy.HoursPerDay <= 0 || policy.HoursPerDay > 24 {
+		return util.NewError(util.ErrorCodeValidation, "hoursPerDay must be more than 0 and at most 24")
+	}
+
+	switch policy.Method {
+	case model.AccrualMethodPerPayPeriod:
+		if policy.PeriodDays < 1 {
+			return util.NewError(util.ErrorCodeValidation, "periodDays must be at least 1")
+		}
+		if policy.PeriodStart == nil {
+			return util.NewError(util.ErrorCodeValidation, "periodStart is required for PER_PAY_PERIOD")
+		}
+	case model.AccrualMethodAnnualGrant:
+		// the 29th of February is granted on the 1st of March in other years
+		if policy.GrantMonth < 1 || policy.GrantMonth > 12 || policy.GrantDay < 1 || policy.GrantDay > 31 ||
+			time.Date(2024, time.Month(policy.GrantMonth), policy.GrantDay, 0, 0, 0, 0, time.UTC).Day() != policy.GrantDay {
+			return util.NewError(util.ErrorCodeValidation, "grantMonth and grantDay must be a day of the year")
+		}
+	}
+
+	return nil
+}
+
+// Adjust adds hours to the balance, or takes them from it when negative
+func (l *Ledger) Adjust(ctx context.Context, channelID string, userID string, leaveType string, hours float64, note *string, actorUserID string) (*model.TimeOffBalance, error) {
+	if hours == 0 || math.IsNaN(hours) || math.IsInf(hours, 0) {
+		return nil, util.NewError(util.ErrorCodeValidation, "hours must not be 0")
+	}
+
+	var balance *model.TimeOffBalance
+	err := l.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		balance, err = lockBalance(tx, channelID, userID, leaveType)
+		if err != nil {
+			return err
+		}
+
+		_, err = postEntry(tx, balance, &model.TimeOffLedgerEntry{
+			Kind:        model.TimeOffLedgerEntryKindAdjustment,
+			Hours:       hours,
+			Note:        note,
+			ActorUserID: &actorUserID,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return balance, nil
+}
+
+// RecordHoursWorked accrues the hours worked until workedUntil for every
+// PER_HOURS_WORKED policy of the channel. The same workedUntil is accrued
+// once, so a retried call does not accrue twice.
+func (l *Ledger) RecordHoursWorked(ctx context.Context, channelID string, userID string, hours float64, workedUntil time.Time, actorUserID string) ([]*model.TimeOffBalance, error) {
+	if hours <= 0 || math.IsNaN(hours) || math.IsInf(hours, 0) {
+		return nil, util.NewError(util.ErrorCodeValidation, "hours must be more than 0")
+	}
+
+	var policies []*model.TimeOffAccrualPolicy
+	err := l.db.WithContext(ctx).Where("channel_id = ? AND method = ? AND enabled", channelID, model.AccrualMethodPerHoursWorked).Order("leave_type").Find(&policies).Error
+	if err != nil {
+		return nil, err
+	}
+
+	balances := []*model.TimeOffBalance{}
+	period := workedUntil.UTC().Format(time.RFC3339)
+	note := fmt.Sprintf("%s hours worked", strconv.FormatFloat(hours, 'f', -1, 64))
+
+	err = l.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, policy := range policies {
+			balance, err := lockBalance(tx, channelID, userID, policy.LeaveType)
+			if err != nil {
+				return err
+			}
+
+			_, err = postEntry(tx, balance, &model.TimeOffLedgerEntry{
+				Kind:        model.TimeOffLedgerEntryKindAccrual,
+				Hours:       hours * policy.Rate,
+				Period:      &period,
+				Note:        &note,
+				ActorUserID: &actorUserID,
+			})
+			if err != nil {
+				return err
+			}
+
+			balances = append(balances, balance)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return balances, nil
+}
+
+// RunAccruer accrues the pay periods and annual grants every interval until
+// ctx is done
+func (l *Ledger) RunAccruer(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := l.Accrue(ctx, time.Now().UTC()); err != nil {
+				sentry.CaptureException(err)
+				log.Printf("time off accruer: %v", err)
+			}
+		}
+	}
+}
+
+// Accrue posts the pay periods ended and the annual grants due by now to the
+// balances of the enabled policies and returns how many entries it posted.
+// Each period is posted once per balance, so accruing again is harmless.
+// When another replica is accruing already it returns right away.
+func (l *Ledger) Accrue(ctx context.Context, now time.Time) (int, error) {
+	var policies []*model.TimeOffAccrualPolicy
+	err := l.db.WithContext(ctx).Where("enabled AND method IN ?", []model.AccrualMethod{model.AccrualMethodPerPayPeriod, model.AccrualMethodAnnualGrant}).Find(&policies).Error
+	if err != nil {
+		return 0, fmt.Errorf("unable to list the accrual policies: %w", err)
+	}
+
+	posted := 0
+	for _, policy := range policies {
+		count, locked, err := l.accruePolicy(ctx, policy, now)
+		if err != nil {
+			return posted, err
+		}
+		if !locked {
+			return posted, nil
+		}
+
+		posted += count
+	}
+
+	return posted, nil
+}
+
+// accruePolicy posts the due entries of the policy to the balances of its
+// leave type in its channel, opening the balances of the members without
+// one, in a transaction holding the accrual lock; locked is false when
+// another replica holds it
+func (l *Ledger) accruePolicy(ctx context.Context, policy *model.TimeOffAccrualPolicy, now time.Time) (int, bool, error) {
+	posted := 0
+	locked := false
+
+	err := l.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Raw("SELECT pg_try_advisory_xact_lock(?)", accrualLockID).Scan(&locked).Error; err != nil {
+			return fmt.Errorf("unable to acquire the accrual lock: %w", err)
+		}
+		if !locked {
+			return nil
+		}
+
+		// members who joined since the policy was set get a balance too; they are
+		// looked up as the user who set the policy, and the ones with a balance
+		// still accrue when the lookup fails
+		if policy.UpdatedByUserID != nil {
+			userIDs, err := channelMemberIDs(ctx, policy.ChannelID, *policy.UpdatedByUserID)
+			if err != nil {
+				sentry.CaptureException(err)
+				log.Printf("unable to list the members of channel %s to accrue %s: %v", policy.ChannelID, policy.LeaveType, err)
+			} else if err := openBalances(tx, policy.ChannelID, policy.LeaveType, userIDs); err != nil {
+				return fmt.Errorf("unable to open the balances of %s in channel %s: %w", policy.LeaveType, policy.ChannelID, err)
+			}
+		}
+
+		var balances []*model.TimeOffBalance
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("channel_id = ? AND leave_type = ?", policy.ChannelID, policy.LeaveType).
+			Order("id").
+			Find(&balances).Error
+		if err != nil {
+			return fmt.Errorf("unable to list the balances of %s in channel %s: %w", policy.LeaveType, policy.ChannelID, err)
+		}
+
+		for _, balance := range balances {
+			var entries []*model.TimeOffLedgerEntry
+			switch policy.Method {
+			case model.AccrualMethodPerPayPeriod:
+				entries = payPeriodEntries(policy, balance, now)
+			case model.AccrualMethodAnnualGrant:
+				entries = annualGrantEntries(policy, balance, now)
+
+				// the carry over cap only applies before the grant of the year
+				granted, err := periodPosted(tx, balance.ID, entries)
+				if err != nil {
+					return err
+				}
+				if granted {
+					entries = nil
+				}
+			}
+
+			for _, entry := range entries {
+				ok, err := postEntry(tx, balance, entry)
+				if err != nil {
+					return fmt.Errorf("unable to accrue %s for user %s: %w", policy.LeaveType, balance.UserID, err)
+				}
+				if ok {
+					posted++
+				}
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, false, err
+	}
+
+	return posted, locked, nil
+}
+
+// payPeriodEntries are the accruals of the pay periods ended by now since the
+// policy and the balance exist; a period is named after its last day
+func payPeriodEntries(policy *model.TimeOffAccrualPolicy, balance *model.TimeOffBalance, now time.Time) []*model.TimeOffLedgerEntry {
+	if policy.PeriodStart == nil || policy.PeriodDays < 1 || policy.Hours == 0 {
+		return nil
+	}
+
+	since := policy.CreatedAt
+	if balance.CreatedAt.After(since) {
+		since = balance.CreatedAt
+	}
+
+	length := time.Duration(policy.PeriodDays) * 24 * time.Hour
+	start := policy.PeriodStart.UTC()
+	index := 0
+	if since.After(start) {
+		index = int(since.Sub(start) / length)
+	}
+
+	var entries []*model.TimeOffLedgerEntry
+	for end := start.Add(time.Duration(index+1) * length); !end.After(now); end = end.Add(length) {
+		if !end.After(since) {
+			continue
+		}
+
+		period := end.Add(-24 * time.Hour).Format("2006-01-02")
+		entries = append(entries, &model.TimeOffLedgerEntry{
+			Kind:   model.TimeOffLedgerEntryKindAccrual,
+			Hours:  policy.Hours,
+			Period: &period,
+		})
+	}
+
+	return entries
+}
+
+// annualGrantEntries are the entries of the latest grant due by now: the
+// hours above the carry over cap expire, then the hours of the year are
+// granted. A grant is named after its year.
+func annualGrantEntries(policy *model.TimeOffAccrualPolicy, balance *model.TimeOffBalance, now time.Time) []*model.TimeOffLedgerEntry {
+	grantedAt := time.Date(now.Year(), time.Month(policy.GrantMonth), policy.GrantDay, 0, 0, 0, 0, time.UTC)
+	if grantedAt.After(now) {
+		grantedAt = grantedAt.AddDate(-1, 0, 0)
+	}
+
+	period := strconv.Itoa(grantedAt.Year())
+	var entries []*model.TimeOffLedgerEntry
+
+	if policy.CarryOverCap != nil && balance.BalanceHours > *policy.CarryOverCap {
+		entries = append(entries, &model.TimeOffLedgerEntry{
+			Kind:   model.TimeOffLedgerEntryKindCarryOverExpiry,
+			Hours:  *policy.CarryOverCap - balance.BalanceHours,
+			Period: &period,
+		})
+	}
+
+	if policy.Hours > 0 {
+		entries = append(entries, &model.TimeOffLedgerEntry{
+			Kind:   model.TimeOffLedgerEntryKindGrant,
+			Hours:  policy.Hours,
+			Period: &period,
+		})
+	}
+
+	return entries
+}
+
+// periodPosted tells whether any of the entries is posted to the balance
+// already
+func periodPosted(tx *gorm.DB, balanceID string, entries []*model.TimeOffLedgerEntry) (bool, error) {
+	if len(entries) == 0 {
+		return false, nil
+	}
+
+	kinds := make([]model.TimeOffLedgerEntryKind, 0, len(entries))
+	for _, entry := range entries {
+		kinds = append(kinds, entry.Kind)
+	}
+
+	var count int64
+	err := tx.Model(&model.TimeOffLedgerEntry{}).
+		Where("balance_id = ? AND kind IN ? AND period = ?", balanceID, kinds, *entries[0].Period).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// lockBalance locks the balance of the user, opening it when the user has none
+func lockBalance(tx *gorm.DB, channelID string, userID string, leaveType string) (*model.TimeOffBalance, error) {
+	now := time.Now().UTC()
+	err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&model.TimeOffBalance{
+		ChannelID: channelID,
+		UserID:    userID,
+		LeaveType: leaveType,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var balance model.TimeOffBalance
+	err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("channel_id = ? AND user_id = ? AND leave_type = ?", channelID, userID, leaveType).
+		First(&balance).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &balance, nil
+}
+
+// postEntry appends the entry to the ledger of the balance, locked by the
+// caller, and moves the balance by its hours. An entry of a period already
+// posted for the balance is skipped and ok is false.
+func postEntry(tx *gorm.DB, balance *model.TimeOffBalance, entry *model.TimeOffLedgerEntry) (bool, error) {
+	now := time.Now().UTC()
+	entry.BalanceID = balance.ID
+	entry.Hours = roundHours(entry.Hours)
+	entry.BalanceAfter = roundHours(balance.BalanceHours + entry.Hours)
+	entry.CreatedAt = now
+
+	result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(entry)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return false, nil
+	}
+
+	err := tx.Model(balance).Updates(map[string]interface{}{
+		"balance_hours": entry.BalanceAfter,
+		"updated_at":    now,
+	}).Error
+	if err != nil {
+		return false, err
+	}
+
+	balance.BalanceHours = entry.BalanceAfter
+	balance.UpdatedAt = now
+
+	return true, nil
+}
+
+// debitTimeOff takes the working hours of the approved time off from the
+// balance of its leave type; unpaid leave types are not debited
+func debitTimeOff(tx *gorm.DB, requestTimeOff *model.RequestTimeOff, actorUserID string) error {
+	if requestTimeOff.ChannelID == nil {
+		return nil
+	}
+
+	leaveType, err := findLeaveType(tx, *requestTimeOff.ChannelID, leaveTypeOf(requestTimeOff))
+	if err != nil {
+		return err
+	}
+	if leaveType != nil && !leaveType.Paid {
+		return nil
+	}
+
+	policy, err := findPolicy(tx, *requestTimeOff.ChannelID, leaveTypeOf(requestTimeOff))
+	if err != nil {
+		return err
+	}
+
+	hours := leaveHours(requestTimeOff, policy)
+	if hours == 0 {
+		return nil
+	}
+
+	balance, err := lockBalance(tx, *requestTimeOff.ChannelID, requestTimeOff.UserID, leaveTypeOf(requestTimeOff))
+	if err != nil {
+		return err
+	}
+
+	_, err = postEntry(tx, balance, &model.TimeOffLedgerEntry{
+		Kind:             model.TimeOffLedgerEntryKindDebit,
+		Hours:            -hours,
+		RequestTimeOffID: &requestTimeOff.ID,
+		ActorUserID:      &actorUserID,
+	})
+	return err
+}
+
+// creditTimeOff gives back the hours debited for the time off and not
+// credited yet, such as when an approved time off is cancelled
+func creditTimeOff(tx *gorm.DB, requestTimeOff *model.RequestTimeOff, actorUserID string) error {
+	var entries []*model.TimeOffLedgerEntry
+	err := tx.Where("request_time_off_id = ? AND kind IN ?", requestTimeOff.ID, []model.TimeOffLedgerEntryKind{model.TimeOffLedgerEntryKindDebit, model.TimeOffLedgerEntryKindCredit}).
+		Find(&entries).Error
+	if err != nil {
+		return err
+	}
+
+	// the entries of one time off are all in the balance it was debited from
+	debited := map[string]float64{}
+	for _, entry := range entries {
+		debited[entry.BalanceID] -= entry.Hours
+	}
+
+	for balanceID, hours := range debited {
+		if roundHours(hours) <= 0 {
+			continue
+		}
+
+		var balance model.TimeOffBalance
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", balanceID).First(&balance).Error; err != nil {
+			return err
+		}
+
+		_, err := postEntry(tx, &balance, &model.TimeOffLedgerEntry{
+			Kind:             model.TimeOffLedgerEntryKindCredit,
+			Hours:            hours,
+			RequestTimeOffID: &requestTimeOff.ID,
+			ActorUserID:      &actorUserID,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// findPolicy returns the accrual policy of the leave type in the channel, nil
+// when it has none
+func findPolicy(tx *gorm.DB, channelID string, leaveType string) (*model.TimeOffAccrualPolicy, error) {
+	var policies []*model.TimeOffAccrualPolicy
+	err := tx.Where("channel_id = ? AND leave_type = ?", channelID, leaveType).Limit(1).Find(&policies).Error
+	if err != nil {
+		return nil, err
+	}
+
+	if len(policies) == 0 {
+		return nil, nil
+	}
+
+	return policies[0], nil
+}
+
+// leaveHours are the working hours the time off covers: on every working day
+// (UTC) it overlaps, the hours it overlaps up to the hours of a day of leave.
+// A time off without an end lasts the day it starts.
+func leaveHours(requestTimeOff *model.RequestTimeOff, policy *model.TimeOffAccrualPolicy) float64 {
+	hoursPerDay, includeWeekends := float64(defaultHoursPerDay), false
+	if policy != nil {
+		hoursPerDay, includeWeekends = policy.HoursPerDay, policy.IncludeWeekends
+	}
+
+	start, end := timeOffPeriod(requestTimeOff)
+	start, end = start.UTC(), end.UTC()
+
+	hours := 0.0
+	for day := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC); day.Before(end); day = day.AddDate(0, 0, 1) {
+		if !includeWeekends && (day.Weekday() == time.Saturday || day.Weekday() == time.Sunday) {
+			continue
+		}
+
+		from, to := day, day.AddDate(0, 0, 1)
+		if start.After(from) {
+			from = start
+		}
+		if end.Before(to) {
+			to = end
+		}
+
+		overlap := to.Sub(from).Hours()
+		if overlap > hoursPerDay {
+			overlap = hoursPerDay
+		}
+		if overlap > 0 {
+			hours += overlap
+		}
+	}
+
+	return roundHours(hours)
+}
+
+// inputLeaveType is the leave type of a time off created or updated with
+// leaveType
+func inputLeaveType(leaveType *string) string {
+	if leaveType == nil || *leaveType == "" {
+		return model.DefaultLeaveType
+	}
+
+	return *leaveType
+}
+
+func leaveTypeOf(requestTimeOff *model.RequestTimeOff) string {
+	if requestTimeOff.LeaveType == "" {
+		return model.DefaultLeaveType
+	}
+
+	return requestTimeOff.LeaveType
+}
+
+// getLedgerEntries fetches the ledger of the balances, oldest first
+func getLedgerEntries(ctx context.Context, ids []string) (map[string][]*model.TimeOffLedgerEntry, error) {
+	var entries []*model.TimeOffLedgerEntry
+	err := GetOpenConnection().WithContext(ctx).Where("balance_id IN ?", ids).Order("created_at, id").Find(&entries).Error
+	if err != nil {
+		return nil, err
+	}
+
+	ledger := make(map[string][]*model.TimeOffLedgerEntry, len(ids))
+	for _, entry := range entries {
+		ledger[entry.BalanceID] = append(ledger[entry.BalanceID], entry)
+	}
+
+	return ledger, nil
+}
+
+// ConfigureFromEnv returns the accrual interval TIME_OFF_ACCRUAL_INTERVAL
+// (seconds)
+func (l *Ledger) ConfigureFromEnv() (time.Duration, error) {
+	interval := defaultAccrualInterval
+
+	if value := os.Getenv("TIME_OFF_ACCRUAL_INTERVAL"); value != "" {
+		seconds, err := strconv.Atoi(value)
+		if err != nil {
+			return 0, fmt.Errorf("unable to convert TIME_OFF_ACCRUAL_INTERVAL to int: %w", err)
+		}
+		interval = time.Duration(seconds) * time.Second
+	}
+
+	return interval, nil
+}
+
+// roundHours rounds to the hundredth of an hour the ledger keeps
+func roundHours(hours float64) float64 {
+	return math.Round(hours*100) / 100
+}
+
+func floatValue(value *float64, fallback float64) float64 {
+	if value == nil {
+		return fallback
+	}
+
+	return *value
+}
+
+func intValue(value *int, fallback int) int {
+	if value == nil {
+		return fallback
+	}
+
+	return *value
+}
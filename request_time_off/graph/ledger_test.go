@@ -0,0 +1,189 @@
+package graph
+
+import (
+	"request_time_offs/graph/model"
+	"testing"
+	"time"
+)
+
+// monday is the start of a week, 2024-03-04 00:00 UTC
+var monday = time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)
+
+func TestLeaveHours(t *testing.T) {
+	at := func(days int, hours int) *time.Time {
+		value := monday.AddDate(0, 0, days).Add(time.Duration(hours) * time.Hour)
+		return &value
+	}
+
+	tests := []struct {
+		name   string
+		start  *time.Time
+		end    *time.Time
+		policy *model.TimeOffAccrualPolicy
+		want   float64
+	}{
+		{name: "without an end", start: at(0, 0), want: 8},
+		{name: "without an end in the morning", start: at(0, 9), want: 8},
+		{name: "without an end on a saturday", start: at(5, 0), want: 0},
+		{name: "part of a day", start: at(0, 9), end: at(0, 13), want: 4},
+		{name: "over midnight", start: at(0, 20), end: at(1, 4), want: 8},
+		{name: "working week", start: at(0, 0), end: at(5, 0), want: 40},
+		{name: "over a weekend", start: at(4, 0), end: at(8, 0), want: 16},
+		{name: "over a weekend with weekends", start: at(4, 0), end: at(8, 0), policy: &model.TimeOffAccrualPolicy{HoursPerDay: 7.5, IncludeWeekends: true}, want: 30},
+		{name: "shorter days", start: at(0, 0), end: at(2, 0), policy: &model.TimeOffAccrualPolicy{HoursPerDay: 6}, want: 12},
+		{name: "end before start", start: at(1, 0), end: at(0, 0), want: 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			requestTimeOff := &model.RequestTimeOff{StartTime: *test.start, EndTime: test.end}
+			if got := leaveHours(requestTimeOff, test.policy); got != test.want {
+				t.Errorf("got %v hours, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestPayPeriodEntries(t *testing.T) {
+	date := func(month time.Month, day int) time.Time {
+		return time.Date(2024, month, day, 0, 0, 0, 0, time.UTC)
+	}
+	periodStart := date(time.January, 1)
+
+	tests := []struct {
+		name         string
+		policy       model.TimeOffAccrualPolicy
+		balanceSince time.Time
+		now          time.Time
+		want         []string
+	}{
+		{
+			name:         "no period ended yet",
+			policy:       model.TimeOffAccrualPolicy{PeriodStart: &periodStart, PeriodDays: 14, Hours: 4, CreatedAt: periodStart},
+			balanceSince: periodStart,
+			now:          date(time.January, 10),
+		},
+		{
+			name:         "period ends at now",
+			policy:       model.TimeOffAccrualPolicy{PeriodStart: &periodStart, PeriodDays: 14, Hours: 4, CreatedAt: periodStart},
+			balanceSince: periodStart,
+			now:          date(time.January, 15),
+			want:         []string{"2024-01-14"},
+		},
+		{
+			name:         "several periods",
+			policy:       model.TimeOffAccrualPolicy{PeriodStart: &periodStart, PeriodDays: 14, Hours: 4, CreatedAt: periodStart},
+			balanceSince: periodStart,
+			now:          date(time.February, 1),
+			want:         []string{"2024-01-14", "2024-01-28"},
+		},
+		{
+			name:         "balance opened later",
+			policy:       model.TimeOffAccrualPolicy{PeriodStart: &periodStart, PeriodDays: 14, Hours: 4, CreatedAt: periodStart},
+			balanceSince: date(time.January, 20),
+			now:          date(time.February, 1),
+			want:         []string{"2024-01-28"},
+		},
+		{
+			name:         "policy set later",
+			policy:       model.TimeOffAccrualPolicy{PeriodStart: &periodStart, PeriodDays: 14, Hours: 4, CreatedAt: date(time.January, 16)},
+			balanceSince: periodStart,
+			now:          date(time.February, 1),
+			want:         []string{"2024-01-28"},
+		},
+		{
+			name:         "without a period start",
+			policy:       model.TimeOffAccrualPolicy{PeriodDays: 14, Hours: 4, CreatedAt: periodStart},
+			balanceSince: periodStart,
+			now:          date(time.February, 1),
+		},
+		{
+			name:         "without hours",
+			policy:       model.TimeOffAccrualPolicy{PeriodStart: &periodStart, PeriodDays: 14, CreatedAt: periodStart},
+			balanceSince: periodStart,
+			now:          date(time.February, 1),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			entries := payPeriodEntries(&test.policy, &model.TimeOffBalance{CreatedAt: test.balanceSince}, test.now)
+			if len(entries) != len(test.want) {
+				t.Fatalf("got %d entries, want %d", len(entries), len(test.want))
+			}
+
+			for i, entry := range entries {
+				if entry.Kind != model.TimeOffLedgerEntryKindAccrual || entry.Hours != test.policy.Hours || *entry.Period != test.want[i] {
+					t.Errorf("entry %d is %s %v %s, want %s %v %s", i, entry.Kind, entry.Hours, *entry.Period, model.TimeOffLedgerEntryKindAccrual, test.policy.Hours, test.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestAnnualGrantEntries(t *testing.T) {
+	capAt := func(hours float64) *float64 { return &hours }
+	now := time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC)
+
+	type entry struct {
+		kind  model.TimeOffLedgerEntryKind
+		hours float64
+	}
+
+	tests := []struct {
+		name       string
+		policy     model.TimeOffAccrualPolicy
+		balance    float64
+		wantPeriod string
+		want       []entry
+	}{
+		{
+			name:       "grant of the year",
+			policy:     model.TimeOffAccrualPolicy{GrantMonth: 1, GrantDay: 1, Hours: 40},
+			balance:    10,
+			wantPeriod: "2024",
+			want:       []entry{{model.TimeOffLedgerEntryKindGrant, 40}},
+		},
+		{
+			name:       "grant of last year before the grant day",
+			policy:     model.TimeOffAccrualPolicy{GrantMonth: 7, GrantDay: 1, Hours: 40},
+			balance:    10,
+			wantPeriod: "2023",
+			want:       []entry{{model.TimeOffLedgerEntryKindGrant, 40}},
+		},
+		{
+			name:       "balance above the cap expires",
+			policy:     model.TimeOffAccrualPolicy{GrantMonth: 1, GrantDay: 1, Hours: 40, CarryOverCap: capAt(16)},
+			balance:    30,
+			wantPeriod: "2024",
+			want:       []entry{{model.TimeOffLedgerEntryKindCarryOverExpiry, -14}, {model.TimeOffLedgerEntryKindGrant, 40}},
+		},
+		{
+			name:       "balance under the cap is kept",
+			policy:     model.TimeOffAccrualPolicy{GrantMonth: 1, GrantDay: 1, Hours: 40, CarryOverCap: capAt(16)},
+			balance:    10,
+			wantPeriod: "2024",
+			want:       []entry{{model.TimeOffLedgerEntryKindGrant, 40}},
+		},
+		{
+			name:    "without hours",
+			policy:  model.TimeOffAccrualPolicy{GrantMonth: 1, GrantDay: 1},
+			balance: 10,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			entries := annualGrantEntries(&test.policy, &model.TimeOffBalance{BalanceHours: test.balance}, now)
+			if len(entries) != len(test.want) {
+				t.Fatalf("got %d entries, want %d", len(entries), len(test.want))
+			}
+
+			for i, got := range entries {
+				if got.Kind != test.want[i].kind || got.Hours != test.want[i].hours || *got.Period != test.wantPeriod {
+					t.Errorf("entry %d is %s %v %s, want %s %v %s", i, got.Kind, got.Hours, *got.Period, test.want[i].kind, test.want[i].hours, test.wantPeriod)
+				}
+			}
+		})
+	}
+}
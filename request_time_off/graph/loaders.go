@@ -0,0 +1,105 @@
+package graph
+
+import (
+	"context"
+	"net/http"
+	"request_time_offs/graph/model"
+	"request_time_offs/util"
+)
+
+type loadersContextKey struct{}
+
+// Loaders batch and cache the users, history and comments the field
+// resolvers load during one request
+type Loaders struct {
+	Users         *util.Loader[*model.User]
+	History       *util.Loader[[]*model.RequestHistoryEntry]
+	Comments      *util.Loader[[]*model.RequestComment]
+	LedgerEntries *util.Loader[[]*model.TimeOffLedgerEntry]
+	LeaveTypes    *util.Loader[*model.LeaveType]
+}
+
+func NewLoaders() *Loaders {
+	return &Loaders{
+		Users:         util.NewLoader(util.GetUsers),
+		History:       util.NewLoader(getRequestHistory),
+		Comments:      util.NewLoader(getRequestComments),
+		LedgerEntries: util.NewLoader(getLedgerEntries),
+		LeaveTypes:    util.NewLoader(getLeaveTypes),
+	}
+}
+
+// LoaderMiddleware gives every request its own Loaders
+func LoaderMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), loadersContextKey{}, NewLoaders())
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// LoadersFor returns the Loaders of the request; outside of a request each
+// call gets new ones
+func LoadersFor(ctx context.Context) *Loaders {
+	if loaders, ok := ctx.Value(loadersContextKey{}).(*Loaders); ok {
+		return loaders
+	}
+
+	return NewLoaders()
+}
+
+func loadUser(ctx context.Context, id *string) (*model.User, error) {
+	if id == nil || *id == "" {
+		return nil, nil
+	}
+
+	return LoadersFor(ctx).Users.Load(ctx, *id)
+}
+
+// loadHistory returns the history of the request time off, empty when it has none
+func loadHistory(ctx context.Context, id string) ([]*model.RequestHistoryEntry, error) {
+	history, err := LoadersFor(ctx).History.Load(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if history == nil {
+		history = []*model.RequestHistoryEntry{}
+	}
+
+	return history, nil
+}
+
+// loadComments returns the comments on the request time off, empty when it has none
+func loadComments(ctx context.Context, id string) ([]*model.RequestComment, error) {
+	comments, err := LoadersFor(ctx).Comments.Load(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if comments == nil {
+		comments = []*model.RequestComment{}
+	}
+
+	return comments, nil
+}
+
+// loadLedgerEntries returns the ledger of the balance, empty when it has none
+func loadLedgerEntries(ctx context.Context, balanceID string) ([]*model.TimeOffLedgerEntry, error) {
+	entries, err := LoadersFor(ctx).LedgerEntries.Load(ctx, balanceID)
+	if err != nil {
+		return nil, err
+	}
+	if entries == nil {
+		entries = []*model.TimeOffLedgerEntry{}
+	}
+
+	return entries, nil
+}
+
+// loadLeaveType returns the leave type of the request time off, nil when its
+// channel has none with its code
+func loadLeaveType(ctx context.Context, requestTimeOff *model.RequestTimeOff) (*model.LeaveType, error) {
+	if requestTimeOff.ChannelID == nil {
+		return nil, nil
+	}
+
+	return LoadersFor(ctx).LeaveTypes.Load(ctx, leaveTypeKey(*requestTimeOff.ChannelID, leaveTypeOf(requestTimeOff)))
+}
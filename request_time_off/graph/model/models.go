@@ -1,5 +1,7 @@
 package model
 
+import "time"
+
 type CreateRequestResponse struct {
 	Data struct {
 		CreateRequest struct {
@@ -8,6 +10,18 @@ type CreateRequestResponse struct {
 	} `json:"data"`
 }
 
+// ParentRequest is a request of the request service
+type ParentRequest struct {
+	ID        string    `json:"id"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type GetRequestsResponse struct {
+	Data struct {
+		Requests []*ParentRequest `json:"requests"`
+	} `json:"data"`
+}
+
 type PermissionResponse struct {
 	Data struct {
 		CheckPermission bool `json:"CheckPermission"`
@@ -27,3 +41,193 @@ type UserResponse struct {
 		}
 	} `json:"data"`
 }
+
+type GetShiftGroupsResponse struct {
+	Data struct {
+		ShiftGroupsByChannel []struct {
+			ID string `json:"id"`
+		} `json:"shiftGroupsByChannel"`
+	} `json:"data"`
+}
+
+type GetAssignedShiftsByTimeResponse struct {
+	Data struct {
+		GetAssignedShiftsByTime []*AssignedShift `json:"getAssignedShiftsByTime"`
+	} `json:"data"`
+}
+
+type GetShiftGroupMembersResponse struct {
+	Data struct {
+		GetShiftGroupMembersList []struct {
+			UserID string `json:"userId"`
+		} `json:"getShiftGroupMembersList"`
+	} `json:"data"`
+}
+
+type UpdateAssignedShiftResponse struct {
+	Data struct {
+		UpdateAssignedShift *AssignedShift `json:"updateAssignedShift"`
+	} `json:"data"`
+}
+
+// RequestResponse is bound in gqlgen.yml instead of being generated: it
+// keeps the ID of the user who responded, which the responseBy field
+// resolver loads only when it is queried
+type RequestResponse struct {
+	ChannelID      string         `json:"channelId"`
+	CreatedAt      *time.Time     `json:"createdAt"`
+	EndTime        *time.Time     `json:"endTime"`
+	ID             string         `json:"id"`
+	IsAllDay       *bool          `json:"isAllDay"`
+	Reason         *string        `json:"reason"`
+	RequestID      string         `json:"requestId"`
+	RequestNote    *string        `json:"requestNote"`
+	ResponseAt     *time.Time     `json:"responseAt"`
+	ResponseNote   *string        `json:"responseNote"`
+	ShiftOfferedTo *User          `json:"shiftOfferedTo"`
+	ShiftToOffer   *AssignedShift `json:"shiftToOffer"`
+	ShiftToSwap    *AssignedShift `json:"shiftToSwap"`
+	StartTime      *time.Time     `json:"startTime"`
+	Status         *RequestStatus `json:"status"`
+	ToSwapWith     *AssignedShift `json:"toSwapWith"`
+	Type           *RequestType   `json:"type"`
+	User           *User          `json:"user"`
+
+	ResponseByID *string `json:"-"`
+}
+
+type RequestSagaKind string
+
+const (
+	RequestSagaKindCreate RequestSagaKind = "create"
+	RequestSagaKindDelete RequestSagaKind = "delete"
+)
+
+type RequestSagaStatus string
+
+const (
+	// RequestSagaStatusPending is set while the saga runs; a pending saga
+	// left behind by a crash is picked up by the reconciler
+	RequestSagaStatusPending      RequestSagaStatus = "pending"
+	RequestSagaStatusCompensating RequestSagaStatus = "compensating"
+	RequestSagaStatusCompleted    RequestSagaStatus = "completed"
+	RequestSagaStatusCompensated  RequestSagaStatus = "compensated"
+	RequestSagaStatusFailed       RequestSagaStatus = "failed"
+)
+
+// RequestSaga is the outbox row of the creation or deletion of a parent
+// request in the request service together with the local row (RecordID)
+type RequestSaga struct {
+	ID        string            `json:"id" gorm:"primaryKey;type:uuid;default:uuid_generate_v4()"`
+	Kind      RequestSagaKind   `json:"kind" gorm:"type:varchar(16); not null"`
+	Status    RequestSagaStatus `json:"status" gorm:"type:varchar(16); not null"`
+	RecordID  string            `json:"recordId" gorm:"type:uuid; not null"`
+	RequestID *string           `json:"requestId" gorm:"type:uuid"`
+	ChannelID string            `json:"channelId" gorm:"type:varchar(64)"`
+	UserID    string            `json:"userId" gorm:"type:varchar(64)"`
+	Attempts  int               `json:"attempts" gorm:"not null;default:0"`
+	LastError *string           `json:"lastError"`
+	CreatedAt time.Time         `json:"createdAt" gorm:"default:now()"`
+	UpdatedAt time.Time         `json:"updatedAt" gorm:"default:now()"`
+}
+
+// requestStatusTransitions is the state machine of the request status: the
+// statuses a request can move to from each status. The other statuses are final.
+var requestStatusTransitions = map[RequestStatus][]RequestStatus{
+	RequestStatusPending: {RequestStatusApproved, RequestStatusDenied, RequestStatusCancelled, RequestStatusExpired},
+	// an approved time off can still be called off
+	RequestStatusApproved: {RequestStatusCancelled},
+}
+
+// ExpiringRequestStatuses are the statuses of the requests still waiting for
+// an answer, which the expiry scheduler moves to EXPIRED once it is too late
+var ExpiringRequestStatuses = []RequestStatus{RequestStatusPending}
+
+// CanTransitionTo reports whether a request in status s may move to next
+func (s RequestStatus) CanTransitionTo(next RequestStatus) bool {
+	for _, status := range requestStatusTransitions[s] {
+		if status == next {
+			return true
+		}
+	}
+
+	return false
+}
+
+// RequestHistoryEntry is one change of a request time off; entries are only
+// ever appended
+type RequestHistoryEntry struct {
+	ID          string               `json:"id" gorm:"primaryKey;type:uuid;default:uuid_generate_v4()"`
+	RecordID    string               `json:"-" gorm:"type:uuid;not null"`
+	Action      RequestHistoryAction `json:"action" gorm:"type:varchar(16);not null"`
+	Field       *string              `json:"field" gorm:"type:varchar(64)"`
+	OldValue    *string              `json:"oldValue"`
+	NewValue    *string              `json:"newValue"`
+	ActorUserID *string              `json:"actorUserId" gorm:"type:varchar(64)"`
+	CreatedAt   time.Time            `json:"createdAt" gorm:"default:now()"`
+}
+
+func (RequestHistoryEntry) TableName() string {
+	return "request_history"
+}
+
+// RequestComment is a comment on a request time off
+type RequestComment struct {
+	ID        string    `json:"id" gorm:"primaryKey;type:uuid;default:uuid_generate_v4()"`
+	RecordID  string    `json:"-" gorm:"type:uuid;not null"`
+	UserID    string    `json:"userId" gorm:"type:varchar(64);not null"`
+	Body      string    `json:"body" gorm:"type:text;not null"`
+	CreatedAt time.Time `json:"createdAt" gorm:"default:now()"`
+}
+
+func (RequestComment) TableName() string {
+	return "request_comments"
+}
+
+// DefaultLeaveType is the leave type of the time offs created without one
+const DefaultLeaveType = "DEFAULT"
+
+// TimeOffLedgerEntry is one change of a time off balance; entries are only
+// ever appended. Accruals and grants carry their period, which is recorded
+// once per balance and kind.
+type TimeOffLedgerEntry struct {
+	ID               string                 `json:"id" gorm:"primaryKey;type:uuid;default:uuid_generate_v4()"`
+	BalanceID        string                 `json:"-" gorm:"type:uuid;not null"`
+	Kind             TimeOffLedgerEntryKind `json:"kind" gorm:"type:varchar(32);not null"`
+	Hours            float64                `json:"hours" gorm:"type:numeric(10,2);not null"`
+	BalanceAfter     float64                `json:"balanceAfter" gorm:"type:numeric(10,2);not null"`
+	RequestTimeOffID *string                `json:"requestTimeOffId" gorm:"type:uuid"`
+	Period           *string                `json:"period" gorm:"type:varchar(64)"`
+	Note             *string                `json:"note"`
+	ActorUserID      *string                `json:"actorUserId" gorm:"type:varchar(64)"`
+	CreatedAt        time.Time              `json:"createdAt" gorm:"default:now()"`
+}
+
+func (TimeOffLedgerEntry) TableName() string {
+	return "time_off_ledger"
+}
+
+// TimeOffAccrualPolicy is how a leave type accrues in a channel; CreatedAt
+// bounds the pay periods it accrues for
+type TimeOffAccrualPolicy struct {
+	ChannelID       string        `json:"channelId" gorm:"primaryKey;type:varchar(64)"`
+	LeaveType       string        `json:"leaveType" gorm:"primaryKey;type:varchar(64)"`
+	Method          AccrualMethod `json:"method" gorm:"type:varchar(32);not null"`
+	Hours           float64       `json:"hours" gorm:"type:numeric(10,2);not null"`
+	Rate            float64       `json:"rate" gorm:"type:numeric(10,4);not null"`
+	PeriodDays      int           `json:"periodDays" gorm:"not null"`
+	PeriodStart     *time.Time    `json:"periodStart"`
+	GrantMonth      int           `json:"grantMonth" gorm:"not null"`
+	GrantDay        int           `json:"grantDay" gorm:"not null"`
+	CarryOverCap    *float64      `json:"carryOverCap" gorm:"type:numeric(10,2)"`
+	HoursPerDay     float64       `json:"hoursPerDay" gorm:"type:numeric(4,2);not null"`
+	IncludeWeekends bool          `json:"includeWeekends" gorm:"not null"`
+	Enabled         bool          `json:"enabled" gorm:"not null"`
+	UpdatedByUserID *string       `json:"updatedByUserId" gorm:"type:varchar(64)"`
+	UpdatedAt       *time.Time    `json:"updatedAt"`
+	CreatedAt       time.Time     `json:"-" gorm:"default:now()"`
+}
+
+func (TimeOffAccrualPolicy) TableName() string {
+	return "time_off_accrual_policies"
+}
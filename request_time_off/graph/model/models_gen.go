@@ -7,6 +7,28 @@ import (
 	"time"
 )
 
+// The most members of a shift group that can be off on the same day, as a count
+// or as a percentage of its members; without either the shift group has no limit
+type AbsenceLimit struct {
+	ChannelID         string     `json:"channelId" gorm:"primaryKey;type:varchar(64)"`
+	ShiftGroupID      string     `json:"shiftGroupId" gorm:"primaryKey;type:varchar(64)"`
+	MaxAbsences       *int       `json:"maxAbsences"`
+	MaxAbsencePercent *float64   `json:"maxAbsencePercent"`
+	UpdatedByUserID   *string    `json:"updatedByUserId" gorm:"type:varchar(64)"`
+	UpdatedAt         *time.Time `json:"updatedAt"`
+}
+
+// At most one of maxAbsences and maxAbsencePercent
+type AbsenceLimitInput struct {
+	MaxAbsences       *int     `json:"maxAbsences"`
+	MaxAbsencePercent *float64 `json:"maxAbsencePercent"`
+}
+
+type AbsenceLimitResponse struct {
+	Errors       []*ShiftError `json:"errors"`
+	AbsenceLimit *AbsenceLimit `json:"absenceLimit"`
+}
+
 type AssignedShift struct {
 	ID              string                     `json:"id"`
 	Break           string                     `json:"break"`
@@ -42,56 +64,201 @@ type AssignedShiftActivities struct {
 	IsPaid          bool      `json:"isPaid"`
 }
 
-type RequestResponse struct {
-	ChannelID      string         `json:"channelId"`
-	CreatedAt      *time.Time     `json:"createdAt"`
-	EndTime        *time.Time     `json:"endTime"`
-	ID             string         `json:"id"`
-	IsAllDay       *bool          `json:"isAllDay"`
-	Reason         *string        `json:"reason"`
-	RequestID      string         `json:"requestId"`
-	RequestNote    *string        `json:"requestNote"`
-	ResponseAt     *time.Time     `json:"responseAt"`
-	ResponseBy     *User          `json:"responseBy"`
-	ResponseNote   *string        `json:"responseNote"`
-	ShiftOfferedTo *User          `json:"shiftOfferedTo"`
-	ShiftToOffer   *AssignedShift `json:"shiftToOffer"`
-	ShiftToSwap    *AssignedShift `json:"shiftToSwap"`
-	StartTime      *time.Time     `json:"startTime"`
-	Status         *RequestStatus `json:"status"`
-	ToSwapWith     *AssignedShift `json:"toSwapWith"`
-	Type           *RequestType   `json:"type"`
-	User           *User          `json:"user"`
+// A period in which time off cannot be requested, in a whole channel or in one
+// of its shift groups
+type BlackoutPeriod struct {
+	ID        string `json:"id" gorm:"primaryKey;type:uuid;default:uuid_generate_v4()"`
+	ChannelID string `json:"channelId" gorm:"type:varchar(64);not null"`
+	// Only the members of the shift group are blocked; the whole channel when null
+	ShiftGroupID    *string    `json:"shiftGroupId" gorm:"type:varchar(64)"`
+	Name            string     `json:"name" gorm:"type:varchar(128);not null"`
+	StartTime       time.Time  `json:"startTime" gorm:"not null"`
+	EndTime         time.Time  `json:"endTime" gorm:"not null"`
+	CreatedByUserID *string    `json:"createdByUserId" gorm:"type:varchar(64)"`
+	CreatedAt       time.Time  `json:"createdAt" gorm:"default:now()"`
+	UpdatedAt       *time.Time `json:"updatedAt"`
+}
+
+type BlackoutPeriodInput struct {
+	ChannelID    string    `json:"channelId"`
+	ShiftGroupID *string   `json:"shiftGroupId"`
+	Name         string    `json:"name"`
+	StartTime    time.Time `json:"startTime"`
+	EndTime      time.Time `json:"endTime"`
+}
+
+type BlackoutPeriodResponse struct {
+	Errors         []*ShiftError   `json:"errors"`
+	BlackoutPeriod *BlackoutPeriod `json:"blackoutPeriod"`
+}
+
+// errors are about the whole call; each request time off has its own result, in the order of the ids
+type BulkTimeOffResponse struct {
+	Errors  []*ShiftError           `json:"errors"`
+	Results []*RequestTimeOffResult `json:"results"`
+}
+
+// A day (UTC) on which approving the time off would put more members of the
+// shift group off than its limit
+type CapacityWarning struct {
+	ShiftGroupID string    `json:"shiftGroupId"`
+	Date         time.Time `json:"date"`
+	// Members of the shift group already off that day, without the user of the time off
+	Absences int `json:"absences"`
+	Limit    int `json:"limit"`
+}
+
+// A kind of leave of a channel, such as vacation or sick leave; time offs and
+// balances refer to it by its code
+type LeaveType struct {
+	ChannelID          string  `json:"channelId" gorm:"primaryKey;type:varchar(64)"`
+	Code               string  `json:"code" gorm:"primaryKey;type:varchar(64)"`
+	Name               string  `json:"name" gorm:"type:varchar(128);not null"`
+	Color              *string `json:"color" gorm:"type:varchar(32)"`
+	Paid               bool    `json:"paid" gorm:"not null"`
+	RequiresAttachment bool    `json:"requiresAttachment" gorm:"not null"`
+	// Hours a time off of the type has to be requested before it starts
+	MinimumNoticeHours int `json:"minimumNoticeHours" gorm:"not null"`
+	// Most calendar days a time off of the type may span
+	MaxConsecutiveDays *int `json:"maxConsecutiveDays"`
+	// Time offs of the type are approved as soon as they are requested
+	AutoApprove bool `json:"autoApprove" gorm:"not null"`
+	// Archived leave types cannot be requested any more
+	Archived        bool       `json:"archived" gorm:"not null"`
+	UpdatedByUserID *string    `json:"updatedByUserId" gorm:"type:varchar(64)"`
+	UpdatedAt       *time.Time `json:"updatedAt"`
+}
+
+type LeaveTypeInput struct {
+	Code               string  `json:"code"`
+	Name               string  `json:"name"`
+	Color              *string `json:"color"`
+	Paid               bool    `json:"paid"`
+	RequiresAttachment *bool   `json:"requiresAttachment"`
+	MinimumNoticeHours *int    `json:"minimumNoticeHours"`
+	MaxConsecutiveDays *int    `json:"maxConsecutiveDays"`
+	AutoApprove        *bool   `json:"autoApprove"`
+	Archived           *bool   `json:"archived"`
+}
+
+type LeaveTypeResponse struct {
+	Errors    []*ShiftError `json:"errors"`
+	LeaveType *LeaveType    `json:"leaveType"`
+}
+
+type PageInfo struct {
+	HasNextPage     bool    `json:"hasNextPage"`
+	HasPreviousPage bool    `json:"hasPreviousPage"`
+	StartCursor     *string `json:"startCursor"`
+	EndCursor       *string `json:"endCursor"`
+}
+
+type RequestCommentResponse struct {
+	Errors  []*ShiftError   `json:"errors"`
+	Comment *RequestComment `json:"comment"`
+}
+
+// How the expiry scheduler treats the pending time offs of a channel
+type RequestExpirySettings struct {
+	ChannelID string `json:"channelId" gorm:"primaryKey;type:varchar(64)"`
+	Enabled   bool   `json:"enabled" gorm:"not null"`
+	// Minutes after its start a pending time off expires
+	GracePeriodMinutes int        `json:"gracePeriodMinutes" gorm:"not null"`
+	UpdatedByUserID    *string    `json:"updatedByUserId" gorm:"type:varchar(64)"`
+	UpdatedAt          *time.Time `json:"updatedAt"`
+}
+
+type RequestExpirySettingsInput struct {
+	Enabled            bool `json:"enabled"`
+	GracePeriodMinutes int  `json:"gracePeriodMinutes"`
+}
+
+type RequestExpirySettingsResponse struct {
+	Errors   []*ShiftError          `json:"errors"`
+	Settings *RequestExpirySettings `json:"settings"`
+}
+
+type RequestFilter struct {
+	Status []RequestStatus `json:"status"`
+	UserID *string         `json:"userId"`
+	// Only time offs that overlap the period between from and to
+	From          *time.Time `json:"from"`
+	To            *time.Time `json:"to"`
+	CreatedAfter  *time.Time `json:"createdAfter"`
+	CreatedBefore *time.Time `json:"createdBefore"`
+}
+
+type RequestOrder struct {
+	Field     RequestOrderField `json:"field"`
+	Direction OrderDirection    `json:"direction"`
 }
 
 type RequestTimeOff struct {
-	ID               string        `json:"id" gorm:"primaryKey;type:uuid;default:uuid_generate_v4()"`
-	UserID           string        `json:"userId" gorm:"type:varchar(64);not null"`
-	ChannelID        *string       `json:"channelId" gorm:"type:varchar(64);not null"`
-	RequestID        *string       `json:"requestId" gorm:"type:uuid;not null"`
-	StartTime        time.Time     `json:"startTime"`
-	EndTime          *time.Time    `json:"endTime"`
-	Is24Hours        *bool         `json:"is24Hours"`
-	Reason           *string       `json:"reason"`
-	RequestNote      *string       `json:"requestNote"`
-	Status           RequestStatus `json:"status" gorm:"type:varchar(16);not null"`
-	ResponseNote     *string       `json:"responseNote"`
-	ResponseByUserID *string       `json:"responseByUserId" gorm:"type:varchar(64);not null"`
-	ResponseAt       *time.Time    `json:"responseAt"`
-	CreatedAt        time.Time     `json:"createdAt" gorm:"default:now()"`
+	ID        string     `json:"id" gorm:"primaryKey;type:uuid;default:uuid_generate_v4()"`
+	UserID    string     `json:"userId" gorm:"type:varchar(64);not null"`
+	ChannelID *string    `json:"channelId" gorm:"type:varchar(64);not null"`
+	RequestID *string    `json:"requestId" gorm:"type:uuid;not null"`
+	StartTime time.Time  `json:"startTime"`
+	EndTime   *time.Time `json:"endTime"`
+	Is24Hours *bool      `json:"is24Hours"`
+	Reason    *string    `json:"reason"`
+	// Code of the leave type whose balance the time off is taken from once approved
+	LeaveType string `json:"leaveType" gorm:"type:varchar(64);not null;default:DEFAULT"`
+	// The leave type of the channel with that code; null when the channel has none
+	LeaveTypeDetails *LeaveType `json:"leaveTypeDetails" gorm:"-"`
+	// Supporting document, required by some leave types
+	AttachmentURL *string `json:"attachmentUrl" gorm:"type:text"`
+	// Why a manager let the time off overlap a blackout period
+	BlackoutOverrideReason   *string `json:"blackoutOverrideReason" gorm:"type:text"`
+	BlackoutOverrideByUserID *string `json:"blackoutOverrideByUserId" gorm:"type:varchar(64)"`
+	// Why a manager approved the time off over the absence limit of a shift group
+	CapacityOverrideReason   *string       `json:"capacityOverrideReason" gorm:"type:text"`
+	CapacityOverrideByUserID *string       `json:"capacityOverrideByUserId" gorm:"type:varchar(64)"`
+	RequestNote              *string       `json:"requestNote"`
+	Status                   RequestStatus `json:"status" gorm:"type:varchar(16);not null"`
+	ResponseNote             *string       `json:"responseNote"`
+	ResponseByUserID         *string       `json:"responseByUserId" gorm:"type:varchar(64)"`
+	ResponseAt               *time.Time    `json:"responseAt"`
+	CreatedAt                time.Time     `json:"createdAt" gorm:"default:now()"`
+	// Every change of the request time off, oldest first
+	History []*RequestHistoryEntry `json:"history" gorm:"-"`
+	// Comments on the request time off, oldest first
+	Comments []*RequestComment `json:"comments" gorm:"-"`
+}
+
+type RequestTimeOffConnection struct {
+	Edges    []*RequestTimeOffEdge `json:"edges"`
+	PageInfo *PageInfo             `json:"pageInfo"`
+}
+
+type RequestTimeOffEdge struct {
+	Cursor string          `json:"cursor"`
+	Node   *RequestTimeOff `json:"node"`
 }
 
 type RequestTimeOffInput struct {
-	UserID           string     `json:"userId"`
-	ChannelID        string     `json:"channelId"`
-	StartTime        time.Time  `json:"startTime"`
-	EndTime          *time.Time `json:"endTime"`
-	Is24Hours        bool       `json:"is24Hours"`
-	Reason           *string    `json:"reason"`
-	RequestNote      *string    `json:"requestNote"`
-	ResponseNote     *string    `json:"responseNote"`
-	ResponseByUserID *string    `json:"responseByUserId"`
-	ResponseAt       *time.Time `json:"responseAt"`
+	UserID    string     `json:"userId"`
+	ChannelID string     `json:"channelId"`
+	StartTime time.Time  `json:"startTime"`
+	EndTime   *time.Time `json:"endTime"`
+	Is24Hours bool       `json:"is24Hours"`
+	Reason    *string    `json:"reason"`
+	// Code of a leave type of the channel, DEFAULT when it is left out
+	LeaveType     *string `json:"leaveType"`
+	AttachmentURL *string `json:"attachmentUrl"`
+	// Lets the time off overlap blackout periods; needs request_time_off.MANAGE_ALL
+	BlackoutOverrideReason *string `json:"blackoutOverrideReason"`
+	RequestNote            *string `json:"requestNote"`
+	ResponseNote           *string `json:"responseNote"`
+}
+
+// Outcome of a bulk mutation for one request time off
+type RequestTimeOffResult struct {
+	ID               string             `json:"id"`
+	Errors           []*ShiftError      `json:"errors"`
+	Request          *RequestResponse   `json:"request"`
+	ShiftConflicts   []*ShiftConflict   `json:"shiftConflicts"`
+	CapacityWarnings []*CapacityWarning `json:"capacityWarnings"`
 }
 
 type RequestsInput struct {
@@ -101,15 +268,81 @@ type RequestsInput struct {
 	Type        *string `json:"type"`
 }
 
+// A shift assigned to the user during a time off being approved
+type ShiftConflict struct {
+	Shift *AssignedShift `json:"shift"`
+	// Whether the shift was made an open shift (CONVERT)
+	Converted bool `json:"converted"`
+}
+
+// How approving the time offs of a channel treats the shifts assigned during them
+type ShiftConflictSettings struct {
+	ChannelID       string              `json:"channelId" gorm:"primaryKey;type:varchar(64)"`
+	Policy          ShiftConflictPolicy `json:"policy" gorm:"type:varchar(16);not null"`
+	UpdatedByUserID *string             `json:"updatedByUserId" gorm:"type:varchar(64)"`
+	UpdatedAt       *time.Time          `json:"updatedAt"`
+}
+
+type ShiftConflictSettingsInput struct {
+	Policy ShiftConflictPolicy `json:"policy"`
+}
+
+type ShiftConflictSettingsResponse struct {
+	Errors   []*ShiftError          `json:"errors"`
+	Settings *ShiftConflictSettings `json:"settings"`
+}
+
 type ShiftError struct {
 	Code    ShiftErrorCode `json:"code"`
 	Field   *string        `json:"field"`
 	Message *string        `json:"message"`
 }
 
+type TimeOffAccrualPolicyInput struct {
+	LeaveType       string        `json:"leaveType"`
+	Method          AccrualMethod `json:"method"`
+	Hours           *float64      `json:"hours"`
+	Rate            *float64      `json:"rate"`
+	PeriodDays      *int          `json:"periodDays"`
+	PeriodStart     *time.Time    `json:"periodStart"`
+	GrantMonth      *int          `json:"grantMonth"`
+	GrantDay        *int          `json:"grantDay"`
+	CarryOverCap    *float64      `json:"carryOverCap"`
+	HoursPerDay     *float64      `json:"hoursPerDay"`
+	IncludeWeekends *bool         `json:"includeWeekends"`
+	Enabled         bool          `json:"enabled"`
+}
+
+type TimeOffAccrualPolicyResponse struct {
+	Errors []*ShiftError         `json:"errors"`
+	Policy *TimeOffAccrualPolicy `json:"policy"`
+}
+
+// Hours of leave a user has in a channel for a leave type
+type TimeOffBalance struct {
+	ID           string    `json:"id" gorm:"primaryKey;type:uuid;default:uuid_generate_v4()"`
+	ChannelID    string    `json:"channelId" gorm:"type:varchar(64);not null"`
+	UserID       string    `json:"userId" gorm:"type:varchar(64);not null"`
+	LeaveType    string    `json:"leaveType" gorm:"type:varchar(64);not null"`
+	BalanceHours float64   `json:"balanceHours" gorm:"type:numeric(10,2);not null;default:0"`
+	CreatedAt    time.Time `json:"createdAt" gorm:"default:now()"`
+	UpdatedAt    time.Time `json:"updatedAt" gorm:"default:now()"`
+	// Every change of the balance, oldest first
+	History []*TimeOffLedgerEntry `json:"history" gorm:"-"`
+}
+
+type TimeOffBalanceResponse struct {
+	Errors   []*ShiftError     `json:"errors"`
+	Balances []*TimeOffBalance `json:"balances"`
+}
+
 type TimeOffResponse struct {
 	Errors  []*ShiftError    `json:"errors"`
 	Request *RequestResponse `json:"request"`
+	// Shifts assigned to the user during the time off; set by approving it
+	ShiftConflicts []*ShiftConflict `json:"shiftConflicts"`
+	// Days on which the time off would exceed the absence limit of a shift group of the user
+	CapacityWarnings []*CapacityWarning `json:"capacityWarnings"`
 }
 
 type User struct {
@@ -122,6 +355,174 @@ type User struct {
 	IsStaff   *bool   `json:"isStaff"`
 }
 
+type AccrualMethod string
+
+const (
+	AccrualMethodPerPayPeriod   AccrualMethod = "PER_PAY_PERIOD"
+	AccrualMethodPerHoursWorked AccrualMethod = "PER_HOURS_WORKED"
+	AccrualMethodAnnualGrant    AccrualMethod = "ANNUAL_GRANT"
+)
+
+var AllAccrualMethod = []AccrualMethod{
+	AccrualMethodPerPayPeriod,
+	AccrualMethodPerHoursWorked,
+	AccrualMethodAnnualGrant,
+}
+
+func (e AccrualMethod) IsValid() bool {
+	switch e {
+	case AccrualMethodPerPayPeriod, AccrualMethodPerHoursWorked, AccrualMethodAnnualGrant:
+		return true
+	}
+	return false
+}
+
+func (e AccrualMethod) String() string {
+	return string(e)
+}
+
+func (e *AccrualMethod) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AccrualMethod(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AccrualMethod", str)
+	}
+	return nil
+}
+
+func (e AccrualMethod) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type OrderDirection string
+
+const (
+	OrderDirectionAsc  OrderDirection = "ASC"
+	OrderDirectionDesc OrderDirection = "DESC"
+)
+
+var AllOrderDirection = []OrderDirection{
+	OrderDirectionAsc,
+	OrderDirectionDesc,
+}
+
+func (e OrderDirection) IsValid() bool {
+	switch e {
+	case OrderDirectionAsc, OrderDirectionDesc:
+		return true
+	}
+	return false
+}
+
+func (e OrderDirection) String() string {
+	return string(e)
+}
+
+func (e *OrderDirection) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = OrderDirection(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid OrderDirection", str)
+	}
+	return nil
+}
+
+func (e OrderDirection) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type RequestHistoryAction string
+
+const (
+	RequestHistoryActionCreated       RequestHistoryAction = "CREATED"
+	RequestHistoryActionUpdated       RequestHistoryAction = "UPDATED"
+	RequestHistoryActionStatusChanged RequestHistoryAction = "STATUS_CHANGED"
+)
+
+var AllRequestHistoryAction = []RequestHistoryAction{
+	RequestHistoryActionCreated,
+	RequestHistoryActionUpdated,
+	RequestHistoryActionStatusChanged,
+}
+
+func (e RequestHistoryAction) IsValid() bool {
+	switch e {
+	case RequestHistoryActionCreated, RequestHistoryActionUpdated, RequestHistoryActionStatusChanged:
+		return true
+	}
+	return false
+}
+
+func (e RequestHistoryAction) String() string {
+	return string(e)
+}
+
+func (e *RequestHistoryAction) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = RequestHistoryAction(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RequestHistoryAction", str)
+	}
+	return nil
+}
+
+func (e RequestHistoryAction) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type RequestOrderField string
+
+const (
+	RequestOrderFieldCreatedAt RequestOrderField = "CREATED_AT"
+	RequestOrderFieldStartTime RequestOrderField = "START_TIME"
+)
+
+var AllRequestOrderField = []RequestOrderField{
+	RequestOrderFieldCreatedAt,
+	RequestOrderFieldStartTime,
+}
+
+func (e RequestOrderField) IsValid() bool {
+	switch e {
+	case RequestOrderFieldCreatedAt, RequestOrderFieldStartTime:
+		return true
+	}
+	return false
+}
+
+func (e RequestOrderField) String() string {
+	return string(e)
+}
+
+func (e *RequestOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = RequestOrderField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RequestOrderField", str)
+	}
+	return nil
+}
+
+func (e RequestOrderField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type RequestStatus string
 
 const (
@@ -129,6 +530,8 @@ const (
 	RequestStatusApproved  RequestStatus = "APPROVED"
 	RequestStatusDenied    RequestStatus = "DENIED"
 	RequestStatusCancelled RequestStatus = "CANCELLED"
+	// Still pending when the time off started, moved here by the expiry scheduler
+	RequestStatusExpired RequestStatus = "EXPIRED"
 )
 
 var AllRequestStatus = []RequestStatus{
@@ -136,11 +539,12 @@ var AllRequestStatus = []RequestStatus{
 	RequestStatusApproved,
 	RequestStatusDenied,
 	RequestStatusCancelled,
+	RequestStatusExpired,
 }
 
 func (e RequestStatus) IsValid() bool {
 	switch e {
-	case RequestStatusPending, RequestStatusApproved, RequestStatusDenied, RequestStatusCancelled:
+	case RequestStatusPending, RequestStatusApproved, RequestStatusDenied, RequestStatusCancelled, RequestStatusExpired:
 		return true
 	}
 	return false
@@ -210,25 +614,85 @@ func (e RequestType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+// What approving a time off does with the shifts assigned to the user during it
+type ShiftConflictPolicy string
+
+const (
+	// The time off is not approved while the user has shifts during it
+	ShiftConflictPolicyBlock ShiftConflictPolicy = "BLOCK"
+	// The time off is approved and the shifts are listed
+	ShiftConflictPolicyWarn ShiftConflictPolicy = "WARN"
+	// The time off is approved and the shifts become open shifts
+	ShiftConflictPolicyConvert ShiftConflictPolicy = "CONVERT"
+)
+
+var AllShiftConflictPolicy = []ShiftConflictPolicy{
+	ShiftConflictPolicyBlock,
+	ShiftConflictPolicyWarn,
+	ShiftConflictPolicyConvert,
+}
+
+func (e ShiftConflictPolicy) IsValid() bool {
+	switch e {
+	case ShiftConflictPolicyBlock, ShiftConflictPolicyWarn, ShiftConflictPolicyConvert:
+		return true
+	}
+	return false
+}
+
+func (e ShiftConflictPolicy) String() string {
+	return string(e)
+}
+
+func (e *ShiftConflictPolicy) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ShiftConflictPolicy(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ShiftConflictPolicy", str)
+	}
+	return nil
+}
+
+func (e ShiftConflictPolicy) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// Stable error codes, also set as the "code" extension of GraphQL errors
 type ShiftErrorCode string
 
 const (
-	ShiftErrorCodeGraphqlError ShiftErrorCode = "GRAPHQL_ERROR"
-	ShiftErrorCodeInvalid      ShiftErrorCode = "INVALID"
-	ShiftErrorCodeNotFound     ShiftErrorCode = "NOT_FOUND"
-	ShiftErrorCodeRequired     ShiftErrorCode = "REQUIRED"
+	ShiftErrorCodeUnauthenticated     ShiftErrorCode = "UNAUTHENTICATED"
+	ShiftErrorCodeForbidden           ShiftErrorCode = "FORBIDDEN"
+	ShiftErrorCodeNotFound            ShiftErrorCode = "NOT_FOUND"
+	ShiftErrorCodeValidation          ShiftErrorCode = "VALIDATION"
+	ShiftErrorCodeConflict            ShiftErrorCode = "CONFLICT"
+	ShiftErrorCodeUpstreamUnavailable ShiftErrorCode = "UPSTREAM_UNAVAILABLE"
+	ShiftErrorCodeInternal            ShiftErrorCode = "INTERNAL"
+	ShiftErrorCodeGraphqlError        ShiftErrorCode = "GRAPHQL_ERROR"
+	ShiftErrorCodeInvalid             ShiftErrorCode = "INVALID"
+	ShiftErrorCodeRequired            ShiftErrorCode = "REQUIRED"
 )
 
 var AllShiftErrorCode = []ShiftErrorCode{
+	ShiftErrorCodeUnauthenticated,
+	ShiftErrorCodeForbidden,
+	ShiftErrorCodeNotFound,
+	ShiftErrorCodeValidation,
+	ShiftErrorCodeConflict,
+	ShiftErrorCodeUpstreamUnavailable,
+	ShiftErrorCodeInternal,
 	ShiftErrorCodeGraphqlError,
 	ShiftErrorCodeInvalid,
-	ShiftErrorCodeNotFound,
 	ShiftErrorCodeRequired,
 }
 
 func (e ShiftErrorCode) IsValid() bool {
 	switch e {
-	case ShiftErrorCodeGraphqlError, ShiftErrorCodeInvalid, ShiftErrorCodeNotFound, ShiftErrorCodeRequired:
+	case ShiftErrorCodeUnauthenticated, ShiftErrorCodeForbidden, ShiftErrorCodeNotFound, ShiftErrorCodeValidation, ShiftErrorCodeConflict, ShiftErrorCodeUpstreamUnavailable, ShiftErrorCodeInternal, ShiftErrorCodeGraphqlError, ShiftErrorCodeInvalid, ShiftErrorCodeRequired:
 		return true
 	}
 	return false
@@ -254,3 +718,52 @@ func (e *ShiftErrorCode) UnmarshalGQL(v interface{}) error {
 func (e ShiftErrorCode) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
+
+type TimeOffLedgerEntryKind string
+
+const (
+	TimeOffLedgerEntryKindAccrual         TimeOffLedgerEntryKind = "ACCRUAL"
+	TimeOffLedgerEntryKindGrant           TimeOffLedgerEntryKind = "GRANT"
+	TimeOffLedgerEntryKindCarryOverExpiry TimeOffLedgerEntryKind = "CARRY_OVER_EXPIRY"
+	TimeOffLedgerEntryKindDebit           TimeOffLedgerEntryKind = "DEBIT"
+	TimeOffLedgerEntryKindCredit          TimeOffLedgerEntryKind = "CREDIT"
+	TimeOffLedgerEntryKindAdjustment      TimeOffLedgerEntryKind = "ADJUSTMENT"
+)
+
+var AllTimeOffLedgerEntryKind = []TimeOffLedgerEntryKind{
+	TimeOffLedgerEntryKindAccrual,
+	TimeOffLedgerEntryKindGrant,
+	TimeOffLedgerEntryKindCarryOverExpiry,
+	TimeOffLedgerEntryKindDebit,
+	TimeOffLedgerEntryKindCredit,
+	TimeOffLedgerEntryKindAdjustment,
+}
+
+func (e TimeOffLedgerEntryKind) IsValid() bool {
+	switch e {
+	case TimeOffLedgerEntryKindAccrual, TimeOffLedgerEntryKindGrant, TimeOffLedgerEntryKindCarryOverExpiry, TimeOffLedgerEntryKindDebit, TimeOffLedgerEntryKindCredit, TimeOffLedgerEntryKindAdjustment:
+		return true
+	}
+	return false
+}
+
+func (e TimeOffLedgerEntryKind) String() string {
+	return string(e)
+}
+
+func (e *TimeOffLedgerEntryKind) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TimeOffLedgerEntryKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TimeOffLedgerEntryKind", str)
+	}
+	return nil
+}
+
+func (e TimeOffLedgerEntryKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
@@ -0,0 +1,29 @@
+package model
+
+import "testing"
+
+func TestCanTransitionTo(t *testing.T) {
+	tests := []struct {
+		from    RequestStatus
+		allowed []RequestStatus
+	}{
+		{RequestStatusPending, []RequestStatus{RequestStatusApproved, RequestStatusDenied, RequestStatusCancelled, RequestStatusExpired}},
+		{RequestStatusApproved, []RequestStatus{RequestStatusCancelled}},
+		{RequestStatusDenied, nil},
+		{RequestStatusCancelled, nil},
+		{RequestStatusExpired, nil},
+	}
+
+	for _, test := range tests {
+		allowed := map[RequestStatus]bool{}
+		for _, status := range test.allowed {
+			allowed[status] = true
+		}
+
+		for _, next := range AllRequestStatus {
+			if got := test.from.CanTransitionTo(next); got != allowed[next] {
+				t.Errorf("%s.CanTransitionTo(%s) = %v, want %v", test.from, next, got, allowed[next])
+			}
+		}
+	}
+}
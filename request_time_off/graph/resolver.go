@@ -1,9 +1,35 @@
 package graph
 
 import (
+	"context"
+	"log"
+	"request_time_offs/events"
+
+	"github.com/getsentry/sentry-go"
 	"gorm.io/gorm"
 )
 
 type Resolver struct {
-	DB *gorm.DB
+	DB        *gorm.DB
+	Sagas     *Sagas
+	Expiry    *Expirer
+	Ledger    *Ledger
+	Roster    *Roster
+	Catalog   *Catalog
+	Blackouts *Blackouts
+	Capacity  *Capacity
+	Events    events.Publisher
+}
+
+// publish runs after the change is stored, so a failure to publish is
+// reported but does not fail the mutation
+func (r *Resolver) publish(ctx context.Context, event events.CloudEvent) {
+	if r.Events == nil {
+		return
+	}
+
+	if err := r.Events.Publish(ctx, event); err != nil {
+		sentry.CaptureException(err)
+		log.Printf("unable to publish %s event %s: %v", event.Type, event.ID, err)
+	}
 }
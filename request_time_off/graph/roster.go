@@ -0,0 +1,242 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"request_time_offs/graph/model"
+	"request_time_offs/util"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/getsentry/sentry-go"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Roster applies the shift conflict policy of a channel to the shifts
+// assigned to the user of a time off being approved. Channels without
+// settings use the default policy of the service.
+type Roster struct {
+	db     *gorm.DB
+	policy model.ShiftConflictPolicy
+}
+
+func NewRoster(db *gorm.DB) *Roster {
+	return &Roster{
+		db:     db,
+		policy: model.ShiftConflictPolicyWarn,
+	}
+}
+
+// Settings are the shift conflict settings of the channel, or the default
+// when the channel has none
+func (r *Roster) Settings(ctx context.Context, channelID string) (*model.ShiftConflictSettings, error) {
+	var settings []*model.ShiftConflictSettings
+	err := r.db.WithContext(ctx).Where("channel_id = ?", channelID).Limit(1).Find(&settings).Error
+	if err != nil {
+		return nil, err
+	}
+
+	if len(settings) > 0 {
+		return settings[0], nil
+	}
+
+	return &model.ShiftConflictSettings{
+		ChannelID: channelID,
+		Policy:    r.policy,
+	}, nil
+}
+
+// SetSettings stores the shift conflict settings of the channel
+func (r *Roster) SetSettings(ctx context.Context, channelID string, input model.ShiftConflictSettingsInput, updatedByUserID string) (*model.ShiftConflictSettings, error) {
+	updatedAt := time.Now().UTC()
+	settings := &model.ShiftConflictSettings{
+		ChannelID:       channelID,
+		Policy:          input.Policy,
+		UpdatedByUserID: &updatedByUserID,
+		UpdatedAt:       &updatedAt,
+	}
+
+	if err := r.db.WithContext(ctx).Save(settings).Error; err != nil {
+		return nil, err
+	}
+
+	return settings, nil
+}
+
+// Conflicts are the shifts assigned to the user of the time off during it,
+// in every shift group of its channel, earliest first. A time off without
+// an end lasts the day it starts, see timeOffPeriod.
+func (r *Roster) Conflicts(ctx context.Context, requestTimeOff *model.RequestTimeOff, authUserID string) ([]*model.ShiftConflict, error) {
+	conflicts := []*model.ShiftConflict{}
+	if requestTimeOff.ChannelID == nil {
+		return conflicts, nil
+	}
+
+	start, end := timeOffPeriod(requestTimeOff)
+
+	shiftGroupIDs, err := util.GetShiftGroupIDs(ctx, *requestTimeOff.ChannelID, authUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := map[string]bool{}
+	for _, shiftGroupID := range shiftGroupIDs {
+		shifts, err := util.GetAssignedShiftsByTime(ctx, *requestTimeOff.ChannelID, shiftGroupID, requestTimeOff.UserID, start, end)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, shift := range shifts {
+			if shift == nil || seen[shift.ID] || (shift.IsOpen != nil && *shift.IsOpen) {
+				continue
+			}
+			if shift.UserID == nil || *shift.UserID != requestTimeOff.UserID {
+				continue
+			}
+			if !shift.StartTime.Before(end) || !shift.EndTime.After(start) {
+				continue
+			}
+
+			seen[shift.ID] = true
+			conflicts = append(conflicts, &model.ShiftConflict{Shift: shift})
+		}
+	}
+
+	sort.SliceStable(conflicts, func(i, j int) bool {
+		return conflicts[i].Shift.StartTime.Before(conflicts[j].Shift.StartTime)
+	})
+
+	return conflicts, nil
+}
+
+// Convert makes the conflicting shifts open shifts. The time off is approved
+// already, so a shift that cannot be converted is reported and stays assigned
+// with converted false.
+func (r *Roster) Convert(ctx context.Context, conflicts []*model.ShiftConflict) {
+	for _, conflict := range conflicts {
+		shift, err := util.OpenAssignedShift(ctx, conflict.Shift.ID)
+		if err != nil {
+			sentry.CaptureException(err)
+			log.Printf("unable to convert assigned shift %s to an open shift: %v", conflict.Shift.ID, err)
+			continue
+		}
+
+		conflict.Shift = shift
+		conflict.Converted = true
+	}
+}
+
+// ConfigureFromEnv reads SHIFT_CONFLICT_POLICY, the policy of channels
+// without settings
+func (r *Roster) ConfigureFromEnv() error {
+	if value := os.Getenv("SHIFT_CONFLICT_POLICY"); value != "" {
+		policy := model.ShiftConflictPolicy(value)
+		if !policy.IsValid() {
+			return fmt.Errorf("%s is not a valid SHIFT_CONFLICT_POLICY", value)
+		}
+		r.policy = policy
+	}
+
+	return nil
+}
+
+// approveRequestTimeOff approves the pending time off under the shift
+// conflict policy of its channel and the absence limits of the shift groups
+// of its user, and returns the shifts assigned to its user during it and the
+// days over the limits. With BLOCK a time off with shifts is not approved;
+// with CONVERT the shifts become open shifts once it is approved. A time off
+// over the limits is only approved with a capacityOverrideReason, which needs
+// MANAGE_ALL.
+func (r *Resolver) approveRequestTimeOff(ctx context.Context, id string, responseByUserID string, responseNote *string, capacityOverrideReason *string) (*model.RequestTimeOff, []*model.ShiftConflict, []*model.CapacityWarning, error) {
+	var requestTimeOff model.RequestTimeOff
+	if err := r.DB.WithContext(ctx).Where("id = ?", id).First(&requestTimeOff).Error; err != nil {
+		return nil, nil, nil, err
+	}
+
+	// the shifts are only fetched for a time off that can be approved
+	if err := checkRequestTimeOffTransition(&requestTimeOff, model.RequestStatusApproved); err != nil {
+		return nil, nil, nil, err
+	}
+
+	policy := model.ShiftConflictPolicyWarn
+	if requestTimeOff.ChannelID != nil {
+		settings, err := r.Roster.Settings(ctx, *requestTimeOff.ChannelID)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		policy = settings.Policy
+	}
+
+	conflicts, err := r.Roster.Conflicts(ctx, &requestTimeOff, responseByUserID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if policy == model.ShiftConflictPolicyBlock && len(conflicts) > 0 {
+		return nil, conflicts, nil, util.NewError(util.ErrorCodeConflict, fmt.Sprintf("Request time off overlaps %d assigned shift(s) of the user", len(conflicts)))
+	}
+
+	groups, err := r.Capacity.groups(ctx, &requestTimeOff, responseByUserID)
+	if err != nil {
+		return nil, conflicts, nil, err
+	}
+
+	overrideReason := ""
+	if capacityOverrideReason != nil {
+		overrideReason = strings.TrimSpace(*capacityOverrideReason)
+	}
+	if overrideReason != "" {
+		permission, err := util.CheckPermission(ctx, "request_time_off", "MANAGE_ALL", responseByUserID)
+		if err != nil {
+			return nil, conflicts, nil, err
+		}
+		if !permission {
+			return nil, conflicts, nil, util.NewError(util.ErrorCodeForbidden, "Permission denied: request_time_off.MANAGE_ALL is required to override an absence limit")
+		}
+	}
+
+	// the absences are counted again holding the locks of the shift groups, so
+	// concurrent approvals cannot both take the last place
+	var warnings []*model.CapacityWarning
+	err = r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockAbsenceGroups(tx, groups); err != nil {
+			return err
+		}
+
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", id).First(&requestTimeOff).Error
+		if err != nil {
+			return err
+		}
+
+		warnings, err = capacityWarnings(tx, &requestTimeOff, groups)
+		if err != nil {
+			return err
+		}
+		if len(warnings) > 0 && overrideReason == "" {
+			return util.NewError(util.ErrorCodeConflict, capacityMessage(warnings))
+		}
+
+		if err := setRequestTimeOffStatus(tx, &requestTimeOff, model.RequestStatusApproved, responseByUserID, responseNote); err != nil {
+			return err
+		}
+
+		if len(warnings) > 0 {
+			return recordCapacityOverride(tx, &requestTimeOff, overrideReason, responseByUserID)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, conflicts, warnings, err
+	}
+
+	if policy == model.ShiftConflictPolicyConvert {
+		r.Roster.Convert(ctx, conflicts)
+	}
+
+	return &requestTimeOff, conflicts, warnings, nil
+}
@@ -0,0 +1,452 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"request_time_offs/graph/model"
+	"request_time_offs/util"
+	"strconv"
+	"time"
+
+	"github.com/getsentry/sentry-go"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	defaultSagaReconcileInterval = 60 * time.Second
+	defaultSagaStaleAfter        = 5 * time.Minute
+	defaultSagaMaxAttempts       = 10
+
+	// compensations run detached from the request, which may be canceled already
+	compensationTimeout = 30 * time.Second
+
+	sagaReconcileBatchSize = 50
+
+	// a parent request created by a saga is created within this time of the
+	// saga, the request timeout included
+	leftoverRequestWindow = 30 * time.Second
+)
+
+// Sagas keeps a parent request in the request service and its local row
+// consistent: both exist or neither does. Every creation and deletion is
+// recorded in the request_sagas outbox table before the remote call, a
+// failed step is compensated right away and whatever is left over (a crash,
+// a failed compensation) is repaired by Reconcile.
+type Sagas struct {
+	db    *gorm.DB
+	table string
+
+	staleAfter  time.Duration
+	maxAttempts int
+}
+
+func NewSagas(db *gorm.DB, table string) *Sagas {
+	return &Sagas{
+		db:          db,
+		table:       table,
+		staleAfter:  defaultSagaStaleAfter,
+		maxAttempts: defaultSagaMaxAttempts,
+	}
+}
+
+// CreateRequest creates the parent request, then runs insert with its ID in
+// the transaction that completes the saga. When insert fails the parent
+// request is deleted again. When the creation fails without an answer, the
+// reconciler looks for the request by channel, user and creation time.
+func (s *Sagas) CreateRequest(ctx context.Context, recordID string, channelID string, userID string, insert func(tx *gorm.DB, requestID string) error) (string, error) {
+	saga := &model.RequestSaga{
+		ID:        uuid.New().String(),
+		Kind:      model.RequestSagaKindCreate,
+		Status:    model.RequestSagaStatusPending,
+		RecordID:  recordID,
+		ChannelID: channelID,
+		UserID:    userID,
+	}
+
+	if err := s.db.WithContext(ctx).Create(saga).Error; err != nil {
+		return "", fmt.Errorf("unable to start request saga: %w", err)
+	}
+
+	requestID, err := createParentRequest(ctx, saga)
+	if err != nil {
+		// the request may have been created when the service could not answer,
+		// the reconciler looks for it; otherwise there is nothing to compensate
+		status := model.RequestSagaStatusFailed
+		if util.ErrorCodeOf(err) == util.ErrorCodeUpstreamUnavailable {
+			status = model.RequestSagaStatusCompensating
+		}
+		s.finish(saga, status, err)
+		return "", err
+	}
+
+	// remember the request before the insert, so the reconciler can delete it after a crash
+	saga.RequestID = &requestID
+	err = s.db.WithContext(ctx).Model(saga).Updates(map[string]interface{}{
+		"request_id": requestID,
+		"updated_at": time.Now().UTC(),
+	}).Error
+	if err == nil {
+		err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			if err := insert(tx, requestID); err != nil {
+				return err
+			}
+
+			return s.setStatus(tx, saga, model.RequestSagaStatusCompleted, nil)
+		})
+	}
+	if err != nil {
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
+
+		s.compensateCreate(saga, err)
+		return "", err
+	}
+
+	return requestID, nil
+}
+
+// DeleteRequest runs remove in the transaction that starts the saga, then
+// deletes the parent request. When that fails, restore puts the local row
+// back; if even that fails the reconciler finishes the deletion instead.
+func (s *Sagas) DeleteRequest(ctx context.Context, recordID string, requestID string, remove func(tx *gorm.DB) error, restore func(tx *gorm.DB) error) error {
+	saga := &model.RequestSaga{
+		ID:        uuid.New().String(),
+		Kind:      model.RequestSagaKindDelete,
+		Status:    model.RequestSagaStatusPending,
+		RecordID:  recordID,
+		RequestID: &requestID,
+	}
+
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(saga).Error; err != nil {
+			return fmt.Errorf("unable to start request saga: %w", err)
+		}
+
+		return remove(tx)
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := deleteParentRequest(ctx, requestID); err != nil {
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
+
+		compensationContext, cancel := context.WithTimeout(context.Background(), compensationTimeout)
+		defer cancel()
+
+		restoreErr := s.db.WithContext(compensationContext).Transaction(func(tx *gorm.DB) error {
+			if err := restore(tx); err != nil {
+				return err
+			}
+
+			return s.setStatus(tx, saga, model.RequestSagaStatusCompensated, err)
+		})
+		if restoreErr != nil {
+			sentry.CaptureException(restoreErr)
+			log.Printf("request saga %s: unable to restore %s %s: %v", saga.ID, s.table, recordID, restoreErr)
+			s.finish(saga, model.RequestSagaStatusCompensating, err)
+		}
+
+		return err
+	}
+
+	s.finish(saga, model.RequestSagaStatusCompleted, nil)
+	return nil
+}
+
+// compensateCreate deletes the parent request of a failed creation; when the
+// request service is unavailable the saga is left to the reconciler
+func (s *Sagas) compensateCreate(saga *model.RequestSaga, cause error) {
+	if saga.RequestID == nil {
+		s.finish(saga, model.RequestSagaStatusFailed, cause)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), compensationTimeout)
+	defer cancel()
+
+	if err := deleteParentRequest(ctx, *saga.RequestID); err != nil {
+		log.Printf("request saga %s: unable to delete request %s: %v", saga.ID, *saga.RequestID, err)
+		s.finish(saga, model.RequestSagaStatusCompensating, err)
+		return
+	}
+
+	s.finish(saga, model.RequestSagaStatusCompensated, cause)
+}
+
+// createParentRequest creates the request of the saga in the request
+// service. The ID of the saga is sent as idempotency key, but the reconciler
+// does not rely on the request service honoring it.
+func createParentRequest(ctx context.Context, saga *model.RequestSaga) (string, error) {
+	requestID, err := util.CreateRequest(util.WithIdempotencyKey(ctx, saga.ID), &saga.ChannelID, &saga.UserID)
+	if err == nil && requestID == "" {
+		err = util.NewError(util.ErrorCodeUpstreamUnavailable, "There was an error creating the request in Request API")
+	}
+
+	return requestID, err
+}
+
+// deleteParentRequest deletes the request in the request service; a request
+// that does not exist anymore counts as deleted
+func deleteParentRequest(ctx context.Context, requestID string) error {
+	deleted, err := util.DeleteRequest(ctx, &requestID)
+	if err != nil {
+		if util.ErrorCodeOf(err) == util.ErrorCodeNotFound {
+			return nil
+		}
+		return err
+	}
+
+	if !deleted {
+		return util.NewError(util.ErrorCodeUpstreamUnavailable, "There was an error deleting the request in Request API")
+	}
+
+	return nil
+}
+
+func (s *Sagas) setStatus(tx *gorm.DB, saga *model.RequestSaga, status model.RequestSagaStatus, cause error) error {
+	updates := map[string]interface{}{
+		"status":     status,
+		"updated_at": time.Now().UTC(),
+	}
+	if cause != nil {
+		updates["last_error"] = cause.Error()
+	}
+
+	saga.Status = status
+	return tx.Model(saga).Updates(updates).Error
+}
+
+// finish records the outcome of a saga outside of any transaction; the
+// reconciler retries sagas whose outcome could not be recorded
+func (s *Sagas) finish(saga *model.RequestSaga, status model.RequestSagaStatus, cause error) {
+	ctx, cancel := context.WithTimeout(context.Background(), compensationTimeout)
+	defer cancel()
+
+	if err := s.setStatus(s.db.WithContext(ctx), saga, status, cause); err != nil {
+		sentry.CaptureException(err)
+		log.Printf("request saga %s: unable to set status %s: %v", saga.ID, status, err)
+	}
+}
+
+// RunReconciler repairs leftover sagas every interval until ctx is done
+func (s *Sagas) RunReconciler(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.Reconcile(ctx); err != nil {
+				sentry.CaptureException(err)
+				log.Printf("request saga reconciler: %v", err)
+			}
+		}
+	}
+}
+
+// Reconcile finishes the pending and compensating sagas that have not been
+// touched for staleAfter. Each saga is locked while it is repaired, so
+// several replicas can reconcile at the same time.
+func (s *Sagas) Reconcile(ctx context.Context) error {
+	var ids []string
+	err := s.db.WithContext(ctx).
+		Model(&model.RequestSaga{}).
+		Where("status IN ? AND updated_at < ?", []model.RequestSagaStatus{model.RequestSagaStatusPending, model.RequestSagaStatusCompensating}, time.Now().UTC().Add(-s.staleAfter)).
+		Order("updated_at").
+		Limit(sagaReconcileBatchSize).
+		Pluck("id", &ids).Error
+	if err != nil {
+		return fmt.Errorf("unable to list open request sagas: %w", err)
+	}
+
+	for _, id := range ids {
+		if err := s.reconcile(ctx, id); err != nil {
+			log.Printf("request saga %s: %v", id, err)
+		}
+	}
+
+	return nil
+}
+
+func (s *Sagas) reconcile(ctx context.Context, id string) error {
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var saga model.RequestSaga
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("id = ? AND status IN ?", id, []model.RequestSagaStatus{model.RequestSagaStatusPending, model.RequestSagaStatusCompensating}).
+			Take(&saga).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			// finished or locked by another replica meanwhile
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if saga.RequestID == nil && saga.Kind == model.RequestSagaKindCreate {
+			// the request may have been created before a crash or a timeout; it is
+			// looked up among the requests of the channel and user, and deleted below
+			requestID, err := s.findLeftoverRequest(ctx, tx, &saga)
+			if err != nil {
+				if util.ErrorCodeOf(err) == util.ErrorCodeUpstreamUnavailable {
+					return s.retryLater(tx, &saga, err)
+				}
+
+				sentry.CaptureException(fmt.Errorf("request saga %s: %w", saga.ID, err))
+				defer sentry.Flush(2 * time.Second)
+
+				return s.setStatus(tx, &saga, model.RequestSagaStatusFailed, err)
+			}
+			if requestID == "" {
+				// the request was never created, there is nothing to compensate
+				return s.setStatus(tx, &saga, model.RequestSagaStatusCompensated, nil)
+			}
+
+			saga.RequestID = &requestID
+			if err := tx.Model(&saga).Update("request_id", requestID).Error; err != nil {
+				return err
+			}
+		}
+		if saga.RequestID == nil {
+			return s.setStatus(tx, &saga, model.RequestSagaStatusFailed, fmt.Errorf("request id is unknown"))
+		}
+
+		var count int64
+		if err := tx.Table(s.table).Where("id = ?", saga.RecordID).Count(&count).Error; err != nil {
+			return err
+		}
+
+		switch {
+		case saga.Kind == model.RequestSagaKindCreate && count > 0:
+			return s.setStatus(tx, &saga, model.RequestSagaStatusCompleted, nil)
+		case saga.Kind == model.RequestSagaKindDelete && count > 0:
+			return s.setStatus(tx, &saga, model.RequestSagaStatusCompensated, nil)
+		}
+
+		// the local row does not exist: the parent request must not either
+		if err := deleteParentRequest(ctx, *saga.RequestID); err != nil {
+			return s.retryLater(tx, &saga, err)
+		}
+
+		log.Printf("request saga %s: deleted leftover request %s", saga.ID, *saga.RequestID)
+		if saga.Kind == model.RequestSagaKindCreate {
+			return s.setStatus(tx, &saga, model.RequestSagaStatusCompensated, nil)
+		}
+		return s.setStatus(tx, &saga, model.RequestSagaStatusCompleted, nil)
+	})
+}
+
+// findLeftoverRequest returns the parent request a create saga left behind,
+// or "" when the request service has none
+func (s *Sagas) findLeftoverRequest(ctx context.Context, tx *gorm.DB, saga *model.RequestSaga) (string, error) {
+	requests, err := util.GetRequests(ctx, saga.ChannelID, saga.UserID)
+	if err != nil {
+		return "", util.NewError(util.ErrorCodeUpstreamUnavailable, err.Error())
+	}
+	if len(requests) == 0 {
+		return "", nil
+	}
+
+	ids := make([]string, 0, len(requests))
+	for _, request := range requests {
+		ids = append(ids, request.ID)
+	}
+
+	// requests of local rows and of other sagas are not leftovers
+	var rowRequestIDs, sagaRequestIDs []string
+	if err := tx.Table(s.table).Where("request_id IN ?", ids).Pluck("request_id", &rowRequestIDs).Error; err != nil {
+		return "", err
+	}
+	if err := tx.Model(&model.RequestSaga{}).Where("request_id IN ? AND id <> ?", ids, saga.ID).Pluck("request_id", &sagaRequestIDs).Error; err != nil {
+		return "", err
+	}
+
+	referenced := map[string]bool{}
+	for _, id := range append(rowRequestIDs, sagaRequestIDs...) {
+		referenced[id] = true
+	}
+
+	return leftoverRequest(saga, requests, referenced)
+}
+
+// leftoverRequest picks the only request created within leftoverRequestWindow
+// of the saga that is not referenced. Several of them cannot be told apart, so
+// none is deleted.
+func leftoverRequest(saga *model.RequestSaga, requests []*model.ParentRequest, referenced map[string]bool) (string, error) {
+	var leftovers []string
+	for _, request := range requests {
+		if referenced[request.ID] {
+			continue
+		}
+		if request.CreatedAt.Before(saga.CreatedAt.Add(-leftoverRequestWindow)) || request.CreatedAt.After(saga.CreatedAt.Add(leftoverRequestWindow)) {
+			continue
+		}
+
+		leftovers = append(leftovers, request.ID)
+	}
+
+	switch len(leftovers) {
+	case 0:
+		return "", nil
+	case 1:
+		return leftovers[0], nil
+	}
+
+	return "", fmt.Errorf("%d requests may be the leftover request: %v", len(leftovers), leftovers)
+}
+
+// retryLater counts the failed attempt and gives up after maxAttempts
+func (s *Sagas) retryLater(tx *gorm.DB, saga *model.RequestSaga, cause error) error {
+	status := saga.Status
+	if saga.Attempts+1 >= s.maxAttempts {
+		status = model.RequestSagaStatusFailed
+
+		sentry.CaptureException(fmt.Errorf("request saga %s gave up after %d attempts: %w", saga.ID, saga.Attempts+1, cause))
+		defer sentry.Flush(2 * time.Second)
+	}
+
+	saga.Status = status
+	return tx.Model(saga).Updates(map[string]interface{}{
+		"status":     status,
+		"attempts":   gorm.Expr("attempts + 1"),
+		"last_error": cause.Error(),
+		"updated_at": time.Now().UTC(),
+	}).Error
+}
+
+// ConfigureFromEnv reads SAGA_STALE_AFTER (seconds) and SAGA_MAX_ATTEMPTS and
+// returns the reconcile interval SAGA_RECONCILE_INTERVAL (seconds)
+func (s *Sagas) ConfigureFromEnv() (time.Duration, error) {
+	interval := defaultSagaReconcileInterval
+
+	for name, target := range map[string]*time.Duration{
+		"SAGA_RECONCILE_INTERVAL": &interval,
+		"SAGA_STALE_AFTER":        &s.staleAfter,
+	} {
+		if value := os.Getenv(name); value != "" {
+			seconds, err := strconv.Atoi(value)
+			if err != nil {
+				return 0, fmt.Errorf("unable to convert %s to int: %w", name, err)
+			}
+			*target = time.Duration(seconds) * time.Second
+		}
+	}
+
+	if value := os.Getenv("SAGA_MAX_ATTEMPTS"); value != "" {
+		attempts, err := strconv.Atoi(value)
+		if err != nil {
+			return 0, fmt.Errorf("unable to convert SAGA_MAX_ATTEMPTS to int: %w", err)
+		}
+		s.maxAttempts = attempts
+	}
+
+	return interval, nil
+}
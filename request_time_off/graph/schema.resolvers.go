@@ -2,15 +2,15 @@ package graph
 
 import (
 	"context"
-	"errors"
-	"fmt"
+	"request_time_offs/events"
 	"request_time_offs/graph/generated"
 	"request_time_offs/graph/model"
 	"request_time_offs/util"
 	"time"
 
 	sentry "github.com/getsentry/sentry-go"
-	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // CreateRequestTimeOff is the resolver for the createRequestTimeOff field.
@@ -19,10 +19,11 @@ func (r *mutationResolver) CreateRequestTimeOff(ctx context.Context, input model
 	fieldError := "Create Request Time Off"
 	errorMessage := "Something went wrong while creating the Request Time Off." // default error message
 
+	authUserID = AuthUserID(ctx, authUserID)
 	if authUserID == nil || *authUserID == string("") {
 		errorMessage = "Authenticated user id is required"
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeRequired,
+			Code:    model.ShiftErrorCodeUnauthenticated,
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -37,28 +38,28 @@ func (r *mutationResolver) CreateRequestTimeOff(ctx context.Context, input model
 	permission := false
 
 	// validate permission
-	permission, err = util.CheckPermission("request_time_off", "WRITE_ALL", *authUserID)
+	permission, err = util.CheckAnyPermission(ctx, "request_time_off", []string{"WRITE_ALL", "WRITE"}, *authUserID)
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
-		return nil, err
-	}
-
-	if !permission {
-		permission, err = util.CheckPermission("request_time_off", "WRITE", *authUserID)
-		if err != nil {
-			sentry.CaptureException(err)
-			defer sentry.Flush(2 * time.Second)
+		errorMessage = err.Error()
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    util.ShiftErrorCodeOf(err),
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
 
-			return nil, err
-		}
+		return &model.TimeOffResponse{
+			Errors:  shiftError,
+			Request: nil,
+		}, nil
 	}
 
 	if !permission {
 		errorMessage = "Permission denied: request_time_off.WRITE, request_time_off.WRITE_ALL"
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeRequired,
+			Code:    model.ShiftErrorCodeForbidden,
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -69,14 +70,16 @@ func (r *mutationResolver) CreateRequestTimeOff(ctx context.Context, input model
 		}, nil
 	}
 
-	requestId, err := util.CreateRequest(&input.ChannelID, &input.UserID)
+	// get the user first, so nothing is created when it fails
+	user, err := util.GetUser(ctx, input.UserID)
+
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
 		errorMessage = err.Error()
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeRequired,
+			Code:    util.ShiftErrorCodeOf(err),
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -87,10 +90,10 @@ func (r *mutationResolver) CreateRequestTimeOff(ctx context.Context, input model
 		}, nil
 	}
 
-	if requestId == "" {
-		errorMessage = "There was an error creating the request in Request API"
+	if user.ID == nil || *user.ID == string("") {
+		errorMessage = "User not found"
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeInvalid,
+			Code:    model.ShiftErrorCodeNotFound,
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -102,32 +105,17 @@ func (r *mutationResolver) CreateRequestTimeOff(ctx context.Context, input model
 	}
 
 	// create RequestTimeOff
-	timeNow := time.Now().UTC()
-	requestTimeOff := &model.RequestTimeOff{
-		ID:               uuid.New().String(),
-		RequestID:        &requestId,
-		ChannelID:        &input.ChannelID,
-		UserID:           input.UserID,
-		Reason:           input.Reason,
-		StartTime:        input.StartTime,
-		EndTime:          input.EndTime,
-		Is24Hours:        &input.Is24Hours,
-		RequestNote:      input.RequestNote,
-		Status:           model.RequestStatusPending,
-		ResponseNote:     input.ResponseNote,
-		ResponseByUserID: input.ResponseByUserID,
-		ResponseAt:       input.ResponseAt,
-		CreatedAt:        timeNow,
-	}
+	requestTimeOff := newRequestTimeOff(input, time.Now().UTC())
 
-	err = r.DB.Create(requestTimeOff).Error
+	// the time off follows the policy of its leave type
+	leaveType, err := validateLeaveType(r.DB.WithContext(ctx), requestTimeOff, true)
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
 		errorMessage = err.Error()
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeInvalid,
+			Code:    util.ShiftErrorCodeOf(err),
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -138,16 +126,15 @@ func (r *mutationResolver) CreateRequestTimeOff(ctx context.Context, input model
 		}, nil
 	}
 
-	// get the user from the user service and return it
-	user, err := util.GetUser(input.UserID)
-
+	// no time off can be requested in a blackout period, unless a manager overrides it
+	blackoutPeriods, err := r.Blackouts.Check(ctx, requestTimeOff, input.BlackoutOverrideReason, *authUserID)
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
 		errorMessage = err.Error()
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeInvalid,
+			Code:    util.ShiftErrorCodeOf(err),
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -158,10 +145,37 @@ func (r *mutationResolver) CreateRequestTimeOff(ctx context.Context, input model
 		}, nil
 	}
 
-	if user.ID == nil || *user.ID == string("") {
-		errorMessage = "User not found"
+	if len(blackoutPeriods) > 0 {
+		fieldError = "startTime"
+		errorMessage = blackoutMessage(blackoutPeriods)
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeNotFound,
+			Code:    model.ShiftErrorCodeValidation,
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.TimeOffResponse{
+			Errors:  shiftError,
+			Request: nil,
+		}, nil
+	}
+
+	// the parent request and the request time off are created together or not at all
+	_, err = r.Sagas.CreateRequest(ctx, requestTimeOff.ID, input.ChannelID, input.UserID, func(tx *gorm.DB, requestID string) error {
+		requestTimeOff.RequestID = &requestID
+		if err := tx.Create(requestTimeOff).Error; err != nil {
+			return err
+		}
+
+		return recordHistory(tx, newHistoryEntry(requestTimeOff.ID, model.RequestHistoryActionCreated, "", nil, nil, authUserID))
+	})
+	if err != nil {
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
+
+		errorMessage = err.Error()
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    util.ShiftErrorCodeOf(err),
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -172,6 +186,14 @@ func (r *mutationResolver) CreateRequestTimeOff(ctx context.Context, input model
 		}, nil
 	}
 
+	r.publish(ctx, events.NewRequestTimeOffEvent(events.RequestTimeOffCreated, *requestTimeOff, nil))
+
+	// the time off is created even when it would exceed an absence limit, its approval is blocked
+	capacityWarnings := r.warnCapacity(ctx, requestTimeOff, *authUserID)
+
+	// a time off of an auto-approved leave type is approved right away
+	requestTimeOff, shiftConflicts := r.autoApprove(ctx, requestTimeOff, leaveType, *authUserID)
+
 	requestResponse := model.RequestResponse{
 		ID:           requestTimeOff.ID,
 		ChannelID:    *requestTimeOff.ChannelID,
@@ -182,11 +204,14 @@ func (r *mutationResolver) CreateRequestTimeOff(ctx context.Context, input model
 		ResponseAt:   requestTimeOff.ResponseAt,
 		CreatedAt:    &requestTimeOff.CreatedAt,
 		User:         user,
+		ResponseByID: requestTimeOff.ResponseByUserID,
 	}
 
 	return &model.TimeOffResponse{
-		Errors:  nil,
-		Request: &requestResponse,
+		Errors:           nil,
+		Request:          &requestResponse,
+		ShiftConflicts:   shiftConflicts,
+		CapacityWarnings: capacityWarnings,
 	}, nil
 }
 
@@ -196,10 +221,11 @@ func (r *mutationResolver) UpdateRequestTimeOff(ctx context.Context, id string,
 	fieldError := "Update Request Time Off"
 	errorMessage := "Something went wrong while updating the Request Time Off." // default error message
 
+	authUserID = AuthUserID(ctx, authUserID)
 	if authUserID == nil || *authUserID == string("") {
 		errorMessage = "Authenticated user id is required"
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeRequired,
+			Code:    model.ShiftErrorCodeUnauthenticated,
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -214,14 +240,14 @@ func (r *mutationResolver) UpdateRequestTimeOff(ctx context.Context, id string,
 	permission := false
 
 	// validate permission
-	permission, err = util.CheckPermission("request_time_off", "WRITE_ALL", *authUserID)
+	permission, err = util.CheckAnyPermission(ctx, "request_time_off", []string{"WRITE_ALL", "WRITE"}, *authUserID)
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
 		errorMessage = err.Error()
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeInvalid,
+			Code:    util.ShiftErrorCodeOf(err),
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -233,29 +259,56 @@ func (r *mutationResolver) UpdateRequestTimeOff(ctx context.Context, id string,
 	}
 
 	if !permission {
-		permission, err = util.CheckPermission("request_time_off", "WRITE", *authUserID)
-		if err != nil {
-			sentry.CaptureException(err)
-			defer sentry.Flush(2 * time.Second)
+		errorMessage = "Permission denied: request_time_off.WRITE, request_time_off.WRITE_ALL"
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    model.ShiftErrorCodeForbidden,
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
 
-			errorMessage = err.Error()
-			shiftError = append(shiftError, &model.ShiftError{
-				Code:    model.ShiftErrorCodeInvalid,
-				Field:   &fieldError,
-				Message: &errorMessage,
-			})
+		return &model.TimeOffResponse{
+			Errors:  shiftError,
+			Request: nil,
+		}, nil
+	}
 
-			return &model.TimeOffResponse{
-				Errors:  shiftError,
-				Request: nil,
-			}, nil
-		}
+	if id == "" {
+		errorMessage = "Request Time Off ID is required"
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    model.ShiftErrorCodeValidation,
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.TimeOffResponse{
+			Errors:  shiftError,
+			Request: nil,
+		}, nil
 	}
 
-	if !permission {
-		errorMessage = "Permission denied: request_time_off.WRITE, request_time_off.WRITE_ALL"
+	// update RequestTimeOff, the status only changes through the status mutations
+	changes := model.RequestTimeOff{
+		UserID:        input.UserID,
+		Reason:        input.Reason,
+		LeaveType:     inputLeaveType(input.LeaveType),
+		AttachmentURL: input.AttachmentURL,
+		StartTime:     input.StartTime,
+		EndTime:       input.EndTime,
+		Is24Hours:     &input.Is24Hours,
+		RequestNote:   input.RequestNote,
+		ResponseNote:  input.ResponseNote,
+		ChannelID:     &input.ChannelID,
+	}
+
+	// the updated time off is checked against the blackout periods like a new one
+	blackoutPeriods, err := r.Blackouts.Check(ctx, &changes, input.BlackoutOverrideReason, *authUserID)
+	if err != nil {
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
+
+		errorMessage = err.Error()
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeRequired,
+			Code:    util.ShiftErrorCodeOf(err),
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -266,10 +319,11 @@ func (r *mutationResolver) UpdateRequestTimeOff(ctx context.Context, id string,
 		}, nil
 	}
 
-	if id == "" {
-		errorMessage = "Request Time Off ID is required"
+	if len(blackoutPeriods) > 0 {
+		fieldError = "startTime"
+		errorMessage = blackoutMessage(blackoutPeriods)
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeRequired,
+			Code:    model.ShiftErrorCodeValidation,
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -280,21 +334,37 @@ func (r *mutationResolver) UpdateRequestTimeOff(ctx context.Context, id string,
 		}, nil
 	}
 
-	// update RequestTimeOff
-	err = r.DB.Model(&model.RequestTimeOff{}).Where("id = ?", id).Updates(
-		model.RequestTimeOff{
-			UserID:           input.UserID,
-			Reason:           input.Reason,
-			StartTime:        input.StartTime,
-			EndTime:          input.EndTime,
-			Is24Hours:        &input.Is24Hours,
-			RequestNote:      input.RequestNote,
-			Status:           model.RequestStatusDenied,
-			ResponseNote:     input.ResponseNote,
-			ResponseByUserID: input.ResponseByUserID,
-			ResponseAt:       input.ResponseAt,
-			ChannelID:        &input.ChannelID},
-	).Error
+	// the request time off is locked so the history records the values it replaces
+	err = r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var previous model.RequestTimeOff
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", id).First(&previous).Error
+		if err != nil {
+			return err
+		}
+
+		// an answered time off is final, it is cancelled or requested again instead
+		if previous.Status != model.RequestStatusPending {
+			return util.NewError(util.ErrorCodeConflict, "Request time off is "+string(previous.Status)+" and can only be updated while PENDING")
+		}
+
+		// the updated time off follows the policy of its leave type; the notice only
+		// counts again when it moves or changes its leave type
+		updated := changes
+		updated.ID = previous.ID
+		if updated.AttachmentURL == nil {
+			updated.AttachmentURL = previous.AttachmentURL
+		}
+		checkNotice := !updated.StartTime.Equal(previous.StartTime) || updated.LeaveType != leaveTypeOf(&previous)
+		if _, err := validateLeaveType(tx, &updated, checkNotice); err != nil {
+			return err
+		}
+
+		if err := tx.Model(&model.RequestTimeOff{}).Where("id = ?", id).Updates(changes).Error; err != nil {
+			return err
+		}
+
+		return recordHistory(tx, requestTimeOffEdits(&previous, changes, authUserID)...)
+	})
 
 	if err != nil {
 		sentry.CaptureException(err)
@@ -302,7 +372,7 @@ func (r *mutationResolver) UpdateRequestTimeOff(ctx context.Context, id string,
 
 		errorMessage = err.Error()
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeInvalid,
+			Code:    util.ShiftErrorCodeOf(err),
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -322,7 +392,7 @@ func (r *mutationResolver) UpdateRequestTimeOff(ctx context.Context, id string,
 
 		errorMessage = err.Error()
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeNotFound,
+			Code:    util.ShiftErrorCodeOf(err),
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -334,7 +404,7 @@ func (r *mutationResolver) UpdateRequestTimeOff(ctx context.Context, id string,
 	}
 
 	// get the user from the user service and return it
-	user, err := util.GetUser(requestTimeOff.UserID)
+	user, err := util.GetUser(ctx, requestTimeOff.UserID)
 
 	if err != nil {
 		sentry.CaptureException(err)
@@ -342,7 +412,7 @@ func (r *mutationResolver) UpdateRequestTimeOff(ctx context.Context, id string,
 
 		errorMessage = err.Error()
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeInvalid,
+			Code:    util.ShiftErrorCodeOf(err),
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -356,7 +426,7 @@ func (r *mutationResolver) UpdateRequestTimeOff(ctx context.Context, id string,
 	if user.ID == nil || *user.ID == string("") {
 		errorMessage = "Something went wrong while fetching the user."
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeInvalid,
+			Code:    model.ShiftErrorCodeNotFound,
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -377,6 +447,7 @@ func (r *mutationResolver) UpdateRequestTimeOff(ctx context.Context, id string,
 		ResponseAt:   requestTimeOff.ResponseAt,
 		CreatedAt:    &requestTimeOff.CreatedAt,
 		User:         user,
+		ResponseByID: requestTimeOff.ResponseByUserID,
 	}
 
 	return &model.TimeOffResponse{
@@ -391,10 +462,11 @@ func (r *mutationResolver) DeleteRequestTimeOff(ctx context.Context, id string,
 	fieldError := "Delete Request Time Off"
 	errorMessage := "Something went wrong while deleting the Request Time Off." // default error message
 
+	authUserID = AuthUserID(ctx, authUserID)
 	if authUserID == nil || *authUserID == string("") {
 		errorMessage = "Authenticated user id is required"
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeRequired,
+			Code:    model.ShiftErrorCodeUnauthenticated,
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -409,14 +481,14 @@ func (r *mutationResolver) DeleteRequestTimeOff(ctx context.Context, id string,
 	permission := false
 
 	// validate permission
-	permission, err = util.CheckPermission("request_time_off", "WRITE_ALL", *authUserID)
+	permission, err = util.CheckAnyPermission(ctx, "request_time_off", []string{"WRITE_ALL", "WRITE"}, *authUserID)
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
 		errorMessage = err.Error()
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeInvalid,
+			Code:    util.ShiftErrorCodeOf(err),
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -427,30 +499,10 @@ func (r *mutationResolver) DeleteRequestTimeOff(ctx context.Context, id string,
 		}, nil
 	}
 
-	if !permission {
-		permission, err = util.CheckPermission("request_time_off", "WRITE", *authUserID)
-		if err != nil {
-			sentry.CaptureException(err)
-			defer sentry.Flush(2 * time.Second)
-
-			errorMessage = err.Error()
-			shiftError = append(shiftError, &model.ShiftError{
-				Code:    model.ShiftErrorCodeInvalid,
-				Field:   &fieldError,
-				Message: &errorMessage,
-			})
-
-			return &model.TimeOffResponse{
-				Errors:  shiftError,
-				Request: nil,
-			}, nil
-		}
-	}
-
 	if !permission {
 		errorMessage = "Permission denied: request_time_off.WRITE, request_time_off.WRITE_ALL"
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeRequired,
+			Code:    model.ShiftErrorCodeForbidden,
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -464,7 +516,7 @@ func (r *mutationResolver) DeleteRequestTimeOff(ctx context.Context, id string,
 	if id == "" {
 		errorMessage = "Request Time Off ID is required"
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeRequired,
+			Code:    model.ShiftErrorCodeValidation,
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -484,7 +536,7 @@ func (r *mutationResolver) DeleteRequestTimeOff(ctx context.Context, id string,
 
 		errorMessage = err.Error()
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeNotFound,
+			Code:    util.ShiftErrorCodeOf(err),
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -495,8 +547,8 @@ func (r *mutationResolver) DeleteRequestTimeOff(ctx context.Context, id string,
 		}, nil
 	}
 
-	// delete request time off
-	err = r.DB.Delete(&requestTimeOff, "id = ?", id).Error
+	// get the user first, so nothing is deleted when it fails
+	user, err := util.GetUser(ctx, requestTimeOff.UserID)
 
 	if err != nil {
 		sentry.CaptureException(err)
@@ -504,7 +556,7 @@ func (r *mutationResolver) DeleteRequestTimeOff(ctx context.Context, id string,
 
 		errorMessage = err.Error()
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeInvalid,
+			Code:    util.ShiftErrorCodeOf(err),
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -515,13 +567,8 @@ func (r *mutationResolver) DeleteRequestTimeOff(ctx context.Context, id string,
 		}, nil
 	}
 
-	// delete request
-	deleted, err := util.DeleteRequest(requestTimeOff.RequestID)
-	if err != nil {
-		sentry.CaptureException(err)
-		defer sentry.Flush(2 * time.Second)
-
-		errorMessage = err.Error()
+	if user.ID == nil || *user.ID == string("") {
+		errorMessage = "Something went wrong while fetching the user."
 		shiftError = append(shiftError, &model.ShiftError{
 			Code:    model.ShiftErrorCodeNotFound,
 			Field:   &fieldError,
@@ -533,44 +580,34 @@ func (r *mutationResolver) DeleteRequestTimeOff(ctx context.Context, id string,
 			Request: nil,
 		}, nil
 	}
-	if !deleted {
-		errorMessage = "Request not found or already deleted"
-		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeNotFound,
-			Field:   &fieldError,
-			Message: &errorMessage,
-		})
 
-		return &model.TimeOffResponse{
-			Errors:  shiftError,
-			Request: nil,
-		}, nil
-	}
+	// the request time off and its parent request are deleted together or not at all;
+	// the hours of an approved time off go back to the balance with it
+	err = r.Sagas.DeleteRequest(ctx, requestTimeOff.ID, *requestTimeOff.RequestID, func(tx *gorm.DB) error {
+		if requestTimeOff.Status == model.RequestStatusApproved {
+			if err := creditTimeOff(tx, &requestTimeOff, *authUserID); err != nil {
+				return err
+			}
+		}
 
-	// get the user from the user service and return it
-	user, err := util.GetUser(requestTimeOff.UserID)
+		return tx.Delete(&requestTimeOff, "id = ?", id).Error
+	}, func(tx *gorm.DB) error {
+		if err := tx.Create(&requestTimeOff).Error; err != nil {
+			return err
+		}
+		if requestTimeOff.Status == model.RequestStatusApproved {
+			return debitTimeOff(tx, &requestTimeOff, *authUserID)
+		}
 
+		return nil
+	})
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
 		errorMessage = err.Error()
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeInvalid,
-			Field:   &fieldError,
-			Message: &errorMessage,
-		})
-
-		return &model.TimeOffResponse{
-			Errors:  shiftError,
-			Request: nil,
-		}, nil
-	}
-
-	if user.ID == nil || *user.ID == string("") {
-		errorMessage = "Something went wrong while fetching the user."
-		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeInvalid,
+			Code:    util.ShiftErrorCodeOf(err),
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -591,6 +628,7 @@ func (r *mutationResolver) DeleteRequestTimeOff(ctx context.Context, id string,
 		ResponseAt:   requestTimeOff.ResponseAt,
 		CreatedAt:    &requestTimeOff.CreatedAt,
 		User:         user,
+		ResponseByID: requestTimeOff.ResponseByUserID,
 	}
 
 	return &model.TimeOffResponse{
@@ -605,10 +643,11 @@ func (r *mutationResolver) CancelRequestTimeOff(ctx context.Context, channelID s
 	fieldError := "Cancel Request Time Off"
 	errorMessage := "Something went wrong while canceling the Request Time Off." // default error message
 
+	authUserID = AuthUserID(ctx, authUserID)
 	if authUserID == nil || *authUserID == string("") {
 		errorMessage = "Authenticated user id is required"
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeRequired,
+			Code:    model.ShiftErrorCodeUnauthenticated,
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -623,14 +662,14 @@ func (r *mutationResolver) CancelRequestTimeOff(ctx context.Context, channelID s
 	permission := false
 
 	// validate permission
-	permission, err = util.CheckPermission("request_time_off", "READ_ALL", *authUserID)
+	permission, err = util.CheckAnyPermission(ctx, "request_time_off", []string{"READ_ALL", "READ"}, *authUserID)
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
 		errorMessage = err.Error()
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeInvalid,
+			Code:    util.ShiftErrorCodeOf(err),
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -641,30 +680,10 @@ func (r *mutationResolver) CancelRequestTimeOff(ctx context.Context, channelID s
 		}, nil
 	}
 
-	if !permission {
-		permission, err = util.CheckPermission("request_time_off", "READ", *authUserID)
-		if err != nil {
-			sentry.CaptureException(err)
-			defer sentry.Flush(2 * time.Second)
-
-			errorMessage = err.Error()
-			shiftError = append(shiftError, &model.ShiftError{
-				Code:    model.ShiftErrorCodeInvalid,
-				Field:   &fieldError,
-				Message: &errorMessage,
-			})
-
-			return &model.TimeOffResponse{
-				Errors:  shiftError,
-				Request: nil,
-			}, nil
-		}
-	}
-
 	if !permission {
 		errorMessage = "Permission denied: request_time_off.READ, request_time_off.READ_ALL"
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeRequired,
+			Code:    model.ShiftErrorCodeForbidden,
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -678,7 +697,7 @@ func (r *mutationResolver) CancelRequestTimeOff(ctx context.Context, channelID s
 	if channelID == "" || requestID == "" {
 		errorMessage = "Channel ID and Request ID are required"
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeRequired,
+			Code:    model.ShiftErrorCodeValidation,
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -689,19 +708,15 @@ func (r *mutationResolver) CancelRequestTimeOff(ctx context.Context, channelID s
 		}, nil
 	}
 
-	status := model.RequestStatusCancelled
-	var requestTimeOff *model.RequestTimeOff
-
-	// auth user ID also should be passed in where clause
-	err = r.DB.Model(&requestTimeOff).Where("channel_id = ? AND request_id = ?", channelID, requestID).Update("status", status).Error
-
+	// cancel the request time off, the state machine decides whether it still can be
+	requestTimeOff, err := r.changeRequestTimeOffStatus(ctx, model.RequestStatusCancelled, *authUserID, nil, "channel_id = ? AND request_id = ?", channelID, requestID)
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
 		errorMessage = err.Error()
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeInvalid,
+			Code:    util.ShiftErrorCodeOf(err),
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -712,27 +727,10 @@ func (r *mutationResolver) CancelRequestTimeOff(ctx context.Context, channelID s
 		}, nil
 	}
 
-	// get request time off
-	err = r.DB.Where("channel_id = ? AND request_id = ?", channelID, requestID).First(&requestTimeOff).Error
-	if err != nil {
-		sentry.CaptureException(err)
-		defer sentry.Flush(2 * time.Second)
-
-		errorMessage = err.Error()
-		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeNotFound,
-			Field:   &fieldError,
-			Message: &errorMessage,
-		})
-
-		return &model.TimeOffResponse{
-			Errors:  shiftError,
-			Request: nil,
-		}, nil
-	}
+	r.publish(ctx, events.NewRequestTimeOffEvent(events.RequestTimeOffCancelled, *requestTimeOff, authUserID))
 
 	// get the user from the user service and return it
-	user, err := util.GetUser(requestTimeOff.UserID)
+	user, err := util.GetUser(ctx, requestTimeOff.UserID)
 
 	if err != nil {
 		sentry.CaptureException(err)
@@ -740,7 +738,7 @@ func (r *mutationResolver) CancelRequestTimeOff(ctx context.Context, channelID s
 
 		errorMessage = err.Error()
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeInvalid,
+			Code:    util.ShiftErrorCodeOf(err),
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -754,7 +752,7 @@ func (r *mutationResolver) CancelRequestTimeOff(ctx context.Context, channelID s
 	if user.ID == nil || *user.ID == string("") {
 		errorMessage = "Something went wrong while fetching the user."
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeInvalid,
+			Code:    model.ShiftErrorCodeNotFound,
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -775,6 +773,7 @@ func (r *mutationResolver) CancelRequestTimeOff(ctx context.Context, channelID s
 		ResponseAt:   requestTimeOff.ResponseAt,
 		CreatedAt:    &requestTimeOff.CreatedAt,
 		User:         user,
+		ResponseByID: requestTimeOff.ResponseByUserID,
 	}
 
 	return &model.TimeOffResponse{
@@ -784,15 +783,16 @@ func (r *mutationResolver) CancelRequestTimeOff(ctx context.Context, channelID s
 }
 
 // ApproveRequestTimeOff is the resolver for the approveRequestTimeOff field.
-func (r *mutationResolver) ApproveRequestTimeOff(ctx context.Context, id string, responseNote *string, authUserID *string) (*model.TimeOffResponse, error) {
+func (r *mutationResolver) ApproveRequestTimeOff(ctx context.Context, id string, responseNote *string, capacityOverrideReason *string, authUserID *string) (*model.TimeOffResponse, error) {
 	var shiftError []*model.ShiftError
 	fieldError := "Approve Request Time Off"
 	errorMessage := "Something went wrong while approving the Request Time Off." // default error message
 
+	authUserID = AuthUserID(ctx, authUserID)
 	if authUserID == nil || *authUserID == string("") {
 		errorMessage = "Authenticated user id is required"
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeRequired,
+			Code:    model.ShiftErrorCodeUnauthenticated,
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -807,12 +807,12 @@ func (r *mutationResolver) ApproveRequestTimeOff(ctx context.Context, id string,
 	permission := false
 
 	// validate permission
-	permission, err = util.CheckPermission("request_time_off", "MANAGE_ALL", *authUserID)
+	permission, err = util.CheckAnyPermission(ctx, "request_time_off", []string{"MANAGE_ALL", "MANAGE"}, *authUserID)
 	if err != nil {
 
 		errorMessage = err.Error()
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeInvalid,
+			Code:    util.ShiftErrorCodeOf(err),
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -823,30 +823,10 @@ func (r *mutationResolver) ApproveRequestTimeOff(ctx context.Context, id string,
 		}, nil
 	}
 
-	if !permission {
-		permission, err = util.CheckPermission("request_time_off", "MANAGE", *authUserID)
-		if err != nil {
-			sentry.CaptureException(err)
-			defer sentry.Flush(2 * time.Second)
-
-			errorMessage = err.Error()
-			shiftError = append(shiftError, &model.ShiftError{
-				Code:    model.ShiftErrorCodeInvalid,
-				Field:   &fieldError,
-				Message: &errorMessage,
-			})
-
-			return &model.TimeOffResponse{
-				Errors:  shiftError,
-				Request: nil,
-			}, nil
-		}
-	}
-
 	if !permission {
 		errorMessage = "Permission denied: request_time_off.MANAGE, request_time_off.MANAGE_ALL"
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeInvalid,
+			Code:    model.ShiftErrorCodeForbidden,
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -860,34 +840,7 @@ func (r *mutationResolver) ApproveRequestTimeOff(ctx context.Context, id string,
 	if id == "" {
 		errorMessage = "Request Time Off ID is required"
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeRequired,
-			Field:   &fieldError,
-			Message: &errorMessage,
-		})
-
-		return &model.TimeOffResponse{
-			Errors:  shiftError,
-			Request: nil,
-		}, nil
-	}
-
-	status := model.RequestStatusApproved
-	var requestTimeOff *model.RequestTimeOff
-
-	approve := &model.RequestTimeOff{
-		Status:       status,
-		ResponseNote: responseNote,
-	}
-	// update request time off status to accepted
-	err = r.DB.Model(&requestTimeOff).Where("id= ?", id).Updates(approve).Error
-
-	if err != nil {
-		sentry.CaptureException(err)
-		defer sentry.Flush(2 * time.Second)
-
-		errorMessage = err.Error()
-		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeInvalid,
+			Code:    model.ShiftErrorCodeValidation,
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -898,9 +851,9 @@ func (r *mutationResolver) ApproveRequestTimeOff(ctx context.Context, id string,
 		}, nil
 	}
 
-	// get request time off by channel id and request id
-	err = r.DB.Where("id= ?", id).First(&requestTimeOff).Error
-
+	// approve the request time off under the shift conflict policy of its channel and the absence limits
+	// of its shift groups, only a pending one can be approved
+	requestTimeOff, shiftConflicts, capacityWarnings, err := r.approveRequestTimeOff(ctx, id, *authUserID, responseNote, capacityOverrideReason)
 	if err != nil {
 
 		sentry.CaptureException(err)
@@ -908,19 +861,23 @@ func (r *mutationResolver) ApproveRequestTimeOff(ctx context.Context, id string,
 
 		errorMessage = err.Error()
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeNotFound,
+			Code:    util.ShiftErrorCodeOf(err),
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
 
 		return &model.TimeOffResponse{
-			Errors:  shiftError,
-			Request: nil,
+			Errors:           shiftError,
+			Request:          nil,
+			ShiftConflicts:   shiftConflicts,
+			CapacityWarnings: capacityWarnings,
 		}, nil
 	}
 
+	r.publish(ctx, events.NewRequestTimeOffEvent(events.RequestTimeOffApproved, *requestTimeOff, authUserID))
+
 	// get the user from the user service and return it
-	user, err := util.GetUser(requestTimeOff.UserID)
+	user, err := util.GetUser(ctx, requestTimeOff.UserID)
 
 	if err != nil {
 		sentry.CaptureException(err)
@@ -928,7 +885,7 @@ func (r *mutationResolver) ApproveRequestTimeOff(ctx context.Context, id string,
 
 		errorMessage = err.Error()
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeInvalid,
+			Code:    util.ShiftErrorCodeOf(err),
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -942,7 +899,7 @@ func (r *mutationResolver) ApproveRequestTimeOff(ctx context.Context, id string,
 	if user.ID == nil || *user.ID == string("") {
 		errorMessage = "Something went wrong while fetching the user."
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeInvalid,
+			Code:    model.ShiftErrorCodeNotFound,
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -963,11 +920,14 @@ func (r *mutationResolver) ApproveRequestTimeOff(ctx context.Context, id string,
 		ResponseAt:   requestTimeOff.ResponseAt,
 		CreatedAt:    &requestTimeOff.CreatedAt,
 		User:         user,
+		ResponseByID: requestTimeOff.ResponseByUserID,
 	}
 
 	return &model.TimeOffResponse{
-		Errors:  nil,
-		Request: &requestResponse,
+		Errors:           nil,
+		Request:          &requestResponse,
+		ShiftConflicts:   shiftConflicts,
+		CapacityWarnings: capacityWarnings,
 	}, nil
 }
 
@@ -977,10 +937,11 @@ func (r *mutationResolver) DenyRequestTimeOff(ctx context.Context, id string, re
 	fieldError := "Deny Request Time Off"
 	errorMessage := "Something went wrong while denying the Request Time Off." // default error message
 
+	authUserID = AuthUserID(ctx, authUserID)
 	if authUserID == nil || *authUserID == string("") {
 		errorMessage = "Authenticated user id is required"
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeRequired,
+			Code:    model.ShiftErrorCodeUnauthenticated,
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -995,14 +956,14 @@ func (r *mutationResolver) DenyRequestTimeOff(ctx context.Context, id string, re
 	permission := false
 
 	// validate permission
-	permission, err = util.CheckPermission("request_time_off", "MANAGE_ALL", *authUserID)
+	permission, err = util.CheckAnyPermission(ctx, "request_time_off", []string{"MANAGE_ALL", "MANAGE"}, *authUserID)
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
 		errorMessage = err.Error()
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeNotFound,
+			Code:    util.ShiftErrorCodeOf(err),
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -1013,30 +974,10 @@ func (r *mutationResolver) DenyRequestTimeOff(ctx context.Context, id string, re
 		}, nil
 	}
 
-	if !permission {
-		permission, err = util.CheckPermission("request_time_off", "MANAGE", *authUserID)
-		if err != nil {
-			sentry.CaptureException(err)
-			defer sentry.Flush(2 * time.Second)
-
-			errorMessage = err.Error()
-			shiftError = append(shiftError, &model.ShiftError{
-				Code:    model.ShiftErrorCodeNotFound,
-				Field:   &fieldError,
-				Message: &errorMessage,
-			})
-
-			return &model.TimeOffResponse{
-				Errors:  shiftError,
-				Request: nil,
-			}, nil
-		}
-	}
-
 	if !permission {
 		errorMessage = "Permission denied: request_time_off.MANAGE, request_time_off.MANAGE_ALL"
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeRequired,
+			Code:    model.ShiftErrorCodeForbidden,
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -1050,7 +991,7 @@ func (r *mutationResolver) DenyRequestTimeOff(ctx context.Context, id string, re
 	if id == "" {
 		errorMessage = "Request Time Off id is required"
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeRequired,
+			Code:    model.ShiftErrorCodeValidation,
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -1061,22 +1002,15 @@ func (r *mutationResolver) DenyRequestTimeOff(ctx context.Context, id string, re
 		}, nil
 	}
 
-	status := model.RequestStatusDenied
-	var requestTimeOff *model.RequestTimeOff
-	deny := &model.RequestTimeOff{
-		Status:       status,
-		ResponseNote: responseNote,
-	}
-	// update request time off status to accepted
-	err = r.DB.Model(&requestTimeOff).Where("id= ?", id).Updates(deny).Error
-
+	// deny the request time off, only a pending one can be denied
+	requestTimeOff, err := r.changeRequestTimeOffStatus(ctx, model.RequestStatusDenied, *authUserID, responseNote, "id = ?", id)
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
 		errorMessage = err.Error()
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeInvalid,
+			Code:    util.ShiftErrorCodeOf(err),
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -1087,28 +1021,10 @@ func (r *mutationResolver) DenyRequestTimeOff(ctx context.Context, id string, re
 		}, nil
 	}
 
-	// get request time off by channel id and request id
-	err = r.DB.Where("id= ?", id).First(&requestTimeOff).Error
-
-	if err != nil {
-		sentry.CaptureException(err)
-		defer sentry.Flush(2 * time.Second)
-
-		errorMessage = err.Error()
-		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeNotFound,
-			Field:   &fieldError,
-			Message: &errorMessage,
-		})
-
-		return &model.TimeOffResponse{
-			Errors:  shiftError,
-			Request: nil,
-		}, nil
-	}
+	r.publish(ctx, events.NewRequestTimeOffEvent(events.RequestTimeOffDenied, *requestTimeOff, authUserID))
 
 	// get the user from the user service and return it
-	user, err := util.GetUser(requestTimeOff.UserID)
+	user, err := util.GetUser(ctx, requestTimeOff.UserID)
 
 	if err != nil {
 		sentry.CaptureException(err)
@@ -1116,7 +1032,7 @@ func (r *mutationResolver) DenyRequestTimeOff(ctx context.Context, id string, re
 
 		errorMessage = err.Error()
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeInvalid,
+			Code:    util.ShiftErrorCodeOf(err),
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -1130,7 +1046,7 @@ func (r *mutationResolver) DenyRequestTimeOff(ctx context.Context, id string, re
 	if user.ID == nil || *user.ID == string("") {
 		errorMessage = "Something went wrong while fetching the user."
 		shiftError = append(shiftError, &model.ShiftError{
-			Code:    model.ShiftErrorCodeInvalid,
+			Code:    model.ShiftErrorCodeNotFound,
 			Field:   &fieldError,
 			Message: &errorMessage,
 		})
@@ -1151,6 +1067,7 @@ func (r *mutationResolver) DenyRequestTimeOff(ctx context.Context, id string, re
 		ResponseAt:   requestTimeOff.ResponseAt,
 		CreatedAt:    &requestTimeOff.CreatedAt,
 		User:         user,
+		ResponseByID: requestTimeOff.ResponseByUserID,
 	}
 
 	return &model.TimeOffResponse{
@@ -1159,127 +1076,1775 @@ func (r *mutationResolver) DenyRequestTimeOff(ctx context.Context, id string, re
 	}, nil
 }
 
-// GetRequestTimeOff is the resolver for the getRequestTimeOff field.
-func (r *queryResolver) GetRequestTimeOff(ctx context.Context, id string, authUserID *string) (*model.RequestTimeOff, error) {
+// ApproveRequestTimeOffs is the resolver for the approveRequestTimeOffs field.
+func (r *mutationResolver) ApproveRequestTimeOffs(ctx context.Context, ids []string, responseNote *string, authUserID *string) (*model.BulkTimeOffResponse, error) {
+	var shiftError []*model.ShiftError
+	fieldError := "Approve Request Time Offs"
+	errorMessage := "Something went wrong while approving the Request Time Offs." // default error message
+
+	authUserID = AuthUserID(ctx, authUserID)
 	if authUserID == nil || *authUserID == string("") {
-		return nil, fmt.Errorf("Authenticated user id is required")
-	}
+		errorMessage = "Authenticated user id is required"
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    model.ShiftErrorCodeUnauthenticated,
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
 
-	var err error
-	permission := false
+		return &model.BulkTimeOffResponse{
+			Errors:  shiftError,
+			Results: nil,
+		}, nil
+	}
 
-	// validate permission
-	permission, err = util.CheckPermission("request_time_off", "READ_ALL", *authUserID)
+	// validate permission, once for all time offs
+	permission, err := util.CheckAnyPermission(ctx, "request_time_off", []string{"MANAGE_ALL", "MANAGE"}, *authUserID)
 	if err != nil {
-
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
-		return nil, err
+		errorMessage = err.Error()
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    util.ShiftErrorCodeOf(err),
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.BulkTimeOffResponse{
+			Errors:  shiftError,
+			Results: nil,
+		}, nil
 	}
 
 	if !permission {
-		permission, err = util.CheckPermission("request_time_off", "READ", *authUserID)
-		if err != nil {
-			sentry.CaptureException(err)
-			defer sentry.Flush(2 * time.Second)
+		errorMessage = "Permission denied: request_time_off.MANAGE, request_time_off.MANAGE_ALL"
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    model.ShiftErrorCodeForbidden,
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
 
-			return nil, err
-		}
+		return &model.BulkTimeOffResponse{
+			Errors:  shiftError,
+			Results: nil,
+		}, nil
 	}
 
-	if !permission {
-		return nil, fmt.Errorf("Permission denied: request_time_off.READ, request_time_off.READ_ALL")
+	ids, err = bulkIDs(ids)
+	if err != nil {
+		errorMessage = err.Error()
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    util.ShiftErrorCodeOf(err),
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.BulkTimeOffResponse{
+			Errors:  shiftError,
+			Results: nil,
+		}, nil
 	}
-	if id == "" {
-		return nil, errors.New("id is required")
+
+	// each request time off is approved on its own under the shift conflict policy of its channel
+	// and the absence limits of its shift groups, only a pending one can be approved
+	shiftConflicts := map[string][]*model.ShiftConflict{}
+	capacityWarnings := map[string][]*model.CapacityWarning{}
+	response := r.changeRequestTimeOffs(ctx, ids, fieldError, func(ctx context.Context, id string) (*model.RequestTimeOff, error) {
+		requestTimeOff, conflicts, warnings, err := r.approveRequestTimeOff(ctx, id, *authUserID, responseNote, nil)
+		shiftConflicts[id] = conflicts
+		capacityWarnings[id] = warnings
+		if err != nil {
+			return nil, err
+		}
+
+		r.publish(ctx, events.NewRequestTimeOffEvent(events.RequestTimeOffApproved, *requestTimeOff, authUserID))
+		return requestTimeOff, nil
+	})
+
+	for _, result := range response.Results {
+		result.ShiftConflicts = shiftConflicts[result.ID]
+		result.CapacityWarnings = capacityWarnings[result.ID]
 	}
 
-	// get request time off by channel id and request id
-	var requestTimeOff model.RequestTimeOff
-	err = r.DB.First(&requestTimeOff, "id= ?", id).Error
+	return response, nil
+}
+
+// DenyRequestTimeOffs is the resolver for the denyRequestTimeOffs field.
+func (r *mutationResolver) DenyRequestTimeOffs(ctx context.Context, ids []string, responseNote *string, authUserID *string) (*model.BulkTimeOffResponse, error) {
+	var shiftError []*model.ShiftError
+	fieldError := "Deny Request Time Offs"
+	errorMessage := "Something went wrong while denying the Request Time Offs." // default error message
+
+	authUserID = AuthUserID(ctx, authUserID)
+	if authUserID == nil || *authUserID == string("") {
+		errorMessage = "Authenticated user id is required"
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    model.ShiftErrorCodeUnauthenticated,
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.BulkTimeOffResponse{
+			Errors:  shiftError,
+			Results: nil,
+		}, nil
+	}
 
+	// validate permission, once for all time offs
+	permission, err := util.CheckAnyPermission(ctx, "request_time_off", []string{"MANAGE_ALL", "MANAGE"}, *authUserID)
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
-		return nil, err
+		errorMessage = err.Error()
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    util.ShiftErrorCodeOf(err),
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.BulkTimeOffResponse{
+			Errors:  shiftError,
+			Results: nil,
+		}, nil
 	}
 
-	return &requestTimeOff, nil
+	if !permission {
+		errorMessage = "Permission denied: request_time_off.MANAGE, request_time_off.MANAGE_ALL"
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    model.ShiftErrorCodeForbidden,
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.BulkTimeOffResponse{
+			Errors:  shiftError,
+			Results: nil,
+		}, nil
+	}
+
+	ids, err = bulkIDs(ids)
+	if err != nil {
+		errorMessage = err.Error()
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    util.ShiftErrorCodeOf(err),
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.BulkTimeOffResponse{
+			Errors:  shiftError,
+			Results: nil,
+		}, nil
+	}
+
+	// each request time off is denied on its own, only a pending one can be denied
+	return r.changeRequestTimeOffs(ctx, ids, fieldError, func(ctx context.Context, id string) (*model.RequestTimeOff, error) {
+		requestTimeOff, err := r.changeRequestTimeOffStatus(ctx, model.RequestStatusDenied, *authUserID, responseNote, "id = ?", id)
+		if err != nil {
+			return nil, err
+		}
+
+		r.publish(ctx, events.NewRequestTimeOffEvent(events.RequestTimeOffDenied, *requestTimeOff, authUserID))
+		return requestTimeOff, nil
+	}), nil
 }
 
-// GetRequestTimeOffs is the resolver for the getRequestTimeOffs field.
-func (r *queryResolver) GetRequestTimeOffs(ctx context.Context, authUserID *string) ([]*model.RequestTimeOff, error) {
+// AddRequestComment is the resolver for the addRequestComment field.
+func (r *mutationResolver) AddRequestComment(ctx context.Context, id string, body string, authUserID *string) (*model.RequestCommentResponse, error) {
+	var shiftError []*model.ShiftError
+	fieldError := "Add Request Comment"
+	errorMessage := "Something went wrong while adding the comment." // default error message
+
+	authUserID = AuthUserID(ctx, authUserID)
 	if authUserID == nil || *authUserID == string("") {
-		return nil, fmt.Errorf("Authenticated user id is required")
-	}
+		errorMessage = "Authenticated user id is required"
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    model.ShiftErrorCodeUnauthenticated,
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
 
-	var err error
-	permission := false
+		return &model.RequestCommentResponse{
+			Errors:  shiftError,
+			Comment: nil,
+		}, nil
+	}
 
-	// validate permission
-	permission, err = util.CheckPermission("request_time_off", "READ_ALL", *authUserID)
+	// validate permission; managers and users reading all time offs comment on any of them
+	privileged, err := util.CheckAnyPermission(ctx, "request_time_off", []string{"READ_ALL", "MANAGE_ALL", "MANAGE"}, *authUserID)
 	if err != nil {
-
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
-		return nil, err
+		errorMessage = err.Error()
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    util.ShiftErrorCodeOf(err),
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.RequestCommentResponse{
+			Errors:  shiftError,
+			Comment: nil,
+		}, nil
 	}
 
-	// if !permission {
-	// 	permission, err = util.CheckPermission("request_time_off", "READ", *authUserID)
-	// 	if err != nil {
-	// 		return nil, err
-	// 	}
-	// }
+	permission := privileged
+	if !permission {
+		permission, err = util.CheckPermission(ctx, "request_time_off", "READ", *authUserID)
+		if err != nil {
+			sentry.CaptureException(err)
+			defer sentry.Flush(2 * time.Second)
+
+			errorMessage = err.Error()
+			shiftError = append(shiftError, &model.ShiftError{
+				Code:    util.ShiftErrorCodeOf(err),
+				Field:   &fieldError,
+				Message: &errorMessage,
+			})
+
+			return &model.RequestCommentResponse{
+				Errors:  shiftError,
+				Comment: nil,
+			}, nil
+		}
+	}
 
 	if !permission {
-		return nil, fmt.Errorf("Permission denied: request_time_off.READ_ALL")
+		errorMessage = "Permission denied: request_time_off.READ, request_time_off.READ_ALL"
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    model.ShiftErrorCodeForbidden,
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.RequestCommentResponse{
+			Errors:  shiftError,
+			Comment: nil,
+		}, nil
 	}
 
-	// get all request time offs
-	var requestTimeOffs []*model.RequestTimeOff
-	err = r.DB.Find(&requestTimeOffs).Error
+	if id == "" {
+		errorMessage = "Request Time Off id is required"
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    model.ShiftErrorCodeValidation,
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.RequestCommentResponse{
+			Errors:  shiftError,
+			Comment: nil,
+		}, nil
+	}
 
+	// the others only comment on their own request time offs
+	requestTimeOff, comment, err := r.addRequestTimeOffComment(ctx, id, *authUserID, body, !privileged)
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
-		return nil, err
+		errorMessage = err.Error()
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    util.ShiftErrorCodeOf(err),
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.RequestCommentResponse{
+			Errors:  shiftError,
+			Comment: nil,
+		}, nil
 	}
 
-	return requestTimeOffs, nil
+	r.publish(ctx, events.NewRequestTimeOffCommentEvent(*requestTimeOff, *comment))
+
+	return &model.RequestCommentResponse{
+		Errors:  nil,
+		Comment: comment,
+	}, nil
 }
 
-// GetRequestTimeOffsByChannelIDRequestID is the resolver for the getRequestTimeOffsByChannelIdRequestId field.
-func (r *queryResolver) GetRequestTimeOffsByChannelIDRequestID(ctx context.Context, channelID string, requestID string) (*model.RequestTimeOff, error) {
-	if channelID == "" || requestID == "" {
-		return nil, errors.New("channelID and requestID are required")
-	}
+// SetRequestExpirySettings is the resolver for the setRequestExpirySettings field.
+func (r *mutationResolver) SetRequestExpirySettings(ctx context.Context, channelID string, input model.RequestExpirySettingsInput, authUserID *string) (*model.RequestExpirySettingsResponse, error) {
+	var shiftError []*model.ShiftError
+	fieldError := "Set Request Expiry Settings"
+	errorMessage := "Something went wrong while setting the Request Expiry Settings." // default error message
 
-	var err error
+	authUserID = AuthUserID(ctx, authUserID)
+	if authUserID == nil || *authUserID == string("") {
+		errorMessage = "Authenticated user id is required"
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    model.ShiftErrorCodeUnauthenticated,
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
 
-	// get all request time offs
-	var requestTimeOffs *model.RequestTimeOff
-	err = r.DB.First(&requestTimeOffs, "channel_id = ? AND request_id = ?", channelID, requestID).Error
+		return &model.RequestExpirySettingsResponse{
+			Errors:   shiftError,
+			Settings: nil,
+		}, nil
+	}
 
+	// validate permission
+	permission, err := util.CheckPermission(ctx, "request_time_off", "WRITE_ALL", *authUserID)
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
-		return nil, err
+		errorMessage = err.Error()
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    util.ShiftErrorCodeOf(err),
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.RequestExpirySettingsResponse{
+			Errors:   shiftError,
+			Settings: nil,
+		}, nil
 	}
 
-	return requestTimeOffs, nil
-}
+	if !permission {
+		errorMessage = "Permission denied: request_time_off.WRITE_ALL"
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    model.ShiftErrorCodeForbidden,
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
 
-// Mutation returns generated.MutationResolver implementation.
-func (r *Resolver) Mutation() generated.MutationResolver { return &mutationResolver{r} }
+		return &model.RequestExpirySettingsResponse{
+			Errors:   shiftError,
+			Settings: nil,
+		}, nil
+	}
 
-// Query returns generated.QueryResolver implementation.
-func (r *Resolver) Query() generated.QueryResolver { return &queryResolver{r} }
+	if channelID == "" || input.GracePeriodMinutes < 0 {
+		errorMessage = "channelId and a gracePeriodMinutes of at least 0 are required"
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    model.ShiftErrorCodeValidation,
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.RequestExpirySettingsResponse{
+			Errors:   shiftError,
+			Settings: nil,
+		}, nil
+	}
+
+	settings, err := r.Expiry.SetSettings(ctx, channelID, input, *authUserID)
+	if err != nil {
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
+
+		errorMessage = err.Error()
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    util.ShiftErrorCodeOf(err),
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.RequestExpirySettingsResponse{
+			Errors:   shiftError,
+			Settings: nil,
+		}, nil
+	}
+
+	return &model.RequestExpirySettingsResponse{
+		Errors:   nil,
+		Settings: settings,
+	}, nil
+}
+
+// SetTimeOffAccrualPolicy is the resolver for the setTimeOffAccrualPolicy field.
+func (r *mutationResolver) SetTimeOffAccrualPolicy(ctx context.Context, channelID string, input model.TimeOffAccrualPolicyInput, authUserID *string) (*model.TimeOffAccrualPolicyResponse, error) {
+	var shiftError []*model.ShiftError
+	fieldError := "Set Time Off Accrual Policy"
+	errorMessage := "Something went wrong while setting the Time Off Accrual Policy." // default error message
+
+	authUserID = AuthUserID(ctx, authUserID)
+	if authUserID == nil || *authUserID == string("") {
+		errorMessage = "Authenticated user id is required"
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    model.ShiftErrorCodeUnauthenticated,
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.TimeOffAccrualPolicyResponse{
+			Errors: shiftError,
+			Policy: nil,
+		}, nil
+	}
+
+	// validate permission
+	permission, err := util.CheckPermission(ctx, "request_time_off", "WRITE_ALL", *authUserID)
+	if err != nil {
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
+
+		errorMessage = err.Error()
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    util.ShiftErrorCodeOf(err),
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.TimeOffAccrualPolicyResponse{
+			Errors: shiftError,
+			Policy: nil,
+		}, nil
+	}
+
+	if !permission {
+		errorMessage = "Permission denied: request_time_off.WRITE_ALL"
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    model.ShiftErrorCodeForbidden,
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.TimeOffAccrualPolicyResponse{
+			Errors: shiftError,
+			Policy: nil,
+		}, nil
+	}
+
+	if channelID == "" {
+		errorMessage = "channelId is required"
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    model.ShiftErrorCodeValidation,
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.TimeOffAccrualPolicyResponse{
+			Errors: shiftError,
+			Policy: nil,
+		}, nil
+	}
+
+	policy, err := r.Ledger.SetPolicy(ctx, channelID, input, *authUserID)
+	if err != nil {
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
+
+		errorMessage = err.Error()
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    util.ShiftErrorCodeOf(err),
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.TimeOffAccrualPolicyResponse{
+			Errors: shiftError,
+			Policy: nil,
+		}, nil
+	}
+
+	return &model.TimeOffAccrualPolicyResponse{
+		Errors: nil,
+		Policy: policy,
+	}, nil
+}
+
+// AdjustTimeOffBalance is the resolver for the adjustTimeOffBalance field.
+func (r *mutationResolver) AdjustTimeOffBalance(ctx context.Context, channelID string, userID string, leaveType string, hours float64, note *string, authUserID *string) (*model.TimeOffBalanceResponse, error) {
+	var shiftError []*model.ShiftError
+	fieldError := "Adjust Time Off Balance"
+	errorMessage := "Something went wrong while adjusting the Time Off Balance." // default error message
+
+	authUserID = AuthUserID(ctx, authUserID)
+	if authUserID == nil || *authUserID == string("") {
+		errorMessage = "Authenticated user id is required"
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    model.ShiftErrorCodeUnauthenticated,
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.TimeOffBalanceResponse{
+			Errors:   shiftError,
+			Balances: nil,
+		}, nil
+	}
+
+	// validate permission
+	permission, err := util.CheckPermission(ctx, "request_time_off", "MANAGE_ALL", *authUserID)
+	if err != nil {
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
+
+		errorMessage = err.Error()
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    util.ShiftErrorCodeOf(err),
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.TimeOffBalanceResponse{
+			Errors:   shiftError,
+			Balances: nil,
+		}, nil
+	}
+
+	if !permission {
+		errorMessage = "Permission denied: request_time_off.MANAGE_ALL"
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    model.ShiftErrorCodeForbidden,
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.TimeOffBalanceResponse{
+			Errors:   shiftError,
+			Balances: nil,
+		}, nil
+	}
+
+	if channelID == "" || userID == "" || leaveType == "" {
+		errorMessage = "channelId, userId and leaveType are required"
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    model.ShiftErrorCodeValidation,
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.TimeOffBalanceResponse{
+			Errors:   shiftError,
+			Balances: nil,
+		}, nil
+	}
+
+	balance, err := r.Ledger.Adjust(ctx, channelID, userID, leaveType, hours, note, *authUserID)
+	if err != nil {
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
+
+		errorMessage = err.Error()
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    util.ShiftErrorCodeOf(err),
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.TimeOffBalanceResponse{
+			Errors:   shiftError,
+			Balances: nil,
+		}, nil
+	}
+
+	return &model.TimeOffBalanceResponse{
+		Errors:   nil,
+		Balances: []*model.TimeOffBalance{balance},
+	}, nil
+}
+
+// RecordHoursWorked is the resolver for the recordHoursWorked field.
+func (r *mutationResolver) RecordHoursWorked(ctx context.Context, channelID string, userID string, hours float64, workedUntil time.Time, authUserID *string) (*model.TimeOffBalanceResponse, error) {
+	var shiftError []*model.ShiftError
+	fieldError := "Record Hours Worked"
+	errorMessage := "Something went wrong while recording the Hours Worked." // default error message
+
+	authUserID = AuthUserID(ctx, authUserID)
+	if authUserID == nil || *authUserID == string("") {
+		errorMessage = "Authenticated user id is required"
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    model.ShiftErrorCodeUnauthenticated,
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.TimeOffBalanceResponse{
+			Errors:   shiftError,
+			Balances: nil,
+		}, nil
+	}
+
+	// validate permission
+	permission, err := util.CheckPermission(ctx, "request_time_off", "WRITE_ALL", *authUserID)
+	if err != nil {
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
+
+		errorMessage = err.Error()
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    util.ShiftErrorCodeOf(err),
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.TimeOffBalanceResponse{
+			Errors:   shiftError,
+			Balances: nil,
+		}, nil
+	}
+
+	if !permission {
+		errorMessage = "Permission denied: request_time_off.WRITE_ALL"
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    model.ShiftErrorCodeForbidden,
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.TimeOffBalanceResponse{
+			Errors:   shiftError,
+			Balances: nil,
+		}, nil
+	}
+
+	if channelID == "" || userID == "" {
+		errorMessage = "channelId and userId are required"
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    model.ShiftErrorCodeValidation,
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.TimeOffBalanceResponse{
+			Errors:   shiftError,
+			Balances: nil,
+		}, nil
+	}
+
+	balances, err := r.Ledger.RecordHoursWorked(ctx, channelID, userID, hours, workedUntil, *authUserID)
+	if err != nil {
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
+
+		errorMessage = err.Error()
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    util.ShiftErrorCodeOf(err),
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.TimeOffBalanceResponse{
+			Errors:   shiftError,
+			Balances: nil,
+		}, nil
+	}
+
+	return &model.TimeOffBalanceResponse{
+		Errors:   nil,
+		Balances: balances,
+	}, nil
+}
+
+// SetShiftConflictSettings is the resolver for the setShiftConflictSettings field.
+func (r *mutationResolver) SetShiftConflictSettings(ctx context.Context, channelID string, input model.ShiftConflictSettingsInput, authUserID *string) (*model.ShiftConflictSettingsResponse, error) {
+	var shiftError []*model.ShiftError
+	fieldError := "Set Shift Conflict Settings"
+	errorMessage := "Something went wrong while setting the Shift Conflict Settings." // default error message
+
+	authUserID = AuthUserID(ctx, authUserID)
+	if authUserID == nil || *authUserID == string("") {
+		errorMessage = "Authenticated user id is required"
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    model.ShiftErrorCodeUnauthenticated,
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.ShiftConflictSettingsResponse{
+			Errors:   shiftError,
+			Settings: nil,
+		}, nil
+	}
+
+	// validate permission
+	permission, err := util.CheckPermission(ctx, "request_time_off", "WRITE_ALL", *authUserID)
+	if err != nil {
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
+
+		errorMessage = err.Error()
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    util.ShiftErrorCodeOf(err),
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.ShiftConflictSettingsResponse{
+			Errors:   shiftError,
+			Settings: nil,
+		}, nil
+	}
+
+	if !permission {
+		errorMessage = "Permission denied: request_time_off.WRITE_ALL"
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    model.ShiftErrorCodeForbidden,
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.ShiftConflictSettingsResponse{
+			Errors:   shiftError,
+			Settings: nil,
+		}, nil
+	}
+
+	if channelID == "" || !input.Policy.IsValid() {
+		errorMessage = "channelId and a valid policy are required"
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    model.ShiftErrorCodeValidation,
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.ShiftConflictSettingsResponse{
+			Errors:   shiftError,
+			Settings: nil,
+		}, nil
+	}
+
+	settings, err := r.Roster.SetSettings(ctx, channelID, input, *authUserID)
+	if err != nil {
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
+
+		errorMessage = err.Error()
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    util.ShiftErrorCodeOf(err),
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.ShiftConflictSettingsResponse{
+			Errors:   shiftError,
+			Settings: nil,
+		}, nil
+	}
+
+	return &model.ShiftConflictSettingsResponse{
+		Errors:   nil,
+		Settings: settings,
+	}, nil
+}
+
+// SetLeaveType is the resolver for the setLeaveType field.
+func (r *mutationResolver) SetLeaveType(ctx context.Context, channelID string, input model.LeaveTypeInput, authUserID *string) (*model.LeaveTypeResponse, error) {
+	var shiftError []*model.ShiftError
+	fieldError := "Set Leave Type"
+	errorMessage := "Something went wrong while setting the Leave Type." // default error message
+
+	authUserID = AuthUserID(ctx, authUserID)
+	if authUserID == nil || *authUserID == string("") {
+		errorMessage = "Authenticated user id is required"
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    model.ShiftErrorCodeUnauthenticated,
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.LeaveTypeResponse{
+			Errors:    shiftError,
+			LeaveType: nil,
+		}, nil
+	}
+
+	// validate permission
+	permission, err := util.CheckPermission(ctx, "request_time_off", "WRITE_ALL", *authUserID)
+	if err != nil {
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
+
+		errorMessage = err.Error()
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    util.ShiftErrorCodeOf(err),
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.LeaveTypeResponse{
+			Errors:    shiftError,
+			LeaveType: nil,
+		}, nil
+	}
+
+	if !permission {
+		errorMessage = "Permission denied: request_time_off.WRITE_ALL"
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    model.ShiftErrorCodeForbidden,
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.LeaveTypeResponse{
+			Errors:    shiftError,
+			LeaveType: nil,
+		}, nil
+	}
+
+	if channelID == "" {
+		errorMessage = "channelId is required"
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    model.ShiftErrorCodeValidation,
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.LeaveTypeResponse{
+			Errors:    shiftError,
+			LeaveType: nil,
+		}, nil
+	}
+
+	leaveType, err := r.Catalog.SetLeaveType(ctx, channelID, input, *authUserID)
+	if err != nil {
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
+
+		errorMessage = err.Error()
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    util.ShiftErrorCodeOf(err),
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.LeaveTypeResponse{
+			Errors:    shiftError,
+			LeaveType: nil,
+		}, nil
+	}
+
+	return &model.LeaveTypeResponse{
+		Errors:    nil,
+		LeaveType: leaveType,
+	}, nil
+}
+
+// CreateBlackoutPeriod is the resolver for the createBlackoutPeriod field.
+func (r *mutationResolver) CreateBlackoutPeriod(ctx context.Context, input model.BlackoutPeriodInput, authUserID *string) (*model.BlackoutPeriodResponse, error) {
+	var shiftError []*model.ShiftError
+	fieldError := "Create Blackout Period"
+	errorMessage := "Something went wrong while creating the Blackout Period." // default error message
+
+	authUserID = AuthUserID(ctx, authUserID)
+	if authUserID == nil || *authUserID == string("") {
+		errorMessage = "Authenticated user id is required"
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    model.ShiftErrorCodeUnauthenticated,
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.BlackoutPeriodResponse{
+			Errors:         shiftError,
+			BlackoutPeriod: nil,
+		}, nil
+	}
+
+	// validate permission
+	permission, err := util.CheckPermission(ctx, "request_time_off", "WRITE_ALL", *authUserID)
+	if err != nil {
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
+
+		errorMessage = err.Error()
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    util.ShiftErrorCodeOf(err),
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.BlackoutPeriodResponse{
+			Errors:         shiftError,
+			BlackoutPeriod: nil,
+		}, nil
+	}
+
+	if !permission {
+		errorMessage = "Permission denied: request_time_off.WRITE_ALL"
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    model.ShiftErrorCodeForbidden,
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.BlackoutPeriodResponse{
+			Errors:         shiftError,
+			BlackoutPeriod: nil,
+		}, nil
+	}
+
+	blackoutPeriod, err := r.Blackouts.Create(ctx, input, *authUserID)
+	if err != nil {
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
+
+		errorMessage = err.Error()
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    util.ShiftErrorCodeOf(err),
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.BlackoutPeriodResponse{
+			Errors:         shiftError,
+			BlackoutPeriod: nil,
+		}, nil
+	}
+
+	return &model.BlackoutPeriodResponse{
+		Errors:         nil,
+		BlackoutPeriod: blackoutPeriod,
+	}, nil
+}
+
+// UpdateBlackoutPeriod is the resolver for the updateBlackoutPeriod field.
+func (r *mutationResolver) UpdateBlackoutPeriod(ctx context.Context, id string, input model.BlackoutPeriodInput, authUserID *string) (*model.BlackoutPeriodResponse, error) {
+	var shiftError []*model.ShiftError
+	fieldError := "Update Blackout Period"
+	errorMessage := "Something went wrong while updating the Blackout Period." // default error message
+
+	authUserID = AuthUserID(ctx, authUserID)
+	if authUserID == nil || *authUserID == string("") {
+		errorMessage = "Authenticated user id is required"
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    model.ShiftErrorCodeUnauthenticated,
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.BlackoutPeriodResponse{
+			Errors:         shiftError,
+			BlackoutPeriod: nil,
+		}, nil
+	}
+
+	// validate permission
+	permission, err := util.CheckPermission(ctx, "request_time_off", "WRITE_ALL", *authUserID)
+	if err != nil {
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
+
+		errorMessage = err.Error()
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    util.ShiftErrorCodeOf(err),
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.BlackoutPeriodResponse{
+			Errors:         shiftError,
+			BlackoutPeriod: nil,
+		}, nil
+	}
+
+	if !permission {
+		errorMessage = "Permission denied: request_time_off.WRITE_ALL"
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    model.ShiftErrorCodeForbidden,
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.BlackoutPeriodResponse{
+			Errors:         shiftError,
+			BlackoutPeriod: nil,
+		}, nil
+	}
+
+	if id == "" {
+		errorMessage = "Blackout Period ID is required"
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    model.ShiftErrorCodeValidation,
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.BlackoutPeriodResponse{
+			Errors:         shiftError,
+			BlackoutPeriod: nil,
+		}, nil
+	}
+
+	blackoutPeriod, err := r.Blackouts.Update(ctx, id, input)
+	if err != nil {
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
+
+		errorMessage = err.Error()
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    util.ShiftErrorCodeOf(err),
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.BlackoutPeriodResponse{
+			Errors:         shiftError,
+			BlackoutPeriod: nil,
+		}, nil
+	}
+
+	return &model.BlackoutPeriodResponse{
+		Errors:         nil,
+		BlackoutPeriod: blackoutPeriod,
+	}, nil
+}
+
+// DeleteBlackoutPeriod is the resolver for the deleteBlackoutPeriod field.
+func (r *mutationResolver) DeleteBlackoutPeriod(ctx context.Context, id string, authUserID *string) (*model.BlackoutPeriodResponse, error) {
+	var shiftError []*model.ShiftError
+	fieldError := "Delete Blackout Period"
+	errorMessage := "Something went wrong while deleting the Blackout Period." // default error message
+
+	authUserID = AuthUserID(ctx, authUserID)
+	if authUserID == nil || *authUserID == string("") {
+		errorMessage = "Authenticated user id is required"
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    model.ShiftErrorCodeUnauthenticated,
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.BlackoutPeriodResponse{
+			Errors:         shiftError,
+			BlackoutPeriod: nil,
+		}, nil
+	}
+
+	// validate permission
+	permission, err := util.CheckPermission(ctx, "request_time_off", "WRITE_ALL", *authUserID)
+	if err != nil {
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
+
+		errorMessage = err.Error()
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    util.ShiftErrorCodeOf(err),
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.BlackoutPeriodResponse{
+			Errors:         shiftError,
+			BlackoutPeriod: nil,
+		}, nil
+	}
+
+	if !permission {
+		errorMessage = "Permission denied: request_time_off.WRITE_ALL"
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    model.ShiftErrorCodeForbidden,
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.BlackoutPeriodResponse{
+			Errors:         shiftError,
+			BlackoutPeriod: nil,
+		}, nil
+	}
+
+	if id == "" {
+		errorMessage = "Blackout Period ID is required"
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    model.ShiftErrorCodeValidation,
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.BlackoutPeriodResponse{
+			Errors:         shiftError,
+			BlackoutPeriod: nil,
+		}, nil
+	}
+
+	blackoutPeriod, err := r.Blackouts.Delete(ctx, id)
+	if err != nil {
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
+
+		errorMessage = err.Error()
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    util.ShiftErrorCodeOf(err),
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.BlackoutPeriodResponse{
+			Errors:         shiftError,
+			BlackoutPeriod: nil,
+		}, nil
+	}
+
+	return &model.BlackoutPeriodResponse{
+		Errors:         nil,
+		BlackoutPeriod: blackoutPeriod,
+	}, nil
+}
+
+// SetAbsenceLimit is the resolver for the setAbsenceLimit field.
+func (r *mutationResolver) SetAbsenceLimit(ctx context.Context, channelID string, shiftGroupID string, input model.AbsenceLimitInput, authUserID *string) (*model.AbsenceLimitResponse, error) {
+	var shiftError []*model.ShiftError
+	fieldError := "Set Absence Limit"
+	errorMessage := "Something went wrong while setting the Absence Limit." // default error message
+
+	authUserID = AuthUserID(ctx, authUserID)
+	if authUserID == nil || *authUserID == string("") {
+		errorMessage = "Authenticated user id is required"
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    model.ShiftErrorCodeUnauthenticated,
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.AbsenceLimitResponse{
+			Errors:       shiftError,
+			AbsenceLimit: nil,
+		}, nil
+	}
+
+	// validate permission
+	permission, err := util.CheckPermission(ctx, "request_time_off", "WRITE_ALL", *authUserID)
+	if err != nil {
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
+
+		errorMessage = err.Error()
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    util.ShiftErrorCodeOf(err),
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.AbsenceLimitResponse{
+			Errors:       shiftError,
+			AbsenceLimit: nil,
+		}, nil
+	}
+
+	if !permission {
+		errorMessage = "Permission denied: request_time_off.WRITE_ALL"
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    model.ShiftErrorCodeForbidden,
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.AbsenceLimitResponse{
+			Errors:       shiftError,
+			AbsenceLimit: nil,
+		}, nil
+	}
+
+	if channelID == "" {
+		errorMessage = "channelId is required"
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    model.ShiftErrorCodeValidation,
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.AbsenceLimitResponse{
+			Errors:       shiftError,
+			AbsenceLimit: nil,
+		}, nil
+	}
+
+	absenceLimit, err := r.Capacity.SetLimit(ctx, channelID, shiftGroupID, input, *authUserID)
+	if err != nil {
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
+
+		errorMessage = err.Error()
+		shiftError = append(shiftError, &model.ShiftError{
+			Code:    util.ShiftErrorCodeOf(err),
+			Field:   &fieldError,
+			Message: &errorMessage,
+		})
+
+		return &model.AbsenceLimitResponse{
+			Errors:       shiftError,
+			AbsenceLimit: nil,
+		}, nil
+	}
+
+	return &model.AbsenceLimitResponse{
+		Errors:       nil,
+		AbsenceLimit: absenceLimit,
+	}, nil
+}
+
+// GetRequestTimeOff is the resolver for the getRequestTimeOff field.
+func (r *queryResolver) GetRequestTimeOff(ctx context.Context, id string, authUserID *string) (*model.RequestTimeOff, error) {
+	authUserID = AuthUserID(ctx, authUserID)
+	if authUserID == nil || *authUserID == string("") {
+		return nil, util.NewError(util.ErrorCodeUnauthenticated, "Authenticated user id is required")
+	}
+
+	var err error
+	permission := false
+
+	// validate permission
+	permission, err = util.CheckAnyPermission(ctx, "request_time_off", []string{"READ_ALL", "READ"}, *authUserID)
+	if err != nil {
+
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
+
+		return nil, err
+	}
+
+	if !permission {
+		return nil, util.NewError(util.ErrorCodeForbidden, "Permission denied: request_time_off.READ, request_time_off.READ_ALL")
+	}
+	if id == "" {
+		return nil, util.NewError(util.ErrorCodeValidation, "id is required")
+	}
+
+	// get request time off by channel id and request id
+	var requestTimeOff model.RequestTimeOff
+	err = r.DB.First(&requestTimeOff, "id= ?", id).Error
+
+	if err != nil {
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
+
+		return nil, err
+	}
+
+	return &requestTimeOff, nil
+}
+
+// GetRequestTimeOffs is the resolver for the getRequestTimeOffs field.
+func (r *queryResolver) GetRequestTimeOffs(ctx context.Context, channelID *string, authUserID *string) ([]*model.RequestTimeOff, error) {
+	authUserID = AuthUserID(ctx, authUserID)
+	if authUserID == nil || *authUserID == string("") {
+		return nil, util.NewError(util.ErrorCodeUnauthenticated, "Authenticated user id is required")
+	}
+
+	var err error
+	permission := false
+
+	// validate permission
+	permission, err = util.CheckPermission(ctx, "request_time_off", "READ_ALL", *authUserID)
+	if err != nil {
+
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
+
+		return nil, err
+	}
+
+	// if !permission {
+	// 	permission, err = util.CheckPermission(ctx, "request_time_off", "READ", *authUserID)
+	// 	if err != nil {
+	// 		return nil, err
+	// 	}
+	// }
+
+	if !permission {
+		return nil, util.NewError(util.ErrorCodeForbidden, "Permission denied: request_time_off.READ_ALL")
+	}
+
+	// get all request time offs, of the channel if there is one
+	query := r.DB
+	if channelID != nil && *channelID != "" {
+		query = query.Where("channel_id = ?", *channelID)
+	}
+
+	var requestTimeOffs []*model.RequestTimeOff
+	err = query.Find(&requestTimeOffs).Error
+
+	if err != nil {
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
+
+		return nil, err
+	}
+
+	return requestTimeOffs, nil
+}
+
+// GetRequestTimeOffsConnection is the resolver for the getRequestTimeOffsConnection field.
+func (r *queryResolver) GetRequestTimeOffsConnection(ctx context.Context, channelID string, filter *model.RequestFilter, orderBy *model.RequestOrder, first *int, after *string, authUserID *string) (*model.RequestTimeOffConnection, error) {
+	authUserID = AuthUserID(ctx, authUserID)
+	if authUserID == nil || *authUserID == string("") {
+		return nil, util.NewError(util.ErrorCodeUnauthenticated, "Authenticated user id is required")
+	}
+
+	// validate permission
+	readAll, err := util.CheckPermission(ctx, "request_time_off", "READ_ALL", *authUserID)
+	if err != nil {
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
+
+		return nil, err
+	}
+
+	permission := readAll
+	if !permission {
+		permission, err = util.CheckPermission(ctx, "request_time_off", "READ", *authUserID)
+		if err != nil {
+			sentry.CaptureException(err)
+			defer sentry.Flush(2 * time.Second)
+
+			return nil, err
+		}
+	}
+
+	if !permission {
+		return nil, util.NewError(util.ErrorCodeForbidden, "Permission denied: request_time_off.READ, request_time_off.READ_ALL")
+	}
+
+	if channelID == "" {
+		return nil, util.NewError(util.ErrorCodeValidation, "channelID is required")
+	}
+
+	// without READ_ALL users only list their own time offs
+	if !readAll {
+		if filter == nil {
+			filter = &model.RequestFilter{}
+		}
+		if filter.UserID != nil && *filter.UserID != *authUserID {
+			return nil, util.NewError(util.ErrorCodeForbidden, "Permission denied: request_time_off.READ_ALL")
+		}
+		filter.UserID = authUserID
+	}
+
+	page, err := newPage(orderBy, first, after)
+	if err != nil {
+		return nil, err
+	}
+
+	// a time off without end time lasts the day it starts
+	var requestTimeOffs []*model.RequestTimeOff
+	query := applyRequestFilter(r.DB.Where("channel_id = ?", channelID), filter, "start_time", timeOffEndColumn)
+	err = page.apply(query).Find(&requestTimeOffs).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
+
+		return nil, err
+	}
+
+	count, pageInfo := page.pageInfo(len(requestTimeOffs), func(i int) string {
+		return page.cursor(page.orderValue(requestTimeOffs[i]), requestTimeOffs[i].ID)
+	})
+
+	edges := make([]*model.RequestTimeOffEdge, 0, count)
+	for _, requestTimeOff := range requestTimeOffs[:count] {
+		edges = append(edges, &model.RequestTimeOffEdge{
+			Cursor: page.cursor(page.orderValue(requestTimeOff), requestTimeOff.ID),
+			Node:   requestTimeOff,
+		})
+	}
+
+	return &model.RequestTimeOffConnection{
+		Edges:    edges,
+		PageInfo: pageInfo,
+	}, nil
+}
+
+// GetRequestTimeOffsByChannelIDRequestID is the resolver for the getRequestTimeOffsByChannelIdRequestId field.
+func (r *queryResolver) GetRequestTimeOffsByChannelIDRequestID(ctx context.Context, channelID string, requestID string) (*model.RequestTimeOff, error) {
+	if channelID == "" || requestID == "" {
+		return nil, util.NewError(util.ErrorCodeValidation, "channelID and requestID are required")
+	}
+
+	var err error
+
+	// get all request time offs
+	var requestTimeOffs *model.RequestTimeOff
+	err = r.DB.First(&requestTimeOffs, "channel_id = ? AND request_id = ?", channelID, requestID).Error
+
+	if err != nil {
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
+
+		return nil, err
+	}
+
+	return requestTimeOffs, nil
+}
+
+// GetRequestExpirySettings is the resolver for the getRequestExpirySettings field.
+func (r *queryResolver) GetRequestExpirySettings(ctx context.Context, channelID string, authUserID *string) (*model.RequestExpirySettings, error) {
+	authUserID = AuthUserID(ctx, authUserID)
+	if authUserID == nil || *authUserID == string("") {
+		return nil, util.NewError(util.ErrorCodeUnauthenticated, "Authenticated user id is required")
+	}
+
+	// validate permission
+	permission, err := util.CheckAnyPermission(ctx, "request_time_off", []string{"READ", "READ_ALL"}, *authUserID)
+	if err != nil {
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
+
+		return nil, err
+	}
+
+	if !permission {
+		return nil, util.NewError(util.ErrorCodeForbidden, "Permission denied: request_time_off.READ, request_time_off.READ_ALL")
+	}
+
+	if channelID == "" {
+		return nil, util.NewError(util.ErrorCodeValidation, "channelID is required")
+	}
+
+	settings, err := r.Expiry.Settings(ctx, channelID)
+	if err != nil {
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
+
+		return nil, err
+	}
+
+	return settings, nil
+}
+
+// TimeOffBalance is the resolver for the timeOffBalance field.
+func (r *queryResolver) TimeOffBalance(ctx context.Context, userID string, channelID *string, leaveType *string, authUserID *string) ([]*model.TimeOffBalance, error) {
+	authUserID = AuthUserID(ctx, authUserID)
+	if authUserID == nil || *authUserID == string("") {
+		return nil, util.NewError(util.ErrorCodeUnauthenticated, "Authenticated user id is required")
+	}
+
+	// validate permission, users may read their own balances
+	permission, err := util.CheckPermission(ctx, "request_time_off", "READ_ALL", *authUserID)
+	if err != nil {
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
+
+		return nil, err
+	}
+
+	if !permission && userID == *authUserID {
+		permission, err = util.CheckPermission(ctx, "request_time_off", "READ", *authUserID)
+		if err != nil {
+			sentry.CaptureException(err)
+			defer sentry.Flush(2 * time.Second)
+
+			return nil, err
+		}
+	}
+
+	if !permission {
+		return nil, util.NewError(util.ErrorCodeForbidden, "Permission denied: request_time_off.READ_ALL")
+	}
+
+	if userID == "" {
+		return nil, util.NewError(util.ErrorCodeValidation, "userID is required")
+	}
+
+	balances, err := r.Ledger.Balances(ctx, userID, channelID, leaveType)
+	if err != nil {
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
+
+		return nil, err
+	}
+
+	return balances, nil
+}
+
+// GetTimeOffAccrualPolicies is the resolver for the getTimeOffAccrualPolicies field.
+func (r *queryResolver) GetTimeOffAccrualPolicies(ctx context.Context, channelID string, authUserID *string) ([]*model.TimeOffAccrualPolicy, error) {
+	authUserID = AuthUserID(ctx, authUserID)
+	if authUserID == nil || *authUserID == string("") {
+		return nil, util.NewError(util.ErrorCodeUnauthenticated, "Authenticated user id is required")
+	}
+
+	// validate permission
+	permission, err := util.CheckAnyPermission(ctx, "request_time_off", []string{"READ", "READ_ALL"}, *authUserID)
+	if err != nil {
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
+
+		return nil, err
+	}
+
+	if !permission {
+		return nil, util.NewError(util.ErrorCodeForbidden, "Permission denied: request_time_off.READ, request_time_off.READ_ALL")
+	}
+
+	if channelID == "" {
+		return nil, util.NewError(util.ErrorCodeValidation, "channelID is required")
+	}
+
+	policies, err := r.Ledger.Policies(ctx, channelID)
+	if err != nil {
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
+
+		return nil, err
+	}
+
+	return policies, nil
+}
+
+// GetShiftConflictSettings is the resolver for the getShiftConflictSettings field.
+func (r *queryResolver) GetShiftConflictSettings(ctx context.Context, channelID string, authUserID *string) (*model.ShiftConflictSettings, error) {
+	authUserID = AuthUserID(ctx, authUserID)
+	if authUserID == nil || *authUserID == string("") {
+		return nil, util.NewError(util.ErrorCodeUnauthenticated, "Authenticated user id is required")
+	}
+
+	// validate permission
+	permission, err := util.CheckAnyPermission(ctx, "request_time_off", []string{"READ", "READ_ALL"}, *authUserID)
+	if err != nil {
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
+
+		return nil, err
+	}
+
+	if !permission {
+		return nil, util.NewError(util.ErrorCodeForbidden, "Permission denied: request_time_off.READ, request_time_off.READ_ALL")
+	}
+
+	if channelID == "" {
+		return nil, util.NewError(util.ErrorCodeValidation, "channelID is required")
+	}
+
+	settings, err := r.Roster.Settings(ctx, channelID)
+	if err != nil {
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
+
+		return nil, err
+	}
+
+	return settings, nil
+}
+
+// GetLeaveTypes is the resolver for the getLeaveTypes field.
+func (r *queryResolver) GetLeaveTypes(ctx context.Context, channelID string, includeArchived *bool, authUserID *string) ([]*model.LeaveType, error) {
+	authUserID = AuthUserID(ctx, authUserID)
+	if authUserID == nil || *authUserID == string("") {
+		return nil, util.NewError(util.ErrorCodeUnauthenticated, "Authenticated user id is required")
+	}
+
+	// validate permission
+	permission, err := util.CheckAnyPermission(ctx, "request_time_off", []string{"READ", "READ_ALL"}, *authUserID)
+	if err != nil {
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
+
+		return nil, err
+	}
+
+	if !permission {
+		return nil, util.NewError(util.ErrorCodeForbidden, "Permission denied: request_time_off.READ, request_time_off.READ_ALL")
+	}
+
+	if channelID == "" {
+		return nil, util.NewError(util.ErrorCodeValidation, "channelID is required")
+	}
+
+	leaveTypes, err := r.Catalog.LeaveTypes(ctx, channelID, includeArchived != nil && *includeArchived)
+	if err != nil {
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
+
+		return nil, err
+	}
+
+	return leaveTypes, nil
+}
+
+// GetBlackoutPeriods is the resolver for the getBlackoutPeriods field.
+func (r *queryResolver) GetBlackoutPeriods(ctx context.Context, channelID string, shiftGroupID *string, from *time.Time, to *time.Time, authUserID *string) ([]*model.BlackoutPeriod, error) {
+	authUserID = AuthUserID(ctx, authUserID)
+	if authUserID == nil || *authUserID == string("") {
+		return nil, util.NewError(util.ErrorCodeUnauthenticated, "Authenticated user id is required")
+	}
+
+	// validate permission
+	permission, err := util.CheckAnyPermission(ctx, "request_time_off", []string{"READ", "READ_ALL"}, *authUserID)
+	if err != nil {
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
+
+		return nil, err
+	}
+
+	if !permission {
+		return nil, util.NewError(util.ErrorCodeForbidden, "Permission denied: request_time_off.READ, request_time_off.READ_ALL")
+	}
+
+	if channelID == "" {
+		return nil, util.NewError(util.ErrorCodeValidation, "channelID is required")
+	}
+
+	blackoutPeriods, err := r.Blackouts.Periods(ctx, channelID, shiftGroupID, from, to)
+	if err != nil {
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
+
+		return nil, err
+	}
+
+	return blackoutPeriods, nil
+}
+
+// GetAbsenceLimits is the resolver for the getAbsenceLimits field.
+func (r *queryResolver) GetAbsenceLimits(ctx context.Context, channelID string, authUserID *string) ([]*model.AbsenceLimit, error) {
+	authUserID = AuthUserID(ctx, authUserID)
+	if authUserID == nil || *authUserID == string("") {
+		return nil, util.NewError(util.ErrorCodeUnauthenticated, "Authenticated user id is required")
+	}
+
+	// validate permission
+	permission, err := util.CheckAnyPermission(ctx, "request_time_off", []string{"READ", "READ_ALL"}, *authUserID)
+	if err != nil {
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
+
+		return nil, err
+	}
+
+	if !permission {
+		return nil, util.NewError(util.ErrorCodeForbidden, "Permission denied: request_time_off.READ, request_time_off.READ_ALL")
+	}
+
+	if channelID == "" {
+		return nil, util.NewError(util.ErrorCodeValidation, "channelID is required")
+	}
+
+	absenceLimits, err := r.Capacity.Limits(ctx, channelID)
+	if err != nil {
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
+
+		return nil, err
+	}
+
+	return absenceLimits, nil
+}
+
+// GetApprovedTimeOffs is the resolver for the getApprovedTimeOffs field.
+func (r *queryResolver) GetApprovedTimeOffs(ctx context.Context, channelID string, userIds []string, startTime time.Time, endTime time.Time, authUserID *string) ([]*model.RequestTimeOff, error) {
+	authUserID = AuthUserID(ctx, authUserID)
+	if authUserID == nil || *authUserID == string("") {
+		return nil, util.NewError(util.ErrorCodeUnauthenticated, "Authenticated user id is required")
+	}
+
+	// employees look for swap candidates with it, so READ is enough
+	permission, err := util.CheckAnyPermission(ctx, "request_time_off", []string{"READ", "READ_ALL"}, *authUserID)
+	if err != nil {
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
+
+		return nil, err
+	}
+
+	if !permission {
+		return nil, util.NewError(util.ErrorCodeForbidden, "Permission denied: request_time_off.READ, request_time_off.READ_ALL")
+	}
+
+	if channelID == "" || !startTime.Before(endTime) {
+		return nil, util.NewError(util.ErrorCodeValidation, "channelId and a startTime before endTime are required")
+	}
+
+	requestTimeOffs := []*model.RequestTimeOff{}
+	if len(userIds) == 0 {
+		return requestTimeOffs, nil
+	}
+
+	// a time off without end time lasts the day it starts
+	err = r.DB.
+		Where("channel_id = ? AND user_id IN ? AND status = ?", channelID, userIds, model.RequestStatusApproved).
+		Where("start_time < ? AND "+timeOffEndColumn+" > ?", endTime, startTime).
+		Order("start_time").
+		Find(&requestTimeOffs).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
+
+		return nil, err
+	}
+
+	return requestTimeOffs, nil
+}
+
+// User is the resolver for the user field.
+func (r *requestCommentResolver) User(ctx context.Context, obj *model.RequestComment) (*model.User, error) {
+	return loadUser(ctx, &obj.UserID)
+}
+
+// Actor is the resolver for the actor field.
+func (r *requestHistoryEntryResolver) Actor(ctx context.Context, obj *model.RequestHistoryEntry) (*model.User, error) {
+	return loadUser(ctx, obj.ActorUserID)
+}
+
+// ResponseBy is the resolver for the responseBy field.
+func (r *requestResponseResolver) ResponseBy(ctx context.Context, obj *model.RequestResponse) (*model.User, error) {
+	return loadUser(ctx, obj.ResponseByID)
+}
+
+// History is the resolver for the history field.
+func (r *requestResponseResolver) History(ctx context.Context, obj *model.RequestResponse) ([]*model.RequestHistoryEntry, error) {
+	return loadHistory(ctx, obj.ID)
+}
+
+// Comments is the resolver for the comments field.
+func (r *requestResponseResolver) Comments(ctx context.Context, obj *model.RequestResponse) ([]*model.RequestComment, error) {
+	return loadComments(ctx, obj.ID)
+}
+
+// LeaveTypeDetails is the resolver for the leaveTypeDetails field.
+func (r *requestTimeOffResolver) LeaveTypeDetails(ctx context.Context, obj *model.RequestTimeOff) (*model.LeaveType, error) {
+	return loadLeaveType(ctx, obj)
+}
+
+// History is the resolver for the history field.
+func (r *requestTimeOffResolver) History(ctx context.Context, obj *model.RequestTimeOff) ([]*model.RequestHistoryEntry, error) {
+	return loadHistory(ctx, obj.ID)
+}
+
+// Comments is the resolver for the comments field.
+func (r *requestTimeOffResolver) Comments(ctx context.Context, obj *model.RequestTimeOff) ([]*model.RequestComment, error) {
+	return loadComments(ctx, obj.ID)
+}
+
+// History is the resolver for the history field.
+func (r *timeOffBalanceResolver) History(ctx context.Context, obj *model.TimeOffBalance) ([]*model.TimeOffLedgerEntry, error) {
+	return loadLedgerEntries(ctx, obj.ID)
+}
+
+// Mutation returns generated.MutationResolver implementation.
+func (r *Resolver) Mutation() generated.MutationResolver { return &mutationResolver{r} }
+
+// Query returns generated.QueryResolver implementation.
+func (r *Resolver) Query() generated.QueryResolver { return &queryResolver{r} }
+
+// RequestComment returns generated.RequestCommentResolver implementation.
+func (r *Resolver) RequestComment() generated.RequestCommentResolver {
+	return &requestCommentResolver{r}
+}
+
+// RequestHistoryEntry returns generated.RequestHistoryEntryResolver implementation.
+func (r *Resolver) RequestHistoryEntry() generated.RequestHistoryEntryResolver {
+	return &requestHistoryEntryResolver{r}
+}
+
+// RequestResponse returns generated.RequestResponseResolver implementation.
+func (r *Resolver) RequestResponse() generated.RequestResponseResolver {
+	return &requestResponseResolver{r}
+}
+
+// RequestTimeOff returns generated.RequestTimeOffResolver implementation.
+func (r *Resolver) RequestTimeOff() generated.RequestTimeOffResolver {
+	return &requestTimeOffResolver{r}
+}
+
+// TimeOffBalance returns generated.TimeOffBalanceResolver implementation.
+func (r *Resolver) TimeOffBalance() generated.TimeOffBalanceResolver {
+	return &timeOffBalanceResolver{r}
+}
 
 type mutationResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
+type requestCommentResolver struct{ *Resolver }
+type requestHistoryEntryResolver struct{ *Resolver }
+type requestResponseResolver struct{ *Resolver }
+type requestTimeOffResolver struct{ *Resolver }
+type timeOffBalanceResolver struct{ *Resolver }
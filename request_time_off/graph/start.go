@@ -2,13 +2,17 @@ package graph
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
 	"os"
+	"os/signal"
+	"request_time_offs/events"
 	"request_time_offs/graph/generated"
 	"strconv"
 	"sync"
+	"syscall"
 	"time"
 
 	"github.com/99designs/gqlgen/graphql/handler"
@@ -16,39 +20,30 @@ import (
 	"github.com/getsentry/sentry-go"
 )
 
-const defaultPort = "8080"
-
-func run(ctx context.Context) error {
-	ctx, cancelContextFunc := context.WithCancel(ctx)
-	defer cancelContextFunc()
+const (
+	defaultPort            = "8080"
+	defaultShutdownTimeout = 30 * time.Second
+	defaultShutdownDelay   = 5 * time.Second
+)
 
-	// vault
-	vault, authToken, err := NewVaultAppRoleClient(
-		ctx,
-		VaultParameters{
-			address:                 os.Getenv("VAULT_ADDRESS"),
-			approleRoleID:           os.Getenv("VAULT_APPROLE_ROLE_ID"),
-			approleSecretID:         os.Getenv("VAULT_APPROLE_SECRET_ID"),
-			apiKeyPath:              os.Getenv("VAULT_API_KEY_PATH"),
-			apiKeyMountPath:         os.Getenv("VAULT_API_KEY_MOUNT_PATH"),
-			apiKeyField:             os.Getenv("VAULT_API_KEY_FIELD"),
-			databaseCredentialsPath: os.Getenv("VAULT_DATABASE_CREDS_PATH"),
-		},
-	)
+// connect retrieves the database credentials from the configured provider
+// and opens the first database connection
+func connect(ctx context.Context) (CredentialsProvider, *Database, error) {
+	// database credentials
+	credentialsProvider, err := NewCredentialsProvider(ctx)
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
-		return fmt.Errorf("unable to initialize vault connection : %w", err)
+		return nil, nil, fmt.Errorf("unable to initialize credentials provider : %w", err)
 	}
 
-	// database
-	databaseCredentials, databaseCredentialsLease, err := vault.GetDatabaseCredentials(ctx)
+	databaseCredentials, err := credentialsProvider.DatabaseCredentials(ctx)
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
-		return fmt.Errorf("unable to retrieve database credentials from vault: %w", err)
+		return nil, nil, fmt.Errorf("unable to retrieve database credentials: %w", err)
 	}
 
 	timeOut, err := strconv.Atoi(os.Getenv("DATABASE_TIMEOUT"))
@@ -56,7 +51,7 @@ func run(ctx context.Context) error {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
-		return fmt.Errorf("unable to convert database timeout to int: %w", err)
+		return nil, nil, fmt.Errorf("unable to convert database timeout to int: %w", err)
 	}
 	database, err := NewDatabase(
 		ctx,
@@ -65,6 +60,7 @@ func run(ctx context.Context) error {
 			port:     os.Getenv("DATABASE_PORT"),
 			name:     os.Getenv("DATABASE_NAME"),
 			timeout:  time.Duration(timeOut) * time.Second,
+			dsn:      os.Getenv("DATABASE_DSN"),
 		},
 		databaseCredentials,
 	)
@@ -72,18 +68,89 @@ func run(ctx context.Context) error {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
-		return fmt.Errorf("unable to connect to database : %w", err)
+		return nil, nil, fmt.Errorf("unable to connect to database : %w", err)
+	}
+
+	return credentialsProvider, database, nil
+}
+
+func run(ctx context.Context) error {
+	ctx, cancelContextFunc := context.WithCancel(ctx)
+	defer cancelContextFunc()
+
+	credentialsProvider, database, err := connect(ctx)
+	if err != nil {
+		return err
 	}
 
 	defer func() {
 		_ = database.Close()
 	}()
 
-	// start the lease-renewal goroutine & wait for it to finish on exit
+	if os.Getenv("DATABASE_MIGRATE_ON_START") != "false" {
+		migrator, err := newMigrator()
+		if err != nil {
+			return err
+		}
+
+		if err := migrator.Up(ctx); err != nil {
+			sentry.CaptureException(err)
+			defer sentry.Flush(2 * time.Second)
+
+			return fmt.Errorf("unable to migrate database: %w", err)
+		}
+	}
+
+	sagas := NewSagas(GetOpenConnection(), "request_time_offs")
+	reconcileInterval, err := sagas.ConfigureFromEnv()
+	if err != nil {
+		return err
+	}
+
+	// without a Dapr sidecar (EVENTS_BROKER=memory) the events stay in the process
+	var publisher events.Publisher = events.NewDaprPublisher()
+	if os.Getenv("EVENTS_BROKER") == "memory" {
+		publisher = events.NewMemoryBroker()
+	}
+
+	expiry := NewExpirer(GetOpenConnection(), publisher)
+	expiryInterval, err := expiry.ConfigureFromEnv()
+	if err != nil {
+		return err
+	}
+
+	ledger := NewLedger(GetOpenConnection())
+	accrualInterval, err := ledger.ConfigureFromEnv()
+	if err != nil {
+		return err
+	}
+
+	roster := NewRoster(GetOpenConnection())
+	if err := roster.ConfigureFromEnv(); err != nil {
+		return err
+	}
+
+	catalog := NewCatalog(GetOpenConnection())
+	blackouts := NewBlackouts(GetOpenConnection())
+	capacity := NewCapacity(GetOpenConnection())
+
+	// start the credentials-renewal, saga-reconciler, expiry and accrual goroutines & wait for them to finish on exit
 	var wg sync.WaitGroup
-	wg.Add(1)
+	wg.Add(4)
+	go func() {
+		credentialsProvider.Renew(ctx, database.Reconnect)
+		wg.Done()
+	}()
 	go func() {
-		vault.PeriodicallyRenewLeases(ctx, authToken, databaseCredentialsLease, database.Reconnect)
+		sagas.RunReconciler(ctx, reconcileInterval)
+		wg.Done()
+	}()
+	go func() {
+		expiry.RunExpirer(ctx, expiryInterval)
+		wg.Done()
+	}()
+	go func() {
+		ledger.RunAccruer(ctx, accrualInterval)
 		wg.Done()
 	}()
 	defer func() {
@@ -96,24 +163,90 @@ func run(ctx context.Context) error {
 		port = defaultPort
 	}
 
+	shutdownTimeout := defaultShutdownTimeout
+	if value := os.Getenv("SHUTDOWN_TIMEOUT"); value != "" {
+		seconds, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("unable to convert shutdown timeout to int: %w", err)
+		}
+		shutdownTimeout = time.Duration(seconds) * time.Second
+	}
+
+	shutdownDelay := defaultShutdownDelay
+	if value := os.Getenv("SHUTDOWN_DELAY"); value != "" {
+		seconds, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("unable to convert shutdown delay to int: %w", err)
+		}
+		shutdownDelay = time.Duration(seconds) * time.Second
+	}
+
+	health := NewHealthChecker(database, credentialsProvider, []Dependency{
+		{Name: "permission", URL: os.Getenv("PERMISSION_API"), DaprAppID: os.Getenv("DAPR_PERMISSION_APP_ID")},
+		{Name: "request", URL: os.Getenv("REQUEST_API"), DaprAppID: os.Getenv("DAPR_REQUEST_APP_ID")},
+		{Name: "user_account", URL: os.Getenv("USER_ACCOUNT_API"), DaprAppID: os.Getenv("DAPR_USER_APP_ID")},
+		{Name: "assigned_shift", URL: os.Getenv("ASSIGNED_SHIFT_API"), DaprAppID: os.Getenv("DAPR_ASSIGNED_SHIFT_APP_ID")},
+		{Name: "shift_group", URL: os.Getenv("SHIFT_GROUP_API"), DaprAppID: os.Getenv("DAPR_SHIFT_GROUP_APP_ID")},
+		{Name: "shift_group_member", URL: os.Getenv("SHIFT_GROUP_MEMBER_API"), DaprAppID: os.Getenv("DAPR_SHIFT_GROUP_MEMBER_APP_ID")},
+	})
+
+	authenticator := NewAuthenticator()
+
 	mux := http.NewServeMux()
 
-	srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: &Resolver{DB: GetOpenConnection()}}))
+	srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: &Resolver{DB: GetOpenConnection(), Sagas: sagas, Expiry: expiry, Ledger: ledger, Roster: roster, Catalog: catalog, Blackouts: blackouts, Capacity: capacity, Events: publisher}}))
+	srv.SetErrorPresenter(ErrorPresenter)
 
 	mux.Handle("/", playground.Handler("GraphQL playground", "/query"))
-	mux.Handle("/query", srv)
+	mux.Handle("/query", authenticator.Middleware(LoaderMiddleware(srv)))
+	mux.HandleFunc("/healthz", health.Healthz)
+	mux.HandleFunc("/readyz", health.Readyz)
+	mux.HandleFunc("/dapr/subscribe", DaprSubscribe)
+	mux.HandleFunc(permissionChangedRoute, PermissionChanged)
+
+	server := &http.Server{
+		Addr:    ":" + port,
+		Handler: mux,
+	}
+
+	// on SIGTERM / SIGINT report not ready, keep serving for shutdownDelay so
+	// the load balancer stops routing here, then stop accepting connections,
+	// drain the in-flight requests and only then let the deferred
+	// database.Close run
+	shutdownComplete := make(chan struct{})
+	go func() {
+		defer close(shutdownComplete)
+
+		<-ctx.Done()
+		health.ShuttingDown()
+		log.Printf("shutting down; serving for %s before draining requests for up to %s", shutdownDelay, shutdownTimeout)
+		time.Sleep(shutdownDelay)
 
-	CreateTables()
+		shutdownContext, cancelShutdownContextFunc := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancelShutdownContextFunc()
+
+		if err := server.Shutdown(shutdownContext); err != nil {
+			sentry.CaptureException(err)
+			log.Printf("graceful shutdown error: %v", err)
+		}
+	}()
 
 	log.Printf("connect to http://localhost:%s/ for GraphQL playground", port)
-	// endless.ListenAndServe(":"+port, mux) <--- this should be used in production
-	log.Fatal(http.ListenAndServe(":"+port, mux))
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("unable to start http server: %w", err)
+	}
+
+	<-shutdownComplete
 
 	return nil
 }
 
+// Start runs the service until it receives SIGINT or SIGTERM
 func Start() {
-	if err := run(context.Background()); err != nil {
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	if err := run(ctx); err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
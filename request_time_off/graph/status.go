@@ -0,0 +1,115 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"request_time_offs/graph/model"
+	"request_time_offs/util"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// setRequestTimeOffStatus moves the requestTimeOff, locked by the caller, to status when
+// the state machine allows it, stamps the acting user and the time and records
+// the change in the history. Approving debits the balance of its leave type and
+// cancelling an approved time off credits it back.
+func setRequestTimeOffStatus(tx *gorm.DB, requestTimeOff *model.RequestTimeOff, status model.RequestStatus, responseByUserID string, responseNote *string) error {
+	if err := checkRequestTimeOffTransition(requestTimeOff, status); err != nil {
+		return err
+	}
+
+	responseAt := time.Now().UTC()
+	updates := map[string]interface{}{
+		"status":              status,
+		"response_by_user_id": responseByUserID,
+		"response_at":         responseAt,
+	}
+	if responseNote != nil {
+		updates["response_note"] = *responseNote
+	}
+
+	if err := tx.Model(requestTimeOff).Updates(updates).Error; err != nil {
+		return err
+	}
+
+	entries := []*model.RequestHistoryEntry{newStatusEntry(requestTimeOff.ID, requestTimeOff.Status, status, &responseByUserID)}
+	if responseNote != nil && (requestTimeOff.ResponseNote == nil || *requestTimeOff.ResponseNote != *responseNote) {
+		entries = append(entries, newHistoryEntry(requestTimeOff.ID, model.RequestHistoryActionUpdated, "responseNote", requestTimeOff.ResponseNote, responseNote, &responseByUserID))
+	}
+	if err := recordHistory(tx, entries...); err != nil {
+		return err
+	}
+
+	switch {
+	case status == model.RequestStatusApproved:
+		if err := debitTimeOff(tx, requestTimeOff, responseByUserID); err != nil {
+			return err
+		}
+	case requestTimeOff.Status == model.RequestStatusApproved:
+		if err := creditTimeOff(tx, requestTimeOff, responseByUserID); err != nil {
+			return err
+		}
+	}
+
+	requestTimeOff.Status = status
+	requestTimeOff.ResponseByUserID = &responseByUserID
+	requestTimeOff.ResponseAt = &responseAt
+	if responseNote != nil {
+		requestTimeOff.ResponseNote = responseNote
+	}
+
+	return nil
+}
+
+// checkRequestTimeOffTransition fails with a conflict when the state machine
+// does not allow moving the requestTimeOff to status
+func checkRequestTimeOffTransition(requestTimeOff *model.RequestTimeOff, status model.RequestStatus) error {
+	if !requestTimeOff.Status.CanTransitionTo(status) {
+		return util.NewError(util.ErrorCodeConflict, fmt.Sprintf("Request time off is %s and cannot become %s", requestTimeOff.Status, status))
+	}
+
+	return nil
+}
+
+// changeRequestTimeOffStatus locks the requestTimeOff matching the query while it is
+// moved to status, so concurrent changes cannot skip the state machine
+func (r *Resolver) changeRequestTimeOffStatus(ctx context.Context, status model.RequestStatus, responseByUserID string, responseNote *string, query string, args ...interface{}) (*model.RequestTimeOff, error) {
+	var requestTimeOff model.RequestTimeOff
+
+	err := r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where(query, args...).First(&requestTimeOff).Error
+		if err != nil {
+			return err
+		}
+
+		return setRequestTimeOffStatus(tx, &requestTimeOff, status, responseByUserID, responseNote)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &requestTimeOff, nil
+}
+
+// newRequestTimeOff is a PENDING time off made from the input; it has no
+// responseByUserId and responseAt until its status changes
+func newRequestTimeOff(input model.RequestTimeOffInput, createdAt time.Time) *model.RequestTimeOff {
+	return &model.RequestTimeOff{
+		ID:            uuid.New().String(),
+		ChannelID:     &input.ChannelID,
+		UserID:        input.UserID,
+		Reason:        input.Reason,
+		LeaveType:     inputLeaveType(input.LeaveType),
+		AttachmentURL: input.AttachmentURL,
+		StartTime:     input.StartTime,
+		EndTime:       input.EndTime,
+		Is24Hours:     &input.Is24Hours,
+		RequestNote:   input.RequestNote,
+		Status:        model.RequestStatusPending,
+		ResponseNote:  input.ResponseNote,
+		CreatedAt:     createdAt,
+	}
+}
@@ -0,0 +1,77 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"os"
+	"request_time_offs/database/migrations"
+	"request_time_offs/graph/model"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// errRollback ends a test transaction without keeping its rows
+var errRollback = errors.New("rollback")
+
+// TestCreatePendingTimeOff stores a new time off in a database migrated to the
+// latest version. It needs a Postgres database in TEST_DATABASE_DSN and is
+// skipped without one.
+func TestCreatePendingTimeOff(t *testing.T) {
+	dsn := os.Getenv("TEST_DATABASE_DSN")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_DSN is not set")
+	}
+
+	ctx := context.Background()
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("unable to connect: %v", err)
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("unable to get the connection: %v", err)
+	}
+	defer sqlDB.Close()
+
+	migrator, err := migrations.NewMigrator(sqlDB)
+	if err != nil {
+		t.Fatalf("NewMigrator: %v", err)
+	}
+	if err := migrator.Up(ctx); err != nil {
+		t.Fatalf("unable to migrate: %v", err)
+	}
+
+	endTime := time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC)
+	requestTimeOff := newRequestTimeOff(model.RequestTimeOffInput{
+		ChannelID: "channel",
+		UserID:    "user",
+		StartTime: time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC),
+		EndTime:   &endTime,
+	}, time.Now().UTC())
+	requestID := uuid.New().String()
+	requestTimeOff.RequestID = &requestID
+
+	err = db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(requestTimeOff).Error; err != nil {
+			return err
+		}
+
+		var stored model.RequestTimeOff
+		if err := tx.Where("id = ?", requestTimeOff.ID).First(&stored).Error; err != nil {
+			return err
+		}
+		if stored.Status != model.RequestStatusPending || stored.ResponseByUserID != nil || stored.ResponseAt != nil {
+			t.Errorf("stored %s by %v at %v, want PENDING without a response", stored.Status, stored.ResponseByUserID, stored.ResponseAt)
+		}
+
+		return errRollback
+	})
+	if !errors.Is(err, errRollback) {
+		t.Fatalf("unable to create the time off: %v", err)
+	}
+}
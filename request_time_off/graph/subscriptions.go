@@ -0,0 +1,57 @@
+package graph
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"request_time_offs/util"
+)
+
+// topics published by other services through Dapr pub/sub
+const (
+	permissionChangedTopic = "permission.changed"
+	permissionChangedRoute = "/events/permission-changed"
+)
+
+type daprSubscription struct {
+	PubsubName string `json:"pubsubname"`
+	Topic      string `json:"topic"`
+	Route      string `json:"route"`
+}
+
+func pubsubName() string {
+	if name := os.Getenv("PUBSUB_NAME"); name != "" {
+		return name
+	}
+
+	return "pubsub"
+}
+
+// DaprSubscribe answers the sidecar's /dapr/subscribe discovery call
+func DaprSubscribe(writer http.ResponseWriter, request *http.Request) {
+	writer.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(writer).Encode([]daprSubscription{
+		{PubsubName: pubsubName(), Topic: permissionChangedTopic, Route: permissionChangedRoute},
+	})
+}
+
+// PermissionChanged drops the cached permission checks of the user whose
+// permissions were granted or revoked
+func PermissionChanged(writer http.ResponseWriter, request *http.Request) {
+	var event struct {
+		Data struct {
+			UserID string `json:"userId"`
+		} `json:"data"`
+	}
+
+	status := "SUCCESS"
+	if err := json.NewDecoder(request.Body).Decode(&event); err != nil {
+		// a malformed event will never succeed, do not let dapr redeliver it
+		status = "DROP"
+	} else {
+		util.InvalidatePermissions(event.Data.UserID)
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(writer).Encode(map[string]string{"status": status})
+}
@@ -1,207 +1,346 @@
 package util
 
 import (
-	"bytes"
-	"encoding/json"
+	"context"
+	"errors"
 	"fmt"
-	"io/ioutil"
-	"net/http"
 	"os"
 	"request_time_offs/graph/model"
+	"strings"
 	"time"
 
 	"github.com/getsentry/sentry-go"
 )
 
-func httpRequest(method string, url string, daprAppId string, query map[string]string) ([]byte, error) {
-	jsonResult, err := json.Marshal(query)
-	newRequest, err := http.NewRequest(method, url, bytes.NewBuffer(jsonResult))
-	if err != nil {
-		sentry.CaptureException(err)
-		defer sentry.Flush(2 * time.Second)
+func requestClient() *GraphQLClient {
+	return NewGraphQLClient(os.Getenv("REQUEST_API"), os.Getenv("DAPR_REQUEST_APP_ID"))
+}
 
-		fmt.Printf("There was an error creating the new request %v", err)
-		return nil, err
-	}
+func permissionClient() *GraphQLClient {
+	return NewGraphQLClient(os.Getenv("PERMISSION_API"), os.Getenv("DAPR_PERMISSION_APP_ID"))
+}
+
+func userAccountClient() *GraphQLClient {
+	return NewGraphQLClient(os.Getenv("USER_ACCOUNT_API"), os.Getenv("DAPR_USER_APP_ID"))
+}
 
-	newRequest.Header.Set("Content-Type", "application/json")
-	// DapR header
-	newRequest.Header.Add("dapr-app-id", daprAppId)
+func assignedShiftClient() *GraphQLClient {
+	return NewGraphQLClient(os.Getenv("ASSIGNED_SHIFT_API"), os.Getenv("DAPR_ASSIGNED_SHIFT_APP_ID"))
+}
 
-	client := &http.Client{Timeout: time.Second * 5}
-	response, err := client.Do(newRequest)
+func shiftGroupClient() *GraphQLClient {
+	return NewGraphQLClient(os.Getenv("SHIFT_GROUP_API"), os.Getenv("DAPR_SHIFT_GROUP_APP_ID"))
+}
 
-	if err != nil {
-		sentry.CaptureException(err)
-		defer sentry.Flush(2 * time.Second)
+func shiftGroupMemberClient() *GraphQLClient {
+	return NewGraphQLClient(os.Getenv("SHIFT_GROUP_MEMBER_API"), os.Getenv("DAPR_SHIFT_GROUP_MEMBER_APP_ID"))
+}
 
-		fmt.Printf("There was an error executing the request%v", err)
+const createRequestMutation = `
+	mutation CreateRequest($channelId: ID!, $userId: ID!) {
+		createRequest(input: {
+			channelId: $channelId
+			userId: $userId
+			recipientId: "-1"
+			type: "requestOffer"
+		}) {
+			id
+		}
 	}
+`
 
-	// check if the response is nil
-	if response == nil {
+func CreateRequest(ctx context.Context, channelId *string, userId *string) (string, error) {
+	var responseObject model.CreateRequestResponse
+	err := requestClient().Do(ctx, createRequestMutation, map[string]interface{}{
+		"channelId": channelId,
+		"userId":    userId,
+	}, &responseObject.Data)
+	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
-		fmt.Println("check the " + url + " url ")
-		return nil, fmt.Errorf("Something went wrong with the request: " + url)
+		return "", fmt.Errorf("unable to create request: %w", err)
 	}
 
-	defer response.Body.Close()
-	responseData, err := ioutil.ReadAll(response.Body)
+	return responseObject.Data.CreateRequest.ID, nil
+}
 
+const getRequestsQuery = `
+	query GetRequests($channelId: ID!, $userId: ID!) {
+		requests(input: {
+			channelId: $channelId
+			userId: $userId
+			type: "requestOffer"
+		}) {
+			id
+			createdAt
+		}
+	}
+`
+
+// GetRequests returns the requests of the user in the channel of the type
+// created by CreateRequest
+func GetRequests(ctx context.Context, channelId string, userId string) ([]*model.ParentRequest, error) {
+	var responseObject model.GetRequestsResponse
+	err := requestClient().Do(ctx, getRequestsQuery, map[string]interface{}{
+		"channelId": channelId,
+		"userId":    userId,
+	}, &responseObject.Data)
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
-		fmt.Printf("Data Read Error%v", err)
+		return nil, fmt.Errorf("unable to get requests: %w", err)
 	}
 
-	return responseData, nil
+	return responseObject.Data.Requests, nil
 }
 
-func CreateRequest(channelId *string, userId *string) (string, error) {
-	jsonMapInstance := map[string]string{
-		"query": `
-		mutation {
-			createRequest(input: {
-			  channelId: "` + *channelId + `"
-			  userId: "` + *userId + `"
-			  recipientId: "-1"
-			  type: "requestOffer"
-			}) {
-			  id
-			}
-		  }
-		`,
+const deleteRequestMutation = `
+	mutation DeleteRequest($id: ID!) {
+		deleteRequest(id: $id)
 	}
+`
 
-	responseData, err := httpRequest("POST", os.Getenv("REQUEST_API"), os.Getenv("DAPR_REQUEST_APP_ID"), jsonMapInstance)
+func DeleteRequest(ctx context.Context, id *string) (bool, error) {
+	err := requestClient().Do(ctx, deleteRequestMutation, map[string]interface{}{
+		"id": id,
+	}, nil)
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
-		fmt.Printf("There was an error executing the request%v", err)
-		return "", err
+		return false, fmt.Errorf("unable to delete request: %w", err)
 	}
 
-	// convert responseData to struct
-	var responseObject model.CreateRequestResponse
-	err = json.Unmarshal(responseData, &responseObject)
+	return true, nil
+}
+
+const getUserQuery = `
+	query GetUser($id: ID) {
+		user(id: $id) {
+			id
+			email
+			firstName
+			lastName
+			isStaff
+			avatar
+		}
+	}
+`
+
+func GetUser(ctx context.Context, id string) (*model.User, error) {
+	var userData model.UserResponse
+	err := userAccountClient().Do(ctx, getUserQuery, map[string]interface{}{
+		"id": id,
+	}, &userData.Data)
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
-		fmt.Printf("JSON Unmarshal error: %v", err)
+		return nil, fmt.Errorf("unable to get user: %w", err)
 	}
 
-	return responseObject.Data.CreateRequest.ID, err
+	return &model.User{
+		ID:        &userData.Data.User.ID,
+		Email:     &userData.Data.User.Email,
+		FirstName: &userData.Data.User.FirstName,
+		LastName:  &userData.Data.User.LastName,
+		IsStaff:   &userData.Data.User.IsStaff,
+		// Avatar:       userData.Data.User.Avatar,
+	}, nil
 }
 
-func DeleteRequest(id *string) (bool, error) {
-	jsonMapInstance := map[string]string{
-		"query": `
-		mutation {
-			deleteRequest(id: "` + *id + `") 
-		  }
-		`,
+// batchQuery builds one operation with an aliased field per id, named
+// <alias><index>, the ids being passed as the variables $id<index>
+func batchQuery(operation string, alias string, field string, selection string, ids []string) (string, map[string]interface{}) {
+	var arguments, fields strings.Builder
+	variables := make(map[string]interface{}, len(ids))
+
+	for i, id := range ids {
+		if i > 0 {
+			arguments.WriteString(", ")
+		}
+		fmt.Fprintf(&arguments, "$id%d: ID!", i)
+		fmt.Fprintf(&fields, "\t\t%s%d: %s(id: $id%d) {%s}\n", alias, i, field, i, selection)
+		variables[fmt.Sprintf("id%d", i)] = id
 	}
 
-	responseData, err := httpRequest("POST", os.Getenv("REQUEST_API"), os.Getenv("DAPR_REQUEST_APP_ID"), jsonMapInstance)
+	return fmt.Sprintf("\n\tquery %s(%s) {\n%s\t}\n", operation, arguments.String(), fields.String()), variables
+}
+
+const userFields = `
+	id
+	email
+	firstName
+	lastName
+	isStaff
+	isActive
+	avatar
+`
+
+// GetUsers gets the users in one call; users that do not exist or could
+// not be read are missing from the result
+func GetUsers(ctx context.Context, ids []string) (map[string]*model.User, error) {
+	query, variables := batchQuery("GetUsers", "user", "user", userFields, ids)
+
+	var data map[string]*model.User
+	err := userAccountClient().Do(ctx, query, variables, &data)
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
-		fmt.Printf("There was an error executing the request%v \n", err)
-		return false, err
+		// errors of single users leave the others usable
+		var graphQLErrors GraphQLErrors
+		if !errors.As(err, &graphQLErrors) {
+			return nil, fmt.Errorf("unable to get users: %w", err)
+		}
 	}
 
-	if string(responseData) != "" {
-		return true, err
-	} else {
-		return false, err
+	users := make(map[string]*model.User, len(ids))
+	for i, id := range ids {
+		if user := data[fmt.Sprintf("user%d", i)]; user != nil && user.ID != nil {
+			users[id] = user
+		}
 	}
 
+	return users, nil
 }
 
-func CheckPermission(object string, permission string, userId string) (bool, error) {
-	jsonMapInstance := map[string]string{
-		"query": `
-			query {
-				CheckPermission(
-					NameSpace: ` + os.Getenv("NAMESPACE") + `
-					object: "` + object + `"
-					permission: ` + permission + `
-					userId: "` + userId + `"
-				)
-			}
-		`,
+const shiftGroupsByChannelQuery = `
+	query ShiftGroupsByChannel($channelId: ID!, $authUserId: ID!) {
+		shiftGroupsByChannel(channelId: $channelId, authUserId: $authUserId) {
+			id
+		}
 	}
-
-	responseData, err := httpRequest("POST", os.Getenv("PERMISSION_API"), os.Getenv("DAPR_PERMISSION_APP_ID"), jsonMapInstance)
+`
+
+// GetShiftGroupIDs returns the IDs of the shift groups of the channel
+func GetShiftGroupIDs(ctx context.Context, channelId string, authUserId string) ([]string, error) {
+	var responseObject model.GetShiftGroupsResponse
+	err := shiftGroupClient().Do(ctx, shiftGroupsByChannelQuery, map[string]interface{}{
+		"channelId":  channelId,
+		"authUserId": authUserId,
+	}, &responseObject.Data)
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
-		fmt.Printf("There was an error executing the request%v \n", err)
-		return false, err
+		return nil, fmt.Errorf("unable to get shift groups: %w", err)
 	}
 
-	var permissionResponse model.PermissionResponse
-	err = json.Unmarshal(responseData, &permissionResponse)
+	shiftGroupIDs := make([]string, 0, len(responseObject.Data.ShiftGroupsByChannel))
+	for _, shiftGroup := range responseObject.Data.ShiftGroupsByChannel {
+		shiftGroupIDs = append(shiftGroupIDs, shiftGroup.ID)
+	}
+
+	return shiftGroupIDs, nil
+}
+
+const getShiftGroupMembersListQuery = `
+	query GetShiftGroupMembersList($channelId: ID!, $shiftGroupId: ID!, $authUserId: ID) {
+		getShiftGroupMembersList(channelId: $channelId, shiftGroupId: $shiftGroupId, authUserId: $authUserId) {
+			userId
+		}
+	}
+`
+
+// GetShiftGroupMemberUserIDs returns the IDs of the users in the shift group
+func GetShiftGroupMemberUserIDs(ctx context.Context, channelId string, shiftGroupId string, authUserId string) ([]string, error) {
+	var responseObject model.GetShiftGroupMembersResponse
+	err := shiftGroupMemberClient().Do(ctx, getShiftGroupMembersListQuery, map[string]interface{}{
+		"channelId":    channelId,
+		"shiftGroupId": shiftGroupId,
+		"authUserId":   authUserId,
+	}, &responseObject.Data)
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
-		fmt.Printf("Unmarshal Error%v", err)
+		return nil, fmt.Errorf("unable to get shift group members: %w", err)
+	}
+
+	userIDs := make([]string, 0, len(responseObject.Data.GetShiftGroupMembersList))
+	for _, member := range responseObject.Data.GetShiftGroupMembersList {
+		userIDs = append(userIDs, member.UserID)
 	}
 
-	return permissionResponse.Data.CheckPermission, nil
+	return userIDs, nil
 }
 
-func GetUser(id string) (*model.User, error) {
-	jsonMapInstance := map[string]string{
-		"query": `
-		{
-			user(id: "` + id + `") {
-			  id
-			  email
-			  firstName
-			  lastName
-			  isStaff
-			  avatar
-			}
-		  }
-		`,
+const assignedShiftFields = `
+	id
+	break
+	color
+	label
+	note
+	startTime
+	endTime
+	is24Hours
+	userId
+	channelId
+	shiftGroupId
+	type
+	isOpen
+	isShared
+`
+
+const getAssignedShiftsByTimeQuery = `
+	query GetAssignedShiftsByTime($channelId: ID!, $shiftGroupId: ID!, $userId: ID!, $startTime: Time!, $endTime: Time!) {
+		getAssignedShiftsByTime(
+			channelId: $channelId
+			shiftGroupId: $shiftGroupId
+			userId: $userId
+			startTime: $startTime
+			endTime: $endTime
+		) {` + assignedShiftFields + `}
 	}
-
-	responseData, err := httpRequest("POST", os.Getenv("USER_ACCOUNT_API"), os.Getenv("DAPR_USER_APP_ID"), jsonMapInstance)
+`
+
+// GetAssignedShiftsByTime returns the shifts of the shift group assigned to
+// the user between startTime and endTime
+func GetAssignedShiftsByTime(ctx context.Context, channelId string, shiftGroupId string, userId string, startTime time.Time, endTime time.Time) ([]*model.AssignedShift, error) {
+	var responseObject model.GetAssignedShiftsByTimeResponse
+	err := assignedShiftClient().Do(ctx, getAssignedShiftsByTimeQuery, map[string]interface{}{
+		"channelId":    channelId,
+		"shiftGroupId": shiftGroupId,
+		"userId":       userId,
+		"startTime":    startTime.UTC().Format(time.RFC3339),
+		"endTime":      endTime.UTC().Format(time.RFC3339),
+	}, &responseObject.Data)
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
-		fmt.Printf("There was an error executing the request%v", err)
-		fmt.Printf("There was an error executing the request%v \n", err)
-		return nil, err
+		return nil, fmt.Errorf("unable to get assigned shifts: %w", err)
 	}
 
-	// convert into User struct
-	var userData model.UserResponse
-	err = json.Unmarshal(responseData, &userData)
+	return responseObject.Data.GetAssignedShiftsByTime, nil
+}
 
+const openAssignedShiftMutation = `
+	mutation OpenAssignedShift($id: ID!) {
+		updateAssignedShift(id: $id, input: { userId: null, isOpen: true }) {` + assignedShiftFields + `}
+	}
+`
+
+// OpenAssignedShift takes the assigned shift from its user and makes it an
+// open shift
+func OpenAssignedShift(ctx context.Context, id string) (*model.AssignedShift, error) {
+	var responseObject model.UpdateAssignedShiftResponse
+	err := assignedShiftClient().Do(ctx, openAssignedShiftMutation, map[string]interface{}{
+		"id": id,
+	}, &responseObject.Data)
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
-		fmt.Printf("There was an error unmarshaling the JSON instance %v", err)
+		return nil, fmt.Errorf("unable to open assigned shift: %w", err)
 	}
 
-	return &model.User{
-		ID:        &userData.Data.User.ID,
-		Email:     &userData.Data.User.Email,
-		FirstName: &userData.Data.User.FirstName,
-		LastName:  &userData.Data.User.LastName,
-		IsStaff:   &userData.Data.User.IsStaff,
-		// Avatar:       userData.Data.User.Avatar,
-	}, nil
+	if responseObject.Data.UpdateAssignedShift == nil {
+		return nil, NewError(ErrorCodeNotFound, fmt.Sprintf("Assigned shift %s not found", id))
+	}
+
+	return responseObject.Data.UpdateAssignedShift, nil
 }
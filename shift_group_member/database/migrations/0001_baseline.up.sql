@@ -0,0 +1,11 @@
+CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
+
+CREATE TABLE IF NOT EXISTS shift_group_members (
+    id text,
+    channel_id text,
+    shift_group_id text,
+    user_id text,
+    position bigint,
+    created_at timestamp with time zone,
+    PRIMARY KEY (id)
+);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS shift_group_members_user_id_idx;
+DROP INDEX IF EXISTS shift_group_members_channel_id_shift_group_id_idx;
@@ -0,0 +1,2 @@
+CREATE INDEX IF NOT EXISTS shift_group_members_channel_id_shift_group_id_idx ON shift_group_members (channel_id, shift_group_id);
+CREATE INDEX IF NOT EXISTS shift_group_members_user_id_idx ON shift_group_members (user_id);
@@ -0,0 +1,231 @@
+package graph
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/getsentry/sentry-go"
+	vault "github.com/hashicorp/vault/api"
+)
+
+// CredentialsProvider supplies the credentials used to connect to the
+// database and keeps them fresh for as long as the service is running
+type CredentialsProvider interface {
+	// DatabaseCredentials returns the credentials for the first connection
+	DatabaseCredentials(ctx context.Context) (DatabaseCredentials, error)
+
+	// Renew blocks until the context is cancelled, calling reconnect every
+	// time the credentials are replaced
+	Renew(ctx context.Context, reconnect func(ctx context.Context, credentials DatabaseCredentials) error)
+
+	// Status returns the last renewal error, nil while the credentials are valid
+	Status() error
+}
+
+const (
+	CredentialsProviderVault  = "vault"
+	CredentialsProviderStatic = "static"
+	CredentialsProviderFile   = "file"
+)
+
+// NewCredentialsProvider builds the provider selected by the
+// CREDENTIALS_PROVIDER variable, defaulting to Vault AppRole
+func NewCredentialsProvider(ctx context.Context) (CredentialsProvider, error) {
+	switch kind := os.Getenv("CREDENTIALS_PROVIDER"); kind {
+	case "", CredentialsProviderVault:
+		return NewVaultCredentialsProvider(
+			ctx,
+			VaultParameters{
+				address:                 os.Getenv("VAULT_ADDRESS"),
+				approleRoleID:           os.Getenv("VAULT_APPROLE_ROLE_ID"),
+				approleSecretID:         os.Getenv("VAULT_APPROLE_SECRET_ID"),
+				apiKeyPath:              os.Getenv("VAULT_API_KEY_PATH"),
+				apiKeyMountPath:         os.Getenv("VAULT_API_KEY_MOUNT_PATH"),
+				apiKeyField:             os.Getenv("VAULT_API_KEY_FIELD"),
+				databaseCredentialsPath: os.Getenv("VAULT_DATABASE_CREDS_PATH"),
+			},
+		)
+	case CredentialsProviderStatic:
+		return NewStaticCredentialsProvider(DatabaseCredentials{
+			Username: os.Getenv("DATABASE_USERNAME"),
+			Password: os.Getenv("DATABASE_PASSWORD"),
+		}), nil
+	case CredentialsProviderFile:
+		interval := 30
+		if value := os.Getenv("DATABASE_CREDENTIALS_FILE_INTERVAL"); value != "" {
+			seconds, err := strconv.Atoi(value)
+			if err != nil {
+				return nil, fmt.Errorf("unable to convert credentials file interval to int: %w", err)
+			}
+			interval = seconds
+		}
+
+		return NewFileCredentialsProvider(os.Getenv("DATABASE_CREDENTIALS_FILE"), time.Duration(interval)*time.Second), nil
+	default:
+		return nil, fmt.Errorf("unknown credentials provider %q", kind)
+	}
+}
+
+// VaultCredentialsProvider retrieves dynamic database credentials from Vault
+// using the AppRole authentication method and renews their leases
+type VaultCredentialsProvider struct {
+	vault     *Vault
+	authToken *vault.Secret
+	lease     *vault.Secret
+}
+
+func NewVaultCredentialsProvider(ctx context.Context, parameters VaultParameters) (*VaultCredentialsProvider, error) {
+	vault, authToken, err := NewVaultAppRoleClient(ctx, parameters)
+	if err != nil {
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
+
+		return nil, fmt.Errorf("unable to initialize vault connection : %w", err)
+	}
+
+	return &VaultCredentialsProvider{
+		vault:     vault,
+		authToken: authToken,
+	}, nil
+}
+
+func (p *VaultCredentialsProvider) DatabaseCredentials(ctx context.Context) (DatabaseCredentials, error) {
+	credentials, lease, err := p.vault.GetDatabaseCredentials(ctx)
+	if err != nil {
+		return DatabaseCredentials{}, fmt.Errorf("unable to retrieve database credentials from vault: %w", err)
+	}
+
+	// the lease is kept to be renewed by the renewal loop
+	p.lease = lease
+
+	return credentials, nil
+}
+
+func (p *VaultCredentialsProvider) Renew(ctx context.Context, reconnect func(ctx context.Context, credentials DatabaseCredentials) error) {
+	p.vault.PeriodicallyRenewLeases(ctx, p.authToken, p.lease, reconnect)
+}
+
+func (p *VaultCredentialsProvider) Status() error {
+	return p.vault.LeaseStatus()
+}
+
+// StaticCredentialsProvider always returns the same credentials, for local
+// development and tests. When DATABASE_DSN is set the credentials are
+// ignored and the connection string is used as is.
+type StaticCredentialsProvider struct {
+	credentials DatabaseCredentials
+}
+
+func NewStaticCredentialsProvider(credentials DatabaseCredentials) *StaticCredentialsProvider {
+	return &StaticCredentialsProvider{credentials: credentials}
+}
+
+func (p *StaticCredentialsProvider) DatabaseCredentials(ctx context.Context) (DatabaseCredentials, error) {
+	return p.credentials, nil
+}
+
+// Renew has nothing to renew, it only waits for the service to stop
+func (p *StaticCredentialsProvider) Renew(ctx context.Context, reconnect func(ctx context.Context, credentials DatabaseCredentials) error) {
+	<-ctx.Done()
+}
+
+func (p *StaticCredentialsProvider) Status() error {
+	return nil
+}
+
+// FileCredentialsProvider reads the credentials from a JSON file such as a
+// mounted Kubernetes secret: {"username": "...", "password": "..."}.
+// The file is polled and the database reconnects when it changes.
+type FileCredentialsProvider struct {
+	path     string
+	interval time.Duration
+	modTime  time.Time
+
+	status      error
+	statusMutex sync.Mutex
+}
+
+func NewFileCredentialsProvider(path string, interval time.Duration) *FileCredentialsProvider {
+	return &FileCredentialsProvider{
+		path:     path,
+		interval: interval,
+	}
+}
+
+func (p *FileCredentialsProvider) DatabaseCredentials(ctx context.Context) (DatabaseCredentials, error) {
+	info, err := os.Stat(p.path)
+	if err != nil {
+		return DatabaseCredentials{}, fmt.Errorf("unable to read credentials file: %w", err)
+	}
+
+	b, err := os.ReadFile(p.path)
+	if err != nil {
+		return DatabaseCredentials{}, fmt.Errorf("unable to read credentials file: %w", err)
+	}
+
+	var credentials DatabaseCredentials
+
+	if err := json.Unmarshal(b, &credentials); err != nil {
+		return DatabaseCredentials{}, fmt.Errorf("unable to unmarshal credentials file: %w", err)
+	}
+
+	p.modTime = info.ModTime()
+
+	return credentials, nil
+}
+
+func (p *FileCredentialsProvider) Renew(ctx context.Context, reconnect func(ctx context.Context, credentials DatabaseCredentials) error) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			info, err := os.Stat(p.path)
+			if err != nil || !info.ModTime().After(p.modTime) {
+				continue
+			}
+
+			log.Printf("database credentials: %s changed; will reconnect", p.path)
+
+			credentials, err := p.DatabaseCredentials(ctx)
+			if err != nil {
+				sentry.CaptureException(err)
+				log.Printf("database credentials error: %v", err)
+
+				p.setStatus(err)
+				continue
+			}
+
+			err = reconnect(ctx, credentials)
+			if err != nil {
+				sentry.CaptureException(err)
+				log.Printf("database connection error: %v", err)
+			}
+
+			p.setStatus(err)
+		}
+	}
+}
+
+func (p *FileCredentialsProvider) Status() error {
+	/* */ p.statusMutex.Lock()
+	defer p.statusMutex.Unlock()
+
+	return p.status
+}
+
+func (p *FileCredentialsProvider) setStatus(err error) {
+	/* */ p.statusMutex.Lock()
+	defer p.statusMutex.Unlock()
+
+	p.status = err
+}
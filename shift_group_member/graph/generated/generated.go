@@ -83,24 +83,28 @@ type ComplexityRoot struct {
 	}
 
 	GetAllUniqueShiftsResponse struct {
+		Errors  func(childComplexity int) int
 		Message func(childComplexity int) int
 		Result  func(childComplexity int) int
 		Status  func(childComplexity int) int
 	}
 
 	GetNonShiftGroupMembersResponse struct {
+		Errors  func(childComplexity int) int
 		Message func(childComplexity int) int
 		Result  func(childComplexity int) int
 		Status  func(childComplexity int) int
 	}
 
 	GetShiftsByTaskResponse struct {
+		Errors  func(childComplexity int) int
 		Message func(childComplexity int) int
 		Result  func(childComplexity int) int
 		Status  func(childComplexity int) int
 	}
 
 	GetShiftsResponse struct {
+		Errors  func(childComplexity int) int
 		Message func(childComplexity int) int
 		Result  func(childComplexity int) int
 		Status  func(childComplexity int) int
@@ -159,6 +163,7 @@ type ComplexityRoot struct {
 	}
 
 	ResponseStatus struct {
+		Errors  func(childComplexity int) int
 		Message func(childComplexity int) int
 		Status  func(childComplexity int) int
 	}
@@ -478,6 +483,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.AssignedShiftActivities.UserID(childComplexity), true
 
+	case "GetAllUniqueShiftsResponse.errors":
+		if e.complexity.GetAllUniqueShiftsResponse.Errors == nil {
+			break
+		}
+
+		return e.complexity.GetAllUniqueShiftsResponse.Errors(childComplexity), true
+
 	case "GetAllUniqueShiftsResponse.message":
 		if e.complexity.GetAllUniqueShiftsResponse.Message == nil {
 			break
@@ -499,6 +511,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.GetAllUniqueShiftsResponse.Status(childComplexity), true
 
+	case "GetNonShiftGroupMembersResponse.errors":
+		if e.complexity.GetNonShiftGroupMembersResponse.Errors == nil {
+			break
+		}
+
+		return e.complexity.GetNonShiftGroupMembersResponse.Errors(childComplexity), true
+
 	case "GetNonShiftGroupMembersResponse.message":
 		if e.complexity.GetNonShiftGroupMembersResponse.Message == nil {
 			break
@@ -520,6 +539,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.GetNonShiftGroupMembersResponse.Status(childComplexity), true
 
+	case "GetShiftsByTaskResponse.errors":
+		if e.complexity.GetShiftsByTaskResponse.Errors == nil {
+			break
+		}
+
+		return e.complexity.GetShiftsByTaskResponse.Errors(childComplexity), true
+
 	case "GetShiftsByTaskResponse.message":
 		if e.complexity.GetShiftsByTaskResponse.Message == nil {
 			break
@@ -541,6 +567,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.GetShiftsByTaskResponse.Status(childComplexity), true
 
+	case "GetShiftsResponse.errors":
+		if e.complexity.GetShiftsResponse.Errors == nil {
+			break
+		}
+
+		return e.complexity.GetShiftsResponse.Errors(childComplexity), true
+
 	case "GetShiftsResponse.message":
 		if e.complexity.GetShiftsResponse.Message == nil {
 			break
@@ -871,6 +904,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.GetShiftsByTask(childComplexity, args["channelId"].(string), args["endDate"].(time.Time), args["filter"].(*model.GetShiftsFilter), args["startDate"].(time.Time), args["authUserId"].(*string)), true
 
+	case "ResponseStatus.errors":
+		if e.complexity.ResponseStatus.Errors == nil {
+			break
+		}
+
+		return e.complexity.ResponseStatus.Errors(childComplexity), true
+
 	case "ResponseStatus.message":
 		if e.complexity.ResponseStatus.Message == nil {
 			break
@@ -1335,17 +1375,20 @@ type OpenShiftActivities {
 }
 
 type ResponseStatus {
+  errors: [ShiftError!]
   message: String
   status: String
 }
 
 type GetNonShiftGroupMembersResponse {
+  errors: [ShiftError!]
   message: String
   result: [User]!
   status: String
 }
 
 type GetAllUniqueShiftsResponse {
+  errors: [ShiftError!]
   message: String
   result: UniqueShifts
   status: String
@@ -1364,6 +1407,7 @@ input GetShiftsFilter {
 }
 
 type GetShiftsResponse {
+  errors: [ShiftError!]
   message: String
   result: Shifts
   status: String
@@ -1389,6 +1433,7 @@ type OpenShiftInfo {
 }
 
 type GetShiftsByTaskResponse {
+  errors: [ShiftError!]
   message: String
   result: [ShiftGroups]
   status: String
@@ -1416,11 +1461,20 @@ type ShiftError {
   message: String
 }
 
+"""
+Stable error codes, also set as the "code" extension of GraphQL errors
+"""
 enum ShiftErrorCode {
-  GRAPHQL_ERROR
-  INVALID
+  UNAUTHENTICATED
+  FORBIDDEN
   NOT_FOUND
-  REQUIRED
+  VALIDATION
+  CONFLICT
+  UPSTREAM_UNAVAILABLE
+  INTERNAL
+  GRAPHQL_ERROR @deprecated(reason: "Use INTERNAL")
+  INVALID @deprecated(reason: "Use VALIDATION")
+  REQUIRED @deprecated(reason: "Use VALIDATION, UNAUTHENTICATED or FORBIDDEN")
 }
 
 scalar Time
@@ -1430,18 +1484,18 @@ type Query {
   getNonShiftGroupMembers(
     channelId: ID!
     shiftGroupId: ID!
-    authUserId: ID
+    authUserId: ID @deprecated(reason: "The user is taken from the session; only honored for trusted services")
   ): GetNonShiftGroupMembersResponse
   getShiftGroupMembers(
     shiftGroupId: ID!
     channel: String!
-    authUserId: ID
+    authUserId: ID @deprecated(reason: "The user is taken from the session; only honored for trusted services")
   ): [User]!
-  getAllShiftMembers(first: Int, last: Int, authUserId: ID): [User!]!
+  getAllShiftMembers(first: Int, last: Int, authUserId: ID @deprecated(reason: "The user is taken from the session; only honored for trusted services")): [User!]!
   getAllUniqueShifts(
     channelId: ID!
     shiftGroupId: ID!
-    authUserId: ID
+    authUserId: ID @deprecated(reason: "The user is taken from the session; only honored for trusted services")
   ): GetAllUniqueShiftsResponse
   getShiftsByPeople(
     channelId: ID!
@@ -1449,7 +1503,7 @@ type Query {
     filter: GetShiftsFilter
     shiftGroupId: ID!
     startDate: Time!
-    authUserId: ID
+    authUserId: ID @deprecated(reason: "The user is taken from the session; only honored for trusted services")
   ): GetShiftsResponse!
 
   getShiftsByTask(
@@ -1457,32 +1511,32 @@ type Query {
     endDate: Time!
     filter: GetShiftsFilter
     startDate: Time!
-    authUserId: ID
+    authUserId: ID @deprecated(reason: "The user is taken from the session; only honored for trusted services")
   ): GetShiftsByTaskResponse
 
   getShiftGroupMembersList(
     channelId: ID!
     shiftGroupId: ID!
-    authUserId: ID
+    authUserId: ID @deprecated(reason: "The user is taken from the session; only honored for trusted services")
   ): [ShiftGroupMember]!
 }
 
 type Mutation {
   shiftGroupMemberAdd(
     input: ShiftGroupMemberInput!
-    authUserId: ID
+    authUserId: ID @deprecated(reason: "The user is taken from the session; only honored for trusted services")
   ): ShiftGroupMemberAddResponse
   shiftGroupMembersReorder(
     channelId: ID
     shiftGroupId: ID!
     userIds: [ID!]!
-    authUserId: ID
+    authUserId: ID @deprecated(reason: "The user is taken from the session; only honored for trusted services")
   ): ResponseStatus!
   shiftGroupMemberRemove(
     channelId: ID!
     shiftGroupId: ID!
     userId: ID!
-    authUserId: ID
+    authUserId: ID @deprecated(reason: "The user is taken from the session; only honored for trusted services")
   ): ShiftGroupMemberRemoveResponse
 }
 `, BuiltIn: false},
@@ -3380,6 +3434,55 @@ func (ec *executionContext) fieldContext_AssignedShiftActivities_createdAt(ctx c
 	return fc, nil
 }
 
+func (ec *executionContext) _GetAllUniqueShiftsResponse_errors(ctx context.Context, field graphql.CollectedField, obj *model.GetAllUniqueShiftsResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GetAllUniqueShiftsResponse_errors(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Errors, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.ShiftError)
+	fc.Result = res
+	return ec.marshalOShiftError2ᚕᚖshift_group_membersᚋgraphᚋmodelᚐShiftErrorᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_GetAllUniqueShiftsResponse_errors(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GetAllUniqueShiftsResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "code":
+				return ec.fieldContext_ShiftError_code(ctx, field)
+			case "field":
+				return ec.fieldContext_ShiftError_field(ctx, field)
+			case "message":
+				return ec.fieldContext_ShiftError_message(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ShiftError", field.Name)
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _GetAllUniqueShiftsResponse_message(ctx context.Context, field graphql.CollectedField, obj *model.GetAllUniqueShiftsResponse) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_GetAllUniqueShiftsResponse_message(ctx, field)
 	if err != nil {
@@ -3509,6 +3612,55 @@ func (ec *executionContext) fieldContext_GetAllUniqueShiftsResponse_status(ctx c
 	return fc, nil
 }
 
+func (ec *executionContext) _GetNonShiftGroupMembersResponse_errors(ctx context.Context, field graphql.CollectedField, obj *model.GetNonShiftGroupMembersResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GetNonShiftGroupMembersResponse_errors(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Errors, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.ShiftError)
+	fc.Result = res
+	return ec.marshalOShiftError2ᚕᚖshift_group_membersᚋgraphᚋmodelᚐShiftErrorᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_GetNonShiftGroupMembersResponse_errors(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GetNonShiftGroupMembersResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "code":
+				return ec.fieldContext_ShiftError_code(ctx, field)
+			case "field":
+				return ec.fieldContext_ShiftError_field(ctx, field)
+			case "message":
+				return ec.fieldContext_ShiftError_message(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ShiftError", field.Name)
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _GetNonShiftGroupMembersResponse_message(ctx context.Context, field graphql.CollectedField, obj *model.GetNonShiftGroupMembersResponse) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_GetNonShiftGroupMembersResponse_message(ctx, field)
 	if err != nil {
@@ -3665,6 +3817,55 @@ func (ec *executionContext) fieldContext_GetNonShiftGroupMembersResponse_status(
 	return fc, nil
 }
 
+func (ec *executionContext) _GetShiftsByTaskResponse_errors(ctx context.Context, field graphql.CollectedField, obj *model.GetShiftsByTaskResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GetShiftsByTaskResponse_errors(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Errors, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.ShiftError)
+	fc.Result = res
+	return ec.marshalOShiftError2ᚕᚖshift_group_membersᚋgraphᚋmodelᚐShiftErrorᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_GetShiftsByTaskResponse_errors(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GetShiftsByTaskResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "code":
+				return ec.fieldContext_ShiftError_code(ctx, field)
+			case "field":
+				return ec.fieldContext_ShiftError_field(ctx, field)
+			case "message":
+				return ec.fieldContext_ShiftError_message(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ShiftError", field.Name)
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _GetShiftsByTaskResponse_message(ctx context.Context, field graphql.CollectedField, obj *model.GetShiftsByTaskResponse) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_GetShiftsByTaskResponse_message(ctx, field)
 	if err != nil {
@@ -3798,6 +3999,55 @@ func (ec *executionContext) fieldContext_GetShiftsByTaskResponse_status(ctx cont
 	return fc, nil
 }
 
+func (ec *executionContext) _GetShiftsResponse_errors(ctx context.Context, field graphql.CollectedField, obj *model.GetShiftsResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GetShiftsResponse_errors(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Errors, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.ShiftError)
+	fc.Result = res
+	return ec.marshalOShiftError2ᚕᚖshift_group_membersᚋgraphᚋmodelᚐShiftErrorᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_GetShiftsResponse_errors(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GetShiftsResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "code":
+				return ec.fieldContext_ShiftError_code(ctx, field)
+			case "field":
+				return ec.fieldContext_ShiftError_field(ctx, field)
+			case "message":
+				return ec.fieldContext_ShiftError_message(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ShiftError", field.Name)
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _GetShiftsResponse_message(ctx context.Context, field graphql.CollectedField, obj *model.GetShiftsResponse) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_GetShiftsResponse_message(ctx, field)
 	if err != nil {
@@ -4024,6 +4274,8 @@ func (ec *executionContext) fieldContext_Mutation_shiftGroupMembersReorder(ctx c
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
+			case "errors":
+				return ec.fieldContext_ResponseStatus_errors(ctx, field)
 			case "message":
 				return ec.fieldContext_ResponseStatus_message(ctx, field)
 			case "status":
@@ -5326,6 +5578,8 @@ func (ec *executionContext) fieldContext_Query_getNonShiftGroupMembers(ctx conte
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
+			case "errors":
+				return ec.fieldContext_GetNonShiftGroupMembersResponse_errors(ctx, field)
 			case "message":
 				return ec.fieldContext_GetNonShiftGroupMembersResponse_message(ctx, field)
 			case "result":
@@ -5556,6 +5810,8 @@ func (ec *executionContext) fieldContext_Query_getAllUniqueShifts(ctx context.Co
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
+			case "errors":
+				return ec.fieldContext_GetAllUniqueShiftsResponse_errors(ctx, field)
 			case "message":
 				return ec.fieldContext_GetAllUniqueShiftsResponse_message(ctx, field)
 			case "result":
@@ -5619,6 +5875,8 @@ func (ec *executionContext) fieldContext_Query_getShiftsByPeople(ctx context.Con
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
+			case "errors":
+				return ec.fieldContext_GetShiftsResponse_errors(ctx, field)
 			case "message":
 				return ec.fieldContext_GetShiftsResponse_message(ctx, field)
 			case "result":
@@ -5679,6 +5937,8 @@ func (ec *executionContext) fieldContext_Query_getShiftsByTask(ctx context.Conte
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
+			case "errors":
+				return ec.fieldContext_GetShiftsByTaskResponse_errors(ctx, field)
 			case "message":
 				return ec.fieldContext_GetShiftsByTaskResponse_message(ctx, field)
 			case "result":
@@ -5901,6 +6161,55 @@ func (ec *executionContext) fieldContext_Query___schema(ctx context.Context, fie
 	return fc, nil
 }
 
+func (ec *executionContext) _ResponseStatus_errors(ctx context.Context, field graphql.CollectedField, obj *model.ResponseStatus) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ResponseStatus_errors(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Errors, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.ShiftError)
+	fc.Result = res
+	return ec.marshalOShiftError2ᚕᚖshift_group_membersᚋgraphᚋmodelᚐShiftErrorᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ResponseStatus_errors(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ResponseStatus",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "code":
+				return ec.fieldContext_ShiftError_code(ctx, field)
+			case "field":
+				return ec.fieldContext_ShiftError_field(ctx, field)
+			case "message":
+				return ec.fieldContext_ShiftError_message(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ShiftError", field.Name)
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _ResponseStatus_message(ctx context.Context, field graphql.CollectedField, obj *model.ResponseStatus) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_ResponseStatus_message(ctx, field)
 	if err != nil {
@@ -10004,6 +10313,10 @@ func (ec *executionContext) _GetAllUniqueShiftsResponse(ctx context.Context, sel
 		switch field.Name {
 		case "__typename":
 			out.Values[i] = graphql.MarshalString("GetAllUniqueShiftsResponse")
+		case "errors":
+
+			out.Values[i] = ec._GetAllUniqueShiftsResponse_errors(ctx, field, obj)
+
 		case "message":
 
 			out.Values[i] = ec._GetAllUniqueShiftsResponse_message(ctx, field, obj)
@@ -10037,6 +10350,10 @@ func (ec *executionContext) _GetNonShiftGroupMembersResponse(ctx context.Context
 		switch field.Name {
 		case "__typename":
 			out.Values[i] = graphql.MarshalString("GetNonShiftGroupMembersResponse")
+		case "errors":
+
+			out.Values[i] = ec._GetNonShiftGroupMembersResponse_errors(ctx, field, obj)
+
 		case "message":
 
 			out.Values[i] = ec._GetNonShiftGroupMembersResponse_message(ctx, field, obj)
@@ -10073,6 +10390,10 @@ func (ec *executionContext) _GetShiftsByTaskResponse(ctx context.Context, sel as
 		switch field.Name {
 		case "__typename":
 			out.Values[i] = graphql.MarshalString("GetShiftsByTaskResponse")
+		case "errors":
+
+			out.Values[i] = ec._GetShiftsByTaskResponse_errors(ctx, field, obj)
+
 		case "message":
 
 			out.Values[i] = ec._GetShiftsByTaskResponse_message(ctx, field, obj)
@@ -10106,6 +10427,10 @@ func (ec *executionContext) _GetShiftsResponse(ctx context.Context, sel ast.Sele
 		switch field.Name {
 		case "__typename":
 			out.Values[i] = graphql.MarshalString("GetShiftsResponse")
+		case "errors":
+
+			out.Values[i] = ec._GetShiftsResponse_errors(ctx, field, obj)
+
 		case "message":
 
 			out.Values[i] = ec._GetShiftsResponse_message(ctx, field, obj)
@@ -10582,6 +10907,10 @@ func (ec *executionContext) _ResponseStatus(ctx context.Context, sel ast.Selecti
 		switch field.Name {
 		case "__typename":
 			out.Values[i] = graphql.MarshalString("ResponseStatus")
+		case "errors":
+
+			out.Values[i] = ec._ResponseStatus_errors(ctx, field, obj)
+
 		case "message":
 
 			out.Values[i] = ec._ResponseStatus_message(ctx, field, obj)
@@ -12305,6 +12634,53 @@ func (ec *executionContext) marshalOOpenShiftActivities2ᚖshift_group_members
 	return ec._OpenShiftActivities(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalOShiftError2ᚕᚖshift_group_membersᚋgraphᚋmodelᚐShiftErrorᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.ShiftError) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNShiftError2ᚖshift_group_membersᚋgraphᚋmodelᚐShiftError(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
 func (ec *executionContext) marshalOShiftGroupMember2ᚖshift_group_membersᚋgraphᚋmodelᚐShiftGroupMember(ctx context.Context, sel ast.SelectionSet, v *model.ShiftGroupMember) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
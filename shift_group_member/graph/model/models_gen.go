@@ -47,18 +47,21 @@ type AssignedShiftActivities struct {
 }
 
 type GetAllUniqueShiftsResponse struct {
+	Errors  []*ShiftError `json:"errors,omitempty"`
 	Message *string       `json:"message,omitempty"`
 	Result  *UniqueShifts `json:"result,omitempty"`
 	Status  *string       `json:"status,omitempty"`
 }
 
 type GetNonShiftGroupMembersResponse struct {
-	Message *string `json:"message,omitempty"`
-	Result  []*User `json:"result"`
-	Status  *string `json:"status,omitempty"`
+	Errors  []*ShiftError `json:"errors,omitempty"`
+	Message *string       `json:"message,omitempty"`
+	Result  []*User       `json:"result"`
+	Status  *string       `json:"status,omitempty"`
 }
 
 type GetShiftsByTaskResponse struct {
+	Errors  []*ShiftError  `json:"errors,omitempty"`
 	Message *string        `json:"message,omitempty"`
 	Result  []*ShiftGroups `json:"result,omitempty"`
 	Status  *string        `json:"status,omitempty"`
@@ -73,9 +76,10 @@ type GetShiftsFilter struct {
 }
 
 type GetShiftsResponse struct {
-	Message *string `json:"message,omitempty"`
-	Result  *Shifts `json:"result,omitempty"`
-	Status  *string `json:"status,omitempty"`
+	Errors  []*ShiftError `json:"errors,omitempty"`
+	Message *string       `json:"message,omitempty"`
+	Result  *Shifts       `json:"result,omitempty"`
+	Status  *string       `json:"status,omitempty"`
 }
 
 type OpenShift struct {
@@ -115,8 +119,9 @@ type OpenShiftInfo struct {
 }
 
 type ResponseStatus struct {
-	Message *string `json:"message,omitempty"`
-	Status  *string `json:"status,omitempty"`
+	Errors  []*ShiftError `json:"errors,omitempty"`
+	Message *string       `json:"message,omitempty"`
+	Status  *string       `json:"status,omitempty"`
 }
 
 type ShiftError struct {
@@ -192,25 +197,38 @@ type UserAssignedShifts struct {
 	UserID        string           `json:"userId"`
 }
 
+// Stable error codes, also set as the "code" extension of GraphQL errors
 type ShiftErrorCode string
 
 const (
-	ShiftErrorCodeGraphqlError ShiftErrorCode = "GRAPHQL_ERROR"
-	ShiftErrorCodeInvalid      ShiftErrorCode = "INVALID"
-	ShiftErrorCodeNotFound     ShiftErrorCode = "NOT_FOUND"
-	ShiftErrorCodeRequired     ShiftErrorCode = "REQUIRED"
+	ShiftErrorCodeUnauthenticated     ShiftErrorCode = "UNAUTHENTICATED"
+	ShiftErrorCodeForbidden           ShiftErrorCode = "FORBIDDEN"
+	ShiftErrorCodeNotFound            ShiftErrorCode = "NOT_FOUND"
+	ShiftErrorCodeValidation          ShiftErrorCode = "VALIDATION"
+	ShiftErrorCodeConflict            ShiftErrorCode = "CONFLICT"
+	ShiftErrorCodeUpstreamUnavailable ShiftErrorCode = "UPSTREAM_UNAVAILABLE"
+	ShiftErrorCodeInternal            ShiftErrorCode = "INTERNAL"
+	ShiftErrorCodeGraphqlError        ShiftErrorCode = "GRAPHQL_ERROR"
+	ShiftErrorCodeInvalid             ShiftErrorCode = "INVALID"
+	ShiftErrorCodeRequired            ShiftErrorCode = "REQUIRED"
 )
 
 var AllShiftErrorCode = []ShiftErrorCode{
+	ShiftErrorCodeUnauthenticated,
+	ShiftErrorCodeForbidden,
+	ShiftErrorCodeNotFound,
+	ShiftErrorCodeValidation,
+	ShiftErrorCodeConflict,
+	ShiftErrorCodeUpstreamUnavailable,
+	ShiftErrorCodeInternal,
 	ShiftErrorCodeGraphqlError,
 	ShiftErrorCodeInvalid,
-	ShiftErrorCodeNotFound,
 	ShiftErrorCodeRequired,
 }
 
 func (e ShiftErrorCode) IsValid() bool {
 	switch e {
-	case ShiftErrorCodeGraphqlError, ShiftErrorCodeInvalid, ShiftErrorCodeNotFound, ShiftErrorCodeRequired:
+	case ShiftErrorCodeUnauthenticated, ShiftErrorCodeForbidden, ShiftErrorCodeNotFound, ShiftErrorCodeValidation, ShiftErrorCodeConflict, ShiftErrorCodeUpstreamUnavailable, ShiftErrorCodeInternal, ShiftErrorCodeGraphqlError, ShiftErrorCodeInvalid, ShiftErrorCodeRequired:
 		return true
 	}
 	return false
@@ -22,54 +22,44 @@ import (
 func (r *mutationResolver) ShiftGroupMemberAdd(ctx context.Context, input model.ShiftGroupMemberInput, authUserID *string) (*model.ShiftGroupMemberAddResponse, error) {
 	errorMessage := "Something went wrong while adding the shift group." // default error message
 
+	authUserID = AuthUserID(ctx, authUserID)
 	if authUserID == nil || *authUserID == string("") {
 		errorMessage = AUTH_USER_ID_REQUIRED
-		return util.ShiftGroupMemberAddRHandleError(&errorMessage, model.ShiftErrorCodeRequired)
+		return util.ShiftGroupMemberAddRHandleError(&errorMessage, model.ShiftErrorCodeUnauthenticated)
 	}
 
 	var err error
 	permission := false
 
 	// validate permission
-	permission, err = util.CheckPermission("shift_group_member", "WRITE_ALL", *authUserID)
+	permission, err = util.CheckAnyPermission(ctx, "shift_group_member", []string{"WRITE_ALL", "WRITE"}, *authUserID)
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
 		errorMessage = err.Error()
-		return util.ShiftGroupMemberAddRHandleError(&errorMessage, model.ShiftErrorCodeInvalid)
-	}
-
-	if !permission {
-		permission, err = util.CheckPermission("shift_group_member", "WRITE", *authUserID)
-		if err != nil {
-			sentry.CaptureException(err)
-			defer sentry.Flush(2 * time.Second)
-
-			errorMessage = err.Error()
-			return util.ShiftGroupMemberAddRHandleError(&errorMessage, model.ShiftErrorCodeInvalid)
-		}
+		return util.ShiftGroupMemberAddRHandleError(&errorMessage, util.ShiftErrorCodeOf(err))
 	}
 
 	if !permission {
 		errorMessage = "Permission denied: shift_group_member.WRITE, shift_group_member.WRITE_ALL"
-		return util.ShiftGroupMemberAddRHandleError(&errorMessage, model.ShiftErrorCodeRequired)
+		return util.ShiftGroupMemberAddRHandleError(&errorMessage, model.ShiftErrorCodeForbidden)
 	}
 
 	// validate the input
 	if input.ChannelID == "" {
 		errorMessage = "Channel id is required"
-		return util.ShiftGroupMemberAddRHandleError(&errorMessage, model.ShiftErrorCodeRequired)
+		return util.ShiftGroupMemberAddRHandleError(&errorMessage, model.ShiftErrorCodeValidation)
 	}
 
 	if input.ShiftGroupID == "" {
 		errorMessage = "Shift group id is required"
-		return util.ShiftGroupMemberAddRHandleError(&errorMessage, model.ShiftErrorCodeRequired)
+		return util.ShiftGroupMemberAddRHandleError(&errorMessage, model.ShiftErrorCodeValidation)
 	}
 
 	if input.UserID == "" {
 		errorMessage = "User id is required"
-		return util.ShiftGroupMemberAddRHandleError(&errorMessage, model.ShiftErrorCodeRequired)
+		return util.ShiftGroupMemberAddRHandleError(&errorMessage, model.ShiftErrorCodeValidation)
 	}
 
 	// check if the user is already a member of the shift group
@@ -78,7 +68,7 @@ func (r *mutationResolver) ShiftGroupMemberAdd(ctx context.Context, input model.
 
 	if shiftGroupMember.ID != "" {
 		errorMessage = "User is already a member of the shift group"
-		return util.ShiftGroupMemberAddRHandleError(&errorMessage, model.ShiftErrorCodeInvalid)
+		return util.ShiftGroupMemberAddRHandleError(&errorMessage, model.ShiftErrorCodeConflict)
 	}
 
 	// create a shift group member
@@ -99,18 +89,18 @@ func (r *mutationResolver) ShiftGroupMemberAdd(ctx context.Context, input model.
 		defer sentry.Flush(2 * time.Second)
 
 		errorMessage = err.Error()
-		return util.ShiftGroupMemberAddRHandleError(&errorMessage, model.ShiftErrorCodeInvalid)
+		return util.ShiftGroupMemberAddRHandleError(&errorMessage, util.ShiftErrorCodeOf(err))
 	}
 
 	// get the user from the user service and return it
-	user, err := util.GetUser(input.UserID)
+	user, err := util.GetUser(ctx, input.UserID)
 
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
 		errorMessage = err.Error()
-		return util.ShiftGroupMemberAddRHandleError(&errorMessage, model.ShiftErrorCodeInvalid)
+		return util.ShiftGroupMemberAddRHandleError(&errorMessage, util.ShiftErrorCodeOf(err))
 	}
 
 	return &model.ShiftGroupMemberAddResponse{
@@ -122,19 +112,21 @@ func (r *mutationResolver) ShiftGroupMemberAdd(ctx context.Context, input model.
 // ShiftGroupMembersReorder is the resolver for the shiftGroupMembersReorder field.
 func (r *mutationResolver) ShiftGroupMembersReorder(ctx context.Context, channelID *string, shiftGroupID string, userIds []string, authUserID *string) (*model.ResponseStatus, error) {
 	var message, status string
+	authUserID = AuthUserID(ctx, authUserID)
 	if authUserID == nil || *authUserID == string("") {
 		message = AUTH_USER_ID_REQUIRED
 		status = "error"
 		return &model.ResponseStatus{
 			Message: &message,
 			Status:  &status,
+			Errors:  util.ShiftErrors(model.ShiftErrorCodeUnauthenticated, &message),
 		}, nil
 	}
 	var err error
 	permission := false
 
 	// validate permission
-	permission, err = util.CheckPermission("shift_group_member", "WRITE_ALL", *authUserID)
+	permission, err = util.CheckAnyPermission(ctx, "shift_group_member", []string{"WRITE_ALL", "MANAGE"}, *authUserID)
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
@@ -144,25 +136,11 @@ func (r *mutationResolver) ShiftGroupMembersReorder(ctx context.Context, channel
 		return &model.ResponseStatus{
 			Message: &message,
 			Status:  &status,
+			Errors:  util.ShiftErrors(util.ShiftErrorCodeOf(err), &message),
 		}, nil
 
 	}
 
-	if !permission {
-		permission, err = util.CheckPermission("shift_group_member", "MANAGE", *authUserID)
-		if err != nil {
-			sentry.CaptureException(err)
-			defer sentry.Flush(2 * time.Second)
-
-			message = err.Error()
-			status = "error"
-			return &model.ResponseStatus{
-				Message: &message,
-				Status:  &status,
-			}, nil
-		}
-	}
-
 	if !permission {
 		message = "Permission denied: shift_group_member.MANAGE, shift_group_member.WRITE_ALL"
 		status = "error"
@@ -170,6 +148,7 @@ func (r *mutationResolver) ShiftGroupMembersReorder(ctx context.Context, channel
 		return &model.ResponseStatus{
 			Message: &message,
 			Status:  &status,
+			Errors:  util.ShiftErrors(model.ShiftErrorCodeForbidden, &message),
 		}, nil
 	}
 
@@ -179,6 +158,7 @@ func (r *mutationResolver) ShiftGroupMembersReorder(ctx context.Context, channel
 		return &model.ResponseStatus{
 			Message: &message,
 			Status:  &status,
+			Errors:  util.ShiftErrors(model.ShiftErrorCodeValidation, &message),
 		}, nil
 	}
 
@@ -209,6 +189,7 @@ func (r *mutationResolver) ShiftGroupMembersReorder(ctx context.Context, channel
 			return &model.ResponseStatus{
 				Message: &message,
 				Status:  &status,
+				Errors:  util.ShiftErrors(util.ShiftErrorCodeOf(err), &message),
 			}, nil
 		}
 	}
@@ -225,66 +206,56 @@ func (r *mutationResolver) ShiftGroupMembersReorder(ctx context.Context, channel
 func (r *mutationResolver) ShiftGroupMemberRemove(ctx context.Context, channelID string, shiftGroupID string, userID string, authUserID *string) (*model.ShiftGroupMemberRemoveResponse, error) {
 	errorMessage := "Something went wrong while removing the shift group." // default error message
 
+	authUserID = AuthUserID(ctx, authUserID)
 	if authUserID == nil || *authUserID == string("") {
 		errorMessage = AUTH_USER_ID_REQUIRED
-		return util.ShiftGroupMemberRemoveRHandleError(&errorMessage, model.ShiftErrorCodeRequired)
+		return util.ShiftGroupMemberRemoveRHandleError(&errorMessage, model.ShiftErrorCodeUnauthenticated)
 	}
 
 	var err error
 	permission := false
 
 	// validate permission
-	permission, err = util.CheckPermission("shift_group_member", "WRITE_ALL", *authUserID)
+	permission, err = util.CheckAnyPermission(ctx, "shift_group_member", []string{"WRITE_ALL", "MANAGE"}, *authUserID)
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
 		errorMessage = err.Error()
-		return util.ShiftGroupMemberRemoveRHandleError(&errorMessage, model.ShiftErrorCodeRequired)
-	}
-
-	if !permission {
-		permission, err = util.CheckPermission("shift_group_member", "MANAGE", *authUserID)
-		if err != nil {
-			sentry.CaptureException(err)
-			defer sentry.Flush(2 * time.Second)
-
-			errorMessage = err.Error()
-			return util.ShiftGroupMemberRemoveRHandleError(&errorMessage, model.ShiftErrorCodeInvalid)
-		}
+		return util.ShiftGroupMemberRemoveRHandleError(&errorMessage, util.ShiftErrorCodeOf(err))
 	}
 
 	if !permission {
 		errorMessage = "Permission denied: shift_group_member.MANAGE, shift_group_member.WRITE_ALL"
-		return util.ShiftGroupMemberRemoveRHandleError(&errorMessage, model.ShiftErrorCodeRequired)
+		return util.ShiftGroupMemberRemoveRHandleError(&errorMessage, model.ShiftErrorCodeForbidden)
 	}
 
 	// validate the input
 	if channelID == "" {
 		errorMessage = "channelId is required"
-		return util.ShiftGroupMemberRemoveRHandleError(&errorMessage, model.ShiftErrorCodeRequired)
+		return util.ShiftGroupMemberRemoveRHandleError(&errorMessage, model.ShiftErrorCodeValidation)
 	}
 
 	if shiftGroupID == "" {
 		errorMessage = "shiftGroupId is required"
-		return util.ShiftGroupMemberRemoveRHandleError(&errorMessage, model.ShiftErrorCodeRequired)
+		return util.ShiftGroupMemberRemoveRHandleError(&errorMessage, model.ShiftErrorCodeValidation)
 	}
 
 	if userID == "" {
 		errorMessage = "userId is required"
-		return util.ShiftGroupMemberRemoveRHandleError(&errorMessage, model.ShiftErrorCodeRequired)
+		return util.ShiftGroupMemberRemoveRHandleError(&errorMessage, model.ShiftErrorCodeValidation)
 	}
 
 	var assignedShifts []*model.AssignedShift
 
-	assignedShifts, err = util.GetAssignedShiftsByChannelIDShiftGroupIDUserID(&channelID, &shiftGroupID, &userID)
+	assignedShifts, err = util.GetAssignedShiftsByChannelIDShiftGroupIDUserID(ctx, &channelID, &shiftGroupID, &userID)
 	if err != nil {
 
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
 		errorMessage = err.Error()
-		util.ShiftGroupMemberRemoveRHandleError(&errorMessage, model.ShiftErrorCodeNotFound)
+		util.ShiftGroupMemberRemoveRHandleError(&errorMessage, util.ShiftErrorCodeOf(err))
 	}
 
 	if len(assignedShifts) != 0 {
@@ -303,41 +274,41 @@ func (r *mutationResolver) ShiftGroupMemberRemove(ctx context.Context, channelID
 
 		if isPendingShift {
 			errorMessage = "Member has pending shifts and cannot be removed"
-			return util.ShiftGroupMemberRemoveRHandleError(&errorMessage, model.ShiftErrorCodeInvalid)
+			return util.ShiftGroupMemberRemoveRHandleError(&errorMessage, model.ShiftErrorCodeConflict)
 		}
 
 		// delete AssignedShifts By ChannelId ShiftGroupId And UserId
 		for _, assignedShift := range assignedShifts {
-			assignedShiftDelRes, err := util.DeleteAssignedShift(&assignedShift.ID, authUserID)
+			assignedShiftDelRes, err := util.DeleteAssignedShift(ctx, &assignedShift.ID, authUserID)
 			if err != nil {
 				sentry.CaptureException(err)
 				defer sentry.Flush(2 * time.Second)
 
 				errorMessage = err.Error()
-				util.ShiftGroupMemberRemoveRHandleError(&errorMessage, model.ShiftErrorCodeNotFound)
+				util.ShiftGroupMemberRemoveRHandleError(&errorMessage, util.ShiftErrorCodeOf(err))
 			}
 
 			if assignedShiftDelRes != "success" {
 				errorMessage = "Error deleting assigned shift"
-				util.ShiftGroupMemberRemoveRHandleError(&errorMessage, model.ShiftErrorCodeRequired)
+				util.ShiftGroupMemberRemoveRHandleError(&errorMessage, model.ShiftErrorCodeUpstreamUnavailable)
 				fmt.Println("Error deleting assigned shift")
 			}
 		}
 	}
 
 	// delete UserTimeOffs ( time off ) By ChannelId ShiftGroupId And UserId
-	timeOffDelRes, err := util.DeleteTimeOff(&channelID, &shiftGroupID, &userID, authUserID)
+	timeOffDelRes, err := util.DeleteTimeOff(ctx, &channelID, &shiftGroupID, &userID, authUserID)
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
 		errorMessage = err.Error()
-		util.ShiftGroupMemberRemoveRHandleError(&errorMessage, model.ShiftErrorCodeRequired)
+		util.ShiftGroupMemberRemoveRHandleError(&errorMessage, util.ShiftErrorCodeOf(err))
 	}
 
 	if timeOffDelRes != "success" {
 		errorMessage = "Error deleting time off"
-		util.ShiftGroupMemberRemoveRHandleError(&errorMessage, model.ShiftErrorCodeRequired)
+		util.ShiftGroupMemberRemoveRHandleError(&errorMessage, model.ShiftErrorCodeUpstreamUnavailable)
 		fmt.Println("Error deleting time off")
 	}
 
@@ -349,7 +320,7 @@ func (r *mutationResolver) ShiftGroupMemberRemove(ctx context.Context, channelID
 		defer sentry.Flush(2 * time.Second)
 
 		errorMessage = err.Error()
-		return util.ShiftGroupMemberRemoveRHandleError(&errorMessage, model.ShiftErrorCodeInvalid)
+		return util.ShiftGroupMemberRemoveRHandleError(&errorMessage, util.ShiftErrorCodeOf(err))
 	}
 
 	if shiftGroupMember.ID == "" {
@@ -359,14 +330,14 @@ func (r *mutationResolver) ShiftGroupMemberRemove(ctx context.Context, channelID
 	}
 
 	// get the user from the user service and return it
-	user, err := util.GetUser(userID)
+	user, err := util.GetUser(ctx, userID)
 
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
 		errorMessage = err.Error()
-		return util.ShiftGroupMemberRemoveRHandleError(&errorMessage, model.ShiftErrorCodeInvalid)
+		return util.ShiftGroupMemberRemoveRHandleError(&errorMessage, util.ShiftErrorCodeOf(err))
 	}
 
 	if &user.ID == nil || user.ID == "" {
@@ -383,6 +354,7 @@ func (r *mutationResolver) ShiftGroupMemberRemove(ctx context.Context, channelID
 // GetNonShiftGroupMembers is the resolver for the getNonShiftGroupMembers field.
 func (r *queryResolver) GetNonShiftGroupMembers(ctx context.Context, channelID string, shiftGroupID string, authUserID *string) (*model.GetNonShiftGroupMembersResponse, error) {
 	var message, status string
+	authUserID = AuthUserID(ctx, authUserID)
 	if authUserID == nil || *authUserID == string("") {
 		message = AUTH_USER_ID_REQUIRED
 		status = "error"
@@ -390,6 +362,7 @@ func (r *queryResolver) GetNonShiftGroupMembers(ctx context.Context, channelID s
 		return &model.GetNonShiftGroupMembersResponse{
 			Message: &message,
 			Status:  &status,
+			Errors:  util.ShiftErrors(model.ShiftErrorCodeUnauthenticated, &message),
 			Result:  nil,
 		}, nil
 	}
@@ -398,7 +371,7 @@ func (r *queryResolver) GetNonShiftGroupMembers(ctx context.Context, channelID s
 	permission := false
 
 	// validate permission
-	permission, err = util.CheckPermission("shift_group_member", "READ_ALL", *authUserID)
+	permission, err = util.CheckAnyPermission(ctx, "shift_group_member", []string{"READ_ALL", "READ"}, *authUserID)
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
@@ -409,27 +382,11 @@ func (r *queryResolver) GetNonShiftGroupMembers(ctx context.Context, channelID s
 		return &model.GetNonShiftGroupMembersResponse{
 			Message: &message,
 			Status:  &status,
+			Errors:  util.ShiftErrors(util.ShiftErrorCodeOf(err), &message),
 			Result:  nil,
 		}, nil
 	}
 
-	if !permission {
-		permission, err = util.CheckPermission("shift_group_member", "READ", *authUserID)
-		if err != nil {
-			sentry.CaptureException(err)
-			defer sentry.Flush(2 * time.Second)
-
-			message = err.Error()
-			status = "error"
-
-			return &model.GetNonShiftGroupMembersResponse{
-				Message: &message,
-				Status:  &status,
-				Result:  nil,
-			}, nil
-		}
-	}
-
 	if !permission {
 		message = "Permission denied: shift_group_member.READ, shift_group_member.READ_ALL"
 		status = "error"
@@ -437,16 +394,17 @@ func (r *queryResolver) GetNonShiftGroupMembers(ctx context.Context, channelID s
 		return &model.GetNonShiftGroupMembersResponse{
 			Message: &message,
 			Status:  &status,
+			Errors:  util.ShiftErrors(model.ShiftErrorCodeForbidden, &message),
 			Result:  nil,
 		}, nil
 	}
 
 	if channelID == "" {
-		return nil, fmt.Errorf("channelID is required")
+		return nil, util.NewError(util.ErrorCodeValidation, "channelID is required")
 	}
 
 	if shiftGroupID == "" {
-		return nil, fmt.Errorf("shiftGroupID is required")
+		return nil, util.NewError(util.ErrorCodeValidation, "shiftGroupID is required")
 	}
 
 	// get Shift Group Members By ChannelId And ShiftGroupId
@@ -463,12 +421,13 @@ func (r *queryResolver) GetNonShiftGroupMembers(ctx context.Context, channelID s
 		return &model.GetNonShiftGroupMembersResponse{
 			Message: &message,
 			Status:  &status,
+			Errors:  util.ShiftErrors(util.ShiftErrorCodeOf(err), &message),
 			Result:  nil,
 		}, nil
 	}
 
 	// get all users by is_staff = true
-	users, err := util.GetUsersByIsStaff(true)
+	users, err := util.GetUsersByIsStaff(ctx, true)
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
@@ -479,6 +438,7 @@ func (r *queryResolver) GetNonShiftGroupMembers(ctx context.Context, channelID s
 		return &model.GetNonShiftGroupMembersResponse{
 			Message: &message,
 			Status:  &status,
+			Errors:  util.ShiftErrors(util.ShiftErrorCodeOf(err), &message),
 			Result:  nil,
 		}, nil
 	}
@@ -491,6 +451,7 @@ func (r *queryResolver) GetNonShiftGroupMembers(ctx context.Context, channelID s
 		return &model.GetNonShiftGroupMembersResponse{
 			Message: &message,
 			Status:  &status,
+			Errors:  util.ShiftErrors(model.ShiftErrorCodeNotFound, &message),
 			Result:  nil,
 		}, nil
 	}
@@ -540,45 +501,36 @@ func (r *queryResolver) GetNonShiftGroupMembers(ctx context.Context, channelID s
 
 // GetShiftGroupMembers is the resolver for the getShiftGroupMembers field.
 func (r *queryResolver) GetShiftGroupMembers(ctx context.Context, shiftGroupID string, channel string, authUserID *string) ([]*model.User, error) {
+	authUserID = AuthUserID(ctx, authUserID)
 	if authUserID == nil || *authUserID == string("") {
-		return nil, fmt.Errorf(AUTH_USER_ID_REQUIRED)
+		return nil, util.NewError(util.ErrorCodeUnauthenticated, AUTH_USER_ID_REQUIRED)
 	}
 
 	var err error
 	permission := false
 
 	// validate permission
-	permission, err = util.CheckPermission("shift_group_member", "READ_ALL", *authUserID)
+	permission, err = util.CheckAnyPermission(ctx, "shift_group_member", []string{"READ_ALL", "READ"}, *authUserID)
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
 		return nil, err
 	}
-
-	if !permission {
-		permission, err = util.CheckPermission("shift_group_member", "READ", *authUserID)
-		if err != nil {
-			sentry.CaptureException(err)
-			defer sentry.Flush(2 * time.Second)
-
-			return nil, err
-		}
-	}
 	if !permission {
-		return nil, fmt.Errorf("Permission denied: shift_group_member.READ, shift_group_member.READ_ALL")
+		return nil, util.NewError(util.ErrorCodeForbidden, "Permission denied: shift_group_member.READ, shift_group_member.READ_ALL")
 	}
 
 	// validate the input
 	if shiftGroupID == "" {
-		return nil, fmt.Errorf("shiftGroup Id is required")
+		return nil, util.NewError(util.ErrorCodeValidation, "shiftGroup Id is required")
 	}
 
 	if channel == "" {
-		return nil, fmt.Errorf("channel slug is required")
+		return nil, util.NewError(util.ErrorCodeValidation, "channel slug is required")
 	}
 
-	channelRes, err := util.GetChannelBySlug(channel)
+	channelRes, err := util.GetChannelBySlug(ctx, channel)
 	if err != nil {
 
 		sentry.CaptureException(err)
@@ -588,7 +540,7 @@ func (r *queryResolver) GetShiftGroupMembers(ctx context.Context, shiftGroupID s
 	}
 
 	if &channelRes.Data.Channel.ID == nil || channelRes.Data.Channel.ID == "" {
-		return nil, fmt.Errorf("channel not found")
+		return nil, util.NewError(util.ErrorCodeNotFound, "channel not found")
 	}
 
 	channelId := channelRes.Data.Channel.ID
@@ -604,13 +556,13 @@ func (r *queryResolver) GetShiftGroupMembers(ctx context.Context, shiftGroupID s
 	}
 
 	if len(shiftGroupMembers) == 0 {
-		return nil, fmt.Errorf("No shift group members found")
+		return nil, util.NewError(util.ErrorCodeNotFound, "No shift group members found")
 	}
 
 	// for each shift group member get the user from the user service
 	var users []*model.User
 	for _, shiftGroupMember := range shiftGroupMembers {
-		user, err := util.GetUser(shiftGroupMember.UserID)
+		user, err := util.GetUser(ctx, shiftGroupMember.UserID)
 		if err != nil {
 			sentry.CaptureException(err)
 			defer sentry.Flush(2 * time.Second)
@@ -624,43 +576,34 @@ func (r *queryResolver) GetShiftGroupMembers(ctx context.Context, shiftGroupID s
 
 // GetAllShiftMembers is the resolver for the getAllShiftMembers field.
 func (r *queryResolver) GetAllShiftMembers(ctx context.Context, first *int, last *int, authUserID *string) ([]*model.User, error) {
+	authUserID = AuthUserID(ctx, authUserID)
 	if authUserID == nil || *authUserID == string("") {
-		return nil, fmt.Errorf(AUTH_USER_ID_REQUIRED)
+		return nil, util.NewError(util.ErrorCodeUnauthenticated, AUTH_USER_ID_REQUIRED)
 	}
 
 	var err error
 	permission := false
 
 	// validate permission
-	permission, err = util.CheckPermission("shift_group_member", "READ_ALL", *authUserID)
+	permission, err = util.CheckAnyPermission(ctx, "shift_group_member", []string{"READ_ALL", "READ"}, *authUserID)
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
 		return nil, err
 	}
-
-	if !permission {
-		permission, err = util.CheckPermission("shift_group_member", "READ", *authUserID)
-		if err != nil {
-			sentry.CaptureException(err)
-			defer sentry.Flush(2 * time.Second)
-
-			return nil, err
-		}
-	}
 	if !permission {
-		return nil, fmt.Errorf("Permission denied: shift_group_member.READ, shift_group_member.READ_ALL")
+		return nil, util.NewError(util.ErrorCodeForbidden, "Permission denied: shift_group_member.READ, shift_group_member.READ_ALL")
 	}
 
 	var users []*model.User
 
 	if first != nil {
-		users, err = util.GetUsers(first, nil)
+		users, err = util.GetUsers(ctx, first, nil)
 	} else if last != nil {
-		users, err = util.GetUsers(nil, last)
+		users, err = util.GetUsers(ctx, nil, last)
 	} else {
-		users, err = util.GetUsers(nil, nil)
+		users, err = util.GetUsers(ctx, nil, nil)
 	}
 
 	if err != nil {
@@ -675,6 +618,7 @@ func (r *queryResolver) GetAllShiftMembers(ctx context.Context, first *int, last
 // GetAllUniqueShifts is the resolver for the getAllUniqueShifts field.
 func (r *queryResolver) GetAllUniqueShifts(ctx context.Context, channelID string, shiftGroupID string, authUserID *string) (*model.GetAllUniqueShiftsResponse, error) {
 	var message, status string
+	authUserID = AuthUserID(ctx, authUserID)
 	if authUserID == nil || *authUserID == string("") {
 		message = AUTH_USER_ID_REQUIRED
 		status = "error"
@@ -682,6 +626,7 @@ func (r *queryResolver) GetAllUniqueShifts(ctx context.Context, channelID string
 		return &model.GetAllUniqueShiftsResponse{
 			Message: &message,
 			Status:  &status,
+			Errors:  util.ShiftErrors(model.ShiftErrorCodeUnauthenticated, &message),
 			Result:  nil,
 		}, nil
 	}
@@ -690,7 +635,7 @@ func (r *queryResolver) GetAllUniqueShifts(ctx context.Context, channelID string
 	permission := false
 
 	// validate permission
-	permission, err = util.CheckPermission("shift_group_member", "READ_ALL", *authUserID)
+	permission, err = util.CheckAnyPermission(ctx, "shift_group_member", []string{"READ_ALL", "READ"}, *authUserID)
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
@@ -701,26 +646,10 @@ func (r *queryResolver) GetAllUniqueShifts(ctx context.Context, channelID string
 		return &model.GetAllUniqueShiftsResponse{
 			Message: &message,
 			Status:  &status,
+			Errors:  util.ShiftErrors(util.ShiftErrorCodeOf(err), &message),
 			Result:  nil,
 		}, nil
 	}
-
-	if !permission {
-		permission, err = util.CheckPermission("shift_group_member", "READ", *authUserID)
-		if err != nil {
-			sentry.CaptureException(err)
-			defer sentry.Flush(2 * time.Second)
-
-			message = err.Error()
-			status = "error"
-
-			return &model.GetAllUniqueShiftsResponse{
-				Message: &message,
-				Status:  &status,
-				Result:  nil,
-			}, nil
-		}
-	}
 	if !permission {
 		message = "Permission denied: shift_group_member.READ, shift_group_member.READ_ALL"
 		status = "error"
@@ -728,20 +657,21 @@ func (r *queryResolver) GetAllUniqueShifts(ctx context.Context, channelID string
 		return &model.GetAllUniqueShiftsResponse{
 			Message: &message,
 			Status:  &status,
+			Errors:  util.ShiftErrors(model.ShiftErrorCodeForbidden, &message),
 			Result:  nil,
 		}, nil
 	}
 
 	if channelID == "" {
-		return nil, fmt.Errorf("channelID is required")
+		return nil, util.NewError(util.ErrorCodeValidation, "channelID is required")
 	}
 
 	if shiftGroupID == "" {
-		return nil, fmt.Errorf("shiftGroupID is required")
+		return nil, util.NewError(util.ErrorCodeValidation, "shiftGroupID is required")
 	}
 
 	// get assigned shifts by channel id and shift group id
-	assignedShifts, err := util.GetAssignedShiftsByChannelIDShiftGroupID(&channelID, &shiftGroupID)
+	assignedShifts, err := util.GetAssignedShiftsByChannelIDShiftGroupID(ctx, &channelID, &shiftGroupID)
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
@@ -752,12 +682,13 @@ func (r *queryResolver) GetAllUniqueShifts(ctx context.Context, channelID string
 		return &model.GetAllUniqueShiftsResponse{
 			Message: &message,
 			Status:  &status,
+			Errors:  util.ShiftErrors(util.ShiftErrorCodeOf(err), &message),
 			Result:  nil,
 		}, nil
 	}
 
 	// get open shifts by channel id and shift group id
-	openShifts, err := util.GetOpenShiftsByChannelIDShiftGroupID(&channelID, &shiftGroupID, authUserID)
+	openShifts, err := util.GetOpenShiftsByChannelIDShiftGroupID(ctx, &channelID, &shiftGroupID, authUserID)
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
@@ -768,6 +699,7 @@ func (r *queryResolver) GetAllUniqueShifts(ctx context.Context, channelID string
 		return &model.GetAllUniqueShiftsResponse{
 			Message: &message,
 			Status:  &status,
+			Errors:  util.ShiftErrors(util.ShiftErrorCodeOf(err), &message),
 			Result:  nil,
 		}, nil
 	}
@@ -787,6 +719,7 @@ func (r *queryResolver) GetAllUniqueShifts(ctx context.Context, channelID string
 // GetShiftsByPeople is the resolver for the getShiftsByPeople field.
 func (r *queryResolver) GetShiftsByPeople(ctx context.Context, channelID string, endDate time.Time, filter *model.GetShiftsFilter, shiftGroupID string, startDate time.Time, authUserID *string) (*model.GetShiftsResponse, error) {
 	var message, status string
+	authUserID = AuthUserID(ctx, authUserID)
 	if authUserID == nil || *authUserID == string("") {
 		message = AUTH_USER_ID_REQUIRED
 		status = "error"
@@ -794,6 +727,7 @@ func (r *queryResolver) GetShiftsByPeople(ctx context.Context, channelID string,
 		return &model.GetShiftsResponse{
 			Message: &message,
 			Status:  &status,
+			Errors:  util.ShiftErrors(model.ShiftErrorCodeUnauthenticated, &message),
 			Result:  nil,
 		}, nil
 	}
@@ -802,7 +736,7 @@ func (r *queryResolver) GetShiftsByPeople(ctx context.Context, channelID string,
 	permission := false
 
 	// validate permission
-	permission, err = util.CheckPermission("shift_group_member", "READ_ALL", *authUserID)
+	permission, err = util.CheckAnyPermission(ctx, "shift_group_member", []string{"READ_ALL", "READ"}, *authUserID)
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
@@ -813,27 +747,11 @@ func (r *queryResolver) GetShiftsByPeople(ctx context.Context, channelID string,
 		return &model.GetShiftsResponse{
 			Message: &message,
 			Status:  &status,
+			Errors:  util.ShiftErrors(util.ShiftErrorCodeOf(err), &message),
 			Result:  nil,
 		}, nil
 	}
 
-	if !permission {
-		permission, err = util.CheckPermission("shift_group_member", "READ", *authUserID)
-		if err != nil {
-			sentry.CaptureException(err)
-			defer sentry.Flush(2 * time.Second)
-
-			message = err.Error()
-			status = "error"
-
-			return &model.GetShiftsResponse{
-				Message: &message,
-				Status:  &status,
-				Result:  nil,
-			}, nil
-		}
-	}
-
 	if !permission {
 		message = "Permission denied: shift_group_member.READ, shift_group_member.READ_ALL"
 		status = "error"
@@ -841,24 +759,25 @@ func (r *queryResolver) GetShiftsByPeople(ctx context.Context, channelID string,
 		return &model.GetShiftsResponse{
 			Message: &message,
 			Status:  &status,
+			Errors:  util.ShiftErrors(model.ShiftErrorCodeForbidden, &message),
 			Result:  nil,
 		}, nil
 	}
 
 	if channelID == "" {
-		return nil, fmt.Errorf("channelID is required")
+		return nil, util.NewError(util.ErrorCodeValidation, "channelID is required")
 	}
 
 	if endDate.IsZero() {
-		return nil, fmt.Errorf("endDate is required")
+		return nil, util.NewError(util.ErrorCodeValidation, "endDate is required")
 	}
 
 	if shiftGroupID == "" {
-		return nil, fmt.Errorf("shiftGroupID is required")
+		return nil, util.NewError(util.ErrorCodeValidation, "shiftGroupID is required")
 	}
 
 	if startDate.IsZero() {
-		return nil, fmt.Errorf("startDate is required")
+		return nil, util.NewError(util.ErrorCodeValidation, "startDate is required")
 	}
 
 	var userAssignedShifts []*model.UserAssignedShifts
@@ -874,7 +793,7 @@ func (r *queryResolver) GetShiftsByPeople(ctx context.Context, channelID string,
 		go func() {
 			defer wg.Done()
 			defer close(openShiftsChan)
-			openShifts, err = util.GetOpenShiftsByTime(&channelID, &shiftGroupID, &endDate, &startDate)
+			openShifts, err = util.GetOpenShiftsByTime(ctx, &channelID, &shiftGroupID, &endDate, &startDate)
 			if err != nil {
 				sentry.CaptureException(err)
 				defer sentry.Flush(2 * time.Second)
@@ -898,6 +817,7 @@ func (r *queryResolver) GetShiftsByPeople(ctx context.Context, channelID string,
 			return &model.GetShiftsResponse{
 				Message: &message,
 				Status:  &status,
+				Errors:  util.ShiftErrors(model.ShiftErrorCodeUpstreamUnavailable, &message),
 				Result:  nil,
 			}, nil
 		}
@@ -918,6 +838,7 @@ func (r *queryResolver) GetShiftsByPeople(ctx context.Context, channelID string,
 			return &model.GetShiftsResponse{
 				Message: &message,
 				Status:  &status,
+				Errors:  util.ShiftErrors(util.ShiftErrorCodeOf(err), &message),
 				Result:  nil,
 			}, nil
 		}
@@ -929,13 +850,14 @@ func (r *queryResolver) GetShiftsByPeople(ctx context.Context, channelID string,
 			return &model.GetShiftsResponse{
 				Message: &message,
 				Status:  &status,
+				Errors:  util.ShiftErrors(model.ShiftErrorCodeNotFound, &message),
 				Result:  nil,
 			}, nil
 		}
 
 		for _, shiftGroupMember := range shiftGroupMembers {
 			// get assignedShifts
-			assignedShifts, err = util.GetAssignedShiftsByTime(&channelID, &shiftGroupID, &shiftGroupMember.UserID, &endDate, &startDate)
+			assignedShifts, err = util.GetAssignedShiftsByTime(ctx, &channelID, &shiftGroupID, &shiftGroupMember.UserID, &endDate, &startDate)
 			if err != nil {
 				sentry.CaptureException(err)
 				defer sentry.Flush(2 * time.Second)
@@ -946,6 +868,7 @@ func (r *queryResolver) GetShiftsByPeople(ctx context.Context, channelID string,
 				return &model.GetShiftsResponse{
 					Message: &message,
 					Status:  &status,
+					Errors:  util.ShiftErrors(util.ShiftErrorCodeOf(err), &message),
 					Result:  nil,
 				}, nil
 			}
@@ -956,7 +879,7 @@ func (r *queryResolver) GetShiftsByPeople(ctx context.Context, channelID string,
 			}
 
 			// get the user firstName and lastName from User
-			user, err := util.GetUser(shiftGroupMember.UserID)
+			user, err := util.GetUser(ctx, shiftGroupMember.UserID)
 			if err != nil {
 				sentry.CaptureException(err)
 				defer sentry.Flush(2 * time.Second)
@@ -967,6 +890,7 @@ func (r *queryResolver) GetShiftsByPeople(ctx context.Context, channelID string,
 				return &model.GetShiftsResponse{
 					Message: &message,
 					Status:  &status,
+					Errors:  util.ShiftErrors(util.ShiftErrorCodeOf(err), &message),
 					Result:  nil,
 				}, nil
 			}
@@ -1023,72 +947,62 @@ func (r *queryResolver) GetShiftsByTask(ctx context.Context, channelID string, e
 		message string
 		status  string
 	)
+	authUserID = AuthUserID(ctx, authUserID)
 	if authUserID == nil || *authUserID == string("") {
 		message = AUTH_USER_ID_REQUIRED
 		status = "error"
 
-		return util.GetShiftsByTaskHandleError(&message, &status)
+		return util.GetShiftsByTaskHandleError(&message, &status, model.ShiftErrorCodeUnauthenticated)
 	}
 
 	var err error
 	permission := false
 
 	// validate permission
-	permission, err = util.CheckPermission("shift_group_member", "READ_ALL", *authUserID)
+	permission, err = util.CheckAnyPermission(ctx, "shift_group_member", []string{"READ_ALL", "READ"}, *authUserID)
 	if err != nil {
 
 		message = err.Error()
 		status = "error"
 
-		return util.GetShiftsByTaskHandleError(&message, &status)
-	}
-
-	if !permission {
-		permission, err = util.CheckPermission("shift_group_member", "READ", *authUserID)
-		if err != nil {
-
-			message = err.Error()
-			status = "error"
-
-			return util.GetShiftsByTaskHandleError(&message, &status)
-		}
+		return util.GetShiftsByTaskHandleError(&message, &status, util.ShiftErrorCodeOf(err))
 	}
 	if !permission {
 		message = "Permission denied: shift_group_member.READ, shift_group_member.READ_ALL"
 		status = "error"
 
-		return util.GetShiftsByTaskHandleError(&message, &status)
+		return util.GetShiftsByTaskHandleError(&message, &status, model.ShiftErrorCodeForbidden)
 	}
 
 	if channelID == "" {
-		return nil, fmt.Errorf("channelID is required")
+		return nil, util.NewError(util.ErrorCodeValidation, "channelID is required")
 	}
 
 	if endDate.IsZero() {
-		return nil, fmt.Errorf("endDate is required")
+		return nil, util.NewError(util.ErrorCodeValidation, "endDate is required")
 	}
 
 	if startDate.IsZero() {
-		return nil, fmt.Errorf("startDate is required")
+		return nil, util.NewError(util.ErrorCodeValidation, "startDate is required")
 	}
 
 	var shiftGroups []*model.ShiftGroup
 
 	// get shift groups by channel id
-	shiftGroups, err = util.GetShiftGroups(&channelID, authUserID)
+	shiftGroups, err = util.GetShiftGroups(ctx, &channelID, authUserID)
 	if err != nil {
 
 		message = err.Error()
 		status = "error"
 
-		return util.GetShiftsByTaskHandleError(&message, &status)
+		return util.GetShiftsByTaskHandleError(&message, &status, util.ShiftErrorCodeOf(err))
 	}
 
 	if len(shiftGroups) == 0 {
 		message = "No shift groups found"
 		status = "error"
 
-		return util.GetShiftsByTaskHandleError(&message, &status)
+		return util.GetShiftsByTaskHandleError(&message, &status, model.ShiftErrorCodeNotFound)
 	}
 
 	// title := "Open shifts"
@@ -1123,11 +1037,11 @@ func (r *queryResolver) GetShiftsByTask(ctx context.Context, channelID string, e
 			go func() {
 				defer wg.Done()
 				defer close(openShiftsChan)
-				openShifts, err = util.GetOpenShiftsByTime(&channelID, &shiftGroup.ID, &endDate, &startDate)
+				openShifts, err = util.GetOpenShiftsByTime(ctx, &channelID, &shiftGroup.ID, &endDate, &startDate)
 				if err != nil {
 					message = err.Error()
 					status = "error"
-					util.GetShiftsByTaskHandleError(&message, &status)
+					util.GetShiftsByTaskHandleError(&message, &status, util.ShiftErrorCodeOf(err))
 					return
 				}
 				openShiftsChan <- openShifts
@@ -1140,7 +1054,7 @@ func (r *queryResolver) GetShiftsByTask(ctx context.Context, channelID string, e
 				// Handle timeout
 				message = "Timed out while getting open shifts"
 				status = "error"
-				return util.GetShiftsByTaskHandleError(&message, &status)
+				return util.GetShiftsByTaskHandleError(&message, &status, model.ShiftErrorCodeUpstreamUnavailable)
 			}
 
 		}
@@ -1151,8 +1065,10 @@ func (r *queryResolver) GetShiftsByTask(ctx context.Context, channelID string, e
 			var shiftGroupMembers []*model.ShiftGroupMember
 			err = r.DB.Where("channel_id = ? AND shift_group_id = ?", channelID, shiftGroup.ID).Find(&shiftGroupMembers).Error
 			if err != nil {
+				message = err.Error()
+				status = "error"
 
-				return util.GetShiftsByTaskHandleError(&message, &status)
+				return util.GetShiftsByTaskHandleError(&message, &status, util.ShiftErrorCodeOf(err))
 			}
 
 			// filter shift group members by members
@@ -1162,19 +1078,19 @@ func (r *queryResolver) GetShiftsByTask(ctx context.Context, channelID string, e
 
 					message = err.Error()
 					status = "error"
-					return util.GetShiftsByTaskHandleError(&message, &status)
+					return util.GetShiftsByTaskHandleError(&message, &status, util.ShiftErrorCodeOf(err))
 				}
 			}
 
 			for _, shiftGroupMember := range shiftGroupMembers {
 				// get assigned shifts
-				assignedShifts, err = util.GetAssignedShiftsByTime(&channelID, &shiftGroup.ID, &shiftGroupMember.UserID, &endDate, &startDate)
+				assignedShifts, err = util.GetAssignedShiftsByTime(ctx, &channelID, &shiftGroup.ID, &shiftGroupMember.UserID, &endDate, &startDate)
 				if err != nil {
 
 					message = err.Error()
 					status = "error"
 
-					return util.GetShiftsByTaskHandleError(&message, &status)
+					return util.GetShiftsByTaskHandleError(&message, &status, util.ShiftErrorCodeOf(err))
 				}
 
 				for _, assignedShift := range assignedShifts {
@@ -1184,13 +1100,13 @@ func (r *queryResolver) GetShiftsByTask(ctx context.Context, channelID string, e
 				if filter != nil && *filter.IncludeRequests {
 
 					// get requests
-					requests, err = util.GetRequests(&channelID, &shiftGroupMember.UserID, authUserID)
+					requests, err = util.GetRequests(ctx, &channelID, &shiftGroupMember.UserID, authUserID)
 					if err != nil {
 
 						message = err.Error()
 						status = "error"
 
-						return util.GetShiftsByTaskHandleError(&message, &status)
+						return util.GetShiftsByTaskHandleError(&message, &status, util.ShiftErrorCodeOf(err))
 
 					}
 
@@ -1223,13 +1139,13 @@ func (r *queryResolver) GetShiftsByTask(ctx context.Context, channelID string, e
 				}
 
 				// get the user firstName and lastName from User
-				user, err := util.GetUser(shiftGroupMember.UserID)
+				user, err := util.GetUser(ctx, shiftGroupMember.UserID)
 				if err != nil {
 
 					message = err.Error()
 					status = "error"
 
-					return util.GetShiftsByTaskHandleError(&message, &status)
+					return util.GetShiftsByTaskHandleError(&message, &status, util.ShiftErrorCodeOf(err))
 				}
 
 				avatar := ""
@@ -1285,41 +1201,32 @@ func (r *queryResolver) GetShiftsByTask(ctx context.Context, channelID string, e
 
 // GetShiftGroupMembersList is the resolver for the getShiftGroupMembersList field.
 func (r *queryResolver) GetShiftGroupMembersList(ctx context.Context, channelID string, shiftGroupID string, authUserID *string) ([]*model.ShiftGroupMember, error) {
+	authUserID = AuthUserID(ctx, authUserID)
 	if authUserID == nil || *authUserID == string("") {
-		return nil, fmt.Errorf(AUTH_USER_ID_REQUIRED)
+		return nil, util.NewError(util.ErrorCodeUnauthenticated, AUTH_USER_ID_REQUIRED)
 	}
 
 	var err error
 	permission := false
 
 	// validate permission
-	permission, err = util.CheckPermission("shift_group_member", "READ_ALL", *authUserID)
+	permission, err = util.CheckAnyPermission(ctx, "shift_group_member", []string{"READ_ALL", "READ"}, *authUserID)
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
 		return nil, err
 	}
-
-	if !permission {
-		permission, err = util.CheckPermission("shift_group_member", "READ", *authUserID)
-		if err != nil {
-			sentry.CaptureException(err)
-			defer sentry.Flush(2 * time.Second)
-
-			return nil, err
-		}
-	}
 	if !permission {
-		return nil, fmt.Errorf("Permission denied: shift_group_member.READ, shift_group_member.READ_ALL")
+		return nil, util.NewError(util.ErrorCodeForbidden, "Permission denied: shift_group_member.READ, shift_group_member.READ_ALL")
 	}
 
 	if channelID == "" {
-		return nil, fmt.Errorf("channelID is required")
+		return nil, util.NewError(util.ErrorCodeValidation, "channelID is required")
 	}
 
 	if shiftGroupID == "" {
-		return nil, fmt.Errorf("shiftGroupID is required")
+		return nil, util.NewError(util.ErrorCodeValidation, "shiftGroupID is required")
 	}
 
 	var shiftGroupMembers []*model.ShiftGroupMember
@@ -21,25 +21,55 @@ func (v *Vault) PeriodicallyRenewLeases(
 
 	currentAuthToken := authToken
 	currentDatabaseCredentialsLease := databaseCredentialsLease
+	retryDelay := minimumRetryDelay
 
-	for {
-		renewed, err := v.renewLeases(ctx, currentAuthToken, currentDatabaseCredentialsLease)
-		if err != nil {
-			log.Fatalf("renew error: %v", err) // simplified error handling
+	// failures are reported through LeaseStatus and retried with a growing
+	// delay so that the readiness probe, not a crash, tells the orchestrator
+	retry := func(err error) bool {
+		sentry.CaptureException(err)
+		log.Printf("renew error: %v; retrying in %s", err, retryDelay)
+
+		v.setLeaseStatus(err)
+
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(retryDelay):
 		}
 
+		retryDelay *= 2
+		if retryDelay > maximumRetryDelay {
+			retryDelay = maximumRetryDelay
+		}
+
+		return true
+	}
+
+	for {
+		renewed, err := v.renewLeases(ctx, currentAuthToken, currentDatabaseCredentialsLease)
 		if renewed&exitRequested != 0 {
 			return
 		}
 
+		if err != nil {
+			if !retry(fmt.Errorf("renew error: %w", err)) {
+				return
+			}
+
+			if renewed&renewError != 0 {
+				continue
+			}
+		}
+
 		if renewed&expiringAuthToken != 0 {
 			log.Printf("auth token: can no longer be renewed; will log in again")
 
 			authToken, err := v.login(ctx)
 			if err != nil {
-				sentry.CaptureException(err)
-				defer sentry.Flush(2 * time.Second)
-				log.Fatalf("login authentication error: %v", err) // simplified error handling
+				if !retry(fmt.Errorf("login authentication error: %w", err)) {
+					return
+				}
+				continue
 			}
 
 			currentAuthToken = authToken
@@ -50,22 +80,48 @@ func (v *Vault) PeriodicallyRenewLeases(
 
 			databaseCredentials, databaseCredentialsLease, err := v.GetDatabaseCredentials(ctx)
 			if err != nil {
-				sentry.CaptureException(err)
-				defer sentry.Flush(2 * time.Second)
-				log.Fatalf("database credentials error: %v", err) // simplified error handling
+				if !retry(fmt.Errorf("database credentials error: %w", err)) {
+					return
+				}
+				continue
 			}
 
 			if err := databaseReconnectFunc(ctx, databaseCredentials); err != nil {
-				sentry.CaptureException(err)
-				defer sentry.Flush(2 * time.Second)
-				log.Fatalf("database connection error: %v", err) // simplified error handling
+				if !retry(fmt.Errorf("database connection error: %w", err)) {
+					return
+				}
+				continue
 			}
 
 			currentDatabaseCredentialsLease = databaseCredentialsLease
 		}
+
+		v.setLeaseStatus(nil)
+		retryDelay = minimumRetryDelay
 	}
 }
 
+const (
+	minimumRetryDelay = 1 * time.Second
+	maximumRetryDelay = 1 * time.Minute
+)
+
+// LeaseStatus returns the last renewal error, or nil when the auth token and
+// the database credentials lease are valid
+func (v *Vault) LeaseStatus() error {
+	/* */ v.leaseStatusMutex.Lock()
+	defer v.leaseStatusMutex.Unlock()
+
+	return v.leaseStatus
+}
+
+func (v *Vault) setLeaseStatus(err error) {
+	/* */ v.leaseStatusMutex.Lock()
+	defer v.leaseStatusMutex.Unlock()
+
+	v.leaseStatus = err
+}
+
 // renewResult is a bitmask which could contain one or more of the values below
 type renewResult uint8
 
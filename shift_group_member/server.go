@@ -26,5 +26,11 @@ func main() {
 		log.Fatalf("sentry.Init: %s", err)
 	}
 
+	// go run server.go migrate up | down [steps] | to <version> | status
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		graph.Migrate(os.Args[2:])
+		return
+	}
+
 	graph.Start()
 }
@@ -0,0 +1,194 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/getsentry/sentry-go"
+)
+
+// permission checks are cached for a short time; the permission service
+// publishes permission.changed on grant/revoke to drop them earlier
+const defaultPermissionCacheTTL = 30 * time.Second
+
+type permissionKey struct {
+	userID     string
+	object     string
+	permission string
+}
+
+type permissionCacheEntry struct {
+	allowed   bool
+	expiresAt time.Time
+}
+
+type permissionCache struct {
+	entries map[permissionKey]permissionCacheEntry
+	mutex   sync.Mutex
+	ttl     time.Duration
+	ttlOnce sync.Once
+}
+
+var permissions = &permissionCache{
+	entries: map[permissionKey]permissionCacheEntry{},
+}
+
+// cacheTTL is read lazily because the .env file is loaded after package initialization
+func (c *permissionCache) cacheTTL() time.Duration {
+	c.ttlOnce.Do(func() {
+		c.ttl = defaultPermissionCacheTTL
+		if value := os.Getenv("PERMISSION_CACHE_TTL"); value != "" {
+			seconds, err := strconv.Atoi(value)
+			if err != nil {
+				sentry.CaptureException(fmt.Errorf("unable to convert permission cache ttl to int: %w", err))
+				return
+			}
+			c.ttl = time.Duration(seconds) * time.Second
+		}
+	})
+
+	return c.ttl
+}
+
+func (c *permissionCache) get(key permissionKey) (bool, bool) {
+	/* */ c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok || time.Now().After(entry.expiresAt) {
+		return false, false
+	}
+
+	return entry.allowed, true
+}
+
+func (c *permissionCache) set(key permissionKey, allowed bool) {
+	ttl := c.cacheTTL()
+	if ttl <= 0 {
+		return
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	now := time.Now()
+	// drop the expired entries while holding the lock anyway
+	for k, entry := range c.entries {
+		if now.After(entry.expiresAt) {
+			delete(c.entries, k)
+		}
+	}
+
+	c.entries[key] = permissionCacheEntry{allowed: allowed, expiresAt: now.Add(ttl)}
+}
+
+// InvalidatePermissions drops the cached checks of the user, or of every
+// user when userId is empty
+func InvalidatePermissions(userId string) {
+	/* */ permissions.mutex.Lock()
+	defer permissions.mutex.Unlock()
+
+	for key := range permissions.entries {
+		if userId == "" || key.userID == userId {
+			delete(permissions.entries, key)
+		}
+	}
+}
+
+const checkPermissionsQuery = `
+	query CheckPermissions($checks: [PermissionCheckInput!]!) {
+		checkPermissions(checks: $checks) {
+			object
+			permission
+			allowed
+		}
+	}
+`
+
+type permissionCheckInput struct {
+	NameSpace  string `json:"nameSpace"`
+	UserID     string `json:"userId"`
+	Permission string `json:"permission"`
+	Object     string `json:"object"`
+}
+
+type checkPermissionsResponse struct {
+	CheckPermissions []struct {
+		Object     string `json:"object"`
+		Permission string `json:"permission"`
+		Allowed    bool   `json:"allowed"`
+	} `json:"checkPermissions"`
+}
+
+// CheckPermissions answers several permissions of the user on the object;
+// the ones missing from the cache are checked in a single call
+func CheckPermissions(ctx context.Context, object string, permissionNames []string, userId string) (map[string]bool, error) {
+	result := make(map[string]bool, len(permissionNames))
+
+	var checks []permissionCheckInput
+	for _, permission := range permissionNames {
+		allowed, ok := permissions.get(permissionKey{userID: userId, object: object, permission: permission})
+		if ok {
+			result[permission] = allowed
+			continue
+		}
+
+		checks = append(checks, permissionCheckInput{
+			NameSpace:  os.Getenv("NAMESPACE"),
+			UserID:     userId,
+			Permission: permission,
+			Object:     object,
+		})
+	}
+
+	if len(checks) == 0 {
+		return result, nil
+	}
+
+	var response checkPermissionsResponse
+	err := permissionClient().Do(ctx, checkPermissionsQuery, map[string]interface{}{
+		"checks": checks,
+	}, &response)
+	if err != nil {
+		sentry.CaptureException(err)
+		defer sentry.Flush(2 * time.Second)
+
+		return nil, fmt.Errorf("unable to check permissions: %w", err)
+	}
+
+	for _, check := range response.CheckPermissions {
+		result[check.Permission] = check.Allowed
+		permissions.set(permissionKey{userID: userId, object: check.Object, permission: check.Permission}, check.Allowed)
+	}
+
+	return result, nil
+}
+
+func CheckPermission(ctx context.Context, object string, permission string, userId string) (bool, error) {
+	result, err := CheckPermissions(ctx, object, []string{permission}, userId)
+	if err != nil {
+		return false, err
+	}
+
+	return result[permission], nil
+}
+
+// CheckAnyPermission reports whether the user has at least one of the permissions
+func CheckAnyPermission(ctx context.Context, object string, permissionNames []string, userId string) (bool, error) {
+	result, err := CheckPermissions(ctx, object, permissionNames, userId)
+	if err != nil {
+		return false, err
+	}
+
+	for _, permission := range permissionNames {
+		if result[permission] {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
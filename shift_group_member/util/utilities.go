@@ -1,60 +1,85 @@
 package util
 
 import (
-	"bytes"
-	"encoding/json"
+	"context"
 	"fmt"
-	"io/ioutil"
-	"net/http"
 	"os"
 	"shift_group_members/graph/model"
-	"strconv"
 	"time"
 
 	"github.com/getsentry/sentry-go"
 )
 
-func GetAssignedShiftsByChannelIDShiftGroupIDUserID(channelId *string, shiftGroupId *string, userId *string) ([]*model.AssignedShift, error) {
-
-	jsonMapInstance := map[string]string{
-		"query": `
-		{
-			getAssignedShiftsByChannelIdShiftGroupIdUserId(
-			  channelId: "` + *channelId + `"
-			  shiftGroupId: "` + *shiftGroupId + `"
-			  userId: "` + *userId + `"
-			) {
-			  id
-			  break
-			  label
-			  note
-			  startTime
-			  userId
-			  channelId
-			  shiftGroupId
-			  is24Hours
-			  endTime
-			  isShared
-			  type
-			  isOpen
-			}
-		  }
-		`,
+func assignedShiftClient() *GraphQLClient {
+	return NewGraphQLClient(os.Getenv("ASSIGNED_SHIFT_API"), "")
+}
+
+func openShiftClient() *GraphQLClient {
+	return NewGraphQLClient(os.Getenv("OPEN_SHIFT_API"), "")
+}
+
+func timeOffClient() *GraphQLClient {
+	return NewGraphQLClient(os.Getenv("TIME_OFF_API"), "")
+}
+
+func shiftGroupClient() *GraphQLClient {
+	return NewGraphQLClient(os.Getenv("SHIFT_GROUP_API"), "")
+}
+
+func userAccountClient() *GraphQLClient {
+	return NewGraphQLClient(os.Getenv("USER_ACCOUNT_API"), os.Getenv("DAPR_USER_APP_ID"))
+}
+
+func channelClient() *GraphQLClient {
+	return NewGraphQLClient(os.Getenv("CHANNEL_API"), os.Getenv("DAPR_CHANNEL_APP_ID"))
+}
+
+func requestClient() *GraphQLClient {
+	return NewGraphQLClient(os.Getenv("REQUEST_API"), os.Getenv("DAPR_REQUEST_APP_ID"))
+}
+
+func permissionClient() *GraphQLClient {
+	return NewGraphQLClient(os.Getenv("PERMISSION_API"), os.Getenv("DAPR_PERMISSION_APP_ID"))
+}
+
+const getAssignedShiftsByChannelIDShiftGroupIDUserIDQuery = `
+	query GetAssignedShiftsByChannelIdShiftGroupIdUserId($channelId: ID!, $shiftGroupId: ID!, $userId: ID!) {
+		getAssignedShiftsByChannelIdShiftGroupIdUserId(
+			channelId: $channelId
+			shiftGroupId: $shiftGroupId
+			userId: $userId
+		) {
+			id
+			break
+			label
+			note
+			startTime
+			userId
+			channelId
+			shiftGroupId
+			is24Hours
+			endTime
+			isShared
+			type
+			isOpen
+		}
 	}
+`
 
-	responseData, err := httpRequest("POST", os.Getenv("ASSIGNED_SHIFT_API"), jsonMapInstance)
+func GetAssignedShiftsByChannelIDShiftGroupIDUserID(ctx context.Context, channelId *string, shiftGroupId *string, userId *string) ([]*model.AssignedShift, error) {
+	var responseObject model.GetAssignedShiftsResponse
+	err := assignedShiftClient().Do(ctx, getAssignedShiftsByChannelIDShiftGroupIDUserIDQuery, map[string]interface{}{
+		"channelId":    channelId,
+		"shiftGroupId": shiftGroupId,
+		"userId":       userId,
+	}, &responseObject.Data)
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
-		fmt.Printf("There was an error executing the request%v", err)
-		return nil, err
+		return nil, fmt.Errorf("unable to get assigned shifts: %w", err)
 	}
 
-	// convert the responseData to GetAssignedShiftsResponse
-	var responseObject model.GetAssignedShiftsResponse
-	json.Unmarshal(responseData, &responseObject)
-
 	// map all responseObject GetAssignedShiftsResponse
 	// to AssignedShifts
 	var assignedShifts []*model.AssignedShift
@@ -79,30 +104,27 @@ func GetAssignedShiftsByChannelIDShiftGroupIDUserID(channelId *string, shiftGrou
 	return assignedShifts, nil
 }
 
-func GetAssignedShiftsByChannelIDShiftGroupID(channelId *string, shiftGroupId *string) ([]*model.AssignedShift, error) {
-
-	jsonMapInstance := map[string]string{
-		"query": `
-		{
-			getAssignedShiftsByChannelIdShiftGroupId(
-			  channelId: "` + *channelId + `"
-			  shiftGroupId: "` + *shiftGroupId + `"
-			) {
-			  id
-			  break
-			  label
-			  color
-			  startTime
-			  endTime
-			  is24Hours
-			  userId
-			  channelId
-			  shiftGroupId
-			  type
-			  isOpen
-			  note
-			  isShared
-			  ShiftActivities {
+const getAssignedShiftsByChannelIDShiftGroupIDQuery = `
+	query GetAssignedShiftsByChannelIdShiftGroupId($channelId: ID!, $shiftGroupId: ID!) {
+		getAssignedShiftsByChannelIdShiftGroupId(
+			channelId: $channelId
+			shiftGroupId: $shiftGroupId
+		) {
+			id
+			break
+			label
+			color
+			startTime
+			endTime
+			is24Hours
+			userId
+			channelId
+			shiftGroupId
+			type
+			isOpen
+			note
+			isShared
+			ShiftActivities {
 				id
 				name
 				code
@@ -111,25 +133,24 @@ func GetAssignedShiftsByChannelIDShiftGroupID(channelId *string, shiftGroupId *s
 				endTime
 				userId
 				isPaid
-			  }
 			}
-		  }
-		`,
+		}
 	}
+`
 
-	responseData, err := httpRequest("POST", os.Getenv("ASSIGNED_SHIFT_API"), jsonMapInstance)
+func GetAssignedShiftsByChannelIDShiftGroupID(ctx context.Context, channelId *string, shiftGroupId *string) ([]*model.AssignedShift, error) {
+	var responseObject model.GetUniqueAssignedShiftsResponse
+	err := assignedShiftClient().Do(ctx, getAssignedShiftsByChannelIDShiftGroupIDQuery, map[string]interface{}{
+		"channelId":    channelId,
+		"shiftGroupId": shiftGroupId,
+	}, &responseObject.Data)
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
-		fmt.Printf("There was an error executing the request%v", err)
-		return nil, err
+		return nil, fmt.Errorf("unable to get assigned shifts: %w", err)
 	}
 
-	// convert the responseData to GetAssignedShiftsResponse
-	var responseObject model.GetUniqueAssignedShiftsResponse
-	json.Unmarshal(responseData, &responseObject)
-
 	var assignedShifts []*model.AssignedShift
 	for _, assignedShift := range responseObject.Data.GetAssignedShiftsByChannelIDShiftGroupID {
 		assignedShifts = append(assignedShifts, &model.AssignedShift{
@@ -166,29 +187,26 @@ func GetAssignedShiftsByChannelIDShiftGroupID(channelId *string, shiftGroupId *s
 	return assignedShifts, nil
 }
 
-func GetOpenShiftsByChannelIDShiftGroupID(channelId *string, shiftGroupId *string, authUserId *string) ([]*model.OpenShift, error) {
-
-	jsonMapInstance := map[string]string{
-		"query": `
-		{
-			getOpenShifts(
-			  channelId: "` + *channelId + `"
-			  shiftGroupId: "` + *shiftGroupId + `"
-			  authUserId: "` + *authUserId + `"
-			) {
-			  id
-			  channelId
-			  break
-			  shiftGroupId
-			  color
-			  endTime
-			  startTime
-			  slots
-			  createdAt
-			  is24Hours
-			  label
-			  note
-			  ShiftActivities {
+const getOpenShiftsQuery = `
+	query GetOpenShifts($channelId: ID!, $shiftGroupId: ID!, $authUserId: ID!) {
+		getOpenShifts(
+			channelId: $channelId
+			shiftGroupId: $shiftGroupId
+			authUserId: $authUserId
+		) {
+			id
+			channelId
+			break
+			shiftGroupId
+			color
+			endTime
+			startTime
+			slots
+			createdAt
+			is24Hours
+			label
+			note
+			ShiftActivities {
 				id
 				channelId
 				shiftGroupId
@@ -200,24 +218,25 @@ func GetOpenShiftsByChannelIDShiftGroupID(channelId *string, shiftGroupId *strin
 				color
 				createdAt
 				isPaid
-			  }
 			}
-		  }
-		`,
+		}
 	}
+`
 
-	responseData, err := httpRequest("POST", os.Getenv("OPEN_SHIFT_API"), jsonMapInstance)
+func GetOpenShiftsByChannelIDShiftGroupID(ctx context.Context, channelId *string, shiftGroupId *string, authUserId *string) ([]*model.OpenShift, error) {
+	var responseObject model.GetUniqueOpenShiftsResponse
+	err := openShiftClient().Do(ctx, getOpenShiftsQuery, map[string]interface{}{
+		"channelId":    channelId,
+		"shiftGroupId": shiftGroupId,
+		"authUserId":   authUserId,
+	}, &responseObject.Data)
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
-		fmt.Printf("There was an error executing the request%v", err)
-		return nil, err
+		return nil, fmt.Errorf("unable to get open shifts: %w", err)
 	}
 
-	var responseObject model.GetUniqueOpenShiftsResponse
-	json.Unmarshal(responseData, &responseObject)
-
 	var openShifts []*model.OpenShift
 	for _, openShift := range responseObject.Data.GetOpenShifts {
 		openShifts = append(openShifts, &model.OpenShift{
@@ -256,35 +275,30 @@ func GetOpenShiftsByChannelIDShiftGroupID(channelId *string, shiftGroupId *strin
 	return openShifts, nil
 }
 
-func DeleteAssignedShift(assignedShiftId *string, authUserId *string) (string, error) {
-
-	jsonMapInstance := map[string]string{
-		"query": `
-		mutation {
-			deleteAssignedShift(id: "` + *assignedShiftId + `" authUserId: "` + *authUserId + `") {
-				assignedShift{
-					id
-					label
-				}
+const deleteAssignedShiftMutation = `
+	mutation DeleteAssignedShift($id: ID!, $authUserId: ID!) {
+		deleteAssignedShift(id: $id, authUserId: $authUserId) {
+			assignedShift {
+				id
+				label
 			}
-		  }
-		`,
+		}
 	}
+`
 
-	responseData, err := httpRequest("POST", os.Getenv("ASSIGNED_SHIFT_API"), jsonMapInstance)
+func DeleteAssignedShift(ctx context.Context, assignedShiftId *string, authUserId *string) (string, error) {
+	var responseObject model.AssignedShiftDeleteResponse
+	err := assignedShiftClient().Do(ctx, deleteAssignedShiftMutation, map[string]interface{}{
+		"id":         assignedShiftId,
+		"authUserId": authUserId,
+	}, &responseObject.Data)
 	if err != nil {
-
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
-		fmt.Printf("There was an error executing the request%v", err)
-		return "", err
+		return "", fmt.Errorf("unable to delete assigned shift: %w", err)
 	}
 
-	// convert the responseData to AssignedShiftDeleteResponse
-	var responseObject model.AssignedShiftDeleteResponse
-	json.Unmarshal(responseData, &responseObject)
-
 	if responseObject.Data.DeleteAssignedShift.AssignedShift.ID == "" {
 		return "Failed to delete assigned shift", nil
 	}
@@ -292,29 +306,27 @@ func DeleteAssignedShift(assignedShiftId *string, authUserId *string) (string, e
 
 }
 
-func DeleteTimeOff(channelId *string, shiftGroupId *string, userId *string, authUserId *string) (string, error) {
-
-	jsonMapInstance := map[string]string{
-		"query": `
-		mutation {
-			deleteTimeOffs(channelId: "` + *channelId + `" shiftGroupId: "` + *shiftGroupId + `" userId: "` + *userId + `" authUserId: "` + *authUserId + `")
-		  }
-		`,
+const deleteTimeOffsMutation = `
+	mutation DeleteTimeOffs($channelId: ID!, $shiftGroupId: ID!, $userId: ID!, $authUserId: ID!) {
+		deleteTimeOffs(channelId: $channelId, shiftGroupId: $shiftGroupId, userId: $userId, authUserId: $authUserId)
 	}
+`
 
-	responseData, err := httpRequest("POST", os.Getenv("TIME_OFF_API"), jsonMapInstance)
+func DeleteTimeOff(ctx context.Context, channelId *string, shiftGroupId *string, userId *string, authUserId *string) (string, error) {
+	var responseObject model.TimeOffDeleteResponse
+	err := timeOffClient().Do(ctx, deleteTimeOffsMutation, map[string]interface{}{
+		"channelId":    channelId,
+		"shiftGroupId": shiftGroupId,
+		"userId":       userId,
+		"authUserId":   authUserId,
+	}, &responseObject.Data)
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
-		fmt.Printf("There was an error executing the request%v", err)
-		return "", err
+		return "", fmt.Errorf("unable to delete time off: %w", err)
 	}
 
-	// convert the responseData to TimeOffDeleteResponse
-	var responseObject model.TimeOffDeleteResponse
-	json.Unmarshal(responseData, &responseObject)
-
 	if responseObject.Data.DeleteTimeOffs == "" {
 		return "Failed to delete time off", nil
 	}
@@ -322,44 +334,33 @@ func DeleteTimeOff(channelId *string, shiftGroupId *string, userId *string, auth
 
 }
 
-func GetUser(id string) (*model.User, error) {
-	jsonMapInstance := map[string]string{
-		"query": `
-		{
-			user(id: "` + id + `") {
-			  id
-			  email
-			  firstName
-			  lastName
-			  isStaff
-			  isActive
-			  note
-			  avatar
-			  languageCode
-			  dateJoined
-			}
-		  }
-		`,
-	}
-
-	responseData, err := httpRequest("POST", os.Getenv("USER_ACCOUNT_API"), jsonMapInstance)
-	if err != nil {
-		sentry.CaptureException(err)
-		defer sentry.Flush(2 * time.Second)
-
-		fmt.Printf("There was an error executing the request%v", err)
-		return nil, err
+const getUserQuery = `
+	query GetUser($id: ID) {
+		user(id: $id) {
+			id
+			email
+			firstName
+			lastName
+			isStaff
+			isActive
+			note
+			avatar
+			languageCode
+			dateJoined
+		}
 	}
+`
 
-	// convert into User struct
+func GetUser(ctx context.Context, id string) (*model.User, error) {
 	var userData model.UserResponse
-	err = json.Unmarshal(responseData, &userData)
-
+	err := userAccountClient().Do(ctx, getUserQuery, map[string]interface{}{
+		"id": id,
+	}, &userData.Data)
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
-		fmt.Printf("There was an error unmarshaling the JSON instance %v", err)
+		return nil, fmt.Errorf("unable to get user: %w", err)
 	}
 
 	return &model.User{
@@ -376,84 +377,34 @@ func GetUser(id string) (*model.User, error) {
 	}, nil
 }
 
-func GetUsers(first *int, last *int) ([]*model.User, error) {
-
-	var query string
-	if first != nil {
-		query = `
-		{
-		users(first: ` + strconv.Itoa(*first) + `) {
-		  id
-		  email
-		  firstName
-		  lastName
-		  avatar
-		  dateJoined
-		  isActive
-		  note
-		  languageCode
-		  isStaff
-		}
-	  }
-	`
-	} else if last != nil {
-		query = `
-		{
-		users(last: ` + strconv.Itoa(*last) + `) {
-		  id
-		  email
-		  firstName
-		  lastName
-		  avatar
-		  dateJoined
-		  isActive
-		  note
-		  languageCode
-		  isStaff
+const getUsersQuery = `
+	query GetUsers($first: Int, $last: Int) {
+		users(first: $first, last: $last) {
+			id
+			email
+			firstName
+			lastName
+			avatar
+			dateJoined
+			isActive
+			note
+			languageCode
+			isStaff
 		}
-	  }
-	`
-	} else {
-		query = `
-			{
-			users {
-			  id
-			  email
-			  firstName
-			  lastName
-			  avatar
-			  dateJoined
-			  isActive
-			  note
-			  languageCode
-			  isStaff
-			}
-		  }
-		`
-	}
-
-	jsonMapInstance := map[string]string{
-		"query": query,
 	}
+`
 
-	responseData, err := httpRequest("POST", os.Getenv("USER_ACCOUNT_API"), jsonMapInstance)
-	if err != nil {
-		sentry.CaptureException(err)
-		defer sentry.Flush(2 * time.Second)
-
-		fmt.Printf("There was an error executing the request%v", err)
-		return nil, err
-	}
-
-	// convert into User struct
+func GetUsers(ctx context.Context, first *int, last *int) ([]*model.User, error) {
 	var userData model.GetUsersResponse
-	err = json.Unmarshal(responseData, &userData)
-
+	err := userAccountClient().Do(ctx, getUsersQuery, map[string]interface{}{
+		"first": first,
+		"last":  last,
+	}, &userData.Data)
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
-		fmt.Printf("There was an error unmarshaling the JSON instance %v", err)
+		return nil, fmt.Errorf("unable to get users: %w", err)
 	}
 
 	var users []*model.User
@@ -475,43 +426,33 @@ func GetUsers(first *int, last *int) ([]*model.User, error) {
 	return users, nil
 }
 
-func GetUsersByIsStaff(isStaff bool) ([]*model.User, error) {
-	jsonMapInstance := map[string]string{
-		"query": `
-			{
-			getUserByIsStaff(isStaff: ` + strconv.FormatBool(isStaff) + `) {
-			  id
-			  email
-			  firstName
-			  lastName
-			  avatar
-			  dateJoined
-			  isActive
-			  note
-			  languageCode
-			  isStaff
-			}
-		  }
-		`,
+const getUserByIsStaffQuery = `
+	query GetUserByIsStaff($isStaff: Boolean!) {
+		getUserByIsStaff(isStaff: $isStaff) {
+			id
+			email
+			firstName
+			lastName
+			avatar
+			dateJoined
+			isActive
+			note
+			languageCode
+			isStaff
+		}
 	}
+`
 
-	responseData, err := httpRequest("POST", os.Getenv("USER_ACCOUNT_API"), jsonMapInstance)
-	if err != nil {
-		sentry.CaptureException(err)
-		defer sentry.Flush(2 * time.Second)
-
-		fmt.Printf("There was an error executing the request%v", err)
-		return nil, err
-	}
-	// convert into User struct
+func GetUsersByIsStaff(ctx context.Context, isStaff bool) ([]*model.User, error) {
 	var userData model.UserIsStaffResponse
-	err = json.Unmarshal(responseData, &userData)
-
+	err := userAccountClient().Do(ctx, getUserByIsStaffQuery, map[string]interface{}{
+		"isStaff": isStaff,
+	}, &userData.Data)
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
-		fmt.Printf("There was an error unmarshaling the JSON instance %v", err)
+		return nil, fmt.Errorf("unable to get users: %w", err)
 	}
 
 	var users []*model.User
@@ -536,37 +477,27 @@ func GetUsersByIsStaff(isStaff bool) ([]*model.User, error) {
 /*
 ------------- function for get Shifts by task --------------------
 */
-func GetShiftGroups(channelId *string, authUserId *string) ([]*model.ShiftGroup, error) {
-	jsonMapInstance := map[string]string{
-		"query": `
-		{
-			shiftGroupsByChannel(channelId: "` + *channelId + `" authUserId: "` + *authUserId + `") {
-			  id
-			  channelId
-			  name
-			}
-		  }
-		`,
-	}
-
-	responseData, err := httpRequest("POST", os.Getenv("SHIFT_GROUP_API"), jsonMapInstance)
-	if err != nil {
-		sentry.CaptureException(err)
-		defer sentry.Flush(2 * time.Second)
-
-		fmt.Printf("There was an error executing the request%v", err)
-		return nil, err
+const shiftGroupsByChannelQuery = `
+	query ShiftGroupsByChannel($channelId: ID!, $authUserId: ID!) {
+		shiftGroupsByChannel(channelId: $channelId, authUserId: $authUserId) {
+			id
+			channelId
+			name
+		}
 	}
+`
 
-	// convert into GetShiftGroupResponse struct
+func GetShiftGroups(ctx context.Context, channelId *string, authUserId *string) ([]*model.ShiftGroup, error) {
 	var shiftGroupData model.GetShiftGroupResponse
-	err = json.Unmarshal(responseData, &shiftGroupData)
-
+	err := shiftGroupClient().Do(ctx, shiftGroupsByChannelQuery, map[string]interface{}{
+		"channelId":  channelId,
+		"authUserId": authUserId,
+	}, &shiftGroupData.Data)
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
-		fmt.Printf("There was an error unmarshaling the JSON instance %v", err)
+		return nil, fmt.Errorf("unable to get shift groups: %w", err)
 	}
 
 	var shiftGroups []*model.ShiftGroup
@@ -582,29 +513,26 @@ func GetShiftGroups(channelId *string, authUserId *string) ([]*model.ShiftGroup,
 
 }
 
-func GetOpenShiftsByTime(channelId *string, shiftGroupId *string, endTime *time.Time, startTime *time.Time) ([]*model.OpenShift, error) {
-
-	jsonMapInstance := map[string]string{
-		"query": `
-		{
-			getOpenShiftsByTime(
-			  channelId: "` + *channelId + `"
-			  shiftGroupId: "` + *shiftGroupId + `"
-			  endTime: "` + fmt.Sprintf("%s", endTime.Format(time.RFC3339Nano)) + `"
-			  startTime: "` + fmt.Sprintf("%s", startTime.Format(time.RFC3339Nano)) + `"
-			) {
-			  id
-			  channelId
-			  break
-			  shiftGroupId
-			  color
-			  endTime
-			  startTime
-			  slots
-			  is24Hours
-			  label
-			  note
-			  ShiftActivities {
+const getOpenShiftsByTimeQuery = `
+	query GetOpenShiftsByTime($channelId: ID!, $shiftGroupId: ID!, $endTime: Time!, $startTime: Time!) {
+		getOpenShiftsByTime(
+			channelId: $channelId
+			shiftGroupId: $shiftGroupId
+			endTime: $endTime
+			startTime: $startTime
+		) {
+			id
+			channelId
+			break
+			shiftGroupId
+			color
+			endTime
+			startTime
+			slots
+			is24Hours
+			label
+			note
+			ShiftActivities {
 				id
 				channelId
 				shiftGroupId
@@ -615,24 +543,26 @@ func GetOpenShiftsByTime(channelId *string, shiftGroupId *string, endTime *time.
 				code
 				color
 				isPaid
-			  }
 			}
-		  }
-		`,
+		}
 	}
+`
 
-	responseData, err := httpRequest("POST", os.Getenv("OPEN_SHIFT_API"), jsonMapInstance)
+func GetOpenShiftsByTime(ctx context.Context, channelId *string, shiftGroupId *string, endTime *time.Time, startTime *time.Time) ([]*model.OpenShift, error) {
+	var responseObject model.GetOpenShiftsResponse
+	err := openShiftClient().Do(ctx, getOpenShiftsByTimeQuery, map[string]interface{}{
+		"channelId":    channelId,
+		"shiftGroupId": shiftGroupId,
+		"endTime":      endTime.Format(time.RFC3339Nano),
+		"startTime":    startTime.Format(time.RFC3339Nano),
+	}, &responseObject.Data)
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
-		fmt.Printf("There was an error executing the request%v", err)
-		return nil, err
+		return nil, fmt.Errorf("unable to get open shifts: %w", err)
 	}
 
-	var responseObject model.GetOpenShiftsResponse
-	json.Unmarshal(responseData, &responseObject)
-
 	var openShifts []*model.OpenShift
 
 	// map responseObject into OpenShift struct
@@ -677,34 +607,30 @@ func GetOpenShiftsByTime(channelId *string, shiftGroupId *string, endTime *time.
 	return openShifts, nil
 }
 
-func GetAssignedShiftsByTime(channelId *string, shiftGroupId *string, userId *string, endTime *time.Time, startTime *time.Time) ([]*model.AssignedShift, error) {
-
-	jsonMapInstance := map[string]string{
-		"query": `
-			{
-			getAssignedShiftsByTime(
-			  channelId: "` + *channelId + `"
-			  shiftGroupId: "` + *shiftGroupId + `"
-			  userId: "` + *userId + `"
-			  startTime: "` + fmt.Sprintf("%s", startTime.Format(time.RFC3339)) + `"
-			  endTime: "` + fmt.Sprintf("%s", endTime.Format(time.RFC3339)) + `"
-			) 
-			{
-			  id
-			  break
-			  label
-			  color
-			  startTime
-			  endTime
-			  is24Hours
-			  userId
-			  channelId
-			  shiftGroupId
-			  type
-			  isOpen
-			  note
-			  isShared
-			  ShiftActivities {
+const getAssignedShiftsByTimeQuery = `
+	query GetAssignedShiftsByTime($channelId: ID!, $shiftGroupId: ID!, $userId: ID!, $startTime: Time!, $endTime: Time!) {
+		getAssignedShiftsByTime(
+			channelId: $channelId
+			shiftGroupId: $shiftGroupId
+			userId: $userId
+			startTime: $startTime
+			endTime: $endTime
+		) {
+			id
+			break
+			label
+			color
+			startTime
+			endTime
+			is24Hours
+			userId
+			channelId
+			shiftGroupId
+			type
+			isOpen
+			note
+			isShared
+			ShiftActivities {
 				id
 				name
 				code
@@ -713,25 +639,27 @@ func GetAssignedShiftsByTime(channelId *string, shiftGroupId *string, userId *st
 				endTime
 				userId
 				isPaid
-			  }
 			}
-		  }
-		`,
+		}
 	}
+`
 
-	responseData, err := httpRequest("POST", os.Getenv("ASSIGNED_SHIFT_API"), jsonMapInstance)
-
+func GetAssignedShiftsByTime(ctx context.Context, channelId *string, shiftGroupId *string, userId *string, endTime *time.Time, startTime *time.Time) ([]*model.AssignedShift, error) {
+	var responseObject model.GetAssignedShiftsByTimeResponse
+	err := assignedShiftClient().Do(ctx, getAssignedShiftsByTimeQuery, map[string]interface{}{
+		"channelId":    channelId,
+		"shiftGroupId": shiftGroupId,
+		"userId":       userId,
+		"startTime":    startTime.Format(time.RFC3339),
+		"endTime":      endTime.Format(time.RFC3339),
+	}, &responseObject.Data)
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
-		fmt.Printf("There was an error executing the request%v", err)
-		return nil, err
+		return nil, fmt.Errorf("unable to get assigned shifts: %w", err)
 	}
 
-	var responseObject model.GetAssignedShiftsByTimeResponse
-	json.Unmarshal(responseData, &responseObject)
-
 	var assignedShifts []*model.AssignedShift
 
 	for _, assignedShift := range responseObject.Data.GetAssignedShiftsByTime {
@@ -774,227 +702,124 @@ func DiffHours(date1, date2 time.Time) int {
 	return int(diff)
 }
 
-func httpRequest(method string, url string, query map[string]string) ([]byte, error) {
-	jsonResult, err := json.Marshal(query)
-	newRequest, err := http.NewRequest(method, url, bytes.NewBuffer(jsonResult))
-	if err != nil {
-
-		sentry.CaptureException(err)
-		defer sentry.Flush(2 * time.Second)
-
-		fmt.Printf("There was an error creating the new request %v", err)
-		return nil, err
-	}
-	newRequest.Header.Set("Content-Type", "application/json")
-
-	client := &http.Client{Timeout: time.Second * 5}
-	response, err := client.Do(newRequest)
-
-	if err != nil {
-		sentry.CaptureException(err)
-		defer sentry.Flush(2 * time.Second)
-		fmt.Printf("There was an error executing the request%v", err)
-	}
-
-	// check if the response is nil
-	if response == nil {
-		sentry.CaptureException(err)
-		defer sentry.Flush(2 * time.Second)
-
-		fmt.Println("check the " + url + " url ")
-		return nil, fmt.Errorf("Something went wrong with the request: " + url)
-	}
-
-	defer response.Body.Close()
-	responseData, err := ioutil.ReadAll(response.Body)
-
-	if err != nil {
-		sentry.CaptureException(err)
-		defer sentry.Flush(2 * time.Second)
-
-		fmt.Printf("Data Read Error%v", err)
-	}
-
-	return responseData, nil
-}
-
-func GetChannelBySlug(slug string) (*model.ChannelResPonse, error) {
-	jsonMapInstance := map[string]string{
-		"query": `
-		{
-			channel(slug: "` + slug + `") {
-			  id
-			  slug
-			  name
-			}
-		  }	  
-		`,
-	}
-
-	responseData, err := httpRequest("POST", os.Getenv("CHANNEL_API"), jsonMapInstance)
-	if err != nil {
-		sentry.CaptureException(err)
-		defer sentry.Flush(2 * time.Second)
-
-		fmt.Printf("There was an error executing the request%v", err)
-		return nil, err
+const getChannelBySlugQuery = `
+	query GetChannelBySlug($slug: String) {
+		channel(slug: $slug) {
+			id
+			slug
+			name
+		}
 	}
+`
 
+func GetChannelBySlug(ctx context.Context, slug string) (*model.ChannelResPonse, error) {
 	var channelResponse model.ChannelResPonse
-	err = json.Unmarshal(responseData, &channelResponse)
+	err := channelClient().Do(ctx, getChannelBySlugQuery, map[string]interface{}{
+		"slug": slug,
+	}, &channelResponse.Data)
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
-		fmt.Printf("There was an error unmarshalling the response data%v", err)
+		return nil, fmt.Errorf("unable to get channel: %w", err)
 	}
 
 	return &channelResponse, nil
 }
-func GetChannelById(id string) (*model.ChannelResPonse, error) {
-	jsonMapInstance := map[string]string{
-		"query": `
-		{
-			channel(id: "` + id + `") {
-			  id
-			  slug
-			  name
-			}
-		  }	  
-		`,
-	}
-
-	responseData, err := httpRequest("POST", os.Getenv("CHANNEL_API"), jsonMapInstance)
-	if err != nil {
-		sentry.CaptureException(err)
-		defer sentry.Flush(2 * time.Second)
 
-		fmt.Printf("There was an error executing the request%v", err)
-		return nil, err
+const getChannelByIdQuery = `
+	query GetChannelById($id: ID) {
+		channel(id: $id) {
+			id
+			slug
+			name
+		}
 	}
+`
 
+func GetChannelById(ctx context.Context, id string) (*model.ChannelResPonse, error) {
 	var channelResponse model.ChannelResPonse
-	err = json.Unmarshal(responseData, &channelResponse)
+	err := channelClient().Do(ctx, getChannelByIdQuery, map[string]interface{}{
+		"id": id,
+	}, &channelResponse.Data)
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
-		fmt.Printf("There was an error unmarshalling the response data%v", err)
+		return nil, fmt.Errorf("unable to get channel: %w", err)
 	}
 
 	return &channelResponse, nil
 }
 
-func GetRequests(channelId *string, userId *string, authUserId *string) (*model.GetRequestsResponse, error) {
-	jsonMapInstance := map[string]string{
-		"query": `
-		{
-			getRequestsByUser(
-			  channelId: "` + *channelId + `"
-			  userId: "` + *userId + `"
-			  authUserId: "` + *authUserId + `"
-			) {
-			  edges {
+const getRequestsByUserQuery = `
+	query GetRequestsByUser($channelId: ID!, $userId: ID!, $authUserId: ID!) {
+		getRequestsByUser(
+			channelId: $channelId
+			userId: $userId
+			authUserId: $authUserId
+		) {
+			edges {
 				node {
-				  id
-				  requestId
-				  status
-				  startTime
-				  endTime
-				  requestNote
-				  reason
-				  responseNote
-				  channelId
-				  isAllDay
-				  type
-				  shiftOfferedTo {
-					id
-					firstName
-					lastName
-					email
-				  }
-				  shiftToSwap {
 					id
-					color
-					label
-					note
-				  }
-				  toSwapWith {
-					id
-					color
-					label
-					note
-				  }
+					requestId
+					status
+					startTime
+					endTime
+					requestNote
+					reason
+					responseNote
+					channelId
+					isAllDay
+					type
+					shiftOfferedTo {
+						id
+						firstName
+						lastName
+						email
+					}
+					shiftToSwap {
+						id
+						color
+						label
+						note
+					}
+					toSwapWith {
+						id
+						color
+						label
+						note
+					}
 				}
-			  }
 			}
-		  }
-		`,
-	}
-
-	responseData, err := httpRequest("POST", os.Getenv("REQUEST_API"), jsonMapInstance)
-	if err != nil {
-		sentry.CaptureException(err)
-		defer sentry.Flush(2 * time.Second)
-
-		fmt.Printf("There was an error executing the request%v", err)
-		return nil, err
+		}
 	}
+`
 
+func GetRequests(ctx context.Context, channelId *string, userId *string, authUserId *string) (*model.GetRequestsResponse, error) {
 	var getRequestsResponse model.GetRequestsResponse
-	err = json.Unmarshal(responseData, &getRequestsResponse)
-
+	err := requestClient().Do(ctx, getRequestsByUserQuery, map[string]interface{}{
+		"channelId":  channelId,
+		"userId":     userId,
+		"authUserId": authUserId,
+	}, &getRequestsResponse.Data)
 	if err != nil {
 		sentry.CaptureException(err)
 		defer sentry.Flush(2 * time.Second)
 
-		fmt.Printf("There was an error unmarshalling the response data%v", err)
+		return nil, fmt.Errorf("unable to get requests: %w", err)
 	}
 
 	return &getRequestsResponse, nil
 
 }
 
-func CheckPermission(object string, permission string, userId string) (bool, error) {
-	jsonMapInstance := map[string]string{
-		"query": `
-			query {
-				CheckPermission(
-					NameSpace: ` + os.Getenv("NAMESPACE") + `
-					object: "` + object + `"
-					permission: ` + permission + `
-					userId: "` + userId + `"
-				)
-			}
-		`,
-	}
-
-	responseData, err := httpRequest("POST", os.Getenv("PERMISSION_API"), jsonMapInstance)
-	if err != nil {
-		sentry.CaptureException(err)
-		defer sentry.Flush(2 * time.Second)
-
-		fmt.Printf("There was an error executing the request%v", err)
-		return false, err
-	}
-
-	var permissionResponse model.PermissionResponse
-	err = json.Unmarshal(responseData, &permissionResponse)
-	if err != nil {
-		sentry.CaptureException(err)
-		defer sentry.Flush(2 * time.Second)
-
-		fmt.Printf("Unmarshal Error%v", err)
-	}
-
-	return permissionResponse.Data.CheckPermission, nil
-}
-
-func GetShiftsByTaskHandleError(message *string, status *string) (*model.GetShiftsByTaskResponse, error) {
+func GetShiftsByTaskHandleError(message *string, status *string, code model.ShiftErrorCode) (*model.GetShiftsByTaskResponse, error) {
 	sentry.CaptureException(fmt.Errorf(*message))
 	defer sentry.Flush(2 * time.Second)
 
 	return &model.GetShiftsByTaskResponse{
+		Errors:  ShiftErrors(code, message),
 		Message: message,
 		Status:  status,
 		Result:  nil,
@@ -17,9 +17,12 @@ type DatabaseParameters struct {
 	port     string
 	name     string
 	timeout  time.Duration
+
+	// dsn replaces the connection string built from the fields above
+	dsn string
 }
 
-// DatabaseCredentials is a set of dynamic credentials retrieved from Vault
+// DatabaseCredentials is a set of credentials retrieved from a CredentialsProvider
 type DatabaseCredentials struct {
 	Username string `json:"username"`
 	Password string `json:"password"`
@@ -31,7 +34,7 @@ type Database struct {
 	parameters      DatabaseParameters
 }
 
-// NewDatabase establishes a database connection with the given Vault credentials
+// NewDatabase establishes a database connection with the given credentials
 func NewDatabase(ctx context.Context, parameters DatabaseParameters, credentials DatabaseCredentials) (*Database, error) {
 	database := &Database{
 		connection:      nil,
@@ -75,6 +78,9 @@ func (db *Database) Reconnect(ctx context.Context, credentials DatabaseCredentia
 		credentials.Username,
 		credentials.Password,
 	)
+	if db.parameters.dsn != "" {
+		connectionString = db.parameters.dsn
+	}
 
 	connection, err := gorm.Open(postgres.Open(connectionString), &gorm.Config{
 		SkipDefaultTransaction: true,
@@ -147,6 +153,23 @@ func (db *Database) Close() error {
 	return nil
 }
 
+// Ping verifies that the current connection is still alive
+func (db *Database) Ping(ctx context.Context) error {
+	/* */ db.connectionMutex.Lock()
+	defer db.connectionMutex.Unlock()
+
+	if db.connection == nil {
+		return fmt.Errorf("database connection is not established")
+	}
+
+	sqlDB, err := db.connection.DB()
+	if err != nil {
+		return err
+	}
+
+	return sqlDB.PingContext(ctx)
+}
+
 // share the database connection with other packages by using a global variable
 var dbConn gorm.DB
 
@@ -161,9 +184,3 @@ func (db *Database) GetConnection() {
 func GetOpenConnection() *gorm.DB {
 	return &dbConn
 }
-
-// Use models to create tables
-func CreateTables() {
-	db := GetOpenConnection()
-	db.AutoMigrate()
-}
@@ -710,7 +710,17 @@ enum AddressTypeEnum {
   SHIPPING
 }
 
+"""
+Stable error codes (UNAUTHENTICATED, FORBIDDEN, NOT_FOUND, VALIDATION, CONFLICT,
+UPSTREAM_UNAVAILABLE, INTERNAL), also set as the "code" extension of GraphQL errors
+"""
 enum AccountErrorCode {
+  UNAUTHENTICATED
+  FORBIDDEN
+  VALIDATION
+  CONFLICT
+  UPSTREAM_UNAVAILABLE
+  INTERNAL
   ACTIVATE_OWN_ACCOUNT
   ACTIVATE_SUPERUSER_ACCOUNT
   DUPLICATED_INPUT_ITEM
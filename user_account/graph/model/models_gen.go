@@ -137,8 +137,6 @@ type AddressInput struct {
 	CountryArea *string `json:"countryArea"`
 	// Phone number.
 	Phone *string `json:"phone"`
-
-	
 }
 
 type CountryDisplay struct {
@@ -192,9 +190,17 @@ type UserCountableEdge struct {
 	Cursor string `json:"cursor"`
 }
 
+// Stable error codes (UNAUTHENTICATED, FORBIDDEN, NOT_FOUND, VALIDATION, CONFLICT,
+// UPSTREAM_UNAVAILABLE, INTERNAL), also set as the "code" extension of GraphQL errors
 type AccountErrorCode string
 
 const (
+	AccountErrorCodeUnauthenticated             AccountErrorCode = "UNAUTHENTICATED"
+	AccountErrorCodeForbidden                   AccountErrorCode = "FORBIDDEN"
+	AccountErrorCodeValidation                  AccountErrorCode = "VALIDATION"
+	AccountErrorCodeConflict                    AccountErrorCode = "CONFLICT"
+	AccountErrorCodeUpstreamUnavailable         AccountErrorCode = "UPSTREAM_UNAVAILABLE"
+	AccountErrorCodeInternal                    AccountErrorCode = "INTERNAL"
 	AccountErrorCodeActivateOwnAccount          AccountErrorCode = "ACTIVATE_OWN_ACCOUNT"
 	AccountErrorCodeActivateSuperuserAccount    AccountErrorCode = "ACTIVATE_SUPERUSER_ACCOUNT"
 	AccountErrorCodeDuplicatedInputItem         AccountErrorCode = "DUPLICATED_INPUT_ITEM"
@@ -231,6 +237,12 @@ const (
 )
 
 var AllAccountErrorCode = []AccountErrorCode{
+	AccountErrorCodeUnauthenticated,
+	AccountErrorCodeForbidden,
+	AccountErrorCodeValidation,
+	AccountErrorCodeConflict,
+	AccountErrorCodeUpstreamUnavailable,
+	AccountErrorCodeInternal,
 	AccountErrorCodeActivateOwnAccount,
 	AccountErrorCodeActivateSuperuserAccount,
 	AccountErrorCodeDuplicatedInputItem,
@@ -268,7 +280,7 @@ var AllAccountErrorCode = []AccountErrorCode{
 
 func (e AccountErrorCode) IsValid() bool {
 	switch e {
-	case AccountErrorCodeActivateOwnAccount, AccountErrorCodeActivateSuperuserAccount, AccountErrorCodeDuplicatedInputItem, AccountErrorCodeDeactivateOwnAccount, AccountErrorCodeDeactivateSuperuserAccount, AccountErrorCodeDeleteNonStaffUser, AccountErrorCodeDeleteOwnAccount, AccountErrorCodeDeleteStaffAccount, AccountErrorCodeDeleteSuperuserAccount, AccountErrorCodeGraphqlError, AccountErrorCodeInactive, AccountErrorCodeInvalid, AccountErrorCodeInvalidPassword, AccountErrorCodeLeftNotManageablePermission, AccountErrorCodeInvalidCredentials, AccountErrorCodeNotFound, AccountErrorCodeOutOfScopeUser, AccountErrorCodeOutOfScopeGroup, AccountErrorCodeOutOfScopePermission, AccountErrorCodePasswordEntirelyNumeric, AccountErrorCodePasswordTooCommon, AccountErrorCodePasswordTooShort, AccountErrorCodePasswordTooSimilar, AccountErrorCodeRequired, AccountErrorCodeUnique, AccountErrorCodeJwtSignatureExpired, AccountErrorCodeJwtInvalidToken, AccountErrorCodeJwtDecodeError, AccountErrorCodeJwtMissingToken, AccountErrorCodeJwtInvalidCsrfToken, AccountErrorCodeChannelInactive, AccountErrorCodeMissingChannelSlug, AccountErrorCodeAccountNotConfirmed:
+	case AccountErrorCodeUnauthenticated, AccountErrorCodeForbidden, AccountErrorCodeValidation, AccountErrorCodeConflict, AccountErrorCodeUpstreamUnavailable, AccountErrorCodeInternal, AccountErrorCodeActivateOwnAccount, AccountErrorCodeActivateSuperuserAccount, AccountErrorCodeDuplicatedInputItem, AccountErrorCodeDeactivateOwnAccount, AccountErrorCodeDeactivateSuperuserAccount, AccountErrorCodeDeleteNonStaffUser, AccountErrorCodeDeleteOwnAccount, AccountErrorCodeDeleteStaffAccount, AccountErrorCodeDeleteSuperuserAccount, AccountErrorCodeGraphqlError, AccountErrorCodeInactive, AccountErrorCodeInvalid, AccountErrorCodeInvalidPassword, AccountErrorCodeLeftNotManageablePermission, AccountErrorCodeInvalidCredentials, AccountErrorCodeNotFound, AccountErrorCodeOutOfScopeUser, AccountErrorCodeOutOfScopeGroup, AccountErrorCodeOutOfScopePermission, AccountErrorCodePasswordEntirelyNumeric, AccountErrorCodePasswordTooCommon, AccountErrorCodePasswordTooShort, AccountErrorCodePasswordTooSimilar, AccountErrorCodeRequired, AccountErrorCodeUnique, AccountErrorCodeJwtSignatureExpired, AccountErrorCodeJwtInvalidToken, AccountErrorCodeJwtDecodeError, AccountErrorCodeJwtMissingToken, AccountErrorCodeJwtInvalidCsrfToken, AccountErrorCodeChannelInactive, AccountErrorCodeMissingChannelSlug, AccountErrorCodeAccountNotConfirmed:
 		return true
 	}
 	return false
@@ -5,7 +5,6 @@ import (
 	"account_user/graph/model"
 	"account_user/util"
 	"context"
-	"fmt"
 	"time"
 )
 
@@ -42,7 +41,7 @@ func (r *mutationResolver) AccountRegister(ctx context.Context, input model.Acco
 		errors = append(errors, &model.AccountError{
 			Field:       &field,
 			Message:     &regErr,
-			Code:        model.AccountErrorCodeInvalid,
+			Code:        util.AccountErrorCodeOf(err),
 			AddressType: nil,
 		})
 
@@ -73,7 +72,7 @@ func (r *mutationResolver) AccountUpdate(ctx context.Context, id string, input m
 		errors = append(errors, &model.AccountError{
 			Field:       &field,
 			Message:     &regErr,
-			Code:        model.AccountErrorCodeNotFound,
+			Code:        util.AccountErrorCodeOf(err),
 			AddressType: nil,
 		})
 
@@ -114,7 +113,7 @@ func (r *mutationResolver) AccountUpdate(ctx context.Context, id string, input m
 		errors = append(errors, &model.AccountError{
 			Field:       &field,
 			Message:     &regErr,
-			Code:        model.AccountErrorCodeInvalid,
+			Code:        util.AccountErrorCodeOf(err),
 			AddressType: nil,
 		})
 
@@ -140,7 +139,7 @@ func (r *mutationResolver) AccountRequestDeletion(ctx context.Context, channel *
 // AccountDelete is the resolver for the accountDelete field.
 func (r *mutationResolver) AccountDelete(ctx context.Context, id string) (*model.AccountDelete, error) {
 	if id == "" {
-		return nil, fmt.Errorf("id is required")
+		return nil, util.NewError(util.ErrorCodeValidation, "id is required")
 	}
 
 	// delete user account by id (also delete form Kratos)
@@ -163,7 +162,7 @@ func (r *mutationResolver) AccountDelete(ctx context.Context, id string) (*model
 		errors = append(errors, &model.AccountError{
 			Field:       &field,
 			Message:     &regErr,
-			Code:        model.AccountErrorCodeInvalid,
+			Code:        util.AccountErrorCodeOf(err),
 			AddressType: nil,
 		})
 
@@ -47,6 +47,12 @@ func main() {
 	// Run App with the Kratos Middleware to check the session
 	// mux.Handle("/", app.sessionMiddleware(playground.Handler("GraphQL playground", "/query")))
 
+	// go run server.go migrate up | down [steps] | to <version> | status
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		graph.Migrate(os.Args[2:])
+		return
+	}
+
 	graph.Start()
 
 }